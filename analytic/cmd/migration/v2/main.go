@@ -29,8 +29,10 @@ import (
 	"github.com/ZupIT/horusec-platform/analytic/cmd/migration/v2/enums"
 	dashboardcontroller "github.com/ZupIT/horusec-platform/analytic/internal/controllers/dashboard"
 	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
 	dashboardenums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
 	dashboardrepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/dashboard"
+	ledgerrepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/ledger"
 	dashboardusecases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/dashboard"
 )
 
@@ -51,7 +53,8 @@ func NewAnalyticMigrationV2() *AnalyticMigration {
 	analyticMigration.dashboardController = dashboardcontroller.NewDashboardController(
 		dashboardrepository.NewRepoDashboard(analyticMigration.dbConnectionAnalytic),
 		dashboardrepository.NewWorkspaceDashboard(analyticMigration.dbConnectionAnalytic),
-		analyticMigration.dbConnectionAnalytic, dashboardusecases.NewUseCaseDashboard())
+		ledgerrepository.NewLedgerRepository(analyticMigration.dbConnectionAnalytic),
+		dashboardusecases.NewUseCaseDashboard())
 
 	return analyticMigration
 }
@@ -136,7 +139,9 @@ func (a *AnalyticMigration) setMigrationInSummary(analysisID uuid.UUID, err erro
 	a.summary[enums.SummaryFailed] = append(a.summary[enums.SummaryFailed], message)
 }
 
-func (a *AnalyticMigration) migrateAnalysis(analysis *analysisentities.Analysis) {
+func (a *AnalyticMigration) migrateAnalysis(entity *analysisentities.Analysis) {
+	analysis := ledger.NewRevisedAnalysis(entity, 0)
+
 	a.setMigrationInSummary(analysis.ID, a.dashboardController.AddVulnerabilitiesByAuthor(analysis),
 		dashboardenums.TableVulnerabilitiesByAuthor)
 
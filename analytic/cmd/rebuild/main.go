@@ -0,0 +1,147 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseConfig "github.com/ZupIT/horusec-devkit/pkg/services/database/config"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/google/uuid"
+
+	rebuildController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/rebuild"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/rebuild"
+	rebuildEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/rebuild"
+	ledgerRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/ledger"
+	rebuildRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/rebuild"
+	dashboardUseCases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/dashboard"
+)
+
+// Rebuilds the analytic tables from the analysis stored in the horusec database, it can run while the analytic
+// service keeps consuming new analysis. Example:
+// HORUSEC_DATABASE_SQL_URI=<analytic uri> HORUSEC_DATABASE_HORUSEC_SQL_URI=<horusec uri> \
+// horusec-analytic-rebuild -workspace <id> -repository <id> -start 2021-01-01T00:00:00Z -dry-run
+func main() {
+	scope := parseScope()
+
+	connection := newAnalyticConnection()
+
+	controller := rebuildController.NewRebuildController(newRepository(connection),
+		ledgerRepository.NewLedgerRepository(connection), dashboardUseCases.NewUseCaseDashboard())
+	if scope.DryRun {
+		printResult(controller.DryRun(scope))
+
+		return
+	}
+
+	printResult(controller.Rebuild(rebuild.NewJob(scope)), nil)
+}
+
+func parseScope() *rebuild.Scope {
+	workspaceID := flag.String("workspace", "", "id of the workspace to rebuild, required")
+	repositoryID := flag.String("repository", "", "id of the repository to rebuild, all of the workspace if empty")
+	startTime := flag.String("start", "", "rebuild analysis created from this date, format 2006-01-02T15:04:05Z")
+	endTime := flag.String("end", "", "rebuild analysis created until this date, format 2006-01-02T15:04:05Z")
+	dryRun := flag.Bool("dry-run", false, "only count the analysis and the analytic rows inside the scope")
+	flag.Parse()
+
+	scope := &rebuild.Scope{
+		WorkspaceID:  parseUUID(*workspaceID),
+		RepositoryID: parseUUID(*repositoryID),
+		StartTime:    parseTime(*startTime),
+		EndTime:      parseTime(*endTime),
+		DryRun:       *dryRun,
+	}
+
+	if err := scope.Validate(); err != nil {
+		logger.LogPanic(rebuildEnums.MessageInvalidScope, err)
+	}
+
+	return scope
+}
+
+func parseUUID(value string) uuid.UUID {
+	if value == "" {
+		return uuid.Nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		logger.LogPanic(rebuildEnums.MessageInvalidScope, err)
+	}
+
+	return id
+}
+
+func parseTime(value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		logger.LogPanic(rebuildEnums.MessageInvalidScope, err)
+	}
+
+	return date
+}
+
+func newAnalyticConnection() *database.Connection {
+	connection, err := database.NewDatabaseReadAndWrite(databaseConfig.NewDatabaseConfig())
+	if err != nil {
+		logger.LogPanic(rebuildEnums.MessageFailedToConnectToDatabase, err)
+	}
+
+	return connection
+}
+
+func newRepository(connection *database.Connection) rebuildRepository.IRepository {
+	platformConnection, err := rebuildRepository.NewPlatformConnection()
+	if err != nil {
+		logger.LogPanic(rebuildEnums.MessageFailedToConnectToDatabase, err)
+	}
+
+	repository := rebuildRepository.NewRebuildRepository(connection, platformConnection)
+	if !repository.IsAvailable() {
+		logger.LogPanic(rebuildEnums.MessageFailedToConnectToDatabase, rebuildEnums.ErrorRebuildUnavailable)
+	}
+
+	return repository
+}
+
+func printResult(response *rebuild.JobResponse, err error) {
+	if err != nil {
+		logger.LogPanic(rebuildEnums.MessageFailedToRebuildScope, err)
+	}
+
+	logger.LogWarn(fmt.Sprintf("TOTAL ANALYSIS: %d", response.TotalAnalysis))
+	logger.LogWarn(fmt.Sprintf("TOTAL ANALYSIS REBUILT: %d", response.Processed-response.Failed))
+	logger.LogWarn(fmt.Sprintf("TOTAL ANALYSIS THAT FAILED: %d", response.Failed))
+
+	for table, rows := range response.ExistingRows {
+		logger.LogWarn(fmt.Sprintf("EXISTING ROWS IN %s: %d", table, rows))
+	}
+
+	for _, table := range response.SkippedTables {
+		logger.LogWarn(fmt.Sprintf("SKIPPED TABLE NOT REBUILT: %s", table))
+	}
+
+	for _, message := range response.Errors {
+		logger.LogWarn(fmt.Sprintf("FAILED: %s", message))
+	}
+}
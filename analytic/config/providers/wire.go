@@ -31,12 +31,25 @@ import (
 
 	"github.com/ZupIT/horusec-platform/analytic/config/cors"
 	dashboardController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/dashboard"
+	digestController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/digest"
+	rebuildController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/rebuild"
+	remediationController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/remediation"
 	dashboardEvents "github.com/ZupIT/horusec-platform/analytic/internal/events/dashboard"
+	remediationEvents "github.com/ZupIT/horusec-platform/analytic/internal/events/remediation"
 	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/digest"
 	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/health"
+	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/rebuild"
+	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/remediation"
+	digestJob "github.com/ZupIT/horusec-platform/analytic/internal/jobs/digest"
 	dashboardRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/dashboard"
+	digestRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/digest"
+	ledgerRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/ledger"
+	rebuildRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/rebuild"
+	remediationRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/remediation"
 	"github.com/ZupIT/horusec-platform/analytic/internal/router"
 	dashboardUseCases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/dashboard"
+	remediationUseCases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/remediation"
 )
 
 var devKitProviders = wire.NewSet(
@@ -59,28 +72,45 @@ var configProviders = wire.NewSet(
 var repositoriesProviders = wire.NewSet(
 	dashboardRepository.NewRepoDashboard,
 	dashboardRepository.NewWorkspaceDashboard,
+	ledgerRepository.NewLedgerRepository,
+	remediationRepository.NewRemediationRepository,
+	rebuildRepository.NewPlatformConnection,
+	rebuildRepository.NewRebuildRepository,
+	digestRepository.NewDigestRepository,
 )
 
 var controllersProviders = wire.NewSet(
 	dashboardController.NewDashboardController,
+	remediationController.NewRemediationController,
+	rebuildController.NewRebuildController,
+	digestController.NewDigestController,
 )
 
 var handlersProviders = wire.NewSet(
 	health.NewHealthHandler,
 	dashboard.NewDashboardHandler,
+	remediation.NewRemediationHandler,
+	rebuild.NewRebuildHandler,
+	digest.NewDigestHandler,
 )
 
 var eventsProviders = wire.NewSet(
 	dashboardEvents.NewDashboardEvents,
+	remediationEvents.NewRemediationEvents,
+)
+
+var jobProviders = wire.NewSet(
+	digestJob.NewDigestJob,
 )
 
 var useCasesProviders = wire.NewSet(
 	dashboardUseCases.NewUseCaseDashboard,
+	remediationUseCases.NewUseCaseRemediation,
 )
 
 func Initialize(_ string) (router.IRouter, error) {
 	wire.Build(devKitProviders, configProviders, repositoriesProviders, controllersProviders,
-		handlersProviders, eventsProviders, useCasesProviders)
+		handlersProviders, eventsProviders, jobProviders, useCasesProviders)
 
 	return &router.Router{}, nil
 }
@@ -20,12 +20,25 @@ import (
 
 	"github.com/ZupIT/horusec-platform/analytic/config/cors"
 	dashboard3 "github.com/ZupIT/horusec-platform/analytic/internal/controllers/dashboard"
+	digest2 "github.com/ZupIT/horusec-platform/analytic/internal/controllers/digest"
+	rebuild3 "github.com/ZupIT/horusec-platform/analytic/internal/controllers/rebuild"
+	remediation3 "github.com/ZupIT/horusec-platform/analytic/internal/controllers/remediation"
 	dashboard5 "github.com/ZupIT/horusec-platform/analytic/internal/events/dashboard"
+	remediation5 "github.com/ZupIT/horusec-platform/analytic/internal/events/remediation"
 	dashboard4 "github.com/ZupIT/horusec-platform/analytic/internal/handlers/dashboard"
+	digest3 "github.com/ZupIT/horusec-platform/analytic/internal/handlers/digest"
 	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/health"
+	rebuild4 "github.com/ZupIT/horusec-platform/analytic/internal/handlers/rebuild"
+	remediation4 "github.com/ZupIT/horusec-platform/analytic/internal/handlers/remediation"
+	digest4 "github.com/ZupIT/horusec-platform/analytic/internal/jobs/digest"
 	"github.com/ZupIT/horusec-platform/analytic/internal/repositories/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/repositories/digest"
+	"github.com/ZupIT/horusec-platform/analytic/internal/repositories/ledger"
+	"github.com/ZupIT/horusec-platform/analytic/internal/repositories/rebuild"
+	"github.com/ZupIT/horusec-platform/analytic/internal/repositories/remediation"
 	"github.com/ZupIT/horusec-platform/analytic/internal/router"
 	dashboard2 "github.com/ZupIT/horusec-platform/analytic/internal/usecases/dashboard"
+	remediation2 "github.com/ZupIT/horusec-platform/analytic/internal/usecases/remediation"
 )
 
 // Injectors from wire.go:
@@ -48,11 +61,29 @@ func Initialize(string2 string) (router.IRouter, error) {
 	handler := health.NewHealthHandler(connection, iBroker)
 	iRepoRepository := dashboard.NewRepoDashboard(connection)
 	iWorkspaceRepository := dashboard.NewWorkspaceDashboard(connection)
+	iRepository := ledger.NewLedgerRepository(connection)
 	iUseCases := dashboard2.NewUseCaseDashboard()
-	iController := dashboard3.NewDashboardController(iRepoRepository, iWorkspaceRepository, connection, iUseCases)
+	iController := dashboard3.NewDashboardController(iRepoRepository, iWorkspaceRepository, iRepository, iUseCases)
 	dashboardHandler := dashboard4.NewDashboardHandler(iController)
 	events := dashboard5.NewDashboardEvents(iBroker, iController)
-	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, dashboardHandler, events)
+	remediationIRepository := remediation.NewRemediationRepository(connection)
+	remediationIUseCases := remediation2.NewUseCaseRemediation()
+	remediationIController := remediation3.NewRemediationController(remediationIRepository, remediationIUseCases)
+	remediationHandler := remediation4.NewRemediationHandler(remediationIController)
+	remediationEvents := remediation5.NewRemediationEvents(iBroker, remediationIController)
+	platformConnection, err := rebuild.NewPlatformConnection()
+	if err != nil {
+		return nil, err
+	}
+	rebuildIRepository := rebuild.NewRebuildRepository(connection, platformConnection)
+	rebuildIController := rebuild3.NewRebuildController(rebuildIRepository, iRepository, iUseCases)
+	rebuildHandler := rebuild4.NewRebuildHandler(rebuildIController)
+	digestIRepository := digest.NewDigestRepository(connection, platformConnection)
+	digestIController := digest2.NewDigestController(digestIRepository, iBroker)
+	authServiceClient := proto.NewAuthServiceClient(clientConnInterface)
+	digestHandler := digest3.NewDigestHandler(digestIController, authServiceClient)
+	iJob := digest4.NewDigestJob(digestIController)
+	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, dashboardHandler, events, remediationHandler, remediationEvents, rebuildHandler, digestHandler, iJob)
 	return routerIRouter, nil
 }
 
@@ -62,12 +93,14 @@ var devKitProviders = wire.NewSet(auth.NewAuthGRPCConnection, proto.NewAuthServi
 
 var configProviders = wire.NewSet(cors.NewCorsConfig, router.NewHTTPRouter)
 
-var repositoriesProviders = wire.NewSet(dashboard.NewRepoDashboard, dashboard.NewWorkspaceDashboard)
+var repositoriesProviders = wire.NewSet(dashboard.NewRepoDashboard, dashboard.NewWorkspaceDashboard, ledger.NewLedgerRepository, remediation.NewRemediationRepository, rebuild.NewPlatformConnection, rebuild.NewRebuildRepository, digest.NewDigestRepository)
+
+var controllersProviders = wire.NewSet(dashboard3.NewDashboardController, remediation3.NewRemediationController, rebuild3.NewRebuildController, digest2.NewDigestController)
 
-var controllersProviders = wire.NewSet(dashboard3.NewDashboardController)
+var handlersProviders = wire.NewSet(health.NewHealthHandler, dashboard4.NewDashboardHandler, remediation4.NewRemediationHandler, rebuild4.NewRebuildHandler, digest3.NewDigestHandler)
 
-var handlersProviders = wire.NewSet(health.NewHealthHandler, dashboard4.NewDashboardHandler)
+var eventsProviders = wire.NewSet(dashboard5.NewDashboardEvents, remediation5.NewRemediationEvents)
 
-var eventsProviders = wire.NewSet(dashboard5.NewDashboardEvents)
+var jobProviders = wire.NewSet(digest4.NewDigestJob)
 
-var useCasesProviders = wire.NewSet(dashboard2.NewUseCaseDashboard)
+var useCasesProviders = wire.NewSet(dashboard2.NewUseCaseDashboard, remediation2.NewUseCaseRemediation)
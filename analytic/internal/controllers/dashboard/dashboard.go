@@ -15,63 +15,119 @@
 package dashboard
 
 import (
-	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
-	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"fmt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 
 	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
 	enumsdashboard "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	enumsledger "github.com/ZupIT/horusec-platform/analytic/internal/enums/ledger"
 	repositoriesdashboard "github.com/ZupIT/horusec-platform/analytic/internal/repositories/dashboard"
+	repositoriesledger "github.com/ZupIT/horusec-platform/analytic/internal/repositories/ledger"
 	usecasesdashboard "github.com/ZupIT/horusec-platform/analytic/internal/usecases/dashboard"
 )
 
 type IController interface {
 	GetAllDashboardChartsWorkspace(filter *dashboard.Filter) (*dashboard.Response, error)
 	GetAllDashboardChartsRepository(filter *dashboard.Filter) (*dashboard.Response, error)
-	AddVulnerabilitiesByAuthor(entity *analysis.Analysis) error
-	AddVulnerabilitiesByRepository(entity *analysis.Analysis) error
-	AddVulnerabilitiesByLanguage(entity *analysis.Analysis) error
-	AddVulnerabilitiesByTime(entity *analysis.Analysis) error
+	AddVulnerabilitiesByAuthor(entity *ledger.RevisedAnalysis) error
+	AddVulnerabilitiesByRepository(entity *ledger.RevisedAnalysis) error
+	AddVulnerabilitiesByLanguage(entity *ledger.RevisedAnalysis) error
+	AddVulnerabilitiesByTime(entity *ledger.RevisedAnalysis) error
+	AddVulnerabilitiesBySecurityTool(entity *ledger.RevisedAnalysis) error
+	AddVulnerabilitiesByFile(entity *ledger.RevisedAnalysis) error
+	GetDashboardChartsBySecurityToolWorkspace(filter *dashboard.Filter) (*dashboard.SecurityToolsResponse, error)
+	GetDashboardChartsBySecurityToolRepository(filter *dashboard.Filter) (*dashboard.SecurityToolsResponse, error)
+	GetDashboardChartsByFileWorkspace(filter *dashboard.Filter) (*dashboard.FilesResponse, error)
+	GetDashboardChartsByFileRepository(filter *dashboard.Filter) (*dashboard.FilesResponse, error)
 }
 
 type Controller struct {
 	repoRepository      repositoriesdashboard.IRepoRepository
 	workspaceRepository repositoriesdashboard.IWorkspaceRepository
 	useCases            usecasesdashboard.IUseCases
-	databaseWrite       database.IDatabaseWrite
+	ledgerRepository    repositoriesledger.IRepository
 }
 
 func NewDashboardController(repoRepository repositoriesdashboard.IRepoRepository,
-	workspaceRepository repositoriesdashboard.IWorkspaceRepository, connection *database.Connection,
+	workspaceRepository repositoriesdashboard.IWorkspaceRepository, ledgerRepository repositoriesledger.IRepository,
 	useCases usecasesdashboard.IUseCases) IController {
 	return &Controller{
 		repoRepository:      repoRepository,
 		workspaceRepository: workspaceRepository,
-		databaseWrite:       connection.Write,
+		ledgerRepository:    ledgerRepository,
 		useCases:            useCases,
 	}
 }
 
-func (c *Controller) AddVulnerabilitiesByAuthor(entity *analysis.Analysis) error {
-	return c.databaseWrite.Create(c.useCases.ParseAnalysisToVulnerabilitiesByAuthor(entity),
-		enumsdashboard.TableVulnerabilitiesByAuthor).GetError()
+func (c *Controller) AddVulnerabilitiesByAuthor(entity *ledger.RevisedAnalysis) error {
+	return c.addProjection(entity, enumsdashboard.TableVulnerabilitiesByAuthor)
+}
+
+func (c *Controller) AddVulnerabilitiesByRepository(entity *ledger.RevisedAnalysis) error {
+	return c.addProjection(entity, enumsdashboard.TableVulnerabilitiesByRepository)
+}
+
+func (c *Controller) AddVulnerabilitiesByLanguage(entity *ledger.RevisedAnalysis) error {
+	return c.addProjection(entity, enumsdashboard.TableVulnerabilitiesByLanguage)
+}
+
+func (c *Controller) AddVulnerabilitiesByTime(entity *ledger.RevisedAnalysis) error {
+	return c.addProjection(entity, enumsdashboard.TableVulnerabilitiesByTime)
+}
+
+func (c *Controller) AddVulnerabilitiesBySecurityTool(entity *ledger.RevisedAnalysis) error {
+	return c.addProjection(entity, enumsdashboard.TableVulnerabilitiesBySecurityTool)
 }
 
-func (c *Controller) AddVulnerabilitiesByRepository(entity *analysis.Analysis) error {
-	return c.databaseWrite.Create(c.useCases.ParseAnalysisToVulnerabilitiesByRepository(entity),
-		enumsdashboard.TableVulnerabilitiesByRepository).GetError()
+func (c *Controller) AddVulnerabilitiesByFile(entity *ledger.RevisedAnalysis) error {
+	return c.addProjection(entity, enumsdashboard.TableVulnerabilitiesByFile)
 }
 
-func (c *Controller) AddVulnerabilitiesByLanguage(entity *analysis.Analysis) error {
-	return c.databaseWrite.Create(c.useCases.ParseAnalysisToVulnerabilitiesByLanguage(entity),
-		enumsdashboard.TableVulnerabilitiesByLanguage).GetError()
+// addProjection replaces the rows of a previous revision of the analysis in the table, packets of a revision already
+// projected are ignored. When another consumer projects the same analysis at the same time it is tried again
+func (c *Controller) addProjection(entity *ledger.RevisedAnalysis, table string) (err error) {
+	for attempt := 0; attempt < enumsledger.MaxProjectionAttempts; attempt++ {
+		if err = c.tryAddProjection(entity, table); err == nil {
+			return nil
+		}
+	}
+
+	return err
 }
 
-func (c *Controller) AddVulnerabilitiesByTime(entity *analysis.Analysis) error {
-	return c.databaseWrite.Create(c.useCases.ParseAnalysisToVulnerabilitiesByTime(entity),
-		enumsdashboard.TableVulnerabilitiesByTime).GetError()
+func (c *Controller) tryAddProjection(entity *ledger.RevisedAnalysis, table string) error {
+	previous, err := c.ledgerRepository.GetLedger(entity.ID, table)
+	if err != nil {
+		return err
+	}
+
+	if previous.IsNewerOrEqual(entity.Revision) {
+		logger.LogInfo(fmt.Sprintf(enumsledger.MessageRevisionAlreadyProjected, entity.Revision, entity.ID, table))
+
+		return nil
+	}
+
+	return c.ledgerRepository.SaveProjection(previous, ledger.NewLedger(entity, table),
+		c.useCases.ParseAnalysisToProjection(entity.Analysis, table))
 }
 
 func (c *Controller) GetAllDashboardChartsWorkspace(filter *dashboard.Filter) (*dashboard.Response, error) {
+	response, err := c.getAllDashboardChartsWorkspace(filter)
+	if err != nil || !filter.ComparePreviousPeriod {
+		return response, err
+	}
+
+	previous, err := c.getAllDashboardChartsWorkspace(filter.GetPreviousPeriod())
+	if err != nil {
+		return nil, err
+	}
+
+	return response.SetDeltas(previous, filter), nil
+}
+
+func (c *Controller) getAllDashboardChartsWorkspace(filter *dashboard.Filter) (*dashboard.Response, error) {
 	response := &dashboard.Response{}
 
 	if err := response.SetTotalAuthors(c.workspaceRepository.GetDashboardTotalDevelopers(filter)); err != nil {
@@ -121,6 +177,20 @@ func (c *Controller) getChartByTimeWorkspace(filter *dashboard.Filter,
 }
 
 func (c *Controller) GetAllDashboardChartsRepository(filter *dashboard.Filter) (*dashboard.Response, error) {
+	response, err := c.getAllDashboardChartsRepository(filter)
+	if err != nil || !filter.ComparePreviousPeriod {
+		return response, err
+	}
+
+	previous, err := c.getAllDashboardChartsRepository(filter.GetPreviousPeriod())
+	if err != nil {
+		return nil, err
+	}
+
+	return response.SetDeltas(previous, filter), nil
+}
+
+func (c *Controller) getAllDashboardChartsRepository(filter *dashboard.Filter) (*dashboard.Response, error) {
 	response := &dashboard.Response{}
 
 	if err := response.SetTotalAuthors(c.repoRepository.GetDashboardTotalDevelopers(filter)); err != nil {
@@ -155,3 +225,53 @@ func (c *Controller) getChartByTimeRepository(filter *dashboard.Filter,
 
 	return response, nil
 }
+
+func (c *Controller) GetDashboardChartsBySecurityToolWorkspace(
+	filter *dashboard.Filter) (*dashboard.SecurityToolsResponse, error) {
+	response := &dashboard.SecurityToolsResponse{}
+
+	if err := response.SetChartBySecurityTool(c.workspaceRepository.GetDashboardVulnBySecurityTool(filter)); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (c *Controller) GetDashboardChartsBySecurityToolRepository(
+	filter *dashboard.Filter) (*dashboard.SecurityToolsResponse, error) {
+	response := &dashboard.SecurityToolsResponse{}
+
+	if err := response.SetChartBySecurityTool(c.repoRepository.GetDashboardVulnBySecurityTool(filter)); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (c *Controller) GetDashboardChartsByFileWorkspace(filter *dashboard.Filter) (*dashboard.FilesResponse, error) {
+	response := &dashboard.FilesResponse{}
+
+	if err := response.SetChartByFile(c.workspaceRepository.GetDashboardVulnByFile(filter)); err != nil {
+		return nil, err
+	}
+
+	if err := response.SetChartByDirectory(c.workspaceRepository.GetDashboardVulnByDirectory(filter)); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (c *Controller) GetDashboardChartsByFileRepository(filter *dashboard.Filter) (*dashboard.FilesResponse, error) {
+	response := &dashboard.FilesResponse{}
+
+	if err := response.SetChartByFile(c.repoRepository.GetDashboardVulnByFile(filter)); err != nil {
+		return nil, err
+	}
+
+	if err := response.SetChartByDirectory(c.repoRepository.GetDashboardVulnByDirectory(filter)); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
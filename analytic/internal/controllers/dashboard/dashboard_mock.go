@@ -15,11 +15,11 @@
 package dashboard
 
 import (
-	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
 )
 
 type Mock struct {
@@ -36,22 +36,54 @@ func (m *Mock) GetAllDashboardChartsRepository(_ *dashboard.Filter) (*dashboard.
 	return args.Get(0).(*dashboard.Response), utilsMock.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) AddVulnerabilitiesByAuthor(_ *analysisEntities.Analysis) error {
+func (m *Mock) AddVulnerabilitiesByAuthor(_ *ledger.RevisedAnalysis) error {
 	args := m.MethodCalled("AddVulnerabilitiesByAuthor")
 	return utilsMock.ReturnNilOrError(args, 0)
 }
 
-func (m *Mock) AddVulnerabilitiesByRepository(_ *analysisEntities.Analysis) error {
+func (m *Mock) AddVulnerabilitiesByRepository(_ *ledger.RevisedAnalysis) error {
 	args := m.MethodCalled("AddVulnerabilitiesByRepository")
 	return utilsMock.ReturnNilOrError(args, 0)
 }
 
-func (m *Mock) AddVulnerabilitiesByLanguage(_ *analysisEntities.Analysis) error {
+func (m *Mock) AddVulnerabilitiesByLanguage(_ *ledger.RevisedAnalysis) error {
 	args := m.MethodCalled("AddVulnerabilitiesByLanguage")
 	return utilsMock.ReturnNilOrError(args, 0)
 }
 
-func (m *Mock) AddVulnerabilitiesByTime(_ *analysisEntities.Analysis) error {
+func (m *Mock) AddVulnerabilitiesByTime(_ *ledger.RevisedAnalysis) error {
 	args := m.MethodCalled("AddVulnerabilitiesByTime")
 	return utilsMock.ReturnNilOrError(args, 0)
 }
+
+func (m *Mock) AddVulnerabilitiesBySecurityTool(_ *ledger.RevisedAnalysis) error {
+	args := m.MethodCalled("AddVulnerabilitiesBySecurityTool")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) AddVulnerabilitiesByFile(_ *ledger.RevisedAnalysis) error {
+	args := m.MethodCalled("AddVulnerabilitiesByFile")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) GetDashboardChartsBySecurityToolWorkspace(
+	_ *dashboard.Filter) (*dashboard.SecurityToolsResponse, error) {
+	args := m.MethodCalled("GetDashboardChartsBySecurityToolWorkspace")
+	return args.Get(0).(*dashboard.SecurityToolsResponse), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetDashboardChartsBySecurityToolRepository(
+	_ *dashboard.Filter) (*dashboard.SecurityToolsResponse, error) {
+	args := m.MethodCalled("GetDashboardChartsBySecurityToolRepository")
+	return args.Get(0).(*dashboard.SecurityToolsResponse), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetDashboardChartsByFileWorkspace(_ *dashboard.Filter) (*dashboard.FilesResponse, error) {
+	args := m.MethodCalled("GetDashboardChartsByFileWorkspace")
+	return args.Get(0).(*dashboard.FilesResponse), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetDashboardChartsByFileRepository(_ *dashboard.Filter) (*dashboard.FilesResponse, error) {
+	args := m.MethodCalled("GetDashboardChartsByFileRepository")
+	return args.Get(0).(*dashboard.FilesResponse), utilsMock.ReturnNilOrError(args, 1)
+}
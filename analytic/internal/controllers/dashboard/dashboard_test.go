@@ -17,14 +17,20 @@ package dashboard
 import (
 	"errors"
 	"testing"
+	"time"
 
 	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
-	"github.com/ZupIT/horusec-devkit/pkg/services/database"
-	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+	enumsDashboard "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	enumsLedger "github.com/ZupIT/horusec-platform/analytic/internal/enums/ledger"
 	dashboardRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/dashboard"
+	ledgerRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/ledger"
 	dashboardUseCases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/dashboard"
 )
 
@@ -40,7 +46,7 @@ func TestGetAllDashboardChartsWorkspace(t *testing.T) {
 		repoMock.On("GetDashboardVulnByLanguage").Return([]*dashboard.VulnerabilitiesByLanguage{}, nil)
 		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, nil)
 
-		controller := NewDashboardController(repoMock, repoMock, &database.Connection{},
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
 			dashboardUseCases.NewUseCaseDashboard())
 
 		result, err := controller.GetAllDashboardChartsWorkspace(&dashboard.Filter{})
@@ -60,7 +66,7 @@ func TestGetAllDashboardChartsWorkspace(t *testing.T) {
 		repoMock.On("GetDashboardVulnByTime").Return(
 			[]*dashboard.VulnerabilitiesByTime{}, errors.New("test"))
 
-		controller := NewDashboardController(repoMock, repoMock, &database.Connection{},
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
 			dashboardUseCases.NewUseCaseDashboard())
 
 		result, err := controller.GetAllDashboardChartsWorkspace(&dashboard.Filter{})
@@ -79,7 +85,7 @@ func TestGetAllDashboardChartsWorkspace(t *testing.T) {
 		repoMock.On("GetDashboardVulnByLanguage").Return(
 			[]*dashboard.VulnerabilitiesByLanguage{}, errors.New("test"))
 
-		controller := NewDashboardController(repoMock, repoMock, &database.Connection{},
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
 			dashboardUseCases.NewUseCaseDashboard())
 
 		result, err := controller.GetAllDashboardChartsWorkspace(&dashboard.Filter{})
@@ -97,7 +103,7 @@ func TestGetAllDashboardChartsWorkspace(t *testing.T) {
 		repoMock.On("GetDashboardVulnByRepository").Return(
 			[]*dashboard.VulnerabilitiesByRepository{}, errors.New("test"))
 
-		controller := NewDashboardController(repoMock, repoMock, &database.Connection{},
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
 			dashboardUseCases.NewUseCaseDashboard())
 
 		result, err := controller.GetAllDashboardChartsWorkspace(&dashboard.Filter{})
@@ -114,7 +120,7 @@ func TestGetAllDashboardChartsWorkspace(t *testing.T) {
 		repoMock.On("GetDashboardVulnByAuthor").Return(
 			[]*dashboard.VulnerabilitiesByAuthor{}, errors.New("test"))
 
-		controller := NewDashboardController(repoMock, repoMock, &database.Connection{},
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
 			dashboardUseCases.NewUseCaseDashboard())
 
 		result, err := controller.GetAllDashboardChartsWorkspace(&dashboard.Filter{})
@@ -129,7 +135,7 @@ func TestGetAllDashboardChartsWorkspace(t *testing.T) {
 		repoMock.On("GetDashboardTotalRepositories").Return(0, nil)
 		repoMock.On("GetDashboardVulnBySeverity").Return(&dashboard.Vulnerability{}, errors.New("test"))
 
-		controller := NewDashboardController(repoMock, repoMock, &database.Connection{},
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
 			dashboardUseCases.NewUseCaseDashboard())
 
 		result, err := controller.GetAllDashboardChartsWorkspace(&dashboard.Filter{})
@@ -143,7 +149,7 @@ func TestGetAllDashboardChartsWorkspace(t *testing.T) {
 		repoMock.On("GetDashboardTotalDevelopers").Return(0, nil)
 		repoMock.On("GetDashboardTotalRepositories").Return(0, errors.New("test"))
 
-		controller := NewDashboardController(repoMock, repoMock, &database.Connection{},
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
 			dashboardUseCases.NewUseCaseDashboard())
 
 		result, err := controller.GetAllDashboardChartsWorkspace(&dashboard.Filter{})
@@ -156,68 +162,189 @@ func TestGetAllDashboardChartsWorkspace(t *testing.T) {
 
 		repoMock.On("GetDashboardTotalDevelopers").Return(0, errors.New("test"))
 
-		controller := NewDashboardController(repoMock, repoMock, &database.Connection{},
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
 			dashboardUseCases.NewUseCaseDashboard())
 
 		result, err := controller.GetAllDashboardChartsWorkspace(&dashboard.Filter{})
 		assert.Error(t, err)
 		assert.Nil(t, result)
 	})
-}
 
-func TestAddVulnerabilitiesByAuthor(t *testing.T) {
-	t.Run("should success add vulnerabilities", func(t *testing.T) {
+	t.Run("should return charts with deltas against the previous period", func(t *testing.T) {
 		repoMock := &dashboardRepository.Mock{}
 
-		databaseMock := &database.Mock{}
-		databaseMock.On("Create").Return(&response.Response{})
+		repoMock.On("GetDashboardTotalDevelopers").Return(5, nil).Once()
+		repoMock.On("GetDashboardTotalDevelopers").Return(3, nil)
+		repoMock.On("GetDashboardTotalRepositories").Return(2, nil).Once()
+		repoMock.On("GetDashboardTotalRepositories").Return(4, nil)
+		repoMock.On("GetDashboardVulnBySeverity").Return(&dashboard.Vulnerability{HighVulnerability: 8}, nil).Once()
+		repoMock.On("GetDashboardVulnBySeverity").Return(&dashboard.Vulnerability{HighVulnerability: 10}, nil)
+		repoMock.On("GetDashboardVulnByAuthor").Return([]*dashboard.VulnerabilitiesByAuthor{}, nil)
+		repoMock.On("GetDashboardVulnByRepository").Return([]*dashboard.VulnerabilitiesByRepository{}, nil)
+		repoMock.On("GetDashboardVulnByLanguage").Return([]*dashboard.VulnerabilitiesByLanguage{}, nil)
+		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, nil)
 
-		controller := NewDashboardController(repoMock, repoMock,
-			&database.Connection{Write: databaseMock, Read: databaseMock}, dashboardUseCases.NewUseCaseDashboard())
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
 
-		assert.NoError(t, controller.AddVulnerabilitiesByAuthor(&analysisEntities.Analysis{}))
+		result, err := controller.GetAllDashboardChartsWorkspace(newComparePreviousPeriodFilter())
+		assert.NoError(t, err)
+		assert.Equal(t, 5, result.TotalAuthors)
+		assert.Equal(t, 2, result.Deltas.TotalAuthors)
+		assert.Equal(t, -2, result.Deltas.TotalRepositories)
+		assert.Equal(t, -2, result.Deltas.VulnerabilityBySeverity.High.Count)
+		assert.Equal(t, -2, result.Deltas.VulnerabilityBySeverity.High.Types.Vulnerability)
+		repoMock.AssertNumberOfCalls(t, "GetDashboardVulnByTime", 2)
 	})
-}
 
-func TestAddVulnerabilitiesByLanguage(t *testing.T) {
-	t.Run("should success add vulnerabilities", func(t *testing.T) {
+	t.Run("should return error when getting charts of the previous period", func(t *testing.T) {
 		repoMock := &dashboardRepository.Mock{}
 
-		databaseMock := &database.Mock{}
-		databaseMock.On("Create").Return(&response.Response{})
+		repoMock.On("GetDashboardTotalDevelopers").Return(5, nil).Once()
+		repoMock.On("GetDashboardTotalDevelopers").Return(0, errors.New("test"))
+		repoMock.On("GetDashboardTotalRepositories").Return(0, nil)
+		repoMock.On("GetDashboardVulnBySeverity").Return(&dashboard.Vulnerability{}, nil)
+		repoMock.On("GetDashboardVulnByAuthor").Return([]*dashboard.VulnerabilitiesByAuthor{}, nil)
+		repoMock.On("GetDashboardVulnByRepository").Return([]*dashboard.VulnerabilitiesByRepository{}, nil)
+		repoMock.On("GetDashboardVulnByLanguage").Return([]*dashboard.VulnerabilitiesByLanguage{}, nil)
+		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, nil)
 
-		controller := NewDashboardController(repoMock, repoMock,
-			&database.Connection{Write: databaseMock, Read: databaseMock}, dashboardUseCases.NewUseCaseDashboard())
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
 
-		assert.NoError(t, controller.AddVulnerabilitiesByLanguage(&analysisEntities.Analysis{}))
+		result, err := controller.GetAllDashboardChartsWorkspace(newComparePreviousPeriodFilter())
+		assert.Error(t, err)
+		assert.Nil(t, result)
 	})
 }
 
-func TestAddVulnerabilitiesByRepository(t *testing.T) {
-	t.Run("should success add vulnerabilities", func(t *testing.T) {
-		repoMock := &dashboardRepository.Mock{}
+func newLedgerRepositoryMock(previous *ledger.Ledger) *ledgerRepository.Mock {
+	ledgerMock := &ledgerRepository.Mock{}
+	ledgerMock.On("GetLedger").Return(previous, nil)
+	ledgerMock.On("SaveProjection", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	return ledgerMock
+}
 
-		databaseMock := &database.Mock{}
-		databaseMock.On("Create").Return(&response.Response{})
+func newRevisedAnalysis(revision int) *ledger.RevisedAnalysis {
+	return ledger.NewRevisedAnalysis(&analysisEntities.Analysis{ID: uuid.New(), RepositoryID: uuid.New(),
+		CreatedAt: time.Now()}, revision)
+}
+
+func TestAddVulnerabilities(t *testing.T) {
+	t.Run("should success add vulnerabilities in every table", func(t *testing.T) {
+		ledgerMock := newLedgerRepositoryMock(nil)
 
-		controller := NewDashboardController(repoMock, repoMock,
-			&database.Connection{Write: databaseMock, Read: databaseMock}, dashboardUseCases.NewUseCaseDashboard())
+		controller := NewDashboardController(&dashboardRepository.Mock{}, &dashboardRepository.Mock{}, ledgerMock,
+			dashboardUseCases.NewUseCaseDashboard())
 
-		assert.NoError(t, controller.AddVulnerabilitiesByRepository(&analysisEntities.Analysis{}))
+		assert.NoError(t, controller.AddVulnerabilitiesByAuthor(newRevisedAnalysis(0)))
+		assert.NoError(t, controller.AddVulnerabilitiesByRepository(newRevisedAnalysis(0)))
+		assert.NoError(t, controller.AddVulnerabilitiesByLanguage(newRevisedAnalysis(0)))
+		assert.NoError(t, controller.AddVulnerabilitiesByTime(newRevisedAnalysis(0)))
+		assert.NoError(t, controller.AddVulnerabilitiesBySecurityTool(newRevisedAnalysis(0)))
+		assert.NoError(t, controller.AddVulnerabilitiesByFile(newRevisedAnalysis(0)))
+		ledgerMock.AssertNumberOfCalls(t, "SaveProjection", 6)
 	})
-}
 
-func TestAddVulnerabilitiesByTime(t *testing.T) {
-	t.Run("should success add vulnerabilities", func(t *testing.T) {
-		repoMock := &dashboardRepository.Mock{}
+	t.Run("should create the ledger when the analysis was never projected", func(t *testing.T) {
+		entity := newRevisedAnalysis(0)
+
+		ledgerMock := &ledgerRepository.Mock{}
+		ledgerMock.On("GetLedger").Return((*ledger.Ledger)(nil), nil)
+		ledgerMock.On("SaveProjection", (*ledger.Ledger)(nil), mock.MatchedBy(func(current *ledger.Ledger) bool {
+			return current.AnalysisID == entity.ID && current.Revision == 0 &&
+				current.Projection == enumsDashboard.TableVulnerabilitiesByAuthor
+		}), mock.Anything).Return(nil)
+
+		controller := NewDashboardController(&dashboardRepository.Mock{}, &dashboardRepository.Mock{}, ledgerMock,
+			dashboardUseCases.NewUseCaseDashboard())
+
+		assert.NoError(t, controller.AddVulnerabilitiesByAuthor(entity))
+		ledgerMock.AssertNumberOfCalls(t, "SaveProjection", 1)
+	})
+
+	t.Run("should replace the rows of the previous revision when a newer one is received", func(t *testing.T) {
+		entity := newRevisedAnalysis(2)
+		previous := ledger.NewLedger(newRevisedAnalysis(1), enumsDashboard.TableVulnerabilitiesByTime)
+
+		ledgerMock := &ledgerRepository.Mock{}
+		ledgerMock.On("GetLedger").Return(previous, nil)
+		ledgerMock.On("SaveProjection", previous, mock.MatchedBy(func(current *ledger.Ledger) bool {
+			return current.Revision == 2 && current.CreatedAt.Equal(entity.CreatedAt)
+		}), mock.Anything).Return(nil)
+
+		controller := NewDashboardController(&dashboardRepository.Mock{}, &dashboardRepository.Mock{}, ledgerMock,
+			dashboardUseCases.NewUseCaseDashboard())
+
+		assert.NoError(t, controller.AddVulnerabilitiesByTime(entity))
+		ledgerMock.AssertNumberOfCalls(t, "SaveProjection", 1)
+	})
+
+	t.Run("should ignore a replayed packet of a revision already projected", func(t *testing.T) {
+		entity := newRevisedAnalysis(1)
+		ledgerMock := newLedgerRepositoryMock(ledger.NewLedger(entity, enumsDashboard.TableVulnerabilitiesByTime))
+
+		controller := NewDashboardController(&dashboardRepository.Mock{}, &dashboardRepository.Mock{}, ledgerMock,
+			dashboardUseCases.NewUseCaseDashboard())
+
+		assert.NoError(t, controller.AddVulnerabilitiesByTime(entity))
+		assert.NoError(t, controller.AddVulnerabilitiesByTime(entity))
+		ledgerMock.AssertNotCalled(t, "SaveProjection", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should ignore an out of order packet older than the revision projected", func(t *testing.T) {
+		ledgerMock := newLedgerRepositoryMock(ledger.NewLedger(newRevisedAnalysis(3),
+			enumsDashboard.TableVulnerabilitiesByAuthor))
+
+		controller := NewDashboardController(&dashboardRepository.Mock{}, &dashboardRepository.Mock{}, ledgerMock,
+			dashboardUseCases.NewUseCaseDashboard())
+
+		assert.NoError(t, controller.AddVulnerabilitiesByAuthor(newRevisedAnalysis(0)))
+		assert.NoError(t, controller.AddVulnerabilitiesByAuthor(newRevisedAnalysis(2)))
+		ledgerMock.AssertNotCalled(t, "SaveProjection", mock.Anything, mock.Anything, mock.Anything)
+	})
 
-		databaseMock := &database.Mock{}
-		databaseMock.On("Create").Return(&response.Response{})
+	t.Run("should try again when another consumer changed the ledger", func(t *testing.T) {
+		entity := newRevisedAnalysis(2)
 
-		controller := NewDashboardController(repoMock, repoMock,
-			&database.Connection{Write: databaseMock, Read: databaseMock}, dashboardUseCases.NewUseCaseDashboard())
+		ledgerMock := &ledgerRepository.Mock{}
+		ledgerMock.On("GetLedger").Return((*ledger.Ledger)(nil), nil).Once()
+		ledgerMock.On("GetLedger").Return(ledger.NewLedger(newRevisedAnalysis(1),
+			enumsDashboard.TableVulnerabilitiesByFile), nil)
+		ledgerMock.On("SaveProjection", mock.Anything, mock.Anything, mock.Anything).Return(
+			enumsLedger.ErrorLedgerConflict).Once()
+		ledgerMock.On("SaveProjection", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
-		assert.NoError(t, controller.AddVulnerabilitiesByTime(&analysisEntities.Analysis{}))
+		controller := NewDashboardController(&dashboardRepository.Mock{}, &dashboardRepository.Mock{}, ledgerMock,
+			dashboardUseCases.NewUseCaseDashboard())
+
+		assert.NoError(t, controller.AddVulnerabilitiesByFile(entity))
+		ledgerMock.AssertNumberOfCalls(t, "GetLedger", 2)
+		ledgerMock.AssertNumberOfCalls(t, "SaveProjection", 2)
+	})
+
+	t.Run("should return error when failed to save after all attempts", func(t *testing.T) {
+		ledgerMock := &ledgerRepository.Mock{}
+		ledgerMock.On("GetLedger").Return((*ledger.Ledger)(nil), nil)
+		ledgerMock.On("SaveProjection", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("test"))
+
+		controller := NewDashboardController(&dashboardRepository.Mock{}, &dashboardRepository.Mock{}, ledgerMock,
+			dashboardUseCases.NewUseCaseDashboard())
+
+		assert.Error(t, controller.AddVulnerabilitiesBySecurityTool(newRevisedAnalysis(0)))
+		ledgerMock.AssertNumberOfCalls(t, "SaveProjection", enumsLedger.MaxProjectionAttempts)
+	})
+
+	t.Run("should return error when failed to get the ledger", func(t *testing.T) {
+		ledgerMock := &ledgerRepository.Mock{}
+		ledgerMock.On("GetLedger").Return((*ledger.Ledger)(nil), errors.New("test"))
+
+		controller := NewDashboardController(&dashboardRepository.Mock{}, &dashboardRepository.Mock{}, ledgerMock,
+			dashboardUseCases.NewUseCaseDashboard())
+
+		assert.Error(t, controller.AddVulnerabilitiesByLanguage(newRevisedAnalysis(0)))
+		ledgerMock.AssertNotCalled(t, "SaveProjection", mock.Anything, mock.Anything, mock.Anything)
 	})
 }
 
@@ -231,7 +358,7 @@ func TestGetAllDashboardChartsRepository(t *testing.T) {
 		repoMock.On("GetDashboardVulnByLanguage").Return([]*dashboard.VulnerabilitiesByLanguage{}, nil)
 		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, nil)
 
-		controller := NewDashboardController(repoMock, repoMock, &database.Connection{},
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
 			dashboardUseCases.NewUseCaseDashboard())
 
 		result, err := controller.GetAllDashboardChartsRepository(&dashboard.Filter{})
@@ -249,7 +376,7 @@ func TestGetAllDashboardChartsRepository(t *testing.T) {
 		repoMock.On("GetDashboardVulnByTime").Return(
 			[]*dashboard.VulnerabilitiesByTime{}, errors.New("test"))
 
-		controller := NewDashboardController(repoMock, repoMock, &database.Connection{},
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
 			dashboardUseCases.NewUseCaseDashboard())
 
 		result, err := controller.GetAllDashboardChartsRepository(&dashboard.Filter{})
@@ -266,7 +393,7 @@ func TestGetAllDashboardChartsRepository(t *testing.T) {
 		repoMock.On("GetDashboardVulnByLanguage").Return(
 			[]*dashboard.VulnerabilitiesByLanguage{}, errors.New("test"))
 
-		controller := NewDashboardController(repoMock, repoMock, &database.Connection{},
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
 			dashboardUseCases.NewUseCaseDashboard())
 
 		result, err := controller.GetAllDashboardChartsRepository(&dashboard.Filter{})
@@ -282,7 +409,7 @@ func TestGetAllDashboardChartsRepository(t *testing.T) {
 		repoMock.On("GetDashboardVulnByAuthor").Return(
 			[]*dashboard.VulnerabilitiesByAuthor{}, errors.New("test"))
 
-		controller := NewDashboardController(repoMock, repoMock, &database.Connection{},
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
 			dashboardUseCases.NewUseCaseDashboard())
 
 		result, err := controller.GetAllDashboardChartsRepository(&dashboard.Filter{})
@@ -296,7 +423,7 @@ func TestGetAllDashboardChartsRepository(t *testing.T) {
 		repoMock.On("GetDashboardTotalDevelopers").Return(0, nil)
 		repoMock.On("GetDashboardVulnBySeverity").Return(&dashboard.Vulnerability{}, errors.New("test"))
 
-		controller := NewDashboardController(repoMock, repoMock, &database.Connection{},
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
 			dashboardUseCases.NewUseCaseDashboard())
 
 		result, err := controller.GetAllDashboardChartsRepository(&dashboard.Filter{})
@@ -309,11 +436,224 @@ func TestGetAllDashboardChartsRepository(t *testing.T) {
 
 		repoMock.On("GetDashboardTotalDevelopers").Return(0, errors.New("test"))
 
-		controller := NewDashboardController(repoMock, repoMock, &database.Connection{},
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
 			dashboardUseCases.NewUseCaseDashboard())
 
 		result, err := controller.GetAllDashboardChartsRepository(&dashboard.Filter{})
 		assert.Error(t, err)
 		assert.Nil(t, result)
 	})
+
+	t.Run("should return charts with deltas against the previous period", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardTotalDevelopers").Return(1, nil).Once()
+		repoMock.On("GetDashboardTotalDevelopers").Return(3, nil)
+		repoMock.On("GetDashboardVulnBySeverity").Return(&dashboard.Vulnerability{}, nil)
+		repoMock.On("GetDashboardVulnByAuthor").Return([]*dashboard.VulnerabilitiesByAuthor{}, nil)
+		repoMock.On("GetDashboardVulnByLanguage").Return([]*dashboard.VulnerabilitiesByLanguage{}, nil)
+		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, nil)
+
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetAllDashboardChartsRepository(newComparePreviousPeriodFilter())
+		assert.NoError(t, err)
+		assert.Equal(t, -2, result.Deltas.TotalAuthors)
+		repoMock.AssertNumberOfCalls(t, "GetDashboardTotalDevelopers", 2)
+	})
+
+	t.Run("should not return deltas when not comparing with previous period", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardTotalDevelopers").Return(1, nil)
+		repoMock.On("GetDashboardVulnBySeverity").Return(&dashboard.Vulnerability{}, nil)
+		repoMock.On("GetDashboardVulnByAuthor").Return([]*dashboard.VulnerabilitiesByAuthor{}, nil)
+		repoMock.On("GetDashboardVulnByLanguage").Return([]*dashboard.VulnerabilitiesByLanguage{}, nil)
+		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, nil)
+
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetAllDashboardChartsRepository(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Nil(t, result.Deltas)
+		repoMock.AssertNumberOfCalls(t, "GetDashboardTotalDevelopers", 1)
+	})
+
+	t.Run("should return error when getting charts of the previous period", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardTotalDevelopers").Return(1, nil)
+		repoMock.On("GetDashboardVulnBySeverity").Return(&dashboard.Vulnerability{}, nil)
+		repoMock.On("GetDashboardVulnByAuthor").Return([]*dashboard.VulnerabilitiesByAuthor{}, nil)
+		repoMock.On("GetDashboardVulnByLanguage").Return([]*dashboard.VulnerabilitiesByLanguage{}, nil)
+		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, nil).Once()
+		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, errors.New("test"))
+
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetAllDashboardChartsRepository(newComparePreviousPeriodFilter())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func newComparePreviousPeriodFilter() *dashboard.Filter {
+	return &dashboard.Filter{
+		StartTime:             time.Date(2021, 1, 11, 0, 0, 0, 0, time.UTC),
+		EndTime:               time.Date(2021, 1, 20, 23, 59, 59, 0, time.UTC),
+		ComparePreviousPeriod: true,
+	}
+}
+
+func TestGetDashboardChartsBySecurityToolWorkspace(t *testing.T) {
+	t.Run("should return security tool charts without errors", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardVulnBySecurityTool").Return(
+			[]*dashboard.VulnerabilitiesBySecurityTool{{SecurityTool: tools.GoSec}}, nil)
+
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetDashboardChartsBySecurityToolWorkspace(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Len(t, result.VulnerabilitiesBySecurityTool, 1)
+		assert.Equal(t, tools.GoSec, result.VulnerabilitiesBySecurityTool[0].SecurityTool)
+	})
+
+	t.Run("should return error when getting vuln by security tool", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardVulnBySecurityTool").Return(
+			[]*dashboard.VulnerabilitiesBySecurityTool{}, errors.New("test"))
+
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetDashboardChartsBySecurityToolWorkspace(&dashboard.Filter{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestGetDashboardChartsByFileWorkspace(t *testing.T) {
+	t.Run("should return file and directory charts without errors", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardVulnByFile").Return([]*dashboard.VulnerabilitiesByFile{{File: "a/b.go"}}, nil)
+		repoMock.On("GetDashboardVulnByDirectory").Return([]*dashboard.VulnerabilitiesByFile{{Directory: "a"}}, nil)
+
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetDashboardChartsByFileWorkspace(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Equal(t, "a/b.go", result.VulnerabilitiesByFile[0].File)
+		assert.Equal(t, "a", result.VulnerabilitiesByDirectory[0].Directory)
+	})
+
+	t.Run("should return error when getting vuln by directory", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardVulnByFile").Return([]*dashboard.VulnerabilitiesByFile{}, nil)
+		repoMock.On("GetDashboardVulnByDirectory").Return([]*dashboard.VulnerabilitiesByFile{}, errors.New("test"))
+
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetDashboardChartsByFileWorkspace(&dashboard.Filter{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when getting vuln by file", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardVulnByFile").Return([]*dashboard.VulnerabilitiesByFile{}, errors.New("test"))
+
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetDashboardChartsByFileWorkspace(&dashboard.Filter{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestGetDashboardChartsBySecurityToolRepository(t *testing.T) {
+	t.Run("should return security tool charts without errors", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardVulnBySecurityTool").Return(
+			[]*dashboard.VulnerabilitiesBySecurityTool{{SecurityTool: tools.GoSec}}, nil)
+
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetDashboardChartsBySecurityToolRepository(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Len(t, result.VulnerabilitiesBySecurityTool, 1)
+		assert.Equal(t, tools.GoSec, result.VulnerabilitiesBySecurityTool[0].SecurityTool)
+	})
+
+	t.Run("should return error when getting vuln by security tool", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardVulnBySecurityTool").Return(
+			[]*dashboard.VulnerabilitiesBySecurityTool{}, errors.New("test"))
+
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetDashboardChartsBySecurityToolRepository(&dashboard.Filter{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestGetDashboardChartsByFileRepository(t *testing.T) {
+	t.Run("should return file and directory charts without errors", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardVulnByFile").Return([]*dashboard.VulnerabilitiesByFile{{File: "a/b.go"}}, nil)
+		repoMock.On("GetDashboardVulnByDirectory").Return([]*dashboard.VulnerabilitiesByFile{{Directory: "a"}}, nil)
+
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetDashboardChartsByFileRepository(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Equal(t, "a/b.go", result.VulnerabilitiesByFile[0].File)
+		assert.Equal(t, "a", result.VulnerabilitiesByDirectory[0].Directory)
+	})
+
+	t.Run("should return error when getting vuln by directory", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardVulnByFile").Return([]*dashboard.VulnerabilitiesByFile{}, nil)
+		repoMock.On("GetDashboardVulnByDirectory").Return([]*dashboard.VulnerabilitiesByFile{}, errors.New("test"))
+
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetDashboardChartsByFileRepository(&dashboard.Filter{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when getting vuln by file", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardVulnByFile").Return([]*dashboard.VulnerabilitiesByFile{}, errors.New("test"))
+
+		controller := NewDashboardController(repoMock, repoMock, &ledgerRepository.Mock{},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetDashboardChartsByFileRepository(&dashboard.Filter{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
 }
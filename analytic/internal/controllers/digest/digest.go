@@ -0,0 +1,173 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/queues"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/digest"
+	digestEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/digest"
+	digestRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/digest"
+)
+
+type IController interface {
+	IsEnabled() bool
+	GetSubscription(accountID, workspaceID uuid.UUID) (*digest.Subscription, error)
+	SaveSubscription(data *digest.SubscriptionData) (*digest.Subscription, error)
+	DeleteSubscription(accountID, workspaceID uuid.UUID) error
+	SendDigests() error
+}
+
+type Controller struct {
+	repository     digestRepository.IRepository
+	broker         broker.IBroker
+	emailsDisabled bool
+}
+
+func NewDigestController(repository digestRepository.IRepository, brokerLib broker.IBroker) IController {
+	return &Controller{
+		repository:     repository,
+		broker:         brokerLib,
+		emailsDisabled: env.GetEnvOrDefaultBool(digestEnums.EnvDisableEmails, false),
+	}
+}
+
+// IsEnabled checks if the digests can be sent, which needs the emails enabled and the platform database
+func (c *Controller) IsEnabled() bool {
+	return !c.emailsDisabled && c.repository.IsAvailable()
+}
+
+func (c *Controller) GetSubscription(accountID, workspaceID uuid.UUID) (*digest.Subscription, error) {
+	subscription, err := c.repository.GetSubscription(accountID, workspaceID)
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return nil, digestEnums.ErrorSubscriptionNotFound
+	}
+
+	return subscription, err
+}
+
+func (c *Controller) SaveSubscription(data *digest.SubscriptionData) (*digest.Subscription, error) {
+	if err := c.repository.SaveSubscription(data.ToSubscription()); err != nil {
+		return nil, err
+	}
+
+	return c.GetSubscription(data.AccountID, data.WorkspaceID)
+}
+
+func (c *Controller) DeleteSubscription(accountID, workspaceID uuid.UUID) error {
+	return c.repository.DeleteSubscription(accountID, workspaceID)
+}
+
+// SendDigests sends the digest of the subscriptions with a whole period since the last one sent, a failure in one
+// of them does not stop the others
+func (c *Controller) SendDigests() error {
+	subscriptions, err := c.repository.ListSubscriptions()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for _, subscription := range subscriptions {
+		if subscription.IsDue(now) {
+			c.sendDigest(subscription, now)
+		}
+	}
+
+	return nil
+}
+
+func (c *Controller) sendDigest(subscription *digest.Subscription, now time.Time) {
+	if err := c.trySendDigest(subscription, now); err != nil {
+		logger.LogError(fmt.Sprintf(digestEnums.MessageFailedToSendDigest, subscription.WorkspaceID,
+			subscription.AccountID), err)
+	}
+}
+
+func (c *Controller) trySendDigest(subscription *digest.Subscription, now time.Time) error {
+	recipient, err := c.repository.GetRecipient(subscription.AccountID, subscription.WorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	if recipient == nil {
+		return c.removeSubscription(subscription)
+	}
+
+	entity, err := c.buildDigest(subscription, recipient, now)
+	if err != nil {
+		return err
+	}
+
+	return c.publishDigest(subscription, entity, now)
+}
+
+func (c *Controller) removeSubscription(subscription *digest.Subscription) error {
+	logger.LogInfo(fmt.Sprintf(digestEnums.MessageRemovedSubscription, subscription.AccountID,
+		subscription.WorkspaceID))
+
+	return c.repository.DeleteSubscription(subscription.AccountID, subscription.WorkspaceID)
+}
+
+func (c *Controller) buildDigest(subscription *digest.Subscription, recipient *digest.Recipient,
+	now time.Time) (*digest.Digest, error) {
+	filter := subscription.GetFilter(now)
+	entity := digest.NewDigest(subscription, recipient, filter)
+
+	if err := entity.SetNewFindings(c.repository.ListNewFindings(filter)); err != nil {
+		return nil, err
+	}
+
+	if err := entity.SetFixedFindings(c.repository.ListFixedFindings(filter)); err != nil {
+		return nil, err
+	}
+
+	if err := entity.SetTopRepositories(c.repository.ListTopRepositories(filter)); err != nil {
+		return nil, err
+	}
+
+	return entity, entity.SetRiskAcceptances(c.repository.ListRiskAcceptances(subscription.WorkspaceID,
+		subscription.Frequency.GetPeriodEnd(now)))
+}
+
+// publishDigest registers the digest as sent before publishing it, so when there are many instances of the service
+// only the one that registered it sends the email. When the publish fails the register is undone, so the digest is
+// sent again in the next run instead of being lost
+func (c *Controller) publishDigest(subscription *digest.Subscription, entity *digest.Digest, now time.Time) error {
+	sent, err := c.repository.MarkAsSent(subscription, now)
+	if err != nil || !sent {
+		return err
+	}
+
+	if err := c.broker.Publish(queues.HorusecEmail.ToString(), "", "", entity.ToEmailMessage()); err != nil {
+		if unmarkErr := c.repository.UnmarkAsSent(subscription, now); unmarkErr != nil {
+			logger.LogError(fmt.Sprintf(digestEnums.MessageFailedToUnmarkDigest, subscription.WorkspaceID,
+				subscription.AccountID), unmarkErr)
+		}
+
+		return err
+	}
+
+	return nil
+}
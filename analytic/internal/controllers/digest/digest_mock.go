@@ -0,0 +1,52 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/digest"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) IsEnabled() bool {
+	args := m.MethodCalled("IsEnabled")
+	return args.Get(0).(bool)
+}
+
+func (m *Mock) GetSubscription(_, _ uuid.UUID) (*digest.Subscription, error) {
+	args := m.MethodCalled("GetSubscription")
+	return args.Get(0).(*digest.Subscription), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) SaveSubscription(_ *digest.SubscriptionData) (*digest.Subscription, error) {
+	args := m.MethodCalled("SaveSubscription")
+	return args.Get(0).(*digest.Subscription), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) DeleteSubscription(_, _ uuid.UUID) error {
+	args := m.MethodCalled("DeleteSubscription")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) SendDigests() error {
+	args := m.MethodCalled("SendDigests")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,234 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/digest"
+	digestEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/digest"
+	digestRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/digest"
+)
+
+func newRepositoryMock() *digestRepository.Mock {
+	repositoryMock := &digestRepository.Mock{}
+	repositoryMock.On("ListSubscriptions").Return([]*digest.Subscription{
+		{AccountID: uuid.New(), WorkspaceID: uuid.New(), Frequency: digestEnums.Weekly}}, nil)
+	repositoryMock.On("ListNewFindings").Return([]*digest.FindingsBySeverity{}, nil)
+	repositoryMock.On("ListFixedFindings").Return([]*digest.FindingsBySeverity{}, nil)
+	repositoryMock.On("ListTopRepositories").Return([]*digest.TopRepository{}, nil)
+	repositoryMock.On("ListRiskAcceptances").Return([]*digest.RiskAcceptance{}, nil)
+
+	return repositoryMock
+}
+
+func TestNewDigestController(t *testing.T) {
+	t.Run("should success create a new controller", func(t *testing.T) {
+		assert.NotNil(t, NewDigestController(&digestRepository.Mock{}, &broker.Mock{}))
+	})
+}
+
+func TestIsEnabled(t *testing.T) {
+	t.Run("should return true when emails enabled and platform database available", func(t *testing.T) {
+		repositoryMock := &digestRepository.Mock{}
+		repositoryMock.On("IsAvailable").Return(true)
+
+		assert.True(t, NewDigestController(repositoryMock, &broker.Mock{}).IsEnabled())
+	})
+
+	t.Run("should return false when platform database not available", func(t *testing.T) {
+		repositoryMock := &digestRepository.Mock{}
+		repositoryMock.On("IsAvailable").Return(false)
+
+		assert.False(t, NewDigestController(repositoryMock, &broker.Mock{}).IsEnabled())
+	})
+
+	t.Run("should return false when emails disabled", func(t *testing.T) {
+		t.Setenv(digestEnums.EnvDisableEmails, "true")
+
+		repositoryMock := &digestRepository.Mock{}
+		repositoryMock.On("IsAvailable").Return(true)
+
+		assert.False(t, NewDigestController(repositoryMock, &broker.Mock{}).IsEnabled())
+	})
+}
+
+func TestGetSubscription(t *testing.T) {
+	t.Run("should return the subscription", func(t *testing.T) {
+		repositoryMock := &digestRepository.Mock{}
+		repositoryMock.On("GetSubscription").Return(&digest.Subscription{Frequency: digestEnums.Daily}, nil)
+
+		subscription, err := NewDigestController(repositoryMock, &broker.Mock{}).GetSubscription(uuid.New(),
+			uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, digestEnums.Daily, subscription.Frequency)
+	})
+
+	t.Run("should return subscription not found when not subscribed", func(t *testing.T) {
+		repositoryMock := &digestRepository.Mock{}
+		repositoryMock.On("GetSubscription").Return(&digest.Subscription{}, databaseEnums.ErrorNotFoundRecords)
+
+		_, err := NewDigestController(repositoryMock, &broker.Mock{}).GetSubscription(uuid.New(), uuid.New())
+		assert.Equal(t, digestEnums.ErrorSubscriptionNotFound, err)
+	})
+}
+
+func TestSaveSubscription(t *testing.T) {
+	t.Run("should save and return the subscription", func(t *testing.T) {
+		repositoryMock := &digestRepository.Mock{}
+		repositoryMock.On("SaveSubscription").Return(nil)
+		repositoryMock.On("GetSubscription").Return(&digest.Subscription{Frequency: digestEnums.Monthly}, nil)
+
+		subscription, err := NewDigestController(repositoryMock, &broker.Mock{}).SaveSubscription(
+			&digest.SubscriptionData{Frequency: digestEnums.Monthly})
+		assert.NoError(t, err)
+		assert.Equal(t, digestEnums.Monthly, subscription.Frequency)
+	})
+
+	t.Run("should return error when failed to save", func(t *testing.T) {
+		repositoryMock := &digestRepository.Mock{}
+		repositoryMock.On("SaveSubscription").Return(errors.New("test"))
+
+		_, err := NewDigestController(repositoryMock, &broker.Mock{}).SaveSubscription(&digest.SubscriptionData{})
+		assert.Error(t, err)
+		repositoryMock.AssertNotCalled(t, "GetSubscription")
+	})
+}
+
+func TestDeleteSubscription(t *testing.T) {
+	t.Run("should delete the subscription without errors", func(t *testing.T) {
+		repositoryMock := &digestRepository.Mock{}
+		repositoryMock.On("DeleteSubscription").Return(nil)
+
+		assert.NoError(t, NewDigestController(repositoryMock, &broker.Mock{}).DeleteSubscription(uuid.New(),
+			uuid.New()))
+	})
+}
+
+func TestSendDigests(t *testing.T) {
+	t.Run("should publish the digest of the subscriptions due", func(t *testing.T) {
+		repositoryMock := newRepositoryMock()
+		repositoryMock.On("GetRecipient").Return(&digest.Recipient{Email: "test@horusec.io"}, nil)
+		repositoryMock.On("MarkAsSent").Return(true, nil)
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		assert.NoError(t, NewDigestController(repositoryMock, brokerMock).SendDigests())
+		brokerMock.AssertNumberOfCalls(t, "Publish", 1)
+	})
+
+	t.Run("should undo the digest sent when failed to publish it", func(t *testing.T) {
+		repositoryMock := newRepositoryMock()
+		repositoryMock.On("GetRecipient").Return(&digest.Recipient{Email: "test@horusec.io"}, nil)
+		repositoryMock.On("MarkAsSent").Return(true, nil)
+		repositoryMock.On("UnmarkAsSent").Return(nil)
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(errors.New("test"))
+
+		assert.NoError(t, NewDigestController(repositoryMock, brokerMock).SendDigests())
+		repositoryMock.AssertNumberOfCalls(t, "UnmarkAsSent", 1)
+	})
+
+	t.Run("should not undo the digest sent when published", func(t *testing.T) {
+		repositoryMock := newRepositoryMock()
+		repositoryMock.On("GetRecipient").Return(&digest.Recipient{Email: "test@horusec.io"}, nil)
+		repositoryMock.On("MarkAsSent").Return(true, nil)
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		assert.NoError(t, NewDigestController(repositoryMock, brokerMock).SendDigests())
+		repositoryMock.AssertNotCalled(t, "UnmarkAsSent")
+	})
+
+	t.Run("should not stop when failed to undo the digest sent", func(t *testing.T) {
+		repositoryMock := newRepositoryMock()
+		repositoryMock.On("GetRecipient").Return(&digest.Recipient{Email: "test@horusec.io"}, nil)
+		repositoryMock.On("MarkAsSent").Return(true, nil)
+		repositoryMock.On("UnmarkAsSent").Return(errors.New("test"))
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(errors.New("test"))
+
+		assert.NoError(t, NewDigestController(repositoryMock, brokerMock).SendDigests())
+		repositoryMock.AssertNumberOfCalls(t, "UnmarkAsSent", 1)
+	})
+
+	t.Run("should not send digest of subscription sent in the current period", func(t *testing.T) {
+		lastSentAt := time.Now().Add(-time.Hour)
+
+		repositoryMock := &digestRepository.Mock{}
+		repositoryMock.On("ListSubscriptions").Return([]*digest.Subscription{
+			{Frequency: digestEnums.Daily, LastSentAt: &lastSentAt}}, nil)
+
+		assert.NoError(t, NewDigestController(repositoryMock, &broker.Mock{}).SendDigests())
+		repositoryMock.AssertNotCalled(t, "GetRecipient")
+	})
+
+	t.Run("should not publish when the digest was already sent by another instance", func(t *testing.T) {
+		repositoryMock := newRepositoryMock()
+		repositoryMock.On("GetRecipient").Return(&digest.Recipient{}, nil)
+		repositoryMock.On("MarkAsSent").Return(false, nil)
+
+		brokerMock := &broker.Mock{}
+
+		assert.NoError(t, NewDigestController(repositoryMock, brokerMock).SendDigests())
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should remove subscription when the account is no longer admin of the workspace", func(t *testing.T) {
+		repositoryMock := newRepositoryMock()
+		repositoryMock.On("GetRecipient").Return((*digest.Recipient)(nil), nil)
+		repositoryMock.On("DeleteSubscription").Return(nil)
+
+		assert.NoError(t, NewDigestController(repositoryMock, &broker.Mock{}).SendDigests())
+		repositoryMock.AssertCalled(t, "DeleteSubscription")
+		repositoryMock.AssertNotCalled(t, "MarkAsSent")
+	})
+
+	t.Run("should not mark as sent when failed to build the digest", func(t *testing.T) {
+		repositoryMock := &digestRepository.Mock{}
+		repositoryMock.On("ListSubscriptions").Return([]*digest.Subscription{{}}, nil)
+		repositoryMock.On("GetRecipient").Return(&digest.Recipient{}, nil)
+		repositoryMock.On("ListNewFindings").Return([]*digest.FindingsBySeverity{}, errors.New("test"))
+
+		assert.NoError(t, NewDigestController(repositoryMock, &broker.Mock{}).SendDigests())
+		repositoryMock.AssertNotCalled(t, "MarkAsSent")
+	})
+
+	t.Run("should not stop when failed to get the recipient", func(t *testing.T) {
+		repositoryMock := newRepositoryMock()
+		repositoryMock.On("GetRecipient").Return((*digest.Recipient)(nil), errors.New("test"))
+
+		assert.NoError(t, NewDigestController(repositoryMock, &broker.Mock{}).SendDigests())
+		repositoryMock.AssertNotCalled(t, "DeleteSubscription")
+	})
+
+	t.Run("should return error when failed to list subscriptions", func(t *testing.T) {
+		repositoryMock := &digestRepository.Mock{}
+		repositoryMock.On("ListSubscriptions").Return([]*digest.Subscription{}, errors.New("test"))
+
+		assert.Error(t, NewDigestController(repositoryMock, &broker.Mock{}).SendDigests())
+	})
+}
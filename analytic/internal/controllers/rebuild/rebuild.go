@@ -0,0 +1,200 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/rebuild"
+	rebuildEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/rebuild"
+	ledgerRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/ledger"
+	rebuildRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/rebuild"
+	dashboardUseCases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/dashboard"
+)
+
+type IController interface {
+	DryRun(scope *rebuild.Scope) (*rebuild.JobResponse, error)
+	StartRebuild(scope *rebuild.Scope) (*rebuild.JobResponse, error)
+	Rebuild(job *rebuild.Job) *rebuild.JobResponse
+	GetJob(workspaceID, jobID uuid.UUID) (*rebuild.JobResponse, error)
+}
+
+type Controller struct {
+	repository       rebuildRepository.IRepository
+	ledgerRepository ledgerRepository.IRepository
+	useCases         dashboardUseCases.IUseCases
+	jobs             map[uuid.UUID]*rebuild.Job
+	jobRetention     time.Duration
+	mutex            sync.Mutex
+}
+
+func NewRebuildController(repository rebuildRepository.IRepository, ledgers ledgerRepository.IRepository,
+	useCases dashboardUseCases.IUseCases) IController {
+	return &Controller{
+		repository:       repository,
+		ledgerRepository: ledgers,
+		useCases:         useCases,
+		jobs:             map[uuid.UUID]*rebuild.Job{},
+		jobRetention:     rebuildEnums.JobRetentionTime,
+	}
+}
+
+// DryRun returns how many analysis would be rebuilt and how many analytic rows currently exist in the scope,
+// without changing anything
+func (c *Controller) DryRun(scope *rebuild.Scope) (*rebuild.JobResponse, error) {
+	if !c.repository.IsAvailable() {
+		return nil, rebuildEnums.ErrorRebuildUnavailable
+	}
+
+	job := rebuild.NewJob(scope)
+
+	analysisIDs, err := c.repository.ListAnalysisIDs(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	job.SetTotalAnalysis(len(analysisIDs))
+
+	existingRows, err := c.repository.CountProjectionRows(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	job.SetExistingRows(existingRows)
+
+	return job.Finish(nil).ToResponse(), nil
+}
+
+// StartRebuild runs the rebuild in background, only one rebuild can run at a time for each workspace. The jobs are
+// kept in the memory of the replica that received the request, so this check and the job progress are per replica
+func (c *Controller) StartRebuild(scope *rebuild.Scope) (*rebuild.JobResponse, error) {
+	if !c.repository.IsAvailable() {
+		return nil, rebuildEnums.ErrorRebuildUnavailable
+	}
+
+	job, err := c.registerJob(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	go c.Rebuild(job)
+
+	return job.ToResponse(), nil
+}
+
+func (c *Controller) registerJob(scope *rebuild.Scope) (*rebuild.Job, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.removeExpiredJobs()
+
+	for _, job := range c.jobs {
+		if job.GetScope().WorkspaceID == scope.WorkspaceID && job.IsRunning() {
+			return nil, rebuildEnums.ErrorRebuildAlreadyRunning
+		}
+	}
+
+	job := rebuild.NewJob(scope)
+	c.jobs[job.GetID()] = job
+
+	return job, nil
+}
+
+// removeExpiredJobs forgets the jobs finished longer than the retention time ago, it must be called with the lock
+func (c *Controller) removeExpiredJobs() {
+	for jobID, job := range c.jobs {
+		if job.IsExpired(c.jobRetention) {
+			delete(c.jobs, jobID)
+		}
+	}
+}
+
+// Rebuild replaces the analytic rows of every analysis in the job scope, an analysis that fails, including the ones
+// changed by the analytic consumers at the same time, does not stop the others and can be fixed by running it again
+func (c *Controller) Rebuild(job *rebuild.Job) *rebuild.JobResponse {
+	scope := job.GetScope()
+
+	analysisIDs, err := c.repository.ListAnalysisIDs(scope)
+	if err != nil {
+		return job.Finish(err).ToResponse()
+	}
+
+	job.SetTotalAnalysis(len(analysisIDs))
+	logger.LogInfo(fmt.Sprintf(rebuildEnums.MessageRebuildStarted, len(analysisIDs), scope.WorkspaceID,
+		scope.RepositoryID, scope.StartTime, scope.EndTime))
+
+	for index, analysisID := range analysisIDs {
+		logger.LogInfo(fmt.Sprintf(rebuildEnums.MessageRebuildProgress, index+1, len(analysisIDs), analysisID))
+
+		job.AddResult(analysisID, c.rebuildAnalysis(analysisID))
+	}
+
+	response := job.Finish(nil).ToResponse()
+	logger.LogInfo(fmt.Sprintf(rebuildEnums.MessageRebuildFinished, response.Processed, response.Failed))
+
+	return response
+}
+
+func (c *Controller) rebuildAnalysis(analysisID uuid.UUID) error {
+	entity, err := c.repository.GetAnalysis(analysisID)
+	if err != nil {
+		logger.LogError(fmt.Sprintf(rebuildEnums.MessageFailedToRebuild, analysisID), err)
+
+		return err
+	}
+
+	for _, table := range ledger.ProjectionTables() {
+		if err := c.rebuildProjection(ledger.NewRevisedAnalysis(entity, 0), table); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rebuildProjection replaces the rows of the analysis with its current state, keeping the revision and date
+// recorded in the ledger so the rebuild never adds another snapshot of an analysis already projected
+func (c *Controller) rebuildProjection(entity *ledger.RevisedAnalysis, table string) error {
+	previous, err := c.ledgerRepository.GetLedger(entity.ID, table)
+	if err != nil {
+		return err
+	}
+
+	revised := entity.AtLedger(previous)
+
+	return c.ledgerRepository.SaveProjection(previous, ledger.NewLedger(revised, table),
+		c.useCases.ParseAnalysisToProjection(revised.Analysis, table))
+}
+
+// GetJob returns the progress of a rebuild, jobs of other workspaces or already expired are not found
+func (c *Controller) GetJob(workspaceID, jobID uuid.UUID) (*rebuild.JobResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.removeExpiredJobs()
+
+	job, ok := c.jobs[jobID]
+	if !ok || job.GetScope().WorkspaceID != workspaceID {
+		return nil, rebuildEnums.ErrorJobNotFound
+	}
+
+	return job.ToResponse(), nil
+}
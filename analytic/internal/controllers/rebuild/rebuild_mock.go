@@ -0,0 +1,47 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/rebuild"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) DryRun(_ *rebuild.Scope) (*rebuild.JobResponse, error) {
+	args := m.MethodCalled("DryRun")
+	return args.Get(0).(*rebuild.JobResponse), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) StartRebuild(_ *rebuild.Scope) (*rebuild.JobResponse, error) {
+	args := m.MethodCalled("StartRebuild")
+	return args.Get(0).(*rebuild.JobResponse), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Rebuild(_ *rebuild.Job) *rebuild.JobResponse {
+	args := m.MethodCalled("Rebuild")
+	return args.Get(0).(*rebuild.JobResponse)
+}
+
+func (m *Mock) GetJob(_, _ uuid.UUID) (*rebuild.JobResponse, error) {
+	args := m.MethodCalled("GetJob")
+	return args.Get(0).(*rebuild.JobResponse), utilsMock.ReturnNilOrError(args, 1)
+}
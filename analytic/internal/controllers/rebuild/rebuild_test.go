@@ -0,0 +1,266 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/rebuild"
+	rebuildEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/rebuild"
+	ledgerRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/ledger"
+	rebuildRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/rebuild"
+	dashboardUseCases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/dashboard"
+)
+
+func newController(repositoryMock *rebuildRepository.Mock, ledgerMocks ...*ledgerRepository.Mock) IController {
+	ledgerMock := &ledgerRepository.Mock{}
+	if len(ledgerMocks) > 0 {
+		ledgerMock = ledgerMocks[0]
+	}
+
+	return NewRebuildController(repositoryMock, ledgerMock, dashboardUseCases.NewUseCaseDashboard())
+}
+
+func newLedgerMock(previous *ledger.Ledger, err error) *ledgerRepository.Mock {
+	ledgerMock := &ledgerRepository.Mock{}
+	ledgerMock.On("GetLedger").Return(previous, nil)
+	ledgerMock.On("SaveProjection", mock.Anything, mock.Anything, mock.Anything).Return(err)
+
+	return ledgerMock
+}
+
+func waitJob(t *testing.T, controller IController, workspaceID, jobID uuid.UUID) *rebuild.JobResponse {
+	assert.Eventually(t, func() bool {
+		job, err := controller.GetJob(workspaceID, jobID)
+
+		return err == nil && job.Status != rebuildEnums.StatusRunning
+	}, time.Second, time.Millisecond)
+
+	job, _ := controller.GetJob(workspaceID, jobID)
+
+	return job
+}
+
+func TestDryRun(t *testing.T) {
+	t.Run("should return the total of analysis and existing rows without changing anything", func(t *testing.T) {
+		repositoryMock := &rebuildRepository.Mock{}
+		repositoryMock.On("IsAvailable").Return(true)
+		repositoryMock.On("ListAnalysisIDs").Return([]uuid.UUID{uuid.New(), uuid.New()}, nil)
+		repositoryMock.On("CountProjectionRows").Return(map[string]int{"test": 3}, nil)
+
+		result, err := newController(repositoryMock).DryRun(&rebuild.Scope{WorkspaceID: uuid.New(), DryRun: true})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.TotalAnalysis)
+		assert.Equal(t, map[string]int{"test": 3}, result.ExistingRows)
+		assert.Equal(t, rebuildEnums.StatusFinished, result.Status)
+	})
+
+	t.Run("should return error when rebuild is unavailable", func(t *testing.T) {
+		repositoryMock := &rebuildRepository.Mock{}
+		repositoryMock.On("IsAvailable").Return(false)
+
+		_, err := newController(repositoryMock).DryRun(&rebuild.Scope{})
+		assert.Equal(t, rebuildEnums.ErrorRebuildUnavailable, err)
+	})
+
+	t.Run("should return error when failed to list analysis", func(t *testing.T) {
+		repositoryMock := &rebuildRepository.Mock{}
+		repositoryMock.On("IsAvailable").Return(true)
+		repositoryMock.On("ListAnalysisIDs").Return([]uuid.UUID{}, errors.New("test"))
+
+		_, err := newController(repositoryMock).DryRun(&rebuild.Scope{})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to count rows", func(t *testing.T) {
+		repositoryMock := &rebuildRepository.Mock{}
+		repositoryMock.On("IsAvailable").Return(true)
+		repositoryMock.On("ListAnalysisIDs").Return([]uuid.UUID{}, nil)
+		repositoryMock.On("CountProjectionRows").Return(map[string]int{}, errors.New("test"))
+
+		_, err := newController(repositoryMock).DryRun(&rebuild.Scope{})
+		assert.Error(t, err)
+	})
+}
+
+func TestRebuild(t *testing.T) {
+	t.Run("should replace the projections of every analysis", func(t *testing.T) {
+		repositoryMock := &rebuildRepository.Mock{}
+		repositoryMock.On("ListAnalysisIDs").Return([]uuid.UUID{uuid.New(), uuid.New()}, nil)
+		repositoryMock.On("GetAnalysis").Return(&analysis.Analysis{}, nil)
+
+		ledgerMock := newLedgerMock(nil, nil)
+
+		result := newController(repositoryMock, ledgerMock).Rebuild(rebuild.NewJob(&rebuild.Scope{}))
+		assert.Equal(t, rebuildEnums.StatusFinished, result.Status)
+		assert.Equal(t, 2, result.Processed)
+		assert.Equal(t, 0, result.Failed)
+		assert.Equal(t, float64(100), result.Progress)
+		ledgerMock.AssertNumberOfCalls(t, "SaveProjection", 2*len(ledger.ProjectionTables()))
+	})
+
+	t.Run("should keep the revision and date of the analysis already projected", func(t *testing.T) {
+		createdAt := time.Now().Add(-time.Hour)
+		previous := &ledger.Ledger{Revision: 4, CreatedAt: createdAt}
+
+		repositoryMock := &rebuildRepository.Mock{}
+		repositoryMock.On("ListAnalysisIDs").Return([]uuid.UUID{uuid.New()}, nil)
+		repositoryMock.On("GetAnalysis").Return(&analysis.Analysis{CreatedAt: time.Now()}, nil)
+
+		ledgerMock := &ledgerRepository.Mock{}
+		ledgerMock.On("GetLedger").Return(previous, nil)
+		ledgerMock.On("SaveProjection", previous, mock.MatchedBy(func(current *ledger.Ledger) bool {
+			return current.Revision == 4 && current.CreatedAt.Equal(createdAt)
+		}), mock.Anything).Return(nil)
+
+		result := newController(repositoryMock, ledgerMock).Rebuild(rebuild.NewJob(&rebuild.Scope{}))
+		assert.Equal(t, 0, result.Failed)
+		ledgerMock.AssertNumberOfCalls(t, "SaveProjection", len(ledger.ProjectionTables()))
+	})
+
+	t.Run("should continue the rebuild when an analysis fails", func(t *testing.T) {
+		repositoryMock := &rebuildRepository.Mock{}
+		repositoryMock.On("ListAnalysisIDs").Return([]uuid.UUID{uuid.New(), uuid.New(), uuid.New()}, nil)
+		repositoryMock.On("GetAnalysis").Return(&analysis.Analysis{}, errors.New("test")).Once()
+		repositoryMock.On("GetAnalysis").Return(&analysis.Analysis{}, nil)
+
+		ledgerMock := &ledgerRepository.Mock{}
+		ledgerMock.On("GetLedger").Return((*ledger.Ledger)(nil), nil)
+		ledgerMock.On("SaveProjection", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("test")).Once()
+		ledgerMock.On("SaveProjection", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		result := newController(repositoryMock, ledgerMock).Rebuild(rebuild.NewJob(&rebuild.Scope{}))
+		assert.Equal(t, rebuildEnums.StatusFinished, result.Status)
+		assert.Equal(t, 3, result.Processed)
+		assert.Equal(t, 2, result.Failed)
+		assert.Len(t, result.Errors, 2)
+	})
+
+	t.Run("should fail the job when failed to list analysis", func(t *testing.T) {
+		repositoryMock := &rebuildRepository.Mock{}
+		repositoryMock.On("ListAnalysisIDs").Return([]uuid.UUID{}, errors.New("test"))
+
+		result := newController(repositoryMock).Rebuild(rebuild.NewJob(&rebuild.Scope{}))
+		assert.Equal(t, rebuildEnums.StatusFailed, result.Status)
+		repositoryMock.AssertNotCalled(t, "GetAnalysis")
+	})
+}
+
+func TestStartRebuild(t *testing.T) {
+	t.Run("should start the rebuild in background and return the job", func(t *testing.T) {
+		repositoryMock := &rebuildRepository.Mock{}
+		repositoryMock.On("IsAvailable").Return(true)
+		repositoryMock.On("ListAnalysisIDs").Return([]uuid.UUID{uuid.New()}, nil)
+		repositoryMock.On("GetAnalysis").Return(&analysis.Analysis{}, nil)
+
+		controller := newController(repositoryMock, newLedgerMock(nil, nil))
+		scope := &rebuild.Scope{WorkspaceID: uuid.New()}
+
+		result, err := controller.StartRebuild(scope)
+		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, result.JobID)
+
+		job := waitJob(t, controller, scope.WorkspaceID, result.JobID)
+		assert.Equal(t, rebuildEnums.StatusFinished, job.Status)
+		assert.Equal(t, 1, job.Processed)
+	})
+
+	t.Run("should return error when there is a rebuild running for the workspace", func(t *testing.T) {
+		release := make(chan time.Time)
+
+		repositoryMock := &rebuildRepository.Mock{}
+		repositoryMock.On("IsAvailable").Return(true)
+		repositoryMock.On("ListAnalysisIDs").WaitUntil(release).Return([]uuid.UUID{}, nil)
+
+		controller := newController(repositoryMock)
+		scope := &rebuild.Scope{WorkspaceID: uuid.New()}
+
+		result, err := controller.StartRebuild(scope)
+		assert.NoError(t, err)
+
+		_, err = controller.StartRebuild(scope)
+		assert.Equal(t, rebuildEnums.ErrorRebuildAlreadyRunning, err)
+
+		_, err = controller.StartRebuild(&rebuild.Scope{WorkspaceID: uuid.New()})
+		assert.NoError(t, err)
+
+		close(release)
+		waitJob(t, controller, scope.WorkspaceID, result.JobID)
+
+		_, err = controller.StartRebuild(scope)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when rebuild is unavailable", func(t *testing.T) {
+		repositoryMock := &rebuildRepository.Mock{}
+		repositoryMock.On("IsAvailable").Return(false)
+
+		_, err := newController(repositoryMock).StartRebuild(&rebuild.Scope{})
+		assert.Equal(t, rebuildEnums.ErrorRebuildUnavailable, err)
+	})
+}
+
+func TestGetJob(t *testing.T) {
+	t.Run("should return not found when the job does not exist", func(t *testing.T) {
+		_, err := newController(&rebuildRepository.Mock{}).GetJob(uuid.New(), uuid.New())
+		assert.Equal(t, rebuildEnums.ErrorJobNotFound, err)
+	})
+
+	t.Run("should return not found when the job belongs to another workspace", func(t *testing.T) {
+		repositoryMock := &rebuildRepository.Mock{}
+		repositoryMock.On("IsAvailable").Return(true)
+		repositoryMock.On("ListAnalysisIDs").Return([]uuid.UUID{}, nil)
+
+		controller := newController(repositoryMock)
+		scope := &rebuild.Scope{WorkspaceID: uuid.New()}
+
+		result, err := controller.StartRebuild(scope)
+		assert.NoError(t, err)
+		waitJob(t, controller, scope.WorkspaceID, result.JobID)
+
+		_, err = controller.GetJob(uuid.New(), result.JobID)
+		assert.Equal(t, rebuildEnums.ErrorJobNotFound, err)
+	})
+
+	t.Run("should return not found and forget the job after the retention time", func(t *testing.T) {
+		repositoryMock := &rebuildRepository.Mock{}
+		repositoryMock.On("IsAvailable").Return(true)
+		repositoryMock.On("ListAnalysisIDs").Return([]uuid.UUID{}, nil)
+
+		controller := newController(repositoryMock)
+		scope := &rebuild.Scope{WorkspaceID: uuid.New()}
+
+		result, err := controller.StartRebuild(scope)
+		assert.NoError(t, err)
+		waitJob(t, controller, scope.WorkspaceID, result.JobID)
+
+		controller.(*Controller).jobRetention = 0
+
+		assert.Eventually(t, func() bool {
+			_, err = controller.GetJob(scope.WorkspaceID, result.JobID)
+
+			return err == rebuildEnums.ErrorJobNotFound
+		}, time.Second, time.Millisecond)
+		assert.Empty(t, controller.(*Controller).jobs)
+	})
+}
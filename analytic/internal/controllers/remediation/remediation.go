@@ -0,0 +1,100 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"fmt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/remediation"
+	remediationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/remediation"
+	remediationRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/remediation"
+	remediationUseCases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/remediation"
+)
+
+type IController interface {
+	TrackRemediation(entity *ledger.RevisedAnalysis) error
+	GetRemediation(filter *dashboard.Filter) (*remediation.Response, error)
+}
+
+type Controller struct {
+	repository remediationRepository.IRepository
+	useCases   remediationUseCases.IUseCases
+	slaTargets remediation.SLATargets
+}
+
+func NewRemediationController(repository remediationRepository.IRepository,
+	useCases remediationUseCases.IUseCases) IController {
+	return &Controller{
+		repository: repository,
+		useCases:   useCases,
+		slaTargets: remediation.NewSLATargets(),
+	}
+}
+
+// TrackRemediation updates the first seen and resolved dates of the vulnerabilities of the analysis repository, only
+// when it is the latest analysis of the repository or a new revision of it, retrying when another consumer tracked
+// the repository at the same time
+func (c *Controller) TrackRemediation(entity *ledger.RevisedAnalysis) (err error) {
+	for attempt := 0; attempt < remediationEnums.MaxTrackAttempts; attempt++ {
+		if err = c.tryTrackRemediation(entity); err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+func (c *Controller) tryTrackRemediation(entity *ledger.RevisedAnalysis) error {
+	previous, err := c.repository.GetCheckpoint(entity.RepositoryID)
+	if err != nil {
+		return err
+	}
+
+	if previous.IsNewerOrEqual(entity) {
+		logger.LogInfo(fmt.Sprintf(remediationEnums.MessageAnalysisAlreadyTracked, entity.Revision, entity.ID,
+			entity.RepositoryID))
+
+		return nil
+	}
+
+	tracked, err := c.repository.ListByRepository(entity.RepositoryID)
+	if err != nil {
+		return err
+	}
+
+	return c.repository.SaveChanges(previous, remediation.NewCheckpoint(entity),
+		c.useCases.ParseAnalysisToRemediationChanges(entity.Analysis, tracked))
+}
+
+// GetRemediation returns the mean time to remediate of the vulnerabilities resolved in the period and the
+// vulnerabilities still open at the end of it, of the repository when it is in the filter or else of the workspace
+func (c *Controller) GetRemediation(filter *dashboard.Filter) (*remediation.Response, error) {
+	response := remediation.NewResponse(c.slaTargets)
+
+	if err := response.SetResolved(c.repository.ListResolvedBySeverity(filter)); err != nil {
+		return nil, err
+	}
+
+	open, err := c.repository.ListOpenByAge(filter)
+	if err := response.SetOpen(open, c.slaTargets, err); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
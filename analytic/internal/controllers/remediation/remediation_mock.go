@@ -0,0 +1,38 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/remediation"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) TrackRemediation(_ *ledger.RevisedAnalysis) error {
+	args := m.MethodCalled("TrackRemediation")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) GetRemediation(_ *dashboard.Filter) (*remediation.Response, error) {
+	args := m.MethodCalled("GetRemediation")
+	return args.Get(0).(*remediation.Response), utilsMock.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,183 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnum "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/remediation"
+	remediationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/remediation"
+	remediationRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/remediation"
+	remediationUseCases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/remediation"
+)
+
+func TestTrackRemediation(t *testing.T) {
+	entity := ledger.NewRevisedAnalysis(&analysisEntities.Analysis{
+		ID:        uuid.New(),
+		CreatedAt: time.Now(),
+		AnalysisVulnerabilities: []analysisEntities.AnalysisVulnerabilities{
+			{Vulnerability: vulnerability.Vulnerability{VulnHash: "hash", Severity: severities.High,
+				Type: vulnerabilityEnum.Vulnerability}},
+		},
+	}, 1)
+
+	t.Run("should save the remediation changes of the analysis", func(t *testing.T) {
+		repoMock := &remediationRepository.Mock{}
+		repoMock.On("GetCheckpoint").Return(&remediation.Checkpoint{AnalysisID: entity.ID, Revision: 0}, nil)
+		repoMock.On("ListByRepository").Return([]*remediation.Remediation{}, nil)
+		repoMock.On("SaveChanges", mock.MatchedBy(func(changes *remediation.Changes) bool {
+			return len(changes.Created) == 1
+		})).Return(nil)
+
+		controller := NewRemediationController(repoMock, remediationUseCases.NewUseCaseRemediation())
+
+		assert.NoError(t, controller.TrackRemediation(entity))
+		repoMock.AssertCalled(t, "SaveChanges", mock.Anything)
+	})
+
+	t.Run("should save the checkpoint of the first analysis of the repository", func(t *testing.T) {
+		repoMock := &remediationRepository.Mock{}
+		repoMock.On("GetCheckpoint").Return((*remediation.Checkpoint)(nil), nil)
+		repoMock.On("ListByRepository").Return([]*remediation.Remediation{}, nil)
+		repoMock.On("SaveChanges", mock.Anything).Return(nil)
+
+		controller := NewRemediationController(repoMock, remediationUseCases.NewUseCaseRemediation())
+
+		assert.NoError(t, controller.TrackRemediation(ledger.NewRevisedAnalysis(&analysisEntities.Analysis{}, 0)))
+		repoMock.AssertCalled(t, "SaveChanges", mock.Anything)
+	})
+
+	t.Run("should not save when the revision was already tracked", func(t *testing.T) {
+		repoMock := &remediationRepository.Mock{}
+		repoMock.On("GetCheckpoint").Return(&remediation.Checkpoint{AnalysisID: entity.ID, Revision: 1}, nil)
+
+		controller := NewRemediationController(repoMock, remediationUseCases.NewUseCaseRemediation())
+
+		assert.NoError(t, controller.TrackRemediation(entity))
+		repoMock.AssertNotCalled(t, "ListByRepository")
+		repoMock.AssertNotCalled(t, "SaveChanges", mock.Anything)
+	})
+
+	t.Run("should not save when a newer analysis of the repository was already tracked", func(t *testing.T) {
+		repoMock := &remediationRepository.Mock{}
+		repoMock.On("GetCheckpoint").Return(&remediation.Checkpoint{AnalysisID: uuid.New(),
+			AnalysisCreatedAt: entity.CreatedAt.Add(time.Hour)}, nil)
+
+		controller := NewRemediationController(repoMock, remediationUseCases.NewUseCaseRemediation())
+
+		assert.NoError(t, controller.TrackRemediation(entity))
+		repoMock.AssertNotCalled(t, "SaveChanges", mock.Anything)
+	})
+
+	t.Run("should retry when another consumer tracked the repository at the same time", func(t *testing.T) {
+		repoMock := &remediationRepository.Mock{}
+		repoMock.On("GetCheckpoint").Return((*remediation.Checkpoint)(nil), nil)
+		repoMock.On("ListByRepository").Return([]*remediation.Remediation{}, nil)
+		repoMock.On("SaveChanges", mock.Anything).Return(remediationEnums.ErrorCheckpointConflict).Once()
+		repoMock.On("SaveChanges", mock.Anything).Return(nil).Once()
+
+		controller := NewRemediationController(repoMock, remediationUseCases.NewUseCaseRemediation())
+
+		assert.NoError(t, controller.TrackRemediation(entity))
+		repoMock.AssertNumberOfCalls(t, "SaveChanges", 2)
+	})
+
+	t.Run("should return error when the conflict persists after all attempts", func(t *testing.T) {
+		repoMock := &remediationRepository.Mock{}
+		repoMock.On("GetCheckpoint").Return((*remediation.Checkpoint)(nil), nil)
+		repoMock.On("ListByRepository").Return([]*remediation.Remediation{}, nil)
+		repoMock.On("SaveChanges", mock.Anything).Return(remediationEnums.ErrorCheckpointConflict)
+
+		controller := NewRemediationController(repoMock, remediationUseCases.NewUseCaseRemediation())
+
+		assert.Equal(t, remediationEnums.ErrorCheckpointConflict, controller.TrackRemediation(entity))
+		repoMock.AssertNumberOfCalls(t, "SaveChanges", remediationEnums.MaxTrackAttempts)
+	})
+
+	t.Run("should return error when failed to get the checkpoint", func(t *testing.T) {
+		repoMock := &remediationRepository.Mock{}
+		repoMock.On("GetCheckpoint").Return((*remediation.Checkpoint)(nil), errors.New("test"))
+
+		controller := NewRemediationController(repoMock, remediationUseCases.NewUseCaseRemediation())
+
+		assert.Error(t, controller.TrackRemediation(entity))
+		repoMock.AssertNotCalled(t, "SaveChanges", mock.Anything)
+	})
+
+	t.Run("should return error when failed to list the tracked remediations", func(t *testing.T) {
+		repoMock := &remediationRepository.Mock{}
+		repoMock.On("GetCheckpoint").Return((*remediation.Checkpoint)(nil), nil)
+		repoMock.On("ListByRepository").Return([]*remediation.Remediation{}, errors.New("test"))
+
+		controller := NewRemediationController(repoMock, remediationUseCases.NewUseCaseRemediation())
+
+		assert.Error(t, controller.TrackRemediation(entity))
+		repoMock.AssertNotCalled(t, "SaveChanges", mock.Anything)
+	})
+}
+
+func TestGetRemediation(t *testing.T) {
+	t.Run("should return the remediation metrics without errors", func(t *testing.T) {
+		repoMock := &remediationRepository.Mock{}
+		repoMock.On("ListResolvedBySeverity").Return([]*remediation.ResolvedBySeverity{
+			{Severity: severities.Critical, TotalResolved: 1, MTTRHours: 48},
+		}, nil)
+		repoMock.On("ListOpenByAge").Return([]*remediation.OpenByAge{
+			{Severity: severities.Critical, AgeDays: 400, Total: 2},
+		}, nil)
+
+		controller := NewRemediationController(repoMock, remediationUseCases.NewUseCaseRemediation())
+
+		result, err := controller.GetRemediation(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Equal(t, float64(48), result.BySeverity[0].MTTRHours)
+		assert.Equal(t, 2, result.BySeverity[0].OpenPastSLA)
+	})
+
+	t.Run("should return error when failed to list resolved vulnerabilities", func(t *testing.T) {
+		repoMock := &remediationRepository.Mock{}
+		repoMock.On("ListResolvedBySeverity").Return([]*remediation.ResolvedBySeverity{}, errors.New("test"))
+
+		controller := NewRemediationController(repoMock, remediationUseCases.NewUseCaseRemediation())
+
+		result, err := controller.GetRemediation(&dashboard.Filter{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when failed to list open vulnerabilities", func(t *testing.T) {
+		repoMock := &remediationRepository.Mock{}
+		repoMock.On("ListResolvedBySeverity").Return([]*remediation.ResolvedBySeverity{}, nil)
+		repoMock.On("ListOpenByAge").Return([]*remediation.OpenByAge{}, errors.New("test"))
+
+		controller := NewRemediationController(repoMock, remediationUseCases.NewUseCaseRemediation())
+
+		result, err := controller.GetRemediation(&dashboard.Filter{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
@@ -35,6 +35,9 @@ type Filter struct {
 	EndTime      time.Time
 	Page         int
 	Size         int
+
+	// ComparePreviousPeriod returns the deltas of the charts against the period of the same duration before it
+	ComparePreviousPeriod bool
 }
 
 func (f *Filter) GetRepositoryFilter() interface{} {
@@ -90,10 +93,32 @@ func (f *Filter) SetDateRangeAndPagination(request *http.Request) error {
 	f.StartTime = initialDate
 	f.EndTime = finalDate
 	f.setPageAndSize(request)
+	f.ComparePreviousPeriod, _ = strconv.ParseBool(request.URL.Query().Get(dashboard.ComparePreviousPeriodHeader))
 
 	return nil
 }
 
+func (f *Filter) GetPeriodDuration() time.Duration {
+	return f.EndTime.Sub(f.StartTime)
+}
+
+// GetPreviousPeriodOffset returns the whole days between the start of the previous period and the current one
+func (f *Filter) GetPreviousPeriodOffset() time.Duration {
+	return f.GetPeriodDuration().Round(24 * time.Hour)
+}
+
+// GetPreviousPeriod returns the filter of the period with the same duration ending right before the start time
+func (f *Filter) GetPreviousPeriod() *Filter {
+	return &Filter{
+		RepositoryID: f.RepositoryID,
+		WorkspaceID:  f.WorkspaceID,
+		StartTime:    f.StartTime.Add(-f.GetPeriodDuration()),
+		EndTime:      f.StartTime.Add(-time.Microsecond),
+		Page:         f.Page,
+		Size:         f.Size,
+	}
+}
+
 func (f *Filter) parseDate(date string) (time.Time, error) {
 	if date != "" {
 		return time.Parse("2006-01-02T15:04:05Z", date)
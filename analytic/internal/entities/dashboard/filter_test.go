@@ -175,6 +175,45 @@ func TestSetDateRangeAndPagination(t *testing.T) {
 		assert.NoError(t, filter.SetDateRangeAndPagination(r))
 		assert.Equal(t, 18, filter.Page)
 		assert.Equal(t, 18, filter.Size)
+		assert.False(t, filter.ComparePreviousPeriod)
+	})
+
+	t.Run("should success set compare previous period", func(t *testing.T) {
+		filter := &Filter{}
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s&comparePreviousPeriod=true",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		ctx := chi.NewRouteContext()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		assert.NoError(t, filter.SetDateRangeAndPagination(r))
+		assert.True(t, filter.ComparePreviousPeriod)
+	})
+}
+
+func TestGetPreviousPeriod(t *testing.T) {
+	t.Run("should return the previous period with the same duration", func(t *testing.T) {
+		startTime := time.Date(2021, 1, 11, 0, 0, 0, 0, time.UTC)
+		endTime := time.Date(2021, 1, 20, 23, 59, 59, 0, time.UTC)
+
+		filter := &Filter{
+			WorkspaceID:           uuid.New(),
+			RepositoryID:          uuid.New(),
+			StartTime:             startTime,
+			EndTime:               endTime,
+			ComparePreviousPeriod: true,
+		}
+
+		previous := filter.GetPreviousPeriod()
+
+		assert.Equal(t, filter.WorkspaceID, previous.WorkspaceID)
+		assert.Equal(t, filter.RepositoryID, previous.RepositoryID)
+		assert.Equal(t, startTime.Add(-filter.GetPeriodDuration()), previous.StartTime)
+		assert.Equal(t, startTime.Add(-time.Microsecond), previous.EndTime)
+		assert.True(t, previous.EndTime.Before(filter.StartTime))
+		assert.False(t, previous.ComparePreviousPeriod)
 	})
 }
 
@@ -22,6 +22,8 @@ type Response struct {
 	VulnerabilitiesByRepository []ByRepository `json:"vulnerabilitiesByRepository"`
 	VulnerabilitiesByLanguage   []ByLanguage   `json:"vulnerabilitiesByLanguage"`
 	VulnerabilitiesByTime       []ByTime       `json:"vulnerabilityByTime"`
+
+	Deltas *Deltas `json:"deltas,omitempty"`
 }
 
 func (r *Response) SetTotalAuthors(totalAuthors int, err error) error {
@@ -87,3 +89,9 @@ func (r *Response) SetChartByTime(vulns []*VulnerabilitiesByTime, err error) err
 
 	return err
 }
+
+func (r *Response) SetDeltas(previous *Response, filter *Filter) *Response {
+	r.Deltas = NewDeltas(r, previous, filter.GetPreviousPeriod(), filter.GetPreviousPeriodOffset())
+
+	return r
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"github.com/google/uuid"
+)
+
+type ByFile struct {
+	RepositoryID uuid.UUID `json:"repositoryID"`
+	File         string    `json:"file"`
+	*BySeverities
+}
+
+type ByDirectory struct {
+	RepositoryID uuid.UUID `json:"repositoryID"`
+	Directory    string    `json:"directory"`
+	*BySeverities
+}
+
+type FilesResponse struct {
+	VulnerabilitiesByFile      []ByFile      `json:"vulnerabilitiesByFile"`
+	VulnerabilitiesByDirectory []ByDirectory `json:"vulnerabilitiesByDirectory"`
+}
+
+func (r *FilesResponse) SetChartByFile(vulns []*VulnerabilitiesByFile, err error) error {
+	if err == nil {
+		for index := range vulns {
+			r.VulnerabilitiesByFile = append(r.VulnerabilitiesByFile, vulns[index].ToResponseByFile())
+		}
+	}
+
+	return err
+}
+
+func (r *FilesResponse) SetChartByDirectory(vulns []*VulnerabilitiesByFile, err error) error {
+	if err == nil {
+		for index := range vulns {
+			r.VulnerabilitiesByDirectory = append(r.VulnerabilitiesByDirectory, vulns[index].ToResponseByDirectory())
+		}
+	}
+
+	return err
+}
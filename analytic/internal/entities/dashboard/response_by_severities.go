@@ -91,3 +91,25 @@ func (s *BySeverities) SumVulnerabilityUnknown(vuln *Vulnerability) *BySeveritie
 
 	return s
 }
+
+// Subtract returns the difference of every severity against the previous values
+func (s *BySeverities) Subtract(previous *BySeverities) *BySeverities {
+	current, previous := s.orEmpty(), previous.orEmpty()
+
+	return &BySeverities{
+		Critical: current.Critical.Subtract(previous.Critical),
+		High:     current.High.Subtract(previous.High),
+		Medium:   current.Medium.Subtract(previous.Medium),
+		Low:      current.Low.Subtract(previous.Low),
+		Info:     current.Info.Subtract(previous.Info),
+		Unknown:  current.Unknown.Subtract(previous.Unknown),
+	}
+}
+
+func (s *BySeverities) orEmpty() *BySeverities {
+	if s == nil {
+		return &BySeverities{}
+	}
+
+	return s
+}
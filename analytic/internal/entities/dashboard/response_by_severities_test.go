@@ -163,3 +163,33 @@ func TestSumVulnerabilityInfo(t *testing.T) {
 		assert.Equal(t, 8, bySeverities.Info.Types.Corrected)
 	})
 }
+
+func TestSubtractBySeverities(t *testing.T) {
+	t.Run("should success subtract previous values of every severity", func(t *testing.T) {
+		current := &BySeverities{
+			Critical: &BySeverity{Count: 3, Types: &ByVulnerabilityTypes{Vulnerability: 1, Corrected: 2}},
+		}
+
+		previous := &BySeverities{
+			Critical: &BySeverity{Count: 5, Types: &ByVulnerabilityTypes{Vulnerability: 4, RiskAccepted: 1}},
+			Low:      &BySeverity{Count: 1, Types: &ByVulnerabilityTypes{FalsePositive: 1}},
+		}
+
+		result := current.Subtract(previous)
+		assert.Equal(t, -2, result.Critical.Count)
+		assert.Equal(t, -3, result.Critical.Types.Vulnerability)
+		assert.Equal(t, -1, result.Critical.Types.RiskAccepted)
+		assert.Equal(t, 2, result.Critical.Types.Corrected)
+		assert.Equal(t, -1, result.Low.Count)
+		assert.Equal(t, -1, result.Low.Types.FalsePositive)
+		assert.Equal(t, 0, result.High.Count)
+	})
+
+	t.Run("should handle missing values as zero", func(t *testing.T) {
+		var current *BySeverities
+
+		result := current.Subtract(nil)
+		assert.Equal(t, 0, result.Info.Count)
+		assert.Equal(t, 0, result.Unknown.Types.Vulnerability)
+	})
+}
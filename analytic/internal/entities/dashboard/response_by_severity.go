@@ -18,3 +18,21 @@ type BySeverity struct {
 	Count int                   `json:"count"`
 	Types *ByVulnerabilityTypes `json:"types"`
 }
+
+// Subtract returns the difference of the count and types against the previous values
+func (s *BySeverity) Subtract(previous *BySeverity) *BySeverity {
+	current, previous := s.orEmpty(), previous.orEmpty()
+
+	return &BySeverity{
+		Count: current.Count - previous.Count,
+		Types: current.Types.Subtract(previous.Types),
+	}
+}
+
+func (s *BySeverity) orEmpty() *BySeverity {
+	if s == nil {
+		return &BySeverity{}
+	}
+
+	return s
+}
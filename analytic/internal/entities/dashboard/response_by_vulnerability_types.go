@@ -20,3 +20,23 @@ type ByVulnerabilityTypes struct {
 	FalsePositive int `json:"falsePositive"`
 	Corrected     int `json:"corrected"`
 }
+
+// Subtract returns the difference of each type against the previous values, missing values are handled as zero
+func (t *ByVulnerabilityTypes) Subtract(previous *ByVulnerabilityTypes) *ByVulnerabilityTypes {
+	current, previous := t.orEmpty(), previous.orEmpty()
+
+	return &ByVulnerabilityTypes{
+		Vulnerability: current.Vulnerability - previous.Vulnerability,
+		RiskAccepted:  current.RiskAccepted - previous.RiskAccepted,
+		FalsePositive: current.FalsePositive - previous.FalsePositive,
+		Corrected:     current.Corrected - previous.Corrected,
+	}
+}
+
+func (t *ByVulnerabilityTypes) orEmpty() *ByVulnerabilityTypes {
+	if t == nil {
+		return &ByVulnerabilityTypes{}
+	}
+
+	return t
+}
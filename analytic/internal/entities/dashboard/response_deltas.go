@@ -0,0 +1,151 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+)
+
+const deltasDateLayout = "2006-01-02"
+
+type Deltas struct {
+	PreviousStartTime           time.Time      `json:"previousStartTime"`
+	PreviousEndTime             time.Time      `json:"previousEndTime"`
+	TotalAuthors                int            `json:"totalAuthors"`
+	TotalRepositories           int            `json:"totalRepositories"`
+	VulnerabilityBySeverity     *BySeverities  `json:"vulnerabilityBySeverity"`
+	VulnerabilitiesByAuthor     []ByAuthor     `json:"vulnerabilitiesByAuthor"`
+	VulnerabilitiesByRepository []ByRepository `json:"vulnerabilitiesByRepository"`
+	VulnerabilitiesByLanguage   []ByLanguage   `json:"vulnerabilitiesByLanguage"`
+	VulnerabilitiesByTime       []ByTime       `json:"vulnerabilityByTime"`
+}
+
+// keyedSeverities returns the chart key and values of the entry at the index
+type keyedSeverities func(index int) (string, *BySeverities)
+
+// NewDeltas compares the current charts against the charts of the previous period. Entries that only exist in the
+// previous period are kept with negative values and the time chart is aligned by the whole days between the periods
+func NewDeltas(current, previous *Response, previousPeriod *Filter, periodOffset time.Duration) *Deltas {
+	deltas := &Deltas{
+		PreviousStartTime:       previousPeriod.StartTime,
+		PreviousEndTime:         previousPeriod.EndTime,
+		TotalAuthors:            current.TotalAuthors - previous.TotalAuthors,
+		TotalRepositories:       current.TotalRepositories - previous.TotalRepositories,
+		VulnerabilityBySeverity: current.VulnerabilityBySeverity.Subtract(previous.VulnerabilityBySeverity),
+	}
+
+	deltas.VulnerabilitiesByAuthor = newDeltasByAuthor(current.VulnerabilitiesByAuthor,
+		previous.VulnerabilitiesByAuthor)
+	deltas.VulnerabilitiesByRepository = newDeltasByRepository(current.VulnerabilitiesByRepository,
+		previous.VulnerabilitiesByRepository)
+	deltas.VulnerabilitiesByLanguage = newDeltasByLanguage(current.VulnerabilitiesByLanguage,
+		previous.VulnerabilitiesByLanguage)
+	deltas.VulnerabilitiesByTime = newDeltasByTime(current.VulnerabilitiesByTime,
+		previous.VulnerabilitiesByTime, periodOffset)
+
+	return deltas
+}
+
+func newDeltasByAuthor(current, previous []ByAuthor) (deltas []ByAuthor) {
+	keys, values := subtractByKey(
+		len(current), func(index int) (string, *BySeverities) {
+			return current[index].Author, current[index].BySeverities
+		},
+		len(previous), func(index int) (string, *BySeverities) {
+			return previous[index].Author, previous[index].BySeverities
+		})
+
+	for index := range keys {
+		deltas = append(deltas, ByAuthor{Author: keys[index], BySeverities: values[index]})
+	}
+
+	return deltas
+}
+
+func newDeltasByRepository(current, previous []ByRepository) (deltas []ByRepository) {
+	keys, values := subtractByKey(
+		len(current), func(index int) (string, *BySeverities) {
+			return current[index].RepositoryName, current[index].BySeverities
+		},
+		len(previous), func(index int) (string, *BySeverities) {
+			return previous[index].RepositoryName, previous[index].BySeverities
+		})
+
+	for index := range keys {
+		deltas = append(deltas, ByRepository{RepositoryName: keys[index], BySeverities: values[index]})
+	}
+
+	return deltas
+}
+
+func newDeltasByLanguage(current, previous []ByLanguage) (deltas []ByLanguage) {
+	keys, values := subtractByKey(
+		len(current), func(index int) (string, *BySeverities) {
+			return current[index].Language.ToString(), current[index].BySeverities
+		},
+		len(previous), func(index int) (string, *BySeverities) {
+			return previous[index].Language.ToString(), previous[index].BySeverities
+		})
+
+	for index := range keys {
+		deltas = append(deltas, ByLanguage{Language: languages.Language(keys[index]), BySeverities: values[index]})
+	}
+
+	return deltas
+}
+
+func subtractByKey(totalCurrent int, current keyedSeverities,
+	totalPrevious int, previous keyedSeverities) (keys []string, values []*BySeverities) {
+	previousByKey := map[string]*BySeverities{}
+	for index := 0; index < totalPrevious; index++ {
+		key, value := previous(index)
+		previousByKey[key] = value
+	}
+
+	for index := 0; index < totalCurrent; index++ {
+		key, value := current(index)
+		keys, values = append(keys, key), append(values, value.Subtract(previousByKey[key]))
+		delete(previousByKey, key)
+	}
+
+	for index := 0; index < totalPrevious; index++ {
+		key, _ := previous(index)
+		if value, ok := previousByKey[key]; ok {
+			keys, values = append(keys, key), append(values, (*BySeverities)(nil).Subtract(value))
+			delete(previousByKey, key)
+		}
+	}
+
+	return keys, values
+}
+
+func newDeltasByTime(current, previous []ByTime, periodOffset time.Duration) (deltas []ByTime) {
+	previousByDate := map[string]*BySeverities{}
+	for index := range previous {
+		previousByDate[previous[index].Time.Add(periodOffset).Format(deltasDateLayout)] = previous[index].BySeverities
+	}
+
+	for index := range current {
+		deltas = append(deltas, ByTime{
+			Time: current[index].Time,
+			BySeverities: current[index].BySeverities.Subtract(
+				previousByDate[current[index].Time.Format(deltasDateLayout)]),
+		})
+	}
+
+	return deltas
+}
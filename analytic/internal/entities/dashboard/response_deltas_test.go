@@ -0,0 +1,94 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/stretchr/testify/assert"
+)
+
+func newBySeveritiesWithHigh(count int) *BySeverities {
+	return &BySeverities{High: &BySeverity{Count: count, Types: &ByVulnerabilityTypes{Vulnerability: count}}}
+}
+
+func TestNewDeltas(t *testing.T) {
+	filter := &Filter{
+		StartTime: time.Date(2021, 1, 11, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2021, 1, 20, 23, 59, 59, 0, time.UTC),
+	}
+
+	t.Run("should return the difference of the totals and previous period range", func(t *testing.T) {
+		current := &Response{TotalAuthors: 4, TotalRepositories: 1}
+		previous := &Response{TotalAuthors: 6, TotalRepositories: 1}
+
+		deltas := NewDeltas(current, previous, filter.GetPreviousPeriod(), filter.GetPreviousPeriodOffset())
+
+		assert.Equal(t, -2, deltas.TotalAuthors)
+		assert.Equal(t, 0, deltas.TotalRepositories)
+		assert.Equal(t, filter.GetPreviousPeriod().StartTime, deltas.PreviousStartTime)
+		assert.Equal(t, filter.GetPreviousPeriod().EndTime, deltas.PreviousEndTime)
+		assert.NotNil(t, deltas.VulnerabilityBySeverity.Critical.Types)
+	})
+
+	t.Run("should match authors, repositories and languages by key", func(t *testing.T) {
+		current := &Response{
+			VulnerabilitiesByAuthor:     []ByAuthor{{Author: "a", BySeverities: newBySeveritiesWithHigh(5)}},
+			VulnerabilitiesByRepository: []ByRepository{{RepositoryName: "r", BySeverities: newBySeveritiesWithHigh(1)}},
+			VulnerabilitiesByLanguage:   []ByLanguage{{Language: languages.Go, BySeverities: newBySeveritiesWithHigh(3)}},
+		}
+		previous := &Response{
+			VulnerabilitiesByAuthor: []ByAuthor{
+				{Author: "b", BySeverities: newBySeveritiesWithHigh(2)},
+				{Author: "a", BySeverities: newBySeveritiesWithHigh(1)},
+			},
+			VulnerabilitiesByRepository: []ByRepository{{RepositoryName: "r", BySeverities: newBySeveritiesWithHigh(4)}},
+		}
+
+		deltas := NewDeltas(current, previous, filter.GetPreviousPeriod(), filter.GetPreviousPeriodOffset())
+
+		assert.Len(t, deltas.VulnerabilitiesByAuthor, 2)
+		assert.Equal(t, "a", deltas.VulnerabilitiesByAuthor[0].Author)
+		assert.Equal(t, 4, deltas.VulnerabilitiesByAuthor[0].High.Count)
+		assert.Equal(t, "b", deltas.VulnerabilitiesByAuthor[1].Author)
+		assert.Equal(t, -2, deltas.VulnerabilitiesByAuthor[1].High.Count)
+		assert.Equal(t, -3, deltas.VulnerabilitiesByRepository[0].High.Types.Vulnerability)
+		assert.Equal(t, languages.Go, deltas.VulnerabilitiesByLanguage[0].Language)
+		assert.Equal(t, 3, deltas.VulnerabilitiesByLanguage[0].High.Count)
+	})
+
+	t.Run("should align the time chart by the days between the periods", func(t *testing.T) {
+		current := &Response{
+			VulnerabilitiesByTime: []ByTime{
+				{Time: time.Date(2021, 1, 11, 0, 0, 0, 0, time.UTC), BySeverities: newBySeveritiesWithHigh(5)},
+				{Time: time.Date(2021, 1, 12, 15, 0, 0, 0, time.UTC), BySeverities: newBySeveritiesWithHigh(5)},
+			},
+		}
+		previous := &Response{
+			VulnerabilitiesByTime: []ByTime{
+				{Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), BySeverities: newBySeveritiesWithHigh(2)},
+			},
+		}
+
+		deltas := NewDeltas(current, previous, filter.GetPreviousPeriod(), filter.GetPreviousPeriodOffset())
+
+		assert.Len(t, deltas.VulnerabilitiesByTime, 2)
+		assert.Equal(t, current.VulnerabilitiesByTime[0].Time, deltas.VulnerabilitiesByTime[0].Time)
+		assert.Equal(t, 3, deltas.VulnerabilitiesByTime[0].High.Count)
+		assert.Equal(t, 5, deltas.VulnerabilitiesByTime[1].High.Count)
+	})
+}
@@ -16,6 +16,7 @@ package dashboard
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -152,3 +153,18 @@ func TestSetChartByTime(t *testing.T) {
 		assert.Error(t, response.SetChartByTime(times, errors.New("test")))
 	})
 }
+
+func TestSetDeltas(t *testing.T) {
+	t.Run("should success set deltas against previous response", func(t *testing.T) {
+		response := &Response{TotalAuthors: 3}
+
+		filter := &Filter{
+			StartTime: time.Date(2021, 1, 11, 0, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2021, 1, 20, 23, 59, 59, 0, time.UTC),
+		}
+
+		assert.Equal(t, response, response.SetDeltas(&Response{TotalAuthors: 1}, filter))
+		assert.Equal(t, 2, response.Deltas.TotalAuthors)
+		assert.Equal(t, filter.GetPreviousPeriod().StartTime, response.Deltas.PreviousStartTime)
+	})
+}
@@ -24,6 +24,7 @@ import (
 
 type Vulnerability struct {
 	VulnerabilityID       uuid.UUID `json:"vulnerabilityID" gorm:"Column:vulnerability_id"`
+	AnalysisID            uuid.UUID `json:"analysisID" gorm:"Column:analysis_id"`
 	CreatedAt             time.Time `json:"createdAt" gorm:"Column:created_at"`
 	WorkspaceID           uuid.UUID `json:"workspaceID" gorm:"Column:workspace_id"`
 	RepositoryID          uuid.UUID `json:"repositoryID" gorm:"Column:repository_id"`
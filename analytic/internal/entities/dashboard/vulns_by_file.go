@@ -0,0 +1,60 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"path"
+	"strings"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+)
+
+type VulnerabilitiesByFile struct {
+	File      string `json:"file" gorm:"Column:file"`
+	Directory string `json:"directory" gorm:"Column:directory"`
+	Vulnerability
+}
+
+// SetFile normalizes the path separators and sets the directory of the file, files without one are set on the root
+func (v *VulnerabilitiesByFile) SetFile(file string) *VulnerabilitiesByFile {
+	v.File = strings.ReplaceAll(file, "\\", "/")
+	v.Directory = path.Dir(v.File)
+
+	if v.File == "" {
+		v.Directory = ""
+	}
+
+	if v.Directory == "/" {
+		v.Directory = dashboard.RootDirectory
+	}
+
+	return v
+}
+
+func (v *VulnerabilitiesByFile) ToResponseByFile() ByFile {
+	return ByFile{
+		RepositoryID: v.RepositoryID,
+		File:         v.File,
+		BySeverities: v.ToResponseBySeverities(),
+	}
+}
+
+func (v *VulnerabilitiesByFile) ToResponseByDirectory() ByDirectory {
+	return ByDirectory{
+		RepositoryID: v.RepositoryID,
+		Directory:    v.Directory,
+		BySeverities: v.ToResponseBySeverities(),
+	}
+}
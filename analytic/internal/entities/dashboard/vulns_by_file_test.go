@@ -0,0 +1,97 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetFile(t *testing.T) {
+	t.Run("should success set file and directory", func(t *testing.T) {
+		vulns := (&VulnerabilitiesByFile{}).SetFile("internal/api/handler.go")
+
+		assert.Equal(t, "internal/api/handler.go", vulns.File)
+		assert.Equal(t, "internal/api", vulns.Directory)
+	})
+
+	t.Run("should normalize windows path separators", func(t *testing.T) {
+		vulns := (&VulnerabilitiesByFile{}).SetFile("internal\\api\\handler.go")
+
+		assert.Equal(t, "internal/api/handler.go", vulns.File)
+		assert.Equal(t, "internal/api", vulns.Directory)
+	})
+
+	t.Run("should set root directory when file has no directory", func(t *testing.T) {
+		assert.Equal(t, ".", (&VulnerabilitiesByFile{}).SetFile("main.go").Directory)
+		assert.Equal(t, ".", (&VulnerabilitiesByFile{}).SetFile("/main.go").Directory)
+	})
+
+	t.Run("should set empty directory when file is empty", func(t *testing.T) {
+		assert.Empty(t, (&VulnerabilitiesByFile{}).SetFile("").Directory)
+	})
+}
+
+func TestToResponseByFile(t *testing.T) {
+	t.Run("should success parse", func(t *testing.T) {
+		result := (&VulnerabilitiesByFile{File: "main.go"}).ToResponseByFile()
+
+		assert.Equal(t, "main.go", result.File)
+		assert.NotNil(t, result.BySeverities)
+	})
+}
+
+func TestToResponseByDirectory(t *testing.T) {
+	t.Run("should success parse", func(t *testing.T) {
+		result := (&VulnerabilitiesByFile{Directory: "internal"}).ToResponseByDirectory()
+
+		assert.Equal(t, "internal", result.Directory)
+		assert.NotNil(t, result.BySeverities)
+	})
+}
+
+func TestSetChartByFile(t *testing.T) {
+	t.Run("should success set chart by file", func(t *testing.T) {
+		response := &FilesResponse{}
+
+		assert.NoError(t, response.SetChartByFile([]*VulnerabilitiesByFile{{File: "main.go"}}, nil))
+		assert.Len(t, response.VulnerabilitiesByFile, 1)
+	})
+
+	t.Run("should return error when it is not nil", func(t *testing.T) {
+		response := &FilesResponse{}
+
+		assert.Error(t, response.SetChartByFile(nil, errors.New("test")))
+		assert.Empty(t, response.VulnerabilitiesByFile)
+	})
+}
+
+func TestSetChartByDirectory(t *testing.T) {
+	t.Run("should success set chart by directory", func(t *testing.T) {
+		response := &FilesResponse{}
+
+		assert.NoError(t, response.SetChartByDirectory([]*VulnerabilitiesByFile{{Directory: "internal"}}, nil))
+		assert.Len(t, response.VulnerabilitiesByDirectory, 1)
+	})
+
+	t.Run("should return error when it is not nil", func(t *testing.T) {
+		response := &FilesResponse{}
+
+		assert.Error(t, response.SetChartByDirectory(nil, errors.New("test")))
+		assert.Empty(t, response.VulnerabilitiesByDirectory)
+	})
+}
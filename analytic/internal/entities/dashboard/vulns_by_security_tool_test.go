@@ -0,0 +1,48 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToResponseBySecurityTool(t *testing.T) {
+	t.Run("should success parse", func(t *testing.T) {
+		result := (&VulnerabilitiesBySecurityTool{SecurityTool: tools.GoSec}).ToResponseBySecurityTool()
+
+		assert.Equal(t, tools.GoSec, result.SecurityTool)
+		assert.NotNil(t, result.BySeverities)
+	})
+}
+
+func TestSetChartBySecurityTool(t *testing.T) {
+	t.Run("should success set chart by security tool", func(t *testing.T) {
+		response := &SecurityToolsResponse{}
+
+		assert.NoError(t, response.SetChartBySecurityTool([]*VulnerabilitiesBySecurityTool{{}}, nil))
+		assert.Len(t, response.VulnerabilitiesBySecurityTool, 1)
+	})
+
+	t.Run("should return error when it is not nil", func(t *testing.T) {
+		response := &SecurityToolsResponse{}
+
+		assert.Error(t, response.SetChartBySecurityTool(nil, errors.New("test")))
+		assert.Empty(t, response.VulnerabilitiesBySecurityTool)
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"fmt"
+	"time"
+
+	emailEntities "github.com/ZupIT/horusec-devkit/pkg/entities/email"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	digestEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/digest"
+)
+
+// Recipient is the account subscribed with the name of the workspace, only found while the account is admin of it
+type Recipient struct {
+	Email         string `json:"email" gorm:"Column:email"`
+	Username      string `json:"username" gorm:"Column:username"`
+	WorkspaceName string `json:"workspaceName" gorm:"Column:workspace_name"`
+	Language      string `json:"language" gorm:"Column:language"`
+}
+
+// Digest is the summary of the workspace in the period sent by email to the subscribed account
+type Digest struct {
+	Recipient       *Recipient
+	Frequency       digestEnums.Frequency
+	StartTime       time.Time
+	EndTime         time.Time
+	NewFindings     *Findings
+	FixedFindings   *Findings
+	TopRepositories []*TopRepository
+	RiskAcceptances []*RiskAcceptance
+}
+
+func NewDigest(subscription *Subscription, recipient *Recipient, filter *dashboard.Filter) *Digest {
+	return &Digest{
+		Recipient:     recipient,
+		Frequency:     subscription.Frequency,
+		StartTime:     filter.StartTime,
+		EndTime:       filter.EndTime,
+		NewFindings:   &Findings{},
+		FixedFindings: &Findings{},
+	}
+}
+
+func (d *Digest) SetNewFindings(rows []*FindingsBySeverity, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.NewFindings = NewFindings(rows)
+
+	return nil
+}
+
+func (d *Digest) SetFixedFindings(rows []*FindingsBySeverity, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.FixedFindings = NewFindings(rows)
+
+	return nil
+}
+
+func (d *Digest) SetTopRepositories(repositories []*TopRepository, err error) error {
+	d.TopRepositories = repositories
+
+	return err
+}
+
+func (d *Digest) SetRiskAcceptances(riskAcceptances []*RiskAcceptance, err error) error {
+	d.RiskAcceptances = riskAcceptances
+
+	return err
+}
+
+func (d *Digest) ToEmailMessage() []byte {
+	message := &emailEntities.Message{
+		To:           d.Recipient.Email,
+		TemplateName: digestEnums.EmailTemplate,
+		Subject:      fmt.Sprintf(digestEnums.EmailSubject, d.Frequency.ToTitle(), d.Recipient.WorkspaceName),
+		Data: map[string]interface{}{
+			"Locale":          d.Recipient.Language,
+			"Username":        d.Recipient.Username,
+			"WorkspaceName":   d.Recipient.WorkspaceName,
+			"StartDate":       d.StartTime.Format(digestEnums.DateLayout),
+			"EndDate":         d.EndTime.Format(digestEnums.DateLayout),
+			"NewFindings":     d.NewFindings.ToEmailData(),
+			"FixedFindings":   d.FixedFindings.ToEmailData(),
+			"TopRepositories": d.getTopRepositoriesData(),
+			"RiskAcceptances": d.getRiskAcceptancesData(),
+		},
+	}
+
+	return message.ToBytes()
+}
+
+func (d *Digest) getTopRepositoriesData() (data []map[string]interface{}) {
+	for _, repository := range d.TopRepositories {
+		data = append(data, repository.ToEmailData())
+	}
+
+	return data
+}
+
+func (d *Digest) getRiskAcceptancesData() (data []map[string]interface{}) {
+	for _, riskAcceptance := range d.RiskAcceptances {
+		data = append(data, riskAcceptance.ToEmailData(d.EndTime))
+	}
+
+	return data
+}
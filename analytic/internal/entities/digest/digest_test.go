@@ -0,0 +1,117 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	emailEntities "github.com/ZupIT/horusec-devkit/pkg/entities/email"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	digestEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/digest"
+)
+
+func newDigest() *Digest {
+	subscription := &Subscription{WorkspaceID: uuid.New(), Frequency: digestEnums.Weekly}
+	recipient := &Recipient{Email: "test@horusec.io", Username: "test", WorkspaceName: "horusec", Language: "pt-BR"}
+
+	return NewDigest(subscription, recipient, subscription.GetFilter(time.Now()))
+}
+
+func TestNewDigest(t *testing.T) {
+	t.Run("should create the digest of the period without findings", func(t *testing.T) {
+		digest := newDigest()
+
+		assert.Equal(t, digestEnums.Weekly, digest.Frequency)
+		assert.Equal(t, digest.EndTime.AddDate(0, 0, -7), digest.StartTime)
+		assert.Equal(t, &Findings{}, digest.NewFindings)
+		assert.Equal(t, &Findings{}, digest.FixedFindings)
+	})
+}
+
+func TestSetFindings(t *testing.T) {
+	rows := []*FindingsBySeverity{{Severity: severities.High, Total: 2}}
+
+	t.Run("should set the new and fixed findings", func(t *testing.T) {
+		digest := newDigest()
+
+		assert.NoError(t, digest.SetNewFindings(rows, nil))
+		assert.NoError(t, digest.SetFixedFindings(rows, nil))
+		assert.Equal(t, 2, digest.NewFindings.High)
+		assert.Equal(t, 2, digest.FixedFindings.Total)
+	})
+
+	t.Run("should return error when failed to get the findings", func(t *testing.T) {
+		digest := newDigest()
+
+		assert.Error(t, digest.SetNewFindings(rows, errors.New("test")))
+		assert.Error(t, digest.SetFixedFindings(rows, errors.New("test")))
+		assert.Equal(t, 0, digest.NewFindings.Total)
+	})
+}
+
+func TestSetTopRepositoriesAndRiskAcceptances(t *testing.T) {
+	t.Run("should set the top repositories and risk acceptances", func(t *testing.T) {
+		digest := newDigest()
+
+		assert.NoError(t, digest.SetTopRepositories([]*TopRepository{{RepositoryName: "horusec"}}, nil))
+		assert.NoError(t, digest.SetRiskAcceptances([]*RiskAcceptance{{RepositoryName: "horusec"}}, nil))
+		assert.Len(t, digest.TopRepositories, 1)
+		assert.Len(t, digest.RiskAcceptances, 1)
+	})
+
+	t.Run("should return error when failed to get them", func(t *testing.T) {
+		digest := newDigest()
+
+		assert.Error(t, digest.SetTopRepositories(nil, errors.New("test")))
+		assert.Error(t, digest.SetRiskAcceptances(nil, errors.New("test")))
+	})
+}
+
+func TestToEmailMessage(t *testing.T) {
+	t.Run("should return the email message of the digest template", func(t *testing.T) {
+		digest := newDigest()
+		_ = digest.SetTopRepositories([]*TopRepository{{RepositoryName: "horusec", Critical: 1, Total: 1}}, nil)
+		_ = digest.SetRiskAcceptances([]*RiskAcceptance{{RepositoryName: "horusec",
+			ExpiresAt: digest.EndTime.Add(-time.Hour)}}, nil)
+
+		message := &emailEntities.Message{}
+		assert.NoError(t, json.Unmarshal(digest.ToEmailMessage(), message))
+		assert.Equal(t, "test@horusec.io", message.To)
+		assert.Equal(t, digestEnums.EmailTemplate, message.TemplateName)
+		assert.Equal(t, "[Horusec] Weekly digest of the workspace horusec", message.Subject)
+
+		data := message.Data.(map[string]interface{})
+		assert.Equal(t, "test", data["Username"])
+		assert.Equal(t, "pt-BR", data["Locale"])
+		assert.Equal(t, digest.EndTime.Format(digestEnums.DateLayout), data["EndDate"])
+		assert.Len(t, data["TopRepositories"], 1)
+		assert.Equal(t, true, data["RiskAcceptances"].([]interface{})[0].(map[string]interface{})["IsOverdue"])
+	})
+
+	t.Run("should return empty lists when there are no repositories or risk acceptances", func(t *testing.T) {
+		message := &emailEntities.Message{}
+		assert.NoError(t, json.Unmarshal(newDigest().ToEmailMessage(), message))
+
+		data := message.Data.(map[string]interface{})
+		assert.Nil(t, data["TopRepositories"])
+		assert.Nil(t, data["RiskAcceptances"])
+	})
+}
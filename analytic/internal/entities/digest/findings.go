@@ -0,0 +1,76 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+)
+
+// FindingsBySeverity is the count of vulnerabilities found or fixed in the period with the same severity
+type FindingsBySeverity struct {
+	Severity severities.Severity `json:"severity" gorm:"Column:severity"`
+	Total    int                 `json:"total" gorm:"Column:total"`
+}
+
+type Findings struct {
+	Critical int `json:"critical"`
+	High     int `json:"high"`
+	Medium   int `json:"medium"`
+	Low      int `json:"low"`
+	Info     int `json:"info"`
+	Unknown  int `json:"unknown"`
+	Total    int `json:"total"`
+}
+
+func NewFindings(rows []*FindingsBySeverity) *Findings {
+	findings := &Findings{}
+
+	for _, row := range rows {
+		findings.add(row)
+	}
+
+	return findings
+}
+
+func (f *Findings) add(row *FindingsBySeverity) {
+	switch row.Severity {
+	case severities.Critical:
+		f.Critical += row.Total
+	case severities.High:
+		f.High += row.Total
+	case severities.Medium:
+		f.Medium += row.Total
+	case severities.Low:
+		f.Low += row.Total
+	case severities.Info:
+		f.Info += row.Total
+	default:
+		f.Unknown += row.Total
+	}
+
+	f.Total += row.Total
+}
+
+func (f *Findings) ToEmailData() map[string]interface{} {
+	return map[string]interface{}{
+		"Critical": f.Critical,
+		"High":     f.High,
+		"Medium":   f.Medium,
+		"Low":      f.Low,
+		"Info":     f.Info,
+		"Unknown":  f.Unknown,
+		"Total":    f.Total,
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFindings(t *testing.T) {
+	t.Run("should sum the findings of each severity", func(t *testing.T) {
+		findings := NewFindings([]*FindingsBySeverity{
+			{Severity: severities.Critical, Total: 1},
+			{Severity: severities.High, Total: 2},
+			{Severity: severities.Medium, Total: 3},
+			{Severity: severities.Low, Total: 4},
+			{Severity: severities.Info, Total: 5},
+			{Severity: severities.Unknown, Total: 6},
+		})
+
+		assert.Equal(t, &Findings{Critical: 1, High: 2, Medium: 3, Low: 4, Info: 5, Unknown: 6, Total: 21}, findings)
+	})
+
+	t.Run("should return zero findings when there are no rows", func(t *testing.T) {
+		assert.Equal(t, &Findings{}, NewFindings(nil))
+	})
+}
+
+func TestToEmailDataFindings(t *testing.T) {
+	t.Run("should return the findings with the names used by the template", func(t *testing.T) {
+		data := (&Findings{Critical: 1, Total: 1}).ToEmailData()
+
+		assert.Equal(t, 1, data["Critical"])
+		assert.Equal(t, 1, data["Total"])
+		assert.Equal(t, 0, data["High"])
+	})
+}
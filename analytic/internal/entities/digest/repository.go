@@ -0,0 +1,36 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+// TopRepository is a repository of the workspace with the most open vulnerabilities at the end of the period
+type TopRepository struct {
+	RepositoryName string `json:"repositoryName" gorm:"Column:repository_name"`
+	Critical       int    `json:"critical" gorm:"Column:critical"`
+	High           int    `json:"high" gorm:"Column:high"`
+	Medium         int    `json:"medium" gorm:"Column:medium"`
+	Low            int    `json:"low" gorm:"Column:low"`
+	Total          int    `json:"total" gorm:"Column:total"`
+}
+
+func (t *TopRepository) ToEmailData() map[string]interface{} {
+	return map[string]interface{}{
+		"RepositoryName": t.RepositoryName,
+		"Critical":       t.Critical,
+		"High":           t.High,
+		"Medium":         t.Medium,
+		"Low":            t.Low,
+		"Total":          t.Total,
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+
+	digestEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/digest"
+)
+
+// RiskAcceptance is a risk accepted in the workspace that is overdue or expires before the next digest, so it
+// needs to be reviewed
+type RiskAcceptance struct {
+	RepositoryName  string              `json:"repositoryName" gorm:"Column:repository_name"`
+	VulnHash        string              `json:"vulnHash" gorm:"Column:vuln_hash"`
+	Severity        severities.Severity `json:"severity" gorm:"Column:severity"`
+	AccountUsername string              `json:"accountUsername" gorm:"Column:account_username"`
+	ExpiresAt       time.Time           `json:"expiresAt" gorm:"Column:expires_at"`
+}
+
+func (r *RiskAcceptance) IsOverdue(now time.Time) bool {
+	return !r.ExpiresAt.After(now)
+}
+
+func (r *RiskAcceptance) ToEmailData(now time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"RepositoryName":  r.RepositoryName,
+		"VulnHash":        r.VulnHash,
+		"Severity":        r.Severity,
+		"AccountUsername": r.AccountUsername,
+		"ExpiresAt":       r.ExpiresAt.Format(digestEnums.DateLayout),
+		"IsOverdue":       r.IsOverdue(now),
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsOverdue(t *testing.T) {
+	now := time.Now()
+
+	t.Run("should return true when the expiry date passed", func(t *testing.T) {
+		assert.True(t, (&RiskAcceptance{ExpiresAt: now.Add(-time.Hour)}).IsOverdue(now))
+	})
+
+	t.Run("should return false when expires after now", func(t *testing.T) {
+		assert.False(t, (&RiskAcceptance{ExpiresAt: now.Add(time.Hour)}).IsOverdue(now))
+	})
+}
+
+func TestToEmailDataRiskAcceptance(t *testing.T) {
+	t.Run("should return the risk acceptance with the names used by the template", func(t *testing.T) {
+		expiresAt := time.Date(2021, 12, 30, 10, 0, 0, 0, time.UTC)
+
+		data := (&RiskAcceptance{RepositoryName: "horusec", VulnHash: "1234", Severity: severities.High,
+			AccountUsername: "test", ExpiresAt: expiresAt}).ToEmailData(expiresAt.AddDate(0, 0, 1))
+
+		assert.Equal(t, "horusec", data["RepositoryName"])
+		assert.Equal(t, "2021-12-30", data["ExpiresAt"])
+		assert.Equal(t, true, data["IsOverdue"])
+	})
+}
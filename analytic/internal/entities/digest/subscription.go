@@ -0,0 +1,134 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	digestEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/digest"
+)
+
+// Subscription is the preference of an account to receive the digest of a workspace by email
+type Subscription struct {
+	AccountID   uuid.UUID             `json:"accountID" gorm:"Column:account_id"`
+	WorkspaceID uuid.UUID             `json:"workspaceID" gorm:"Column:workspace_id"`
+	Frequency   digestEnums.Frequency `json:"frequency" gorm:"Column:frequency" example:"weekly"`
+	LastSentAt  *time.Time            `json:"lastSentAt" gorm:"Column:last_sent_at"`
+	CreatedAt   time.Time             `json:"createdAt" gorm:"Column:created_at"`
+	UpdatedAt   time.Time             `json:"updatedAt" gorm:"Column:updated_at"`
+}
+
+func (s *Subscription) ToFilter() map[string]interface{} {
+	return map[string]interface{}{
+		"account_id":   s.AccountID,
+		"workspace_id": s.WorkspaceID,
+	}
+}
+
+// ToUpdateMap returns only the preferences, the date of the last digest sent is kept when they change
+func (s *Subscription) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"frequency":  s.Frequency,
+		"updated_at": s.UpdatedAt,
+	}
+}
+
+// ToSentFilter returns the condition to register the digest as sent only if no other instance sent it since the
+// subscription was read
+func (s *Subscription) ToSentFilter() map[string]interface{} {
+	filter := s.ToFilter()
+	filter["last_sent_at"] = nil
+
+	if s.LastSentAt != nil {
+		filter["last_sent_at"] = *s.LastSentAt
+	}
+
+	return filter
+}
+
+// ToUnsentFilter returns the condition to restore the last digest sent only if no other instance sent another one
+// since it was registered
+func (s *Subscription) ToUnsentFilter(sentAt time.Time) map[string]interface{} {
+	filter := s.ToFilter()
+	filter["last_sent_at"] = sentAt
+
+	return filter
+}
+
+// IsDue checks if a whole period of the frequency passed since the last digest sent
+func (s *Subscription) IsDue(now time.Time) bool {
+	return s.LastSentAt == nil || !s.LastSentAt.After(s.Frequency.GetPeriodStart(now))
+}
+
+// GetFilter returns the period of the digest, since the last one sent or a whole period of the frequency when it
+// is the first one
+func (s *Subscription) GetFilter(now time.Time) *dashboard.Filter {
+	filter := &dashboard.Filter{
+		WorkspaceID: s.WorkspaceID,
+		StartTime:   s.Frequency.GetPeriodStart(now),
+		EndTime:     now,
+	}
+
+	if s.LastSentAt != nil {
+		filter.StartTime = *s.LastSentAt
+	}
+
+	return filter
+}
+
+type SubscriptionData struct {
+	AccountID   uuid.UUID             `json:"-"`
+	WorkspaceID uuid.UUID             `json:"-"`
+	Frequency   digestEnums.Frequency `json:"frequency" example:"weekly" enums:"daily, weekly, monthly"`
+}
+
+func (s *SubscriptionData) Validate() error {
+	return validation.ValidateStruct(s,
+		validation.Field(&s.Frequency, validation.Required,
+			validation.In(digestEnums.Daily, digestEnums.Weekly, digestEnums.Monthly)),
+	)
+}
+
+// SetDataFromRequest sets the workspace only from the url, so the workspace sent in the body is ignored
+func (s *SubscriptionData) SetDataFromRequest(r *http.Request) (err error) {
+	if s.WorkspaceID, err = uuid.Parse(chi.URLParam(r, dashboardEnums.WorkspaceID)); err != nil {
+		return dashboardEnums.ErrorInvalidWorkspaceID
+	}
+
+	return nil
+}
+
+func (s *SubscriptionData) SetAccountID(accountID uuid.UUID) *SubscriptionData {
+	s.AccountID = accountID
+
+	return s
+}
+
+func (s *SubscriptionData) ToSubscription() *Subscription {
+	return &Subscription{
+		AccountID:   s.AccountID,
+		WorkspaceID: s.WorkspaceID,
+		Frequency:   s.Frequency,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	digestEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/digest"
+)
+
+func TestToFilterSubscription(t *testing.T) {
+	t.Run("should return the account and workspace of the subscription", func(t *testing.T) {
+		subscription := &Subscription{AccountID: uuid.New(), WorkspaceID: uuid.New()}
+
+		assert.Equal(t, map[string]interface{}{"account_id": subscription.AccountID,
+			"workspace_id": subscription.WorkspaceID}, subscription.ToFilter())
+	})
+}
+
+func TestToUpdateMapSubscription(t *testing.T) {
+	t.Run("should return only the preferences of the subscription", func(t *testing.T) {
+		subscription := &Subscription{Frequency: digestEnums.Daily, UpdatedAt: time.Now()}
+
+		assert.Equal(t, map[string]interface{}{"frequency": digestEnums.Daily,
+			"updated_at": subscription.UpdatedAt}, subscription.ToUpdateMap())
+	})
+}
+
+func TestToSentFilter(t *testing.T) {
+	t.Run("should return the condition without last sent date when never sent", func(t *testing.T) {
+		filter := (&Subscription{}).ToSentFilter()
+
+		assert.Contains(t, filter, "last_sent_at")
+		assert.Nil(t, filter["last_sent_at"])
+	})
+
+	t.Run("should return the condition with the last sent date", func(t *testing.T) {
+		lastSentAt := time.Now()
+
+		assert.Equal(t, lastSentAt, (&Subscription{LastSentAt: &lastSentAt}).ToSentFilter()["last_sent_at"])
+	})
+}
+
+func TestToUnsentFilter(t *testing.T) {
+	t.Run("should return the condition with the date registered as sent", func(t *testing.T) {
+		sentAt := time.Now()
+		subscription := &Subscription{AccountID: uuid.New()}
+
+		filter := subscription.ToUnsentFilter(sentAt)
+		assert.Equal(t, sentAt, filter["last_sent_at"])
+		assert.Equal(t, subscription.AccountID, filter["account_id"])
+	})
+}
+
+func TestIsDue(t *testing.T) {
+	now := time.Now()
+
+	t.Run("should return true when never sent", func(t *testing.T) {
+		assert.True(t, (&Subscription{Frequency: digestEnums.Weekly}).IsDue(now))
+	})
+
+	t.Run("should return true when a whole period passed since the last digest", func(t *testing.T) {
+		lastSentAt := now.AddDate(0, 0, -7)
+
+		assert.True(t, (&Subscription{Frequency: digestEnums.Weekly, LastSentAt: &lastSentAt}).IsDue(now))
+	})
+
+	t.Run("should return false when the period did not pass yet", func(t *testing.T) {
+		lastSentAt := now.AddDate(0, 0, -6)
+
+		assert.False(t, (&Subscription{Frequency: digestEnums.Weekly, LastSentAt: &lastSentAt}).IsDue(now))
+	})
+
+	t.Run("should use the period of the frequency of the subscription", func(t *testing.T) {
+		lastSentAt := now.AddDate(0, 0, -2)
+
+		assert.True(t, (&Subscription{Frequency: digestEnums.Daily, LastSentAt: &lastSentAt}).IsDue(now))
+		assert.False(t, (&Subscription{Frequency: digestEnums.Monthly, LastSentAt: &lastSentAt}).IsDue(now))
+	})
+}
+
+func TestGetFilter(t *testing.T) {
+	now := time.Now()
+
+	t.Run("should return a whole period of the frequency when never sent", func(t *testing.T) {
+		subscription := &Subscription{WorkspaceID: uuid.New(), Frequency: digestEnums.Monthly}
+
+		filter := subscription.GetFilter(now)
+		assert.Equal(t, subscription.WorkspaceID, filter.WorkspaceID)
+		assert.Equal(t, now.AddDate(0, -1, 0), filter.StartTime)
+		assert.Equal(t, now, filter.EndTime)
+	})
+
+	t.Run("should return the period since the last digest sent", func(t *testing.T) {
+		lastSentAt := now.AddDate(0, 0, -10)
+
+		filter := (&Subscription{Frequency: digestEnums.Weekly, LastSentAt: &lastSentAt}).GetFilter(now)
+		assert.Equal(t, lastSentAt, filter.StartTime)
+	})
+}
+
+func TestValidateSubscriptionData(t *testing.T) {
+	t.Run("should return no error when valid frequency", func(t *testing.T) {
+		assert.NoError(t, (&SubscriptionData{Frequency: digestEnums.Daily}).Validate())
+		assert.NoError(t, (&SubscriptionData{Frequency: digestEnums.Weekly}).Validate())
+		assert.NoError(t, (&SubscriptionData{Frequency: digestEnums.Monthly}).Validate())
+	})
+
+	t.Run("should return error when invalid or missing frequency", func(t *testing.T) {
+		assert.Error(t, (&SubscriptionData{Frequency: "yearly"}).Validate())
+		assert.Error(t, (&SubscriptionData{}).Validate())
+	})
+}
+
+func TestSetDataFromRequest(t *testing.T) {
+	newRequest := func(workspaceID string) *http.Request {
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add(dashboardEnums.WorkspaceID, workspaceID)
+
+		r, _ := http.NewRequest(http.MethodPut, "/test", bytes.NewBufferString(""))
+
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("should set the workspace of the url", func(t *testing.T) {
+		workspaceID := uuid.New()
+		data := &SubscriptionData{}
+
+		assert.NoError(t, data.SetDataFromRequest(newRequest(workspaceID.String())))
+		assert.Equal(t, workspaceID, data.WorkspaceID)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		assert.Equal(t, dashboardEnums.ErrorInvalidWorkspaceID,
+			(&SubscriptionData{}).SetDataFromRequest(newRequest("test")))
+	})
+}
+
+func TestToSubscription(t *testing.T) {
+	t.Run("should parse the data to a subscription of the account", func(t *testing.T) {
+		data := (&SubscriptionData{WorkspaceID: uuid.New(), Frequency: digestEnums.Weekly}).SetAccountID(uuid.New())
+
+		subscription := data.ToSubscription()
+		assert.Equal(t, data.AccountID, subscription.AccountID)
+		assert.Equal(t, data.WorkspaceID, subscription.WorkspaceID)
+		assert.Equal(t, digestEnums.Weekly, subscription.Frequency)
+		assert.Nil(t, subscription.LastSentAt)
+		assert.False(t, subscription.CreatedAt.IsZero())
+	})
+}
@@ -0,0 +1,103 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/enums/remediation"
+)
+
+// Ledger records which revision of an analysis is in each analytic table and the date of its rows
+type Ledger struct {
+	AnalysisID   uuid.UUID `json:"analysisID"`
+	Projection   string    `json:"projection"`
+	RepositoryID uuid.UUID `json:"repositoryID"`
+	Revision     int       `json:"revision"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+func NewLedger(entity *RevisedAnalysis, projection string) *Ledger {
+	return &Ledger{
+		AnalysisID:   entity.ID,
+		Projection:   projection,
+		RepositoryID: entity.RepositoryID,
+		Revision:     entity.Revision,
+		CreatedAt:    entity.CreatedAt,
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// IsNewerOrEqual checks if the revision recorded is the same or newer than the informed one, which happens when
+// the packet was replayed or received out of order
+func (l *Ledger) IsNewerOrEqual(revision int) bool {
+	return l != nil && l.Revision >= revision
+}
+
+// ToFilter returns the condition to update the ledger only if no other consumer changed it since it was read
+func (l *Ledger) ToFilter() map[string]interface{} {
+	return map[string]interface{}{
+		"analysis_id": l.AnalysisID,
+		"projection":  l.Projection,
+		"revision":    l.Revision,
+	}
+}
+
+func (l *Ledger) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"revision":   l.Revision,
+		"created_at": l.CreatedAt,
+		"updated_at": l.UpdatedAt,
+	}
+}
+
+// GetReplacedRowsFilters returns the filters of the rows of the analysis that will be replaced. When the analysis was
+// never projected, the rows saved before the analysis id was recorded are also replaced by their repository and date
+func (l *Ledger) GetReplacedRowsFilters(previous *Ledger) []map[string]interface{} {
+	filters := []map[string]interface{}{{"analysis_id": l.AnalysisID}}
+
+	if previous == nil {
+		filters = append(filters, map[string]interface{}{"repository_id": l.RepositoryID,
+			"created_at": l.CreatedAt, "analysis_id": nil})
+	}
+
+	return filters
+}
+
+// ProjectionTables returns the analytic tables generated only from the content of each analysis, the remediation
+// table is not part of it since it depends on the order the analysis were received
+func ProjectionTables() []string {
+	return []string{
+		dashboard.TableVulnerabilitiesByAuthor,
+		dashboard.TableVulnerabilitiesByRepository,
+		dashboard.TableVulnerabilitiesByLanguage,
+		dashboard.TableVulnerabilitiesByTime,
+		dashboard.TableVulnerabilitiesBySecurityTool,
+		dashboard.TableVulnerabilitiesByFile,
+	}
+}
+
+// SkippedTables returns the analytic tables that a rebuild does not replace, the remediation depends on the order
+// the analysis were received and is only kept up to date by the analytic consumers
+func SkippedTables() []string {
+	return []string{
+		remediation.TableVulnerabilitiesRemediation,
+		remediation.TableRemediationCheckpoints,
+	}
+}
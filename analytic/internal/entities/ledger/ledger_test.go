@@ -0,0 +1,125 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"testing"
+	"time"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLedgerMock(revision int, createdAt time.Time) *Ledger {
+	return NewLedger(NewRevisedAnalysis(&analysisEntities.Analysis{ID: uuid.New(), RepositoryID: uuid.New(),
+		CreatedAt: createdAt}, revision), "test")
+}
+
+func TestNewLedger(t *testing.T) {
+	t.Run("should create the ledger with the revision and date of the analysis", func(t *testing.T) {
+		entity := NewRevisedAnalysis(&analysisEntities.Analysis{ID: uuid.New(), RepositoryID: uuid.New(),
+			CreatedAt: time.Now()}, 2)
+
+		ledger := NewLedger(entity, "test")
+		assert.Equal(t, entity.ID, ledger.AnalysisID)
+		assert.Equal(t, entity.RepositoryID, ledger.RepositoryID)
+		assert.Equal(t, "test", ledger.Projection)
+		assert.Equal(t, 2, ledger.Revision)
+		assert.Equal(t, entity.CreatedAt, ledger.CreatedAt)
+		assert.False(t, ledger.UpdatedAt.IsZero())
+	})
+}
+
+func TestIsNewerOrEqual(t *testing.T) {
+	t.Run("should return true when the revision was already projected", func(t *testing.T) {
+		assert.True(t, newLedgerMock(1, time.Now()).IsNewerOrEqual(1))
+	})
+
+	t.Run("should return true when the revision is older than the projected one", func(t *testing.T) {
+		assert.True(t, newLedgerMock(3, time.Now()).IsNewerOrEqual(2))
+	})
+
+	t.Run("should return false when the revision is newer than the projected one", func(t *testing.T) {
+		assert.False(t, newLedgerMock(1, time.Now()).IsNewerOrEqual(2))
+	})
+
+	t.Run("should return false when the analysis was never projected", func(t *testing.T) {
+		var ledger *Ledger
+
+		assert.False(t, ledger.IsNewerOrEqual(0))
+	})
+}
+
+func TestToFilter(t *testing.T) {
+	t.Run("should return the condition with the revision read", func(t *testing.T) {
+		ledger := newLedgerMock(1, time.Now())
+
+		assert.Equal(t, map[string]interface{}{"analysis_id": ledger.AnalysisID, "projection": "test",
+			"revision": 1}, ledger.ToFilter())
+	})
+}
+
+func TestToUpdateMap(t *testing.T) {
+	t.Run("should return the new revision and dates", func(t *testing.T) {
+		ledger := newLedgerMock(2, time.Now())
+
+		assert.Equal(t, map[string]interface{}{"revision": 2, "created_at": ledger.CreatedAt,
+			"updated_at": ledger.UpdatedAt}, ledger.ToUpdateMap())
+	})
+}
+
+func TestGetReplacedRowsFilters(t *testing.T) {
+	t.Run("should return the analysis and the rows without analysis at its date when never projected", func(t *testing.T) {
+		ledger := newLedgerMock(0, time.Now())
+
+		assert.Equal(t, []map[string]interface{}{{"analysis_id": ledger.AnalysisID}, {"repository_id": ledger.RepositoryID,
+			"created_at": ledger.CreatedAt, "analysis_id": nil}}, ledger.GetReplacedRowsFilters(nil))
+	})
+
+	t.Run("should return only the analysis when already projected even with a different date", func(t *testing.T) {
+		previous := newLedgerMock(1, time.Now().Add(-time.Hour))
+		ledger := newLedgerMock(2, time.Now())
+
+		assert.Equal(t, []map[string]interface{}{{"analysis_id": ledger.AnalysisID}},
+			ledger.GetReplacedRowsFilters(previous))
+	})
+
+	t.Run("should not replace the rows of another analysis of the repository at the same date", func(t *testing.T) {
+		createdAt := time.Now()
+		ledger := newLedgerMock(2, createdAt)
+
+		for _, filter := range ledger.GetReplacedRowsFilters(newLedgerMock(1, createdAt)) {
+			assert.Equal(t, ledger.AnalysisID, filter["analysis_id"])
+		}
+	})
+}
+
+func TestProjectionTables(t *testing.T) {
+	t.Run("should return the six analytic tables generated from the analysis", func(t *testing.T) {
+		assert.Len(t, ProjectionTables(), 6)
+	})
+}
+
+func TestSkippedTables(t *testing.T) {
+	t.Run("should return the remediation tables that are not part of the projections", func(t *testing.T) {
+		assert.Equal(t, []string{"vulnerabilities_remediation", "vulnerabilities_remediation_checkpoints"},
+			SkippedTables())
+
+		for _, table := range SkippedTables() {
+			assert.NotContains(t, ProjectionTables(), table)
+		}
+	})
+}
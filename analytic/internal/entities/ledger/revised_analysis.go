@@ -0,0 +1,46 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+)
+
+// RevisedAnalysis is the analysis received from the broker, analysis published again after a change carry a
+// greater revision, the first publication has none
+type RevisedAnalysis struct {
+	*analysis.Analysis
+	Revision int `json:"revision"`
+}
+
+func NewRevisedAnalysis(entity *analysis.Analysis, revision int) *RevisedAnalysis {
+	return &RevisedAnalysis{
+		Analysis: entity,
+		Revision: revision,
+	}
+}
+
+// AtLedger returns the analysis with the revision and date already recorded in the ledger, so it can be projected
+// again without creating another snapshot
+func (r *RevisedAnalysis) AtLedger(previous *Ledger) *RevisedAnalysis {
+	if previous == nil {
+		return r
+	}
+
+	entity := *r.Analysis
+	entity.CreatedAt = previous.CreatedAt
+
+	return NewRevisedAnalysis(&entity, previous.Revision)
+}
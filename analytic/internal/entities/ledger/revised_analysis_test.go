@@ -0,0 +1,62 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRevisedAnalysis(t *testing.T) {
+	t.Run("should parse the revision together with the analysis", func(t *testing.T) {
+		analysisID := uuid.New()
+		entity := NewRevisedAnalysis(&analysisEntities.Analysis{}, 0)
+
+		assert.NoError(t, json.Unmarshal([]byte(`{"id":"`+analysisID.String()+`","revision":3}`), entity))
+		assert.Equal(t, analysisID, entity.ID)
+		assert.Equal(t, 3, entity.Revision)
+	})
+
+	t.Run("should parse revision zero when the packet has none", func(t *testing.T) {
+		entity := NewRevisedAnalysis(&analysisEntities.Analysis{}, 0)
+
+		assert.NoError(t, json.Unmarshal([]byte(`{"id":"`+uuid.NewString()+`"}`), entity))
+		assert.Equal(t, 0, entity.Revision)
+	})
+}
+
+func TestAtLedger(t *testing.T) {
+	t.Run("should return the analysis with the revision and date of the ledger", func(t *testing.T) {
+		entity := NewRevisedAnalysis(&analysisEntities.Analysis{ID: uuid.New(), CreatedAt: time.Now()}, 0)
+		previous := &Ledger{Revision: 4, CreatedAt: time.Now().Add(-time.Hour)}
+
+		result := entity.AtLedger(previous)
+		assert.Equal(t, entity.ID, result.ID)
+		assert.Equal(t, 4, result.Revision)
+		assert.Equal(t, previous.CreatedAt, result.CreatedAt)
+		assert.NotEqual(t, previous.CreatedAt, entity.CreatedAt)
+	})
+
+	t.Run("should return the same analysis when it was never projected", func(t *testing.T) {
+		entity := NewRevisedAnalysis(&analysisEntities.Analysis{}, 0)
+
+		assert.Equal(t, entity, entity.AtLedger(nil))
+	})
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+	"github.com/ZupIT/horusec-platform/analytic/internal/enums/rebuild"
+)
+
+// Job keeps the progress of a rebuild, it is updated by the rebuild routine while being read by the api
+type Job struct {
+	mutex         sync.RWMutex
+	id            uuid.UUID
+	scope         *Scope
+	status        string
+	totalAnalysis int
+	processed     int
+	failed        int
+	errors        []string
+	existingRows  map[string]int
+	startedAt     time.Time
+	finishedAt    time.Time
+}
+
+func NewJob(scope *Scope) *Job {
+	return &Job{
+		id:        uuid.New(),
+		scope:     scope,
+		status:    rebuild.StatusRunning,
+		startedAt: time.Now(),
+	}
+}
+
+func (j *Job) GetID() uuid.UUID {
+	return j.id
+}
+
+func (j *Job) GetScope() *Scope {
+	return j.scope
+}
+
+func (j *Job) IsRunning() bool {
+	j.mutex.RLock()
+	defer j.mutex.RUnlock()
+
+	return j.status == rebuild.StatusRunning
+}
+
+// IsExpired returns if the job finished longer than the retention time ago, so it can be forgotten
+func (j *Job) IsExpired(retention time.Duration) bool {
+	j.mutex.RLock()
+	defer j.mutex.RUnlock()
+
+	return j.status != rebuild.StatusRunning && time.Since(j.finishedAt) > retention
+}
+
+func (j *Job) SetTotalAnalysis(totalAnalysis int) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.totalAnalysis = totalAnalysis
+}
+
+func (j *Job) SetExistingRows(existingRows map[string]int) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.existingRows = existingRows
+}
+
+func (j *Job) AddResult(analysisID uuid.UUID, err error) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.processed++
+
+	if err != nil {
+		j.failed++
+		j.addError(fmt.Sprintf("%s: %v", analysisID, err))
+	}
+}
+
+func (j *Job) Finish(err error) *Job {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.status = rebuild.StatusFinished
+	j.finishedAt = time.Now()
+
+	if err != nil {
+		j.status = rebuild.StatusFailed
+		j.addError(err.Error())
+	}
+
+	return j
+}
+
+func (j *Job) addError(message string) {
+	if len(j.errors) < rebuild.MaxJobErrors {
+		j.errors = append(j.errors, message)
+	}
+}
+
+func (j *Job) ToResponse() *JobResponse {
+	j.mutex.RLock()
+	defer j.mutex.RUnlock()
+
+	return &JobResponse{
+		JobID:         j.id,
+		Scope:         *j.scope,
+		Status:        j.status,
+		TotalAnalysis: j.totalAnalysis,
+		Processed:     j.processed,
+		Failed:        j.failed,
+		Progress:      j.getProgress(),
+		Errors:        append([]string{}, j.errors...),
+		ExistingRows:  j.existingRows,
+		SkippedTables: ledger.SkippedTables(),
+		StartedAt:     j.startedAt,
+		FinishedAt:    j.finishedAt,
+	}
+}
+
+func (j *Job) getProgress() float64 {
+	if j.totalAnalysis == 0 {
+		if j.status == rebuild.StatusRunning {
+			return 0
+		}
+
+		return 100
+	}
+
+	return float64(j.processed) * 100 / float64(j.totalAnalysis)
+}
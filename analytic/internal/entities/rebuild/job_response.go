@@ -0,0 +1,36 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JobResponse struct {
+	JobID         uuid.UUID      `json:"jobID"`
+	Scope         Scope          `json:"scope"`
+	Status        string         `json:"status"`
+	TotalAnalysis int            `json:"totalAnalysis"`
+	Processed     int            `json:"processed"`
+	Failed        int            `json:"failed"`
+	Progress      float64        `json:"progress"`
+	Errors        []string       `json:"errors"`
+	ExistingRows  map[string]int `json:"existingRows,omitempty"`
+	SkippedTables []string       `json:"skippedTables"`
+	StartedAt     time.Time      `json:"startedAt"`
+	FinishedAt    time.Time      `json:"finishedAt"`
+}
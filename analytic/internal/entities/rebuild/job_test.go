@@ -0,0 +1,137 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/enums/rebuild"
+)
+
+func TestNewJob(t *testing.T) {
+	t.Run("should create a running job", func(t *testing.T) {
+		scope := &Scope{WorkspaceID: uuid.New()}
+
+		job := NewJob(scope)
+		assert.NotEqual(t, uuid.Nil, job.GetID())
+		assert.Equal(t, scope, job.GetScope())
+		assert.True(t, job.IsRunning())
+	})
+}
+
+func TestAddResult(t *testing.T) {
+	t.Run("should count processed and failed analysis", func(t *testing.T) {
+		job := NewJob(&Scope{})
+		job.SetTotalAnalysis(4)
+
+		job.AddResult(uuid.New(), nil)
+		job.AddResult(uuid.New(), errors.New("test"))
+
+		response := job.ToResponse()
+		assert.Equal(t, 2, response.Processed)
+		assert.Equal(t, 1, response.Failed)
+		assert.Equal(t, float64(50), response.Progress)
+		assert.Len(t, response.Errors, 1)
+		assert.Contains(t, response.Errors[0], "test")
+	})
+
+	t.Run("should limit the amount of errors kept", func(t *testing.T) {
+		job := NewJob(&Scope{})
+
+		for i := 0; i <= rebuild.MaxJobErrors; i++ {
+			job.AddResult(uuid.New(), errors.New("test"))
+		}
+
+		response := job.ToResponse()
+		assert.Equal(t, rebuild.MaxJobErrors+1, response.Failed)
+		assert.Len(t, response.Errors, rebuild.MaxJobErrors)
+	})
+
+	t.Run("should support results added while reading the progress", func(t *testing.T) {
+		job := NewJob(&Scope{})
+		job.SetTotalAnalysis(100)
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				job.AddResult(uuid.New(), nil)
+				_ = job.ToResponse()
+			}()
+		}
+
+		wg.Wait()
+
+		assert.Equal(t, float64(100), job.ToResponse().Progress)
+	})
+}
+
+func TestFinish(t *testing.T) {
+	t.Run("should finish the job with success", func(t *testing.T) {
+		job := NewJob(&Scope{}).Finish(nil)
+
+		response := job.ToResponse()
+		assert.False(t, job.IsRunning())
+		assert.Equal(t, rebuild.StatusFinished, response.Status)
+		assert.Equal(t, float64(100), response.Progress)
+		assert.False(t, response.FinishedAt.IsZero())
+	})
+
+	t.Run("should finish the job with failure", func(t *testing.T) {
+		response := NewJob(&Scope{}).Finish(errors.New("test")).ToResponse()
+
+		assert.Equal(t, rebuild.StatusFailed, response.Status)
+		assert.Equal(t, []string{"test"}, response.Errors)
+	})
+}
+
+func TestToResponse(t *testing.T) {
+	t.Run("should return zero progress while running without analysis", func(t *testing.T) {
+		assert.Equal(t, float64(0), NewJob(&Scope{}).ToResponse().Progress)
+	})
+
+	t.Run("should return the existing rows", func(t *testing.T) {
+		job := NewJob(&Scope{})
+		job.SetExistingRows(map[string]int{"test": 1})
+
+		assert.Equal(t, map[string]int{"test": 1}, job.ToResponse().ExistingRows)
+	})
+
+	t.Run("should return the remediation tables as skipped", func(t *testing.T) {
+		assert.Contains(t, NewJob(&Scope{}).ToResponse().SkippedTables, "vulnerabilities_remediation")
+	})
+}
+
+func TestIsExpired(t *testing.T) {
+	t.Run("should never expire a running job", func(t *testing.T) {
+		assert.False(t, NewJob(&Scope{}).IsExpired(0))
+	})
+
+	t.Run("should expire a finished job only after the retention time", func(t *testing.T) {
+		job := NewJob(&Scope{}).Finish(nil)
+
+		assert.False(t, job.IsExpired(time.Hour))
+		assert.Eventually(t, func() bool { return job.IsExpired(0) }, time.Second, time.Millisecond)
+	})
+}
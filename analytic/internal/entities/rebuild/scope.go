@@ -0,0 +1,73 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/enums/rebuild"
+)
+
+// Scope delimits the analysis that will have their analytic projections rebuilt, only the workspace is required
+type Scope struct {
+	WorkspaceID  uuid.UUID `json:"workspaceID" swaggerignore:"true"`
+	RepositoryID uuid.UUID `json:"repositoryID"`
+	StartTime    time.Time `json:"startTime"`
+	EndTime      time.Time `json:"endTime"`
+	DryRun       bool      `json:"dryRun"`
+}
+
+func (s *Scope) Validate() error {
+	if s.WorkspaceID == uuid.Nil {
+		return dashboard.ErrorInvalidWorkspaceID
+	}
+
+	if !s.StartTime.IsZero() && !s.EndTime.IsZero() && s.EndTime.Before(s.StartTime) {
+		return rebuild.ErrorInvalidTimeRange
+	}
+
+	return nil
+}
+
+func (s *Scope) GetCondition() (condition string) {
+	condition = "workspace_id = @workspaceID"
+
+	if s.RepositoryID != uuid.Nil {
+		condition += " AND repository_id = @repositoryID"
+	}
+
+	if !s.StartTime.IsZero() {
+		condition += " AND created_at >= @startTime"
+	}
+
+	if !s.EndTime.IsZero() {
+		condition += " AND created_at <= @endTime"
+	}
+
+	return condition
+}
+
+func (s *Scope) GetArgs() []interface{} {
+	return []interface{}{
+		sql.Named("workspaceID", s.WorkspaceID),
+		sql.Named("repositoryID", s.RepositoryID),
+		sql.Named("startTime", s.StartTime),
+		sql.Named("endTime", s.EndTime),
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/enums/rebuild"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("should return no error when valid scope", func(t *testing.T) {
+		scope := &Scope{WorkspaceID: uuid.New(), StartTime: time.Now().AddDate(0, -1, 0), EndTime: time.Now()}
+
+		assert.NoError(t, scope.Validate())
+	})
+
+	t.Run("should return no error when only the workspace is informed", func(t *testing.T) {
+		assert.NoError(t, (&Scope{WorkspaceID: uuid.New()}).Validate())
+	})
+
+	t.Run("should return error when missing workspace", func(t *testing.T) {
+		assert.Equal(t, dashboard.ErrorInvalidWorkspaceID, (&Scope{}).Validate())
+	})
+
+	t.Run("should return error when end time is before the start time", func(t *testing.T) {
+		scope := &Scope{WorkspaceID: uuid.New(), StartTime: time.Now(), EndTime: time.Now().AddDate(0, -1, 0)}
+
+		assert.Equal(t, rebuild.ErrorInvalidTimeRange, scope.Validate())
+	})
+}
+
+func TestGetCondition(t *testing.T) {
+	t.Run("should return only the workspace condition", func(t *testing.T) {
+		assert.Equal(t, "workspace_id = @workspaceID", (&Scope{WorkspaceID: uuid.New()}).GetCondition())
+	})
+
+	t.Run("should return the condition of all the informed fields", func(t *testing.T) {
+		scope := &Scope{WorkspaceID: uuid.New(), RepositoryID: uuid.New(), StartTime: time.Now(), EndTime: time.Now()}
+
+		assert.Equal(t, "workspace_id = @workspaceID AND repository_id = @repositoryID AND "+
+			"created_at >= @startTime AND created_at <= @endTime", scope.GetCondition())
+	})
+}
+
+func TestGetArgs(t *testing.T) {
+	t.Run("should return the named args of the scope", func(t *testing.T) {
+		scope := &Scope{WorkspaceID: uuid.New(), RepositoryID: uuid.New()}
+
+		args := scope.GetArgs()
+		assert.Len(t, args, 4)
+		assert.Equal(t, sql.Named("workspaceID", scope.WorkspaceID), args[0])
+		assert.Equal(t, sql.Named("repositoryID", scope.RepositoryID), args[1])
+	})
+}
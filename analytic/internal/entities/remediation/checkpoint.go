@@ -0,0 +1,75 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+)
+
+// Checkpoint records the last analysis of the repository applied to the remediation, so replayed packets and
+// older analysis received out of order do not resolve or reopen its vulnerabilities
+type Checkpoint struct {
+	RepositoryID      uuid.UUID `json:"repositoryID" gorm:"Column:repository_id"`
+	AnalysisID        uuid.UUID `json:"analysisID" gorm:"Column:analysis_id"`
+	AnalysisCreatedAt time.Time `json:"analysisCreatedAt" gorm:"Column:analysis_created_at"`
+	Revision          int       `json:"revision" gorm:"Column:revision"`
+	UpdatedAt         time.Time `json:"updatedAt" gorm:"Column:updated_at"`
+}
+
+func NewCheckpoint(entity *ledger.RevisedAnalysis) *Checkpoint {
+	return &Checkpoint{
+		RepositoryID:      entity.RepositoryID,
+		AnalysisID:        entity.ID,
+		AnalysisCreatedAt: entity.CreatedAt,
+		Revision:          entity.Revision,
+		UpdatedAt:         time.Now(),
+	}
+}
+
+// IsNewerOrEqual checks if the analysis recorded is the same revision, a newer revision or a newer analysis than
+// the informed one
+func (c *Checkpoint) IsNewerOrEqual(entity *ledger.RevisedAnalysis) bool {
+	if c == nil {
+		return false
+	}
+
+	if c.AnalysisID == entity.ID {
+		return c.Revision >= entity.Revision
+	}
+
+	return !entity.CreatedAt.After(c.AnalysisCreatedAt)
+}
+
+// ToFilter returns the condition to update the checkpoint only if no other consumer changed it since it was read
+func (c *Checkpoint) ToFilter() map[string]interface{} {
+	return map[string]interface{}{
+		"repository_id": c.RepositoryID,
+		"analysis_id":   c.AnalysisID,
+		"revision":      c.Revision,
+	}
+}
+
+func (c *Checkpoint) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"analysis_id":         c.AnalysisID,
+		"analysis_created_at": c.AnalysisCreatedAt,
+		"revision":            c.Revision,
+		"updated_at":          c.UpdatedAt,
+	}
+}
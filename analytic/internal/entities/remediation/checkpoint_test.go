@@ -0,0 +1,98 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+)
+
+func TestNewCheckpoint(t *testing.T) {
+	t.Run("should create the checkpoint of the analysis revision", func(t *testing.T) {
+		entity := ledger.NewRevisedAnalysis(&analysis.Analysis{ID: uuid.New(), RepositoryID: uuid.New(),
+			CreatedAt: time.Now()}, 2)
+
+		checkpoint := NewCheckpoint(entity)
+
+		assert.Equal(t, entity.RepositoryID, checkpoint.RepositoryID)
+		assert.Equal(t, entity.ID, checkpoint.AnalysisID)
+		assert.Equal(t, entity.CreatedAt, checkpoint.AnalysisCreatedAt)
+		assert.Equal(t, 2, checkpoint.Revision)
+		assert.False(t, checkpoint.UpdatedAt.IsZero())
+	})
+}
+
+func TestCheckpointIsNewerOrEqual(t *testing.T) {
+	createdAt := time.Now()
+	checkpoint := &Checkpoint{AnalysisID: uuid.New(), AnalysisCreatedAt: createdAt, Revision: 1}
+
+	t.Run("should return false when there is no checkpoint", func(t *testing.T) {
+		var empty *Checkpoint
+
+		assert.False(t, empty.IsNewerOrEqual(ledger.NewRevisedAnalysis(&analysis.Analysis{}, 0)))
+	})
+
+	t.Run("should return true when it is the same or an older revision of the analysis", func(t *testing.T) {
+		assert.True(t, checkpoint.IsNewerOrEqual(ledger.NewRevisedAnalysis(
+			&analysis.Analysis{ID: checkpoint.AnalysisID, CreatedAt: createdAt}, 1)))
+		assert.True(t, checkpoint.IsNewerOrEqual(ledger.NewRevisedAnalysis(
+			&analysis.Analysis{ID: checkpoint.AnalysisID, CreatedAt: createdAt}, 0)))
+	})
+
+	t.Run("should return false when it is a newer revision of the analysis", func(t *testing.T) {
+		assert.False(t, checkpoint.IsNewerOrEqual(ledger.NewRevisedAnalysis(
+			&analysis.Analysis{ID: checkpoint.AnalysisID, CreatedAt: createdAt}, 2)))
+	})
+
+	t.Run("should return true when it is an older analysis of the repository", func(t *testing.T) {
+		assert.True(t, checkpoint.IsNewerOrEqual(ledger.NewRevisedAnalysis(
+			&analysis.Analysis{ID: uuid.New(), CreatedAt: createdAt.Add(-time.Hour)}, 0)))
+	})
+
+	t.Run("should return false when it is a newer analysis of the repository", func(t *testing.T) {
+		assert.False(t, checkpoint.IsNewerOrEqual(ledger.NewRevisedAnalysis(
+			&analysis.Analysis{ID: uuid.New(), CreatedAt: createdAt.Add(time.Hour)}, 0)))
+	})
+}
+
+func TestCheckpointToFilter(t *testing.T) {
+	t.Run("should return the read state of the checkpoint", func(t *testing.T) {
+		checkpoint := &Checkpoint{RepositoryID: uuid.New(), AnalysisID: uuid.New(), Revision: 1}
+
+		assert.Equal(t, map[string]interface{}{"repository_id": checkpoint.RepositoryID,
+			"analysis_id": checkpoint.AnalysisID, "revision": 1}, checkpoint.ToFilter())
+	})
+}
+
+func TestCheckpointToUpdateMap(t *testing.T) {
+	t.Run("should return the analysis fields of the checkpoint", func(t *testing.T) {
+		checkpoint := &Checkpoint{AnalysisID: uuid.New(), AnalysisCreatedAt: time.Now(), Revision: 1,
+			UpdatedAt: time.Now()}
+
+		updateMap := checkpoint.ToUpdateMap()
+
+		assert.Equal(t, checkpoint.AnalysisID, updateMap["analysis_id"])
+		assert.Equal(t, checkpoint.AnalysisCreatedAt, updateMap["analysis_created_at"])
+		assert.Equal(t, 1, updateMap["revision"])
+		assert.Equal(t, checkpoint.UpdatedAt, updateMap["updated_at"])
+	})
+}
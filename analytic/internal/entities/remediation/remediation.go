@@ -0,0 +1,95 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+)
+
+// Remediation tracks when a vulnerability was first seen in a repository and when it was resolved, a vulnerability
+// is resolved when it is corrected or when it is no longer found by the analysis of the repository
+type Remediation struct {
+	RemediationID uuid.UUID           `json:"remediationID" gorm:"Column:remediation_id"`
+	WorkspaceID   uuid.UUID           `json:"workspaceID" gorm:"Column:workspace_id"`
+	RepositoryID  uuid.UUID           `json:"repositoryID" gorm:"Column:repository_id"`
+	VulnHash      string              `json:"vulnHash" gorm:"Column:vuln_hash"`
+	Severity      severities.Severity `json:"severity" gorm:"Column:severity"`
+	FirstSeenAt   time.Time           `json:"firstSeenAt" gorm:"Column:first_seen_at"`
+	LastSeenAt    time.Time           `json:"lastSeenAt" gorm:"Column:last_seen_at"`
+	ResolvedAt    *time.Time          `json:"resolvedAt" gorm:"Column:resolved_at"`
+}
+
+func NewRemediation(entity *analysis.Analysis, vuln *vulnerability.Vulnerability) *Remediation {
+	return &Remediation{
+		RemediationID: uuid.New(),
+		WorkspaceID:   entity.WorkspaceID,
+		RepositoryID:  entity.RepositoryID,
+		VulnHash:      vuln.VulnHash,
+		Severity:      vuln.Severity,
+		FirstSeenAt:   entity.CreatedAt,
+		LastSeenAt:    entity.CreatedAt,
+	}
+}
+
+func (r *Remediation) IsOpen() bool {
+	return r.ResolvedAt == nil
+}
+
+// See registers the vulnerability found again, a resolved vulnerability found again is reopened as a new occurrence
+func (r *Remediation) See(seenAt time.Time, severity severities.Severity) {
+	if !r.IsOpen() {
+		r.FirstSeenAt = seenAt
+		r.ResolvedAt = nil
+	}
+
+	r.LastSeenAt = seenAt
+	r.Severity = severity
+}
+
+func (r *Remediation) Resolve(resolvedAt time.Time) {
+	r.ResolvedAt = &resolvedAt
+}
+
+func (r *Remediation) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"severity":      r.Severity,
+		"first_seen_at": r.FirstSeenAt,
+		"last_seen_at":  r.LastSeenAt,
+		"resolved_at":   r.ResolvedAt,
+	}
+}
+
+func (r *Remediation) ToFilter() map[string]interface{} {
+	return map[string]interface{}{"remediation_id": r.RemediationID}
+}
+
+// ToUpsertFilter returns the condition to update the remediation of the vulnerability already in the repository
+// instead of creating it again
+func (r *Remediation) ToUpsertFilter() map[string]interface{} {
+	return map[string]interface{}{"repository_id": r.RepositoryID, "vuln_hash": r.VulnHash}
+}
+
+// Changes are the remediations created, updated and deleted by an analysis of the repository
+type Changes struct {
+	Created []*Remediation
+	Updated []*Remediation
+	Deleted []*Remediation
+}
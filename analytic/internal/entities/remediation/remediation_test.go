@@ -0,0 +1,92 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+)
+
+func TestNewRemediation(t *testing.T) {
+	t.Run("should create an open remediation first seen at the analysis", func(t *testing.T) {
+		entity := &analysis.Analysis{WorkspaceID: uuid.New(), RepositoryID: uuid.New(), CreatedAt: time.Now()}
+
+		remediation := NewRemediation(entity, &vulnerability.Vulnerability{VulnHash: "hash",
+			Severity: severities.Critical})
+
+		assert.NotEqual(t, uuid.Nil, remediation.RemediationID)
+		assert.Equal(t, entity.WorkspaceID, remediation.WorkspaceID)
+		assert.Equal(t, entity.RepositoryID, remediation.RepositoryID)
+		assert.Equal(t, "hash", remediation.VulnHash)
+		assert.Equal(t, severities.Critical, remediation.Severity)
+		assert.Equal(t, entity.CreatedAt, remediation.FirstSeenAt)
+		assert.Equal(t, entity.CreatedAt, remediation.LastSeenAt)
+		assert.True(t, remediation.IsOpen())
+	})
+}
+
+func TestSee(t *testing.T) {
+	t.Run("should keep the first seen date of an open remediation", func(t *testing.T) {
+		firstSeenAt := time.Now().Add(-time.Hour)
+		remediation := &Remediation{FirstSeenAt: firstSeenAt, LastSeenAt: firstSeenAt, Severity: severities.Low}
+		seenAt := time.Now()
+
+		remediation.See(seenAt, severities.High)
+
+		assert.Equal(t, firstSeenAt, remediation.FirstSeenAt)
+		assert.Equal(t, seenAt, remediation.LastSeenAt)
+		assert.Equal(t, severities.High, remediation.Severity)
+	})
+
+	t.Run("should reopen a resolved remediation as a new occurrence", func(t *testing.T) {
+		remediation := &Remediation{FirstSeenAt: time.Now().Add(-time.Hour)}
+		remediation.Resolve(time.Now().Add(-time.Minute))
+		seenAt := time.Now()
+
+		remediation.See(seenAt, severities.High)
+
+		assert.True(t, remediation.IsOpen())
+		assert.Equal(t, seenAt, remediation.FirstSeenAt)
+	})
+}
+
+func TestResolve(t *testing.T) {
+	t.Run("should set the resolved date", func(t *testing.T) {
+		remediation := &Remediation{RemediationID: uuid.New()}
+		resolvedAt := time.Now()
+
+		remediation.Resolve(resolvedAt)
+
+		assert.False(t, remediation.IsOpen())
+		assert.Equal(t, &resolvedAt, remediation.ToUpdateMap()["resolved_at"])
+		assert.Equal(t, remediation.RemediationID, remediation.ToFilter()["remediation_id"])
+	})
+}
+
+func TestToUpsertFilter(t *testing.T) {
+	t.Run("should return the repository and hash of the vulnerability", func(t *testing.T) {
+		remediation := &Remediation{RepositoryID: uuid.New(), VulnHash: "hash"}
+
+		assert.Equal(t, map[string]interface{}{"repository_id": remediation.RepositoryID, "vuln_hash": "hash"},
+			remediation.ToUpsertFilter())
+	})
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"fmt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+
+	remediationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/remediation"
+)
+
+// ResolvedBySeverity is the total of vulnerabilities of a severity resolved in the period with their mean time to
+// remediate in hours
+type ResolvedBySeverity struct {
+	Severity      severities.Severity `gorm:"Column:severity"`
+	TotalResolved int                 `gorm:"Column:total_resolved"`
+	MTTRHours     float64             `gorm:"Column:mttr_hours"`
+}
+
+// OpenByAge is the total of vulnerabilities of a severity open at the end of the period for the same amount of days
+type OpenByAge struct {
+	Severity severities.Severity `gorm:"Column:severity"`
+	AgeDays  int                 `gorm:"Column:age_days"`
+	Total    int                 `gorm:"Column:total"`
+}
+
+type BySeverity struct {
+	Severity      severities.Severity `json:"severity"`
+	SLADays       int                 `json:"slaDays"`
+	TotalResolved int                 `json:"totalResolved"`
+	MTTRHours     float64             `json:"mttrHours"`
+	TotalOpen     int                 `json:"totalOpen"`
+	OpenPastSLA   int                 `json:"openPastSLA"`
+}
+
+// AgingBucket is the total of open vulnerabilities by severity open between the min and max days, the max days is
+// -1 for the last bucket since it has no upper limit
+type AgingBucket struct {
+	Label           string                      `json:"label"`
+	MinDays         int                         `json:"minDays"`
+	MaxDays         int                         `json:"maxDays"`
+	Total           int                         `json:"total"`
+	TotalBySeverity map[severities.Severity]int `json:"totalBySeverity"`
+}
+
+type Response struct {
+	BySeverity     []*BySeverity  `json:"bySeverity"`
+	AgingHistogram []*AgingBucket `json:"agingHistogram"`
+}
+
+func NewResponse(targets SLATargets) *Response {
+	response := &Response{AgingHistogram: newAgingHistogram()}
+
+	for _, severity := range severities.Values() {
+		response.BySeverity = append(response.BySeverity, &BySeverity{Severity: severity, SLADays: targets[severity]})
+	}
+
+	return response
+}
+
+func newAgingHistogram() []*AgingBucket {
+	limits := [][]int{{0, 7}, {8, 30}, {31, 90}, {91, 180}, {181, remediationEnums.AgingBucketUnbounded}}
+	histogram := make([]*AgingBucket, 0, len(limits))
+
+	for _, limit := range limits {
+		histogram = append(histogram, &AgingBucket{Label: newAgingBucketLabel(limit[0], limit[1]),
+			MinDays: limit[0], MaxDays: limit[1], TotalBySeverity: map[severities.Severity]int{}})
+	}
+
+	return histogram
+}
+
+func newAgingBucketLabel(minDays, maxDays int) string {
+	if maxDays == remediationEnums.AgingBucketUnbounded {
+		return fmt.Sprintf("%d+", minDays)
+	}
+
+	return fmt.Sprintf("%d-%d", minDays, maxDays)
+}
+
+func (r *Response) SetResolved(resolved []*ResolvedBySeverity, err error) error {
+	if err == nil {
+		for index := range resolved {
+			if bySeverity := r.getBySeverity(resolved[index].Severity); bySeverity != nil {
+				bySeverity.TotalResolved = resolved[index].TotalResolved
+				bySeverity.MTTRHours = resolved[index].MTTRHours
+			}
+		}
+	}
+
+	return err
+}
+
+func (r *Response) SetOpen(open []*OpenByAge, targets SLATargets, err error) error {
+	if err == nil {
+		for index := range open {
+			r.addOpen(open[index], targets)
+		}
+	}
+
+	return err
+}
+
+func (r *Response) addOpen(open *OpenByAge, targets SLATargets) {
+	if bySeverity := r.getBySeverity(open.Severity); bySeverity != nil {
+		bySeverity.TotalOpen += open.Total
+
+		if targets.IsPastSLA(open.Severity, open.AgeDays) {
+			bySeverity.OpenPastSLA += open.Total
+		}
+	}
+
+	if bucket := r.getAgingBucket(open.AgeDays); bucket != nil {
+		bucket.Total += open.Total
+		bucket.TotalBySeverity[open.Severity] += open.Total
+	}
+}
+
+func (r *Response) getBySeverity(severity severities.Severity) *BySeverity {
+	for index := range r.BySeverity {
+		if r.BySeverity[index].Severity == severity {
+			return r.BySeverity[index]
+		}
+	}
+
+	return nil
+}
+
+func (r *Response) getAgingBucket(ageDays int) *AgingBucket {
+	for index := range r.AgingHistogram {
+		bucket := r.AgingHistogram[index]
+		if ageDays >= bucket.MinDays &&
+			(bucket.MaxDays == remediationEnums.AgingBucketUnbounded || ageDays <= bucket.MaxDays) {
+			return bucket
+		}
+	}
+
+	return nil
+}
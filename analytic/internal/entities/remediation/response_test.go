@@ -0,0 +1,82 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+
+	remediationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/remediation"
+)
+
+func TestNewResponse(t *testing.T) {
+	t.Run("should create the response with all severities and aging buckets", func(t *testing.T) {
+		response := NewResponse(SLATargets{severities.Critical: 7})
+
+		assert.Len(t, response.BySeverity, len(severities.Values()))
+		assert.Equal(t, 7, response.getBySeverity(severities.Critical).SLADays)
+		assert.Len(t, response.AgingHistogram, 5)
+		assert.Equal(t, "0-7", response.AgingHistogram[0].Label)
+		assert.Equal(t, "181+", response.AgingHistogram[4].Label)
+		assert.Equal(t, remediationEnums.AgingBucketUnbounded, response.AgingHistogram[4].MaxDays)
+	})
+}
+
+func TestSetResolved(t *testing.T) {
+	t.Run("should set the mean time to remediate of the severities", func(t *testing.T) {
+		response := NewResponse(SLATargets{})
+
+		assert.NoError(t, response.SetResolved([]*ResolvedBySeverity{
+			{Severity: severities.Critical, TotalResolved: 2, MTTRHours: 36.5},
+			{Severity: "INVALID", TotalResolved: 1},
+		}, nil))
+
+		assert.Equal(t, 2, response.getBySeverity(severities.Critical).TotalResolved)
+		assert.Equal(t, 36.5, response.getBySeverity(severities.Critical).MTTRHours)
+	})
+
+	t.Run("should return error when it is not nil", func(t *testing.T) {
+		assert.Error(t, NewResponse(SLATargets{}).SetResolved(nil, errors.New("test")))
+	})
+}
+
+func TestSetOpen(t *testing.T) {
+	t.Run("should set the open vulnerabilities past the sla and the aging histogram", func(t *testing.T) {
+		targets := SLATargets{severities.Critical: 7, severities.High: 30}
+		response := NewResponse(targets)
+
+		assert.NoError(t, response.SetOpen([]*OpenByAge{
+			{Severity: severities.Critical, AgeDays: 7, Total: 1},
+			{Severity: severities.Critical, AgeDays: 8, Total: 2},
+			{Severity: severities.High, AgeDays: 400, Total: 3},
+		}, targets, nil))
+
+		critical := response.getBySeverity(severities.Critical)
+		assert.Equal(t, 3, critical.TotalOpen)
+		assert.Equal(t, 2, critical.OpenPastSLA)
+		assert.Equal(t, 3, response.getBySeverity(severities.High).OpenPastSLA)
+		assert.Equal(t, 1, response.AgingHistogram[0].Total)
+		assert.Equal(t, 2, response.AgingHistogram[1].TotalBySeverity[severities.Critical])
+		assert.Equal(t, 3, response.AgingHistogram[4].TotalBySeverity[severities.High])
+	})
+
+	t.Run("should return error when it is not nil", func(t *testing.T) {
+		assert.Error(t, NewResponse(SLATargets{}).SetOpen(nil, SLATargets{}, errors.New("test")))
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+
+	remediationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/remediation"
+)
+
+// SLATargets are the days that a vulnerability of each severity can stay open before it is past the SLA
+type SLATargets map[severities.Severity]int
+
+func NewSLATargets() SLATargets {
+	return SLATargets{
+		severities.Critical: env.GetEnvOrDefaultInt(remediationEnums.EnvSLACriticalDays,
+			remediationEnums.DefaultSLACriticalDays),
+		severities.High:   env.GetEnvOrDefaultInt(remediationEnums.EnvSLAHighDays, remediationEnums.DefaultSLAHighDays),
+		severities.Medium: env.GetEnvOrDefaultInt(remediationEnums.EnvSLAMediumDays, remediationEnums.DefaultSLAMediumDays),
+		severities.Low:    env.GetEnvOrDefaultInt(remediationEnums.EnvSLALowDays, remediationEnums.DefaultSLALowDays),
+		severities.Info:   env.GetEnvOrDefaultInt(remediationEnums.EnvSLAInfoDays, remediationEnums.DefaultSLAInfoDays),
+		severities.Unknown: env.GetEnvOrDefaultInt(remediationEnums.EnvSLAUnknownDays,
+			remediationEnums.DefaultSLAUnknownDays),
+	}
+}
+
+// IsPastSLA checks if a vulnerability open for the days is past the SLA of its severity
+func (s SLATargets) IsPastSLA(severity severities.Severity, ageDays int) bool {
+	target, ok := s[severity]
+
+	return ok && ageDays > target
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+
+	remediationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/remediation"
+)
+
+func TestNewSLATargets(t *testing.T) {
+	t.Run("should return the default targets", func(t *testing.T) {
+		targets := NewSLATargets()
+
+		assert.Equal(t, remediationEnums.DefaultSLACriticalDays, targets[severities.Critical])
+		assert.Equal(t, remediationEnums.DefaultSLAUnknownDays, targets[severities.Unknown])
+		assert.Len(t, targets, len(severities.Values()))
+	})
+
+	t.Run("should return the targets from the environment", func(t *testing.T) {
+		t.Setenv(remediationEnums.EnvSLACriticalDays, "3")
+
+		assert.Equal(t, 3, NewSLATargets()[severities.Critical])
+	})
+}
+
+func TestIsPastSLA(t *testing.T) {
+	t.Run("should return true only when the age is greater than the target", func(t *testing.T) {
+		targets := SLATargets{severities.Critical: 7}
+
+		assert.False(t, targets.IsPastSLA(severities.Critical, 7))
+		assert.True(t, targets.IsPastSLA(severities.Critical, 8))
+		assert.False(t, targets.IsPastSLA(severities.High, 100))
+	})
+}
@@ -14,6 +14,8 @@
 
 package dashboard
 
+import "github.com/ZupIT/horusec-devkit/pkg/enums/queues"
+
 const (
 	DefaultPaginationSize            = 10
 	TableVulnerabilitiesByAuthor     = "vulnerabilities_by_author"
@@ -26,4 +28,13 @@ const (
 	PageHeader                       = "page"
 	InitialDateHeader                = "initialDate"
 	FinalDateHeader                  = "finalDate"
+	ComparePreviousPeriodHeader      = "comparePreviousPeriod"
+)
+
+const (
+	TableVulnerabilitiesBySecurityTool              = "vulnerabilities_by_security_tool"
+	TableVulnerabilitiesByFile                      = "vulnerabilities_by_file"
+	QueueNewAnalysisBySecurityTool     queues.Queue = "horusec-analytic::new-analysis-by-security-tool"
+	QueueNewAnalysisByFile             queues.Queue = "horusec-analytic::new-analysis-by-file"
+	RootDirectory                                   = "."
 )
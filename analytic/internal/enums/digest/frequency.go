@@ -0,0 +1,61 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Daily   Frequency = "daily"
+	Weekly  Frequency = "weekly"
+	Monthly Frequency = "monthly"
+)
+
+func (f Frequency) ToString() string {
+	return string(f)
+}
+
+// ToTitle returns the frequency with the first letter in upper case, used in the subject of the email
+func (f Frequency) ToTitle() string {
+	return strings.ToUpper(f.ToString()[:1]) + f.ToString()[1:]
+}
+
+// GetPeriodStart returns when the period of the frequency that ends at the time informed started
+func (f Frequency) GetPeriodStart(end time.Time) time.Time {
+	switch f {
+	case Daily:
+		return end.AddDate(0, 0, -1)
+	case Monthly:
+		return end.AddDate(0, -1, 0)
+	default:
+		return end.AddDate(0, 0, -7)
+	}
+}
+
+// GetPeriodEnd returns when the period of the frequency that starts at the time informed ends
+func (f Frequency) GetPeriodEnd(start time.Time) time.Time {
+	switch f {
+	case Daily:
+		return start.AddDate(0, 0, 1)
+	case Monthly:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 7)
+	}
+}
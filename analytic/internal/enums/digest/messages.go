@@ -0,0 +1,26 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+const (
+	MessageDigestDisabled = "{DIGEST} the horusec database uri was not informed or emails are disabled, " +
+		"the digest emails will not be sent"
+	MessageFailedToSendDigests  = "{DIGEST} failed to send the digest emails"
+	MessageFailedToSendDigest   = "{DIGEST} failed to send the digest of the workspace %s to the account %s"
+	MessageFailedToUnmarkDigest = "{DIGEST} failed to undo the digest sent of the workspace %s to the account %s, " +
+		"it will only be sent in the next period"
+	MessageRemovedSubscription = "{DIGEST} account %s is no longer admin of the workspace %s, " +
+		"removing its digest subscription"
+)
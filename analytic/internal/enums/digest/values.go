@@ -0,0 +1,34 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"time"
+
+	emailEnums "github.com/ZupIT/horusec-devkit/pkg/enums/email"
+)
+
+const (
+	TableDigestSubscriptions = "digest_subscriptions"
+	TableRiskAcceptances     = "vulnerability_risk_acceptances"
+	JobInterval              = time.Hour
+	EnvDisableEmails         = "HORUSEC_DISABLE_EMAILS"
+	EmailSubject             = "[Horusec] %s digest of the workspace %s"
+	DateLayout               = "2006-01-02"
+	TopRepositoriesLimit     = 5
+	RiskAcceptancesLimit     = 20
+
+	EmailTemplate emailEnums.Template = "workspace-digest"
+)
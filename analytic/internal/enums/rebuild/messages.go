@@ -0,0 +1,29 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+const (
+	MessageRebuildStarted  = "{REBUILD} starting to rebuild %d analysis of workspace %s, repository %s from %v to %v"
+	MessageRebuildProgress = "{REBUILD} rebuilding analysis [%d/%d] with id %s"
+	MessageRebuildFinished = "{REBUILD} rebuild finished with %d analysis processed and %d failed"
+	MessageFailedToRebuild = "{REBUILD} failed to rebuild analysis with id %s"
+)
+
+const (
+	MessageInvalidScope              = "{REBUILD} invalid rebuild scope"
+	MessageFailedToConnectToDatabase = "{REBUILD} failed to connect to the analytic or horusec database, please " +
+		"check the uris informed in HORUSEC_DATABASE_SQL_URI and HORUSEC_DATABASE_HORUSEC_SQL_URI env variables"
+	MessageFailedToRebuildScope = "{REBUILD} failed to rebuild the analytic"
+)
@@ -0,0 +1,37 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import "github.com/ZupIT/horusec-devkit/pkg/enums/queues"
+
+const (
+	TableVulnerabilitiesRemediation              = "vulnerabilities_remediation"
+	TableRemediationCheckpoints                  = "vulnerabilities_remediation_checkpoints"
+	MaxTrackAttempts                             = 3
+	QueueNewAnalysisByRemediation   queues.Queue = "horusec-analytic::new-analysis-by-remediation"
+	EnvSLACriticalDays                           = "HORUSEC_ANALYTIC_SLA_CRITICAL_DAYS"
+	EnvSLAHighDays                               = "HORUSEC_ANALYTIC_SLA_HIGH_DAYS"
+	EnvSLAMediumDays                             = "HORUSEC_ANALYTIC_SLA_MEDIUM_DAYS"
+	EnvSLALowDays                                = "HORUSEC_ANALYTIC_SLA_LOW_DAYS"
+	EnvSLAInfoDays                               = "HORUSEC_ANALYTIC_SLA_INFO_DAYS"
+	EnvSLAUnknownDays                            = "HORUSEC_ANALYTIC_SLA_UNKNOWN_DAYS"
+	DefaultSLACriticalDays                       = 7
+	DefaultSLAHighDays                           = 30
+	DefaultSLAMediumDays                         = 90
+	DefaultSLALowDays                            = 180
+	DefaultSLAInfoDays                           = 365
+	DefaultSLAUnknownDays                        = 365
+	AgingBucketUnbounded                         = -1
+)
@@ -26,6 +26,8 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
 
 	"github.com/ZupIT/horusec-platform/analytic/internal/controllers/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
 	eventsEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/events"
 )
 
@@ -56,13 +58,19 @@ func (e *Events) startConsumers() *Events {
 	go e.broker.Consume(queues.HorusecAnalyticNewAnalysisByTime.ToString(), exchange.NewAnalysis, exchange.Fanout,
 		func(pack packet.IPacket) { e.handleNewAnalysis(pack, queues.HorusecAnalyticNewAnalysisByTime) })
 
+	go e.broker.Consume(dashboardEnums.QueueNewAnalysisBySecurityTool.ToString(), exchange.NewAnalysis, exchange.Fanout,
+		func(pack packet.IPacket) { e.handleNewAnalysis(pack, dashboardEnums.QueueNewAnalysisBySecurityTool) })
+
+	go e.broker.Consume(dashboardEnums.QueueNewAnalysisByFile.ToString(), exchange.NewAnalysis, exchange.Fanout,
+		func(pack packet.IPacket) { e.handleNewAnalysis(pack, dashboardEnums.QueueNewAnalysisByFile) })
+
 	return e
 }
 
 func (e *Events) handleNewAnalysis(analysisPacket packet.IPacket, queue queues.Queue) {
 	logger.LogInfo(eventsEnums.MessageNewAnalysisReceivedAnalytic)
 
-	analysis := &analysisEntities.Analysis{}
+	analysis := ledger.NewRevisedAnalysis(&analysisEntities.Analysis{}, 0)
 
 	if err := parser.ParsePacketToEntity(analysisPacket, analysis); err != nil {
 		logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToParsePacket, analysisPacket.GetBody(), queue), err)
@@ -78,7 +86,7 @@ func (e *Events) handleNewAnalysis(analysisPacket packet.IPacket, queue queues.Q
 }
 
 //nolint:exhaustive // no need of all constants
-func (e *Events) processNewAnalysisPacketByQueue(queue queues.Queue) func(*analysisEntities.Analysis) error {
+func (e *Events) processNewAnalysisPacketByQueue(queue queues.Queue) func(*ledger.RevisedAnalysis) error {
 	switch queue {
 	case queues.HorusecAnalyticNewAnalysisByAuthors:
 		return e.controller.AddVulnerabilitiesByAuthor
@@ -88,6 +96,10 @@ func (e *Events) processNewAnalysisPacketByQueue(queue queues.Queue) func(*analy
 		return e.controller.AddVulnerabilitiesByLanguage
 	case queues.HorusecAnalyticNewAnalysisByTime:
 		return e.controller.AddVulnerabilitiesByTime
+	case dashboardEnums.QueueNewAnalysisBySecurityTool:
+		return e.controller.AddVulnerabilitiesBySecurityTool
+	case dashboardEnums.QueueNewAnalysisByFile:
+		return e.controller.AddVulnerabilitiesByFile
 	}
 
 	return nil
@@ -15,6 +15,7 @@
 package dashboard
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -23,10 +24,17 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/enums/queues"
 	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
 	brokerPacket "github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+	"github.com/google/uuid"
 	"github.com/streadway/amqp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	dashboardController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	dashboardRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/dashboard"
+	ledgerRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/ledger"
+	dashboardUseCases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/dashboard"
 )
 
 func TestNewDashboardEvent(t *testing.T) {
@@ -45,6 +53,8 @@ func TestNewDashboardEvent(t *testing.T) {
 		controllerMock.On("AddVulnerabilitiesByRepository").Return(nil)
 		controllerMock.On("AddVulnerabilitiesByLanguage").Return(nil)
 		controllerMock.On("AddVulnerabilitiesByTime").Return(nil)
+		controllerMock.On("AddVulnerabilitiesBySecurityTool").Return(nil)
+		controllerMock.On("AddVulnerabilitiesByFile").Return(nil)
 
 		assert.NotPanics(t, func() {
 			NewDashboardEvents(brokerMock, controllerMock)
@@ -92,6 +102,52 @@ func TestNewDashboardEvent(t *testing.T) {
 		})
 	})
 
+	t.Run("should process packets of the security tool and file queues", func(t *testing.T) {
+		controllerMock := &dashboardController.Mock{}
+		brokerMock := &broker.Mock{}
+
+		controllerMock.On("AddVulnerabilitiesBySecurityTool").Return(nil)
+		controllerMock.On("AddVulnerabilitiesByFile").Return(nil)
+
+		events := &Events{broker: brokerMock, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody((&analysis.Analysis{}).ToBytes())
+
+		assert.NotPanics(t, func() {
+			events.handleNewAnalysis(packet, dashboardEnums.QueueNewAnalysisBySecurityTool)
+			events.handleNewAnalysis(packet, dashboardEnums.QueueNewAnalysisByFile)
+		})
+
+		controllerMock.AssertCalled(t, "AddVulnerabilitiesBySecurityTool")
+		controllerMock.AssertCalled(t, "AddVulnerabilitiesByFile")
+	})
+
+	t.Run("should project the revision of the analysis published again", func(t *testing.T) {
+		entity := ledger.NewRevisedAnalysis(&analysis.Analysis{ID: uuid.New()}, 3)
+
+		ledgerMock := &ledgerRepository.Mock{}
+		ledgerMock.On("GetLedger").Return((*ledger.Ledger)(nil), nil)
+		ledgerMock.On("SaveProjection", mock.Anything, mock.MatchedBy(func(current *ledger.Ledger) bool {
+			return current.AnalysisID == entity.ID && current.Revision == 3
+		}), mock.Anything).Return(nil)
+
+		controller := dashboardController.NewDashboardController(&dashboardRepository.Mock{},
+			&dashboardRepository.Mock{}, ledgerMock, dashboardUseCases.NewUseCaseDashboard())
+
+		events := &Events{broker: &broker.Mock{}, controller: controller}
+
+		body, _ := json.Marshal(entity)
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody(body)
+
+		assert.NotPanics(t, func() {
+			events.handleNewAnalysis(packet, queues.HorusecAnalyticNewAnalysisByAuthors)
+		})
+
+		ledgerMock.AssertNumberOfCalls(t, "SaveProjection", 1)
+	})
+
 	t.Run("should panic because invalid queue name", func(t *testing.T) {
 		controllerMock := &dashboardController.Mock{}
 		brokerMock := &broker.Mock{}
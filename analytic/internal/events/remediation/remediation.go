@@ -0,0 +1,71 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"fmt"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	brokerLib "github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/controllers/remediation"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+	eventsEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/events"
+	remediationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/remediation"
+)
+
+type Events struct {
+	broker     brokerLib.IBroker
+	controller remediation.IController
+}
+
+func NewRemediationEvents(broker brokerLib.IBroker, controller remediation.IController) *Events {
+	events := &Events{
+		broker:     broker,
+		controller: controller,
+	}
+
+	return events.startConsumers()
+}
+
+func (e *Events) startConsumers() *Events {
+	go e.broker.Consume(remediationEnums.QueueNewAnalysisByRemediation.ToString(), exchange.NewAnalysis,
+		exchange.Fanout, e.handleNewAnalysis)
+
+	return e
+}
+
+func (e *Events) handleNewAnalysis(analysisPacket packet.IPacket) {
+	logger.LogInfo(eventsEnums.MessageNewAnalysisReceivedAnalytic)
+
+	analysis := ledger.NewRevisedAnalysis(&analysisEntities.Analysis{}, 0)
+
+	if err := parser.ParsePacketToEntity(analysisPacket, analysis); err != nil {
+		logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToParsePacket, analysisPacket.GetBody(),
+			remediationEnums.QueueNewAnalysisByRemediation), err)
+		_ = analysisPacket.Ack()
+
+		return
+	}
+
+	logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToProcessPacket, analysisPacket.GetBody(),
+		remediationEnums.QueueNewAnalysisByRemediation), e.controller.TrackRemediation(analysis))
+
+	_ = analysisPacket.Ack()
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	brokerPacket "github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+
+	remediationController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/remediation"
+)
+
+func TestNewRemediationEvents(t *testing.T) {
+	t.Run("should start consumer and consume without errors", func(t *testing.T) {
+		controllerMock := &remediationController.Mock{}
+		brokerMock := &broker.Mock{}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody((&analysis.Analysis{}).ToBytes())
+
+		brokerMock.On("ConsumeHandlerFunc").Return(packet)
+		brokerMock.On("Consume").Return()
+
+		controllerMock.On("TrackRemediation").Return(nil)
+
+		assert.NotPanics(t, func() {
+			NewRemediationEvents(brokerMock, controllerMock)
+
+			time.Sleep(1 * time.Second)
+
+			brokerMock.AssertCalled(t, "ConsumeHandlerFunc")
+			controllerMock.AssertCalled(t, "TrackRemediation")
+		})
+	})
+
+	t.Run("should not track when failed parse packet", func(t *testing.T) {
+		controllerMock := &remediationController.Mock{}
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		assert.NotPanics(t, func() {
+			events.handleNewAnalysis(brokerPacket.NewPacket(&amqp.Delivery{}))
+		})
+		controllerMock.AssertNotCalled(t, "TrackRemediation")
+	})
+
+	t.Run("should not panic when failed to track remediation", func(t *testing.T) {
+		controllerMock := &remediationController.Mock{}
+		controllerMock.On("TrackRemediation").Return(errors.New("test"))
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody((&analysis.Analysis{}).ToBytes())
+
+		assert.NotPanics(t, func() {
+			events.handleNewAnalysis(packet)
+		})
+		controllerMock.AssertCalled(t, "TrackRemediation")
+	})
+}
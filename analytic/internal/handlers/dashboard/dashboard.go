@@ -52,6 +52,7 @@ func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
 // @Param workspaceID path string true "workspaceID of the workspace"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param comparePreviousPeriod query bool false "returns the deltas against the previous period"
 // @Success 200 {object} entities.Response{content=dashboard.Response} "OK"
 // @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
@@ -85,6 +86,7 @@ func (h *Handler) GetAllChartsByWorkspace(w http.ResponseWriter, r *http.Request
 // @Param repositoryID path string true "repositoryID of the repository"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param comparePreviousPeriod query bool false "returns the deltas against the previous period"
 // @Success 200 {object} entities.Response{content=dashboard.Response} "OK"
 // @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
@@ -106,3 +108,133 @@ func (h *Handler) GetAllChartsByRepository(w http.ResponseWriter, r *http.Reques
 
 	httpUtil.StatusOK(w, result)
 }
+
+// GetSecurityToolChartsByWorkspace
+// @Tags Dashboard
+// @Security ApiKeyAuth
+// @Description Get vulnerabilities by security tool of the workspace
+// @ID GetSecurityToolChartsByWorkspace
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param initialDate query string false "initialDate query string"
+// @Param finalDate query string false "finalDate query string"
+// @Success 200 {object} entities.Response{content=dashboard.SecurityToolsResponse} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/dashboard/{workspaceID}/security-tools [get]
+func (h *Handler) GetSecurityToolChartsByWorkspace(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.useCase.FilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+
+		return
+	}
+
+	result, err := h.controller.GetDashboardChartsBySecurityToolWorkspace(filter)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
+
+// GetSecurityToolChartsByRepository
+// @Tags Dashboard
+// @Security ApiKeyAuth
+// @Description Get vulnerabilities by security tool of the repository
+// @ID GetSecurityToolChartsByRepository
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param initialDate query string false "initialDate query string"
+// @Param finalDate query string false "finalDate query string"
+// @Success 200 {object} entities.Response{content=dashboard.SecurityToolsResponse} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/dashboard/{workspaceID}/{repositoryID}/security-tools [get]
+func (h *Handler) GetSecurityToolChartsByRepository(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.useCase.FilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+
+		return
+	}
+
+	result, err := h.controller.GetDashboardChartsBySecurityToolRepository(filter)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
+
+// GetFileChartsByWorkspace
+// @Tags Dashboard
+// @Security ApiKeyAuth
+// @Description Get most vulnerable files and directories of the workspace
+// @ID GetFileChartsByWorkspace
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param initialDate query string false "initialDate query string"
+// @Param finalDate query string false "finalDate query string"
+// @Success 200 {object} entities.Response{content=dashboard.FilesResponse} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/dashboard/{workspaceID}/files [get]
+func (h *Handler) GetFileChartsByWorkspace(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.useCase.FilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+
+		return
+	}
+
+	result, err := h.controller.GetDashboardChartsByFileWorkspace(filter)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
+
+// GetFileChartsByRepository
+// @Tags Dashboard
+// @Security ApiKeyAuth
+// @Description Get most vulnerable files and directories of the repository
+// @ID GetFileChartsByRepository
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param initialDate query string false "initialDate query string"
+// @Param finalDate query string false "finalDate query string"
+// @Success 200 {object} entities.Response{content=dashboard.FilesResponse} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/dashboard/{workspaceID}/{repositoryID}/files [get]
+func (h *Handler) GetFileChartsByRepository(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.useCase.FilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+
+		return
+	}
+
+	result, err := h.controller.GetDashboardChartsByFileRepository(filter)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
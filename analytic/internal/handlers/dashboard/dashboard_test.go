@@ -177,3 +177,269 @@ func TestGetAllChartsByRepository(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 }
+
+func TestGetSecurityToolChartsByWorkspace(t *testing.T) {
+	layoutDateTime := "2006-01-02T15:04:05Z"
+	startTime, _ := time.Parse(layoutDateTime, "2020-01-01T00:00:00Z")
+	endTime, _ := time.Parse(layoutDateTime, "2022-01-01T00:00:00Z")
+
+	t.Run("should return 200 when success get charts", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetDashboardChartsBySecurityToolWorkspace").Return(&dashboard.SecurityToolsResponse{}, nil)
+
+		handler := NewDashboardHandler(controllerMock)
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetSecurityToolChartsByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get charts", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetDashboardChartsBySecurityToolWorkspace").Return(
+			&dashboard.SecurityToolsResponse{}, errors.New("test"))
+
+		handler := NewDashboardHandler(controllerMock)
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetSecurityToolChartsByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid filter", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+
+		handler := NewDashboardHandler(controllerMock)
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetSecurityToolChartsByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetSecurityToolChartsByRepository(t *testing.T) {
+	layoutDateTime := "2006-01-02T15:04:05Z"
+	startTime, _ := time.Parse(layoutDateTime, "2020-01-01T00:00:00Z")
+	endTime, _ := time.Parse(layoutDateTime, "2022-01-01T00:00:00Z")
+
+	t.Run("should return 200 when success get charts", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetDashboardChartsBySecurityToolRepository").Return(&dashboard.SecurityToolsResponse{}, nil)
+
+		handler := NewDashboardHandler(controllerMock)
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		ctx.URLParams.Add("repositoryID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetSecurityToolChartsByRepository(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get charts", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetDashboardChartsBySecurityToolRepository").Return(
+			&dashboard.SecurityToolsResponse{}, errors.New("test"))
+
+		handler := NewDashboardHandler(controllerMock)
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		ctx.URLParams.Add("repositoryID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetSecurityToolChartsByRepository(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid filter", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+
+		handler := NewDashboardHandler(controllerMock)
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetSecurityToolChartsByRepository(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetFileChartsByWorkspace(t *testing.T) {
+	layoutDateTime := "2006-01-02T15:04:05Z"
+	startTime, _ := time.Parse(layoutDateTime, "2020-01-01T00:00:00Z")
+	endTime, _ := time.Parse(layoutDateTime, "2022-01-01T00:00:00Z")
+
+	t.Run("should return 200 when success get charts", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetDashboardChartsByFileWorkspace").Return(&dashboard.FilesResponse{}, nil)
+
+		handler := NewDashboardHandler(controllerMock)
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetFileChartsByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get charts", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetDashboardChartsByFileWorkspace").Return(&dashboard.FilesResponse{}, errors.New("test"))
+
+		handler := NewDashboardHandler(controllerMock)
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetFileChartsByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid filter", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+
+		handler := NewDashboardHandler(controllerMock)
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetFileChartsByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetFileChartsByRepository(t *testing.T) {
+	layoutDateTime := "2006-01-02T15:04:05Z"
+	startTime, _ := time.Parse(layoutDateTime, "2020-01-01T00:00:00Z")
+	endTime, _ := time.Parse(layoutDateTime, "2022-01-01T00:00:00Z")
+
+	t.Run("should return 200 when success get charts", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetDashboardChartsByFileRepository").Return(&dashboard.FilesResponse{}, nil)
+
+		handler := NewDashboardHandler(controllerMock)
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		ctx.URLParams.Add("repositoryID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetFileChartsByRepository(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get charts", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetDashboardChartsByFileRepository").Return(&dashboard.FilesResponse{}, errors.New("test"))
+
+		handler := NewDashboardHandler(controllerMock)
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		ctx.URLParams.Add("repositoryID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetFileChartsByRepository(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid filter", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+
+		handler := NewDashboardHandler(controllerMock)
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetFileChartsByRepository(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,193 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // [swagger-import]
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+
+	controller "github.com/ZupIT/horusec-platform/analytic/internal/controllers/digest"
+	_ "github.com/ZupIT/horusec-platform/analytic/internal/entities/digest" // [swagger-import]
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	digestEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/digest"
+	useCase "github.com/ZupIT/horusec-platform/analytic/internal/usecases/digest"
+)
+
+type Handler struct {
+	controller controller.IController
+	useCase    useCase.IUseCases
+	authGRPC   proto.AuthServiceClient
+	context    context.Context
+}
+
+func NewDigestHandler(digestController controller.IController, authGRPC proto.AuthServiceClient) *Handler {
+	return &Handler{
+		controller: digestController,
+		useCase:    useCase.NewUseCaseDigest(),
+		authGRPC:   authGRPC,
+		context:    context.Background(),
+	}
+}
+
+func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
+	httpUtil.StatusNoContent(w)
+}
+
+// GetSubscription
+// @Tags Digest
+// @Security ApiKeyAuth
+// @Description Get the digest email subscription of the account for the workspace
+// @ID GetDigestSubscription
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Success 200 {object} entities.Response{content=digest.Subscription} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/dashboard/{workspaceID}/digest [get]
+func (h *Handler) GetSubscription(w http.ResponseWriter, r *http.Request) {
+	accountID, workspaceID, err := h.getAccountAndWorkspace(r)
+	if err != nil {
+		h.checkDigestErrors(w, err)
+
+		return
+	}
+
+	subscription, err := h.controller.GetSubscription(accountID, workspaceID)
+	if err != nil {
+		h.checkDigestErrors(w, err)
+
+		return
+	}
+
+	httpUtil.StatusOK(w, subscription)
+}
+
+// SaveSubscription
+// @Tags Digest
+// @Security ApiKeyAuth
+// @Description Subscribe the account to the digest email of the workspace or change the frequency of it
+// @ID SaveDigestSubscription
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param SubscriptionData body digest.SubscriptionData true "digest subscription preferences"
+// @Success 200 {object} entities.Response{content=digest.Subscription} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/dashboard/{workspaceID}/digest [put]
+func (h *Handler) SaveSubscription(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCase.SubscriptionDataFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+
+		return
+	}
+
+	accountID, err := h.getAccountID(r)
+	if err != nil {
+		h.checkDigestErrors(w, err)
+
+		return
+	}
+
+	subscription, err := h.controller.SaveSubscription(data.SetAccountID(accountID))
+	if err != nil {
+		h.checkDigestErrors(w, err)
+
+		return
+	}
+
+	httpUtil.StatusOK(w, subscription)
+}
+
+// DeleteSubscription
+// @Tags Digest
+// @Security ApiKeyAuth
+// @Description Unsubscribe the account from the digest email of the workspace
+// @ID DeleteDigestSubscription
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Success 204 {object} entities.Response{content=string} "NO CONTENT"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/dashboard/{workspaceID}/digest [delete]
+func (h *Handler) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
+	accountID, workspaceID, err := h.getAccountAndWorkspace(r)
+	if err != nil {
+		h.checkDigestErrors(w, err)
+
+		return
+	}
+
+	if err := h.controller.DeleteSubscription(accountID, workspaceID); err != nil {
+		h.checkDigestErrors(w, err)
+
+		return
+	}
+
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) getAccountAndWorkspace(r *http.Request) (accountID, workspaceID uuid.UUID, err error) {
+	workspaceID, err = h.useCase.WorkspaceIDFromRequest(r)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	accountID, err = h.getAccountID(r)
+
+	return accountID, workspaceID, err
+}
+
+func (h *Handler) getAccountID(r *http.Request) (uuid.UUID, error) {
+	accountData, err := h.authGRPC.GetAccountInfo(h.context,
+		&proto.GetAccountData{Token: r.Header.Get(jwtEnums.HorusecJWTHeader)})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	accountID, err := uuid.Parse(accountData.AccountID)
+	if err != nil {
+		return uuid.Nil, digestEnums.ErrorInvalidAccountID
+	}
+
+	return accountID, nil
+}
+
+func (h *Handler) checkDigestErrors(w http.ResponseWriter, err error) {
+	switch err {
+	case dashboardEnums.ErrorInvalidWorkspaceID:
+		httpUtil.StatusBadRequest(w, err)
+	case digestEnums.ErrorInvalidAccountID:
+		httpUtil.StatusUnauthorized(w, err)
+	case digestEnums.ErrorSubscriptionNotFound:
+		httpUtil.StatusNotFound(w, err)
+	default:
+		httpUtil.StatusInternalServerError(w, err)
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	controller "github.com/ZupIT/horusec-platform/analytic/internal/controllers/digest"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/digest"
+	digestEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/digest"
+)
+
+func newRequest(body string, params map[string]string) *http.Request {
+	ctx := chi.NewRouteContext()
+	for key, value := range params {
+		ctx.URLParams.Add(key, value)
+	}
+
+	r, _ := http.NewRequest(http.MethodPut, "/test", bytes.NewBufferString(body))
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func newAuthGRPCMock(accountID string) *proto.Mock {
+	authGRPCMock := &proto.Mock{}
+	authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: accountID}, nil)
+
+	return authGRPCMock
+}
+
+func TestOptions(t *testing.T) {
+	t.Run("should return no content when options", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		NewDigestHandler(&controller.Mock{}, &proto.Mock{}).Options(w, newRequest("", nil))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestGetSubscription(t *testing.T) {
+	t.Run("should return 200 when found the subscription", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetSubscription").Return(&digest.Subscription{}, nil)
+
+		w := httptest.NewRecorder()
+
+		NewDigestHandler(controllerMock, newAuthGRPCMock(uuid.NewString())).GetSubscription(w,
+			newRequest("", map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when not subscribed", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetSubscription").Return(&digest.Subscription{}, digestEnums.ErrorSubscriptionNotFound)
+
+		w := httptest.NewRecorder()
+
+		NewDigestHandler(controllerMock, newAuthGRPCMock(uuid.NewString())).GetSubscription(w,
+			newRequest("", map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get the subscription", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetSubscription").Return(&digest.Subscription{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		NewDigestHandler(controllerMock, newAuthGRPCMock(uuid.NewString())).GetSubscription(w,
+			newRequest("", map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		NewDigestHandler(&controller.Mock{}, newAuthGRPCMock(uuid.NewString())).GetSubscription(w,
+			newRequest("", map[string]string{"workspaceID": "test"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 401 when invalid account id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		NewDigestHandler(&controller.Mock{}, newAuthGRPCMock("test")).GetSubscription(w,
+			newRequest("", map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get the account", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		NewDigestHandler(&controller.Mock{}, authGRPCMock).GetSubscription(w,
+			newRequest("", map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestSaveSubscription(t *testing.T) {
+	t.Run("should return 200 when saved the subscription", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("SaveSubscription").Return(&digest.Subscription{}, nil)
+
+		w := httptest.NewRecorder()
+
+		NewDigestHandler(controllerMock, newAuthGRPCMock(uuid.NewString())).SaveSubscription(w,
+			newRequest(`{"frequency": "weekly"}`, map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid frequency", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		NewDigestHandler(&controller.Mock{}, newAuthGRPCMock(uuid.NewString())).SaveSubscription(w,
+			newRequest(`{"frequency": "yearly"}`, map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 401 when invalid account id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		NewDigestHandler(&controller.Mock{}, newAuthGRPCMock("test")).SaveSubscription(w,
+			newRequest(`{"frequency": "weekly"}`, map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return 500 when failed to save the subscription", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("SaveSubscription").Return(&digest.Subscription{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		NewDigestHandler(controllerMock, newAuthGRPCMock(uuid.NewString())).SaveSubscription(w,
+			newRequest(`{"frequency": "weekly"}`, map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestDeleteSubscription(t *testing.T) {
+	t.Run("should return 204 when deleted the subscription", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("DeleteSubscription").Return(nil)
+
+		w := httptest.NewRecorder()
+
+		NewDigestHandler(controllerMock, newAuthGRPCMock(uuid.NewString())).DeleteSubscription(w,
+			newRequest("", map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		NewDigestHandler(&controller.Mock{}, newAuthGRPCMock(uuid.NewString())).DeleteSubscription(w,
+			newRequest("", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when failed to delete the subscription", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("DeleteSubscription").Return(errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		NewDigestHandler(controllerMock, newAuthGRPCMock(uuid.NewString())).DeleteSubscription(w,
+			newRequest("", map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
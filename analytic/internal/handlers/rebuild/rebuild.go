@@ -0,0 +1,140 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	"net/http"
+
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+
+	controller "github.com/ZupIT/horusec-platform/analytic/internal/controllers/rebuild"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/rebuild"
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	rebuildEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/rebuild"
+	useCase "github.com/ZupIT/horusec-platform/analytic/internal/usecases/rebuild"
+)
+
+type Handler struct {
+	controller controller.IController
+	useCase    useCase.IUseCases
+}
+
+func NewRebuildHandler(rebuildController controller.IController) *Handler {
+	return &Handler{
+		controller: rebuildController,
+		useCase:    useCase.NewUseCaseRebuild(),
+	}
+}
+
+func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
+	httpUtil.StatusNoContent(w)
+}
+
+// StartRebuild
+// @Tags Dashboard
+// @Security ApiKeyAuth
+// @Description Rebuild the analytic of the workspace from the analysis stored in the horusec database, optionally
+// @Description limited by repository and date range. With dry run only returns what would be rebuilt. The job runs
+// @Description in the replica that received the request and is kept there for 24 hours after it finishes. The
+// @Description remediation tables depend on the order the analysis were received and are listed as skipped tables
+// @ID StartRebuild
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param Scope body rebuild.Scope true "rebuild scope"
+// @Success 200 {object} entities.Response{content=rebuild.JobResponse} "OK"
+// @Success 201 {object} entities.Response{content=rebuild.JobResponse} "CREATED"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 409 {object} entities.Response{content=string} "CONFLICT"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/dashboard/{workspaceID}/rebuild [post]
+func (h *Handler) StartRebuild(w http.ResponseWriter, r *http.Request) {
+	scope, err := h.useCase.ScopeFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+
+		return
+	}
+
+	if scope.DryRun {
+		h.dryRun(w, scope)
+
+		return
+	}
+
+	job, err := h.controller.StartRebuild(scope)
+	if err != nil {
+		h.checkRebuildErrors(w, err)
+
+		return
+	}
+
+	httpUtil.StatusCreated(w, job)
+}
+
+func (h *Handler) dryRun(w http.ResponseWriter, scope *rebuild.Scope) {
+	job, err := h.controller.DryRun(scope)
+	if err != nil {
+		h.checkRebuildErrors(w, err)
+
+		return
+	}
+
+	httpUtil.StatusOK(w, job)
+}
+
+// GetRebuildJob
+// @Tags Dashboard
+// @Security ApiKeyAuth
+// @Description Get the progress of a rebuild of the workspace analytic
+// @ID GetRebuildJob
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param jobID path string true "jobID of the rebuild"
+// @Success 200 {object} entities.Response{content=rebuild.JobResponse} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Router /analytic/dashboard/{workspaceID}/rebuild/{jobID} [get]
+func (h *Handler) GetRebuildJob(w http.ResponseWriter, r *http.Request) {
+	workspaceID, jobID, err := h.useCase.JobFromRequest(r)
+	if err != nil {
+		h.checkRebuildErrors(w, err)
+
+		return
+	}
+
+	job, err := h.controller.GetJob(workspaceID, jobID)
+	if err != nil {
+		h.checkRebuildErrors(w, err)
+
+		return
+	}
+
+	httpUtil.StatusOK(w, job)
+}
+
+func (h *Handler) checkRebuildErrors(w http.ResponseWriter, err error) {
+	switch err {
+	case rebuildEnums.ErrorRebuildAlreadyRunning:
+		httpUtil.StatusConflict(w, err)
+	case rebuildEnums.ErrorJobNotFound:
+		httpUtil.StatusNotFound(w, err)
+	case dashboardEnums.ErrorInvalidWorkspaceID:
+		httpUtil.StatusBadRequest(w, err)
+	default:
+		httpUtil.StatusInternalServerError(w, err)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	controller "github.com/ZupIT/horusec-platform/analytic/internal/controllers/rebuild"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/rebuild"
+	rebuildEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/rebuild"
+)
+
+func newRequest(body string, params map[string]string) *http.Request {
+	ctx := chi.NewRouteContext()
+	for key, value := range params {
+		ctx.URLParams.Add(key, value)
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(body))
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func TestOptions(t *testing.T) {
+	t.Run("should return no content when options", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		NewRebuildHandler(&controller.Mock{}).Options(w, newRequest("", nil))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestStartRebuild(t *testing.T) {
+	t.Run("should return 201 when rebuild started", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("StartRebuild").Return(&rebuild.JobResponse{}, nil)
+
+		w := httptest.NewRecorder()
+
+		NewRebuildHandler(controllerMock).StartRebuild(w,
+			newRequest("{}", map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		controllerMock.AssertNotCalled(t, "DryRun")
+	})
+
+	t.Run("should return 200 when dry run", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("DryRun").Return(&rebuild.JobResponse{}, nil)
+
+		w := httptest.NewRecorder()
+
+		NewRebuildHandler(controllerMock).StartRebuild(w,
+			newRequest(`{"dryRun": true}`, map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		controllerMock.AssertNotCalled(t, "StartRebuild")
+	})
+
+	t.Run("should return 500 when failed to dry run", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("DryRun").Return(&rebuild.JobResponse{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		NewRebuildHandler(controllerMock).StartRebuild(w,
+			newRequest(`{"dryRun": true}`, map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 409 when there is a rebuild running", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("StartRebuild").Return(&rebuild.JobResponse{}, rebuildEnums.ErrorRebuildAlreadyRunning)
+
+		w := httptest.NewRecorder()
+
+		NewRebuildHandler(controllerMock).StartRebuild(w,
+			newRequest("{}", map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return 500 when rebuild is unavailable", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("StartRebuild").Return(&rebuild.JobResponse{}, rebuildEnums.ErrorRebuildUnavailable)
+
+		w := httptest.NewRecorder()
+
+		NewRebuildHandler(controllerMock).StartRebuild(w,
+			newRequest("{}", map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid scope", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		NewRebuildHandler(&controller.Mock{}).StartRebuild(w,
+			newRequest("{}", map[string]string{"workspaceID": "test"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetRebuildJob(t *testing.T) {
+	t.Run("should return 200 when success get job", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetJob").Return(&rebuild.JobResponse{}, nil)
+
+		w := httptest.NewRecorder()
+
+		NewRebuildHandler(controllerMock).GetRebuildJob(w, newRequest("",
+			map[string]string{"workspaceID": uuid.NewString(), "jobID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when job not found", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetJob").Return(&rebuild.JobResponse{}, rebuildEnums.ErrorJobNotFound)
+
+		w := httptest.NewRecorder()
+
+		NewRebuildHandler(controllerMock).GetRebuildJob(w, newRequest("",
+			map[string]string{"workspaceID": uuid.NewString(), "jobID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 404 when invalid job id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		NewRebuildHandler(&controller.Mock{}).GetRebuildJob(w, newRequest("",
+			map[string]string{"workspaceID": uuid.NewString(), "jobID": "test"}))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		NewRebuildHandler(&controller.Mock{}).GetRebuildJob(w, newRequest("",
+			map[string]string{"workspaceID": "test", "jobID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"net/http"
+
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+
+	controller "github.com/ZupIT/horusec-platform/analytic/internal/controllers/remediation"
+
+	// [swagger-usage]
+	_ "github.com/ZupIT/horusec-platform/analytic/internal/entities/remediation"
+	useCase "github.com/ZupIT/horusec-platform/analytic/internal/usecases/remediation"
+)
+
+type Handler struct {
+	controller controller.IController
+	useCase    useCase.IUseCases
+}
+
+func NewRemediationHandler(remediationController controller.IController) *Handler {
+	return &Handler{
+		controller: remediationController,
+		useCase:    useCase.NewUseCaseRemediation(),
+	}
+}
+
+func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
+	httpUtil.StatusNoContent(w)
+}
+
+// GetRemediationByWorkspace
+// @Tags Dashboard
+// @Security ApiKeyAuth
+// @Description Get the mean time to remediate, the open vulnerabilities past the SLA and the aging of the workspace
+// @ID GetRemediationByWorkspace
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param initialDate query string false "initialDate query string"
+// @Param finalDate query string false "finalDate query string"
+// @Success 200 {object} entities.Response{content=remediation.Response} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/dashboard/{workspaceID}/remediation [get]
+func (h *Handler) GetRemediationByWorkspace(w http.ResponseWriter, r *http.Request) {
+	h.getRemediation(w, r)
+}
+
+// GetRemediationByRepository
+// @Tags Dashboard
+// @Security ApiKeyAuth
+// @Description Get the mean time to remediate, the open vulnerabilities past the SLA and the aging of the repository
+// @ID GetRemediationByRepository
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param initialDate query string false "initialDate query string"
+// @Param finalDate query string false "finalDate query string"
+// @Success 200 {object} entities.Response{content=remediation.Response} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/dashboard/{workspaceID}/{repositoryID}/remediation [get]
+func (h *Handler) GetRemediationByRepository(w http.ResponseWriter, r *http.Request) {
+	h.getRemediation(w, r)
+}
+
+func (h *Handler) getRemediation(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.useCase.FilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+
+		return
+	}
+
+	result, err := h.controller.GetRemediation(filter)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	controller "github.com/ZupIT/horusec-platform/analytic/internal/controllers/remediation"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/remediation"
+)
+
+const url = "/test?initialDate=2020-01-01T00:00:00Z&finalDate=2022-01-01T00:00:00Z"
+
+func newRequest(url string, params map[string]string) *http.Request {
+	ctx := chi.NewRouteContext()
+	for key, value := range params {
+		ctx.URLParams.Add(key, value)
+	}
+
+	r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func TestOptions(t *testing.T) {
+	t.Run("should return no content when options", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		NewRemediationHandler(&controller.Mock{}).Options(w, newRequest("/test", nil))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestGetRemediationByWorkspace(t *testing.T) {
+	t.Run("should return 200 when success get remediation", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetRemediation").Return(&remediation.Response{}, nil)
+
+		w := httptest.NewRecorder()
+
+		NewRemediationHandler(controllerMock).GetRemediationByWorkspace(w,
+			newRequest(url, map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get remediation", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetRemediation").Return(&remediation.Response{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		NewRemediationHandler(controllerMock).GetRemediationByWorkspace(w,
+			newRequest(url, map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		NewRemediationHandler(&controller.Mock{}).GetRemediationByWorkspace(w,
+			newRequest(url, map[string]string{"workspaceID": "test"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetRemediationByRepository(t *testing.T) {
+	t.Run("should return 200 when success get remediation", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetRemediation").Return(&remediation.Response{}, nil)
+
+		w := httptest.NewRecorder()
+
+		NewRemediationHandler(controllerMock).GetRemediationByRepository(w, newRequest(url,
+			map[string]string{"workspaceID": uuid.NewString(), "repositoryID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid repository id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		NewRemediationHandler(&controller.Mock{}).GetRemediationByRepository(w, newRequest(url,
+			map[string]string{"workspaceID": uuid.NewString(), "repositoryID": "test"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
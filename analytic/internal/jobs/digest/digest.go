@@ -0,0 +1,62 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	digestController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/digest"
+	digestEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/digest"
+)
+
+type IJob interface{}
+
+type Job struct {
+	controller digestController.IController
+}
+
+func NewDigestJob(controller digestController.IController) IJob {
+	j := &Job{
+		controller: controller,
+	}
+
+	return j.start()
+}
+
+func (j *Job) start() IJob {
+	if !j.controller.IsEnabled() {
+		logger.LogWarn(digestEnums.MessageDigestDisabled)
+
+		return j
+	}
+
+	go j.sendDigests(time.NewTicker(digestEnums.JobInterval))
+
+	return j
+}
+
+func (j *Job) sendDigests(ticker *time.Ticker) {
+	for range ticker.C {
+		j.run()
+	}
+}
+
+func (j *Job) run() {
+	if err := j.controller.SendDigests(); err != nil {
+		logger.LogError(digestEnums.MessageFailedToSendDigests, err)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	digestController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/digest"
+)
+
+func TestNewDigestJob(t *testing.T) {
+	t.Run("should start job without panics", func(t *testing.T) {
+		controllerMock := &digestController.Mock{}
+		controllerMock.On("IsEnabled").Return(true)
+
+		assert.NotPanics(t, func() {
+			assert.NotNil(t, NewDigestJob(controllerMock))
+		})
+	})
+
+	t.Run("should not start job when digests are disabled", func(t *testing.T) {
+		controllerMock := &digestController.Mock{}
+		controllerMock.On("IsEnabled").Return(false)
+
+		assert.NotPanics(t, func() {
+			assert.NotNil(t, NewDigestJob(controllerMock))
+		})
+	})
+}
+
+func TestRun(t *testing.T) {
+	t.Run("should send digests without panics", func(t *testing.T) {
+		controllerMock := &digestController.Mock{}
+		controllerMock.On("SendDigests").Return(nil)
+		job := &Job{controller: controllerMock}
+		assert.NotPanics(t, job.run)
+		controllerMock.AssertCalled(t, "SendDigests")
+	})
+
+	t.Run("should log error when send digests fails", func(t *testing.T) {
+		controllerMock := &digestController.Mock{}
+		controllerMock.On("SendDigests").Return(errors.New("test"))
+		job := &Job{controller: controllerMock}
+		assert.NotPanics(t, job.run)
+	})
+}
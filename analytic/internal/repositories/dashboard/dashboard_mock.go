@@ -59,3 +59,18 @@ func (m *Mock) GetDashboardVulnByTime(_ *dashboard.Filter) ([]*dashboard.Vulnera
 	args := m.MethodCalled("GetDashboardVulnByTime")
 	return args.Get(0).([]*dashboard.VulnerabilitiesByTime), utilsMock.ReturnNilOrError(args, 1)
 }
+
+func (m *Mock) GetDashboardVulnBySecurityTool(_ *dashboard.Filter) ([]*dashboard.VulnerabilitiesBySecurityTool, error) {
+	args := m.MethodCalled("GetDashboardVulnBySecurityTool")
+	return args.Get(0).([]*dashboard.VulnerabilitiesBySecurityTool), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetDashboardVulnByFile(_ *dashboard.Filter) ([]*dashboard.VulnerabilitiesByFile, error) {
+	args := m.MethodCalled("GetDashboardVulnByFile")
+	return args.Get(0).([]*dashboard.VulnerabilitiesByFile), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetDashboardVulnByDirectory(_ *dashboard.Filter) ([]*dashboard.VulnerabilitiesByFile, error) {
+	args := m.MethodCalled("GetDashboardVulnByDirectory")
+	return args.Get(0).([]*dashboard.VulnerabilitiesByFile), utilsMock.ReturnNilOrError(args, 1)
+}
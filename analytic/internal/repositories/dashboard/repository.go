@@ -29,6 +29,9 @@ type IRepoRepository interface {
 	GetDashboardVulnByAuthor(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByAuthor, error)
 	GetDashboardVulnByLanguage(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByLanguage, error)
 	GetDashboardVulnByTime(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByTime, error)
+	GetDashboardVulnBySecurityTool(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesBySecurityTool, error)
+	GetDashboardVulnByFile(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByFile, error)
+	GetDashboardVulnByDirectory(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByFile, error)
 }
 
 type RepoRepository struct {
@@ -44,9 +47,11 @@ func NewRepoDashboard(connection *database.Connection) IRepoRepository {
 }
 
 func (r *RepoRepository) GetDashboardTotalDevelopers(filter *dashboard.Filter) (count int, err error) {
-	query := fmt.Sprintf(r.queryGetDashboardTotalDevelopers(), enumsdashboard.TableVulnerabilitiesByAuthor)
+	condition, args := filter.GetDateFilter()
+
+	query := fmt.Sprintf(r.queryGetDashboardTotalDevelopers(), enumsdashboard.TableVulnerabilitiesByAuthor, condition)
 
-	return count, r.databaseRead.Raw(query, &count, filter.GetRepositoryFilter()).GetErrorExceptNotFound()
+	return count, r.databaseRead.Raw(query, &count, args...).GetErrorExceptNotFound()
 }
 
 func (r *RepoRepository) queryGetDashboardTotalDevelopers() string {
@@ -54,7 +59,7 @@ func (r *RepoRepository) queryGetDashboardTotalDevelopers() string {
 			SELECT COUNT(DISTINCT(author)) 
 			FROM %[1]s
 			WHERE repository_id = @repositoryID
-			AND created_at = (SELECT MAX(created_at) FROM %[1]s WHERE repository_id = @repositoryID)
+			AND created_at = (SELECT MAX(created_at) FROM %[1]s WHERE repository_id = @repositoryID %[2]s)
 			AND author != ''
 	`
 }
@@ -62,9 +67,11 @@ func (r *RepoRepository) queryGetDashboardTotalDevelopers() string {
 func (r *RepoRepository) GetDashboardVulnBySeverity(filter *dashboard.Filter) (*dashboard.Vulnerability, error) {
 	vulns := &dashboard.Vulnerability{}
 
-	query := fmt.Sprintf(r.queryGetDashboardVulnBySeverity(), enumsdashboard.TableVulnerabilitiesByTime)
+	condition, args := filter.GetDateFilter()
 
-	return vulns, r.databaseRead.Raw(query, vulns, filter.GetRepositoryFilter()).GetErrorExceptNotFound()
+	query := fmt.Sprintf(r.queryGetDashboardVulnBySeverity(), enumsdashboard.TableVulnerabilitiesByTime, condition)
+
+	return vulns, r.databaseRead.Raw(query, vulns, args...).GetErrorExceptNotFound()
 }
 
 func (r *RepoRepository) queryGetDashboardVulnBySeverity() string {
@@ -75,7 +82,7 @@ func (r *RepoRepository) queryGetDashboardVulnBySeverity() string {
 			AND vulnerability_id = (
 										SELECT vulnerability_id 
 										FROM %[1]s 
-										WHERE repository_id = @repositoryID 
+										WHERE repository_id = @repositoryID %[2]s
 										ORDER BY created_at DESC LIMIT 1
 									)
 	`
@@ -83,9 +90,11 @@ func (r *RepoRepository) queryGetDashboardVulnBySeverity() string {
 
 func (r *RepoRepository) GetDashboardVulnByAuthor(
 	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesByAuthor, err error) {
-	query := fmt.Sprintf(r.queryGetDashboardVulnByAuthor(), enumsdashboard.TableVulnerabilitiesByAuthor)
+	condition, args := filter.GetDateFilter()
 
-	err = r.databaseRead.Raw(query, &vulns, filter.GetRepositoryFilter()).GetErrorExceptNotFound()
+	query := fmt.Sprintf(r.queryGetDashboardVulnByAuthor(), enumsdashboard.TableVulnerabilitiesByAuthor, condition)
+
+	err = r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
 	if err != nil || len(vulns) == 0 {
 		return []*dashboard.VulnerabilitiesByAuthor{{}}, err
 	}
@@ -114,11 +123,11 @@ func (r *RepoRepository) queryGetDashboardVulnByAuthor() string {
 			) AS total
 			FROM %[1]s
 			WHERE repository_id = @repositoryID
-			AND created_at = (SELECT MAX(created_at) FROM %[1]s WHERE repository_id = @repositoryID)
+			AND created_at = (SELECT MAX(created_at) FROM %[1]s WHERE repository_id = @repositoryID %[2]s)
 		) AS vulnSum 
 		ON vba.vulnerability_id = vulnSum.vulnerability_id
 		WHERE repository_id = @repositoryID
-		AND created_at = (SELECT MAX(created_at) FROM %[1]s WHERE repository_id = @repositoryID) 
+		AND created_at = (SELECT MAX(created_at) FROM %[1]s WHERE repository_id = @repositoryID %[2]s) 
 	) AS vulnsResult
 	ORDER BY (vulnsResult.total) DESC
 	LIMIT 5
@@ -127,9 +136,11 @@ func (r *RepoRepository) queryGetDashboardVulnByAuthor() string {
 
 func (r *RepoRepository) GetDashboardVulnByLanguage(
 	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesByLanguage, err error) {
-	query := fmt.Sprintf(r.queryGetDashboardVulnByLanguage(), enumsdashboard.TableVulnerabilitiesByLanguage)
+	condition, args := filter.GetDateFilter()
+
+	query := fmt.Sprintf(r.queryGetDashboardVulnByLanguage(), enumsdashboard.TableVulnerabilitiesByLanguage, condition)
 
-	err = r.databaseRead.Raw(query, &vulns, filter.GetRepositoryFilter()).GetErrorExceptNotFound()
+	err = r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
 	if err != nil || len(vulns) == 0 {
 		return []*dashboard.VulnerabilitiesByLanguage{{}}, err
 	}
@@ -142,7 +153,7 @@ func (r *RepoRepository) queryGetDashboardVulnByLanguage() string {
 			SELECT DISTINCT ON(language) language, *
 			FROM %[1]s
 			WHERE repository_id = @repositoryID
-			AND created_at = (SELECT MAX(created_at) FROM %[1]s WHERE repository_id = @repositoryID)  
+			AND created_at = (SELECT MAX(created_at) FROM %[1]s WHERE repository_id = @repositoryID %[2]s)  
 			LIMIT 5
 	`
 }
@@ -170,3 +181,58 @@ func (r *RepoRepository) queryGetDashboardVulnByTime() string {
 			GROUP BY DATE(created_at) )		
 	`
 }
+
+func (r *RepoRepository) GetDashboardVulnBySecurityTool(
+	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesBySecurityTool, err error) {
+	condition, args := filter.GetDateFilter()
+
+	query := fmt.Sprintf(r.queryGetDashboardVulnBySecurityTool(), enumsdashboard.TableVulnerabilitiesBySecurityTool,
+		condition, queryTotalOpenVulnerabilities())
+
+	return vulns, r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
+}
+
+func (r *RepoRepository) queryGetDashboardVulnBySecurityTool() string {
+	return `
+			SELECT *
+			FROM %[1]s
+			WHERE repository_id = @repositoryID
+			AND created_at = (SELECT MAX(created_at) FROM %[1]s WHERE repository_id = @repositoryID %[2]s)
+			AND security_tool != ''
+			ORDER BY (%[3]s) DESC
+	`
+}
+
+func (r *RepoRepository) GetDashboardVulnByFile(
+	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesByFile, err error) {
+	condition, args := filter.GetDateFilter()
+
+	query := fmt.Sprintf(r.queryGetDashboardVulnByFile(), queryDefaultFields(),
+		enumsdashboard.TableVulnerabilitiesByFile, condition, queryTotalOpenVulnerabilities(), "file")
+
+	return vulns, r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
+}
+
+func (r *RepoRepository) GetDashboardVulnByDirectory(
+	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesByFile, err error) {
+	condition, args := filter.GetDateFilter()
+
+	query := fmt.Sprintf(r.queryGetDashboardVulnByFile(), queryDefaultFields(),
+		enumsdashboard.TableVulnerabilitiesByFile, condition, queryTotalOpenVulnerabilities(), "directory")
+
+	return vulns, r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
+}
+
+// queryGetDashboardVulnByFile groups by the file or directory column
+func (r *RepoRepository) queryGetDashboardVulnByFile() string {
+	return `
+			SELECT %[1]s, repository_id, %[5]s
+			FROM %[2]s
+			WHERE repository_id = @repositoryID
+			AND created_at = (SELECT MAX(created_at) FROM %[2]s WHERE repository_id = @repositoryID %[3]s)
+			AND %[5]s != ''
+			GROUP BY(repository_id, %[5]s)
+			ORDER BY SUM(%[4]s) DESC
+			LIMIT 10
+	`
+}
@@ -181,3 +181,108 @@ func TestGetDashboardVulnByTime(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestGetDashboardVulnBySecurityTool(t *testing.T) {
+	t.Run("should return get vulns by security tool without errors", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, nil, &[]*dashboard.VulnerabilitiesBySecurityTool{{}}))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewRepoDashboard(connection)
+
+		result, err := repository.GetDashboardVulnBySecurityTool(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("should return error when failed to get vulns by security tool", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, errors.New("test"), nil))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewRepoDashboard(connection)
+
+		_, err := repository.GetDashboardVulnBySecurityTool(&dashboard.Filter{})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetDashboardVulnByFile(t *testing.T) {
+	t.Run("should return get vulns by file without errors", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, nil, &[]*dashboard.VulnerabilitiesByFile{{}}))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewRepoDashboard(connection)
+
+		result, err := repository.GetDashboardVulnByFile(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("should return error when failed to get vulns by file", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, errors.New("test"), nil))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewRepoDashboard(connection)
+
+		_, err := repository.GetDashboardVulnByFile(&dashboard.Filter{})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetDashboardVulnByDirectory(t *testing.T) {
+	t.Run("should return get vulns by directory without errors", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, nil, &[]*dashboard.VulnerabilitiesByFile{{}}))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewRepoDashboard(connection)
+
+		result, err := repository.GetDashboardVulnByDirectory(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("should return error when failed to get vulns by directory", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, errors.New("test"), nil))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewRepoDashboard(connection)
+
+		_, err := repository.GetDashboardVulnByDirectory(&dashboard.Filter{})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,172 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+)
+
+// rawRecorder keeps the last raw query and values sent to the database to assert the sql window
+type rawRecorder struct {
+	*database.Mock
+	query  string
+	values []interface{}
+}
+
+func (r *rawRecorder) Raw(query string, entityPointer interface{}, values ...interface{}) response.IResponse {
+	r.query, r.values = query, values
+
+	return r.Mock.Raw(query, entityPointer, values...)
+}
+
+func newRawRecorder() *rawRecorder {
+	databaseMock := &database.Mock{}
+	databaseMock.On("Raw").Return(response.NewResponse(0, nil, nil))
+
+	return &rawRecorder{Mock: databaseMock}
+}
+
+type windowTestCase struct {
+	name           string
+	expectedWindow int
+	call           func(connection *database.Connection, filter *dashboard.Filter)
+}
+
+func repositoryWindowTestCases() []windowTestCase {
+	return []windowTestCase{
+		{"total developers", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewRepoDashboard(c).GetDashboardTotalDevelopers(f)
+		}},
+		{"vuln by severity", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewRepoDashboard(c).GetDashboardVulnBySeverity(f)
+		}},
+		{"vuln by author", 2, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewRepoDashboard(c).GetDashboardVulnByAuthor(f)
+		}},
+		{"vuln by language", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewRepoDashboard(c).GetDashboardVulnByLanguage(f)
+		}},
+		{"vuln by time", 2, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewRepoDashboard(c).GetDashboardVulnByTime(f)
+		}},
+		{"vuln by security tool", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewRepoDashboard(c).GetDashboardVulnBySecurityTool(f)
+		}},
+		{"vuln by file", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewRepoDashboard(c).GetDashboardVulnByFile(f)
+		}},
+		{"vuln by directory", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewRepoDashboard(c).GetDashboardVulnByDirectory(f)
+		}},
+	}
+}
+
+func workspaceWindowTestCases() []windowTestCase {
+	return []windowTestCase{
+		{"workspace total developers", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewWorkspaceDashboard(c).GetDashboardTotalDevelopers(f)
+		}},
+		{"workspace total repositories", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewWorkspaceDashboard(c).GetDashboardTotalRepositories(f)
+		}},
+		{"workspace vuln by severity", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewWorkspaceDashboard(c).GetDashboardVulnBySeverity(f)
+		}},
+		{"workspace vuln by author", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewWorkspaceDashboard(c).GetDashboardVulnByAuthor(f)
+		}},
+		{"workspace vuln by repository", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewWorkspaceDashboard(c).GetDashboardVulnByRepository(f)
+		}},
+		{"workspace vuln by language", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewWorkspaceDashboard(c).GetDashboardVulnByLanguage(f)
+		}},
+		{"workspace vuln by time", 2, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewWorkspaceDashboard(c).GetDashboardVulnByTime(f)
+		}},
+		{"workspace vuln by security tool", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewWorkspaceDashboard(c).GetDashboardVulnBySecurityTool(f)
+		}},
+		{"workspace vuln by file", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewWorkspaceDashboard(c).GetDashboardVulnByFile(f)
+		}},
+		{"workspace vuln by directory", 1, func(c *database.Connection, f *dashboard.Filter) {
+			_, _ = NewWorkspaceDashboard(c).GetDashboardVulnByDirectory(f)
+		}},
+	}
+}
+
+func TestDashboardQueriesDateWindow(t *testing.T) {
+	filter := &dashboard.Filter{
+		RepositoryID: uuid.New(),
+		WorkspaceID:  uuid.New(),
+		StartTime:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:      time.Date(2021, 1, 31, 23, 59, 59, 0, time.UTC),
+	}
+
+	for _, testCase := range append(repositoryWindowTestCases(), workspaceWindowTestCases()...) {
+		t.Run("should apply the date window on the latest analysis of "+testCase.name, func(t *testing.T) {
+			recorder := newRawRecorder()
+
+			testCase.call(&database.Connection{Read: recorder, Write: recorder}, filter)
+
+			assert.Equal(t, testCase.expectedWindow, strings.Count(recorder.query, "created_at >= @startTime"))
+			assert.Equal(t, testCase.expectedWindow, strings.Count(recorder.query, "created_at <= @endTime"))
+			assert.Contains(t, recorder.values, sql.Named("startTime", filter.StartTime))
+			assert.Contains(t, recorder.values, sql.Named("endTime", filter.EndTime))
+			assert.Contains(t, recorder.values, sql.Named("repositoryID", filter.RepositoryID))
+			assert.Contains(t, recorder.values, sql.Named("workspaceID", filter.WorkspaceID))
+		})
+
+		t.Run("should not apply the date window when empty on "+testCase.name, func(t *testing.T) {
+			recorder := newRawRecorder()
+
+			testCase.call(&database.Connection{Read: recorder, Write: recorder}, &dashboard.Filter{})
+
+			assert.NotContains(t, recorder.query, "@startTime")
+			assert.NotContains(t, recorder.query, "@endTime")
+		})
+	}
+}
+
+func TestDashboardQueriesByFileGroupColumn(t *testing.T) {
+	t.Run("should group the file chart by repository and file", func(t *testing.T) {
+		recorder := newRawRecorder()
+
+		_, _ = NewWorkspaceDashboard(&database.Connection{Read: recorder, Write: recorder}).
+			GetDashboardVulnByFile(&dashboard.Filter{})
+
+		assert.Contains(t, recorder.query, "GROUP BY(repository_id, file)")
+	})
+
+	t.Run("should group the directory chart by repository and directory", func(t *testing.T) {
+		recorder := newRawRecorder()
+
+		_, _ = NewRepoDashboard(&database.Connection{Read: recorder, Write: recorder}).
+			GetDashboardVulnByDirectory(&dashboard.Filter{})
+
+		assert.Contains(t, recorder.query, "GROUP BY(repository_id, directory)")
+	})
+}
@@ -31,6 +31,9 @@ type IWorkspaceRepository interface {
 	GetDashboardVulnByRepository(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByRepository, error)
 	GetDashboardVulnByLanguage(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByLanguage, error)
 	GetDashboardVulnByTime(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByTime, error)
+	GetDashboardVulnBySecurityTool(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesBySecurityTool, error)
+	GetDashboardVulnByFile(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByFile, error)
+	GetDashboardVulnByDirectory(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByFile, error)
 }
 
 type WorkspaceRepository struct {
@@ -46,9 +49,11 @@ func NewWorkspaceDashboard(connection *database.Connection) IWorkspaceRepository
 }
 
 func (r *WorkspaceRepository) GetDashboardTotalDevelopers(filter *dashboard.Filter) (count int, err error) {
-	query := fmt.Sprintf(r.queryGetDashboardTotalDevelopers(), dashboardEnums.TableVulnerabilitiesByAuthor)
+	condition, args := filter.GetDateFilter()
+
+	query := fmt.Sprintf(r.queryGetDashboardTotalDevelopers(), dashboardEnums.TableVulnerabilitiesByAuthor, condition)
 
-	return count, r.databaseRead.Raw(query, &count, filter.GetWorkspaceFilter()).GetErrorExceptNotFound()
+	return count, r.databaseRead.Raw(query, &count, args...).GetErrorExceptNotFound()
 }
 
 func (r *WorkspaceRepository) queryGetDashboardTotalDevelopers() string {
@@ -60,6 +65,7 @@ func (r *WorkspaceRepository) queryGetDashboardTotalDevelopers() string {
 			SELECT MAX(created_at) max_time, repository_id
 			FROM %[1]s
 			WHERE workspace_id = @workspaceID
+			%[2]s
 			GROUP BY(repository_id)
 		) AS last_analysis
 		ON vulns.created_at = last_analysis.max_time 
@@ -69,9 +75,12 @@ func (r *WorkspaceRepository) queryGetDashboardTotalDevelopers() string {
 }
 
 func (r *WorkspaceRepository) GetDashboardTotalRepositories(filter *dashboard.Filter) (count int, err error) {
-	query := fmt.Sprintf(r.queryGetDashboardTotalRepositories(), dashboardEnums.TableVulnerabilitiesByRepository)
+	condition, args := filter.GetDateFilter()
 
-	return count, r.databaseRead.Raw(query, &count, filter.GetWorkspaceFilter()).GetErrorExceptNotFound()
+	query := fmt.Sprintf(r.queryGetDashboardTotalRepositories(),
+		dashboardEnums.TableVulnerabilitiesByRepository, condition)
+
+	return count, r.databaseRead.Raw(query, &count, args...).GetErrorExceptNotFound()
 }
 
 func (r *WorkspaceRepository) queryGetDashboardTotalRepositories() string {
@@ -79,16 +88,19 @@ func (r *WorkspaceRepository) queryGetDashboardTotalRepositories() string {
 		SELECT COUNT(DISTINCT(repository_id)) 
 		FROM %[1]s
 		WHERE workspace_id = @workspaceID
+		%[2]s
 	`
 }
 
 func (r *WorkspaceRepository) GetDashboardVulnBySeverity(filter *dashboard.Filter) (*dashboard.Vulnerability, error) {
 	vulns := &dashboard.Vulnerability{}
 
-	query := fmt.Sprintf(r.queryGetDashboardVulnBySeverity(), r.queryDefaultFields(),
-		dashboardEnums.TableVulnerabilitiesByTime)
+	condition, args := filter.GetDateFilter()
+
+	query := fmt.Sprintf(r.queryGetDashboardVulnBySeverity(), queryDefaultFields(),
+		dashboardEnums.TableVulnerabilitiesByTime, condition)
 
-	return vulns, r.databaseRead.Raw(query, vulns, filter.GetWorkspaceFilter()).GetErrorExceptNotFound()
+	return vulns, r.databaseRead.Raw(query, vulns, args...).GetErrorExceptNotFound()
 }
 
 //nolint:funlen // need to be bigger than 15
@@ -104,6 +116,7 @@ func (r *WorkspaceRepository) queryGetDashboardVulnBySeverity() string {
 				SELECT MAX(created_at) max_time, repository_id
 				FROM %[2]s
 				WHERE workspace_id = @workspaceID
+				%[3]s
 				GROUP BY(repository_id)
 			) AS last_analysis
 			ON vulns.created_at = last_analysis.max_time 
@@ -115,10 +128,12 @@ func (r *WorkspaceRepository) queryGetDashboardVulnBySeverity() string {
 
 func (r *WorkspaceRepository) GetDashboardVulnByAuthor(
 	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesByAuthor, err error) {
-	query := fmt.Sprintf(r.queryGetDashboardVulnByAuthor(), r.queryDefaultFields(),
-		dashboardEnums.TableVulnerabilitiesByAuthor)
+	condition, args := filter.GetDateFilter()
+
+	query := fmt.Sprintf(r.queryGetDashboardVulnByAuthor(), queryDefaultFields(),
+		dashboardEnums.TableVulnerabilitiesByAuthor, condition)
 
-	err = r.databaseRead.Raw(query, &vulns, filter.GetWorkspaceFilter()).GetErrorExceptNotFound()
+	err = r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
 	if err != nil || len(vulns) == 0 {
 		return []*dashboard.VulnerabilitiesByAuthor{{}}, err
 	}
@@ -139,6 +154,7 @@ func (r *WorkspaceRepository) queryGetDashboardVulnByAuthor() string {
 				SELECT MAX(created_at) max_time, repository_id
 				FROM %[2]s
 				WHERE workspace_id = @workspaceID
+				%[3]s
 				GROUP BY(repository_id)
 			) AS last_analysis
 			ON vulns.created_at = last_analysis.max_time 
@@ -152,10 +168,12 @@ func (r *WorkspaceRepository) queryGetDashboardVulnByAuthor() string {
 
 func (r *WorkspaceRepository) GetDashboardVulnByRepository(
 	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesByRepository, err error) {
-	query := fmt.Sprintf(r.queryGetDashboardVulnByRepository(), r.queryDefaultFields(),
-		dashboardEnums.TableVulnerabilitiesByRepository)
+	condition, args := filter.GetDateFilter()
+
+	query := fmt.Sprintf(r.queryGetDashboardVulnByRepository(), queryDefaultFields(),
+		dashboardEnums.TableVulnerabilitiesByRepository, condition)
 
-	err = r.databaseRead.Raw(query, &vulns, filter.GetWorkspaceFilter()).GetErrorExceptNotFound()
+	err = r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
 	if err != nil || len(vulns) == 0 {
 		return []*dashboard.VulnerabilitiesByRepository{{}}, err
 	}
@@ -176,6 +194,7 @@ func (r *WorkspaceRepository) queryGetDashboardVulnByRepository() string {
 				SELECT MAX(created_at) max_time, repository_id
 				FROM %[2]s
 				WHERE workspace_id = @workspaceID
+				%[3]s
 				GROUP BY(repository_id)
 			) AS last_analysis
 			ON vulns.created_at = last_analysis.max_time 
@@ -189,10 +208,12 @@ func (r *WorkspaceRepository) queryGetDashboardVulnByRepository() string {
 
 func (r *WorkspaceRepository) GetDashboardVulnByLanguage(
 	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesByLanguage, err error) {
-	query := fmt.Sprintf(r.queryGetDashboardVulnByLanguage(), r.queryDefaultFields(),
-		dashboardEnums.TableVulnerabilitiesByLanguage)
+	condition, args := filter.GetDateFilter()
+
+	query := fmt.Sprintf(r.queryGetDashboardVulnByLanguage(), queryDefaultFields(),
+		dashboardEnums.TableVulnerabilitiesByLanguage, condition)
 
-	err = r.databaseRead.Raw(query, &vulns, filter.GetWorkspaceFilter()).GetErrorExceptNotFound()
+	err = r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
 	if err != nil || len(vulns) == 0 {
 		return []*dashboard.VulnerabilitiesByLanguage{{}}, err
 	}
@@ -213,6 +234,7 @@ func (r *WorkspaceRepository) queryGetDashboardVulnByLanguage() string {
 				SELECT MAX(created_at) max_time, repository_id
 				FROM %[2]s
 				WHERE workspace_id = @workspaceID
+				%[3]s
 				GROUP BY(repository_id)
 			) AS last_analysis
 			ON vulns.created_at = last_analysis.max_time 
@@ -229,7 +251,7 @@ func (r *WorkspaceRepository) GetDashboardVulnByTime(
 	condition, args := filter.GetDateFilter()
 
 	query := fmt.Sprintf(r.queryGetDashboardVulnByTime(),
-		r.queryDefaultFields(), dashboardEnums.TableVulnerabilitiesByTime, condition)
+		queryDefaultFields(), dashboardEnums.TableVulnerabilitiesByTime, condition)
 
 	return vulns, r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
 }
@@ -247,6 +269,7 @@ func (r *WorkspaceRepository) queryGetDashboardVulnByTime() string {
 				SELECT MAX(created_at) max_time, repository_id
 				FROM %[2]s
 				WHERE workspace_id = @workspaceID
+				%[3]s
 				GROUP BY(DATE(created_at), repository_id)
 			) AS last_analysis
 			ON vulns.created_at = last_analysis.max_time 
@@ -259,7 +282,90 @@ func (r *WorkspaceRepository) queryGetDashboardVulnByTime() string {
 	`
 }
 
-func (r *WorkspaceRepository) queryDefaultFields() string {
+func (r *WorkspaceRepository) GetDashboardVulnBySecurityTool(
+	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesBySecurityTool, err error) {
+	condition, args := filter.GetDateFilter()
+
+	query := fmt.Sprintf(r.queryGetDashboardVulnBySecurityTool(), queryDefaultFields(),
+		dashboardEnums.TableVulnerabilitiesBySecurityTool, condition, queryTotalOpenVulnerabilities())
+
+	return vulns, r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
+}
+
+//nolint:funlen // need to be bigger than 15
+func (r *WorkspaceRepository) queryGetDashboardVulnBySecurityTool() string {
+	return `
+		SELECT %[1]s, security_tool
+		FROM 
+		(
+			SELECT vulns.*
+			FROM %[2]s AS vulns
+			INNER JOIN 
+			(
+				SELECT MAX(created_at) max_time, repository_id
+				FROM %[2]s
+				WHERE workspace_id = @workspaceID
+				%[3]s
+				GROUP BY(repository_id)
+			) AS last_analysis
+			ON vulns.created_at = last_analysis.max_time 
+			AND vulns.repository_id = last_analysis.repository_id
+			WHERE workspace_id = @workspaceID
+		) AS result
+		WHERE security_tool != ''
+		GROUP BY(security_tool)
+		ORDER BY SUM(%[4]s) DESC
+	`
+}
+
+func (r *WorkspaceRepository) GetDashboardVulnByFile(
+	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesByFile, err error) {
+	condition, args := filter.GetDateFilter()
+
+	query := fmt.Sprintf(r.queryGetDashboardVulnByFile(), queryDefaultFields(),
+		dashboardEnums.TableVulnerabilitiesByFile, condition, queryTotalOpenVulnerabilities(), "file")
+
+	return vulns, r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
+}
+
+func (r *WorkspaceRepository) GetDashboardVulnByDirectory(
+	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesByFile, err error) {
+	condition, args := filter.GetDateFilter()
+
+	query := fmt.Sprintf(r.queryGetDashboardVulnByFile(), queryDefaultFields(),
+		dashboardEnums.TableVulnerabilitiesByFile, condition, queryTotalOpenVulnerabilities(), "directory")
+
+	return vulns, r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
+}
+
+//nolint:funlen // need to be bigger than 15
+func (r *WorkspaceRepository) queryGetDashboardVulnByFile() string {
+	return `
+		SELECT %[1]s, repository_id, %[5]s
+		FROM 
+		(
+			SELECT vulns.*
+			FROM %[2]s AS vulns
+			INNER JOIN 
+			(
+				SELECT MAX(created_at) max_time, repository_id
+				FROM %[2]s
+				WHERE workspace_id = @workspaceID
+				%[3]s
+				GROUP BY(repository_id)
+			) AS last_analysis
+			ON vulns.created_at = last_analysis.max_time 
+			AND vulns.repository_id = last_analysis.repository_id
+			WHERE workspace_id = @workspaceID
+		) AS result
+		WHERE %[5]s != ''
+		GROUP BY(repository_id, %[5]s)
+		ORDER BY SUM(%[4]s) DESC
+		LIMIT 10
+	`
+}
+
+func queryDefaultFields() string {
 	return `
 		SUM(critical_vulnerability) as critical_vulnerability, SUM(critical_false_positive) as critical_false_positive, 
 	    SUM(critical_risk_accepted) as critical_risk_accepted, SUM(critical_corrected) as critical_corrected,
@@ -275,3 +381,11 @@ func (r *WorkspaceRepository) queryDefaultFields() string {
 		SUM(unknown_risk_accepted) as unknown_risk_accepted, SUM(unknown_corrected) as unknown_corrected
 	`
 }
+
+// queryTotalOpenVulnerabilities sums the vulnerabilities that are still open, used to rank the drill-down charts
+func queryTotalOpenVulnerabilities() string {
+	return `
+		critical_vulnerability + high_vulnerability + medium_vulnerability + 
+		low_vulnerability + info_vulnerability + unknown_vulnerability
+	`
+}
@@ -250,3 +250,108 @@ func TestGetDashboardVulnByRepositoryWorkspace(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestGetDashboardVulnBySecurityToolWorkspace(t *testing.T) {
+	t.Run("should return get vulns by security tool without errors", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, nil, &[]*dashboard.VulnerabilitiesBySecurityTool{{}}))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewWorkspaceDashboard(connection)
+
+		result, err := repository.GetDashboardVulnBySecurityTool(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("should return error when failed to get vulns by security tool", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, errors.New("test"), nil))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewWorkspaceDashboard(connection)
+
+		_, err := repository.GetDashboardVulnBySecurityTool(&dashboard.Filter{})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetDashboardVulnByFileWorkspace(t *testing.T) {
+	t.Run("should return get vulns by file without errors", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, nil, &[]*dashboard.VulnerabilitiesByFile{{}}))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewWorkspaceDashboard(connection)
+
+		result, err := repository.GetDashboardVulnByFile(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("should return error when failed to get vulns by file", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, errors.New("test"), nil))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewWorkspaceDashboard(connection)
+
+		_, err := repository.GetDashboardVulnByFile(&dashboard.Filter{})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetDashboardVulnByDirectoryWorkspace(t *testing.T) {
+	t.Run("should return get vulns by directory without errors", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, nil, &[]*dashboard.VulnerabilitiesByFile{{}}))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewWorkspaceDashboard(connection)
+
+		result, err := repository.GetDashboardVulnByDirectory(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("should return error when failed to get vulns by directory", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, errors.New("test"), nil))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewWorkspaceDashboard(connection)
+
+		_, err := repository.GetDashboardVulnByDirectory(&dashboard.Filter{})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,223 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	accountEnums "github.com/ZupIT/horusec-devkit/pkg/enums/account"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/digest"
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	digestEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/digest"
+	remediationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/remediation"
+	rebuildRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/rebuild"
+)
+
+type IRepository interface {
+	IsAvailable() bool
+	GetSubscription(accountID, workspaceID uuid.UUID) (*digest.Subscription, error)
+	SaveSubscription(subscription *digest.Subscription) error
+	DeleteSubscription(accountID, workspaceID uuid.UUID) error
+	ListSubscriptions() ([]*digest.Subscription, error)
+	MarkAsSent(subscription *digest.Subscription, sentAt time.Time) (bool, error)
+	UnmarkAsSent(subscription *digest.Subscription, sentAt time.Time) error
+	GetRecipient(accountID, workspaceID uuid.UUID) (*digest.Recipient, error)
+	ListNewFindings(filter *dashboard.Filter) ([]*digest.FindingsBySeverity, error)
+	ListFixedFindings(filter *dashboard.Filter) ([]*digest.FindingsBySeverity, error)
+	ListTopRepositories(filter *dashboard.Filter) ([]*digest.TopRepository, error)
+	ListRiskAcceptances(workspaceID uuid.UUID, until time.Time) ([]*digest.RiskAcceptance, error)
+}
+
+type Repository struct {
+	databaseRead  database.IDatabaseRead
+	databaseWrite database.IDatabaseWrite
+	platformRead  database.IDatabaseRead
+}
+
+func NewDigestRepository(connection *database.Connection,
+	platformConnection *rebuildRepository.PlatformConnection) IRepository {
+	return &Repository{
+		databaseRead:  connection.Read,
+		databaseWrite: connection.Write,
+		platformRead:  platformConnection.Read,
+	}
+}
+
+// IsAvailable checks if connected to the platform database, where the accounts and risk acceptances are stored
+func (r *Repository) IsAvailable() bool {
+	return r.platformRead != nil
+}
+
+func (r *Repository) GetSubscription(accountID, workspaceID uuid.UUID) (*digest.Subscription, error) {
+	subscription := &digest.Subscription{AccountID: accountID, WorkspaceID: workspaceID}
+
+	return subscription, r.databaseRead.First(subscription, subscription.ToFilter(),
+		digestEnums.TableDigestSubscriptions).GetError()
+}
+
+// SaveSubscription updates the preferences of the subscription or creates it when the account was not subscribed
+func (r *Repository) SaveSubscription(subscription *digest.Subscription) error {
+	result := r.databaseWrite.Update(subscription.ToUpdateMap(), subscription.ToFilter(),
+		digestEnums.TableDigestSubscriptions)
+	if result.GetError() != nil || result.GetRowsAffected() > 0 {
+		return result.GetError()
+	}
+
+	return r.databaseWrite.Create(subscription, digestEnums.TableDigestSubscriptions).GetError()
+}
+
+func (r *Repository) DeleteSubscription(accountID, workspaceID uuid.UUID) error {
+	subscription := &digest.Subscription{AccountID: accountID, WorkspaceID: workspaceID}
+
+	return r.databaseWrite.Delete(subscription.ToFilter(), digestEnums.TableDigestSubscriptions).GetError()
+}
+
+func (r *Repository) ListSubscriptions() (subscriptions []*digest.Subscription, err error) {
+	return subscriptions, r.databaseRead.Find(&subscriptions, map[string]interface{}{},
+		digestEnums.TableDigestSubscriptions).GetErrorExceptNotFound()
+}
+
+// MarkAsSent registers the date of the digest sent, returning false when another instance already sent it
+func (r *Repository) MarkAsSent(subscription *digest.Subscription, sentAt time.Time) (bool, error) {
+	result := r.databaseWrite.Update(map[string]interface{}{"last_sent_at": sentAt}, subscription.ToSentFilter(),
+		digestEnums.TableDigestSubscriptions)
+
+	return result.GetRowsAffected() > 0, result.GetError()
+}
+
+// UnmarkAsSent restores the date of the last digest sent before the one registered at the informed date, so it is
+// sent again in the next run
+func (r *Repository) UnmarkAsSent(subscription *digest.Subscription, sentAt time.Time) error {
+	return r.databaseWrite.Update(map[string]interface{}{"last_sent_at": subscription.LastSentAt},
+		subscription.ToUnsentFilter(sentAt), digestEnums.TableDigestSubscriptions).GetError()
+}
+
+// GetRecipient returns the email of the account and the name of the workspace, or nil when the account is no
+// longer admin of the workspace
+func (r *Repository) GetRecipient(accountID, workspaceID uuid.UUID) (*digest.Recipient, error) {
+	recipient := &digest.Recipient{}
+
+	result := r.platformRead.Raw(r.queryGetRecipient(), recipient, sql.Named("accountID", accountID),
+		sql.Named("workspaceID", workspaceID), sql.Named("role", accountEnums.Admin))
+	if result.GetErrorExceptNotFound() != nil || result.GetRowsAffected() == 0 {
+		return nil, result.GetErrorExceptNotFound()
+	}
+
+	return recipient, nil
+}
+
+func (r *Repository) queryGetRecipient() string {
+	return `
+		SELECT accounts.email, accounts.username, accounts.language, workspaces.name AS workspace_name
+		FROM accounts
+		JOIN account_workspace ON account_workspace.account_id = accounts.account_id
+		JOIN workspaces ON workspaces.workspace_id = account_workspace.workspace_id
+		WHERE accounts.account_id = @accountID AND account_workspace.workspace_id = @workspaceID
+		AND account_workspace.role = @role
+	`
+}
+
+func (r *Repository) ListNewFindings(filter *dashboard.Filter) (findings []*digest.FindingsBySeverity, err error) {
+	query := fmt.Sprintf(r.queryListFindings(), remediationEnums.TableVulnerabilitiesRemediation, "first_seen_at")
+
+	return findings, r.databaseRead.Raw(query, &findings, r.getFilterArgs(filter)...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) ListFixedFindings(filter *dashboard.Filter) (findings []*digest.FindingsBySeverity, err error) {
+	query := fmt.Sprintf(r.queryListFindings(), remediationEnums.TableVulnerabilitiesRemediation, "resolved_at")
+
+	return findings, r.databaseRead.Raw(query, &findings, r.getFilterArgs(filter)...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListFindings() string {
+	return `
+		SELECT severity, COUNT(*) AS total
+		FROM %[1]s
+		WHERE workspace_id = @workspaceID AND %[2]s >= @startTime AND %[2]s <= @endTime
+		GROUP BY severity
+	`
+}
+
+func (r *Repository) ListTopRepositories(filter *dashboard.Filter) (repositories []*digest.TopRepository, err error) {
+	query := fmt.Sprintf(r.queryListTopRepositories(), dashboardEnums.TableVulnerabilitiesByRepository)
+
+	return repositories, r.databaseRead.Raw(query, &repositories, append(r.getFilterArgs(filter),
+		sql.Named("limit", digestEnums.TopRepositoriesLimit))...).GetErrorExceptNotFound()
+}
+
+//nolint:funlen // need to be bigger than 15
+func (r *Repository) queryListTopRepositories() string {
+	return `
+		SELECT vulns.repository_name, SUM(vulns.critical_vulnerability) AS critical,
+			SUM(vulns.high_vulnerability) AS high, SUM(vulns.medium_vulnerability) AS medium,
+			SUM(vulns.low_vulnerability) AS low, SUM(vulns.critical_vulnerability + vulns.high_vulnerability +
+			vulns.medium_vulnerability + vulns.low_vulnerability + vulns.info_vulnerability +
+			vulns.unknown_vulnerability) AS total
+		FROM %[1]s AS vulns
+		INNER JOIN
+		(
+			SELECT MAX(created_at) max_time, repository_id
+			FROM %[1]s
+			WHERE workspace_id = @workspaceID AND created_at <= @endTime
+			GROUP BY(repository_id)
+		) AS last_analysis
+		ON vulns.created_at = last_analysis.max_time
+		AND vulns.repository_id = last_analysis.repository_id
+		WHERE vulns.workspace_id = @workspaceID
+		GROUP BY(vulns.repository_id, vulns.repository_name)
+		ORDER BY critical DESC, high DESC, total DESC
+		LIMIT @limit
+	`
+}
+
+// ListRiskAcceptances returns the risk acceptances of the workspace that expire until the time informed
+func (r *Repository) ListRiskAcceptances(workspaceID uuid.UUID,
+	until time.Time) (riskAcceptances []*digest.RiskAcceptance, err error) {
+	query := fmt.Sprintf(r.queryListRiskAcceptances(), digestEnums.TableRiskAcceptances)
+
+	return riskAcceptances, r.platformRead.Raw(query, &riskAcceptances, sql.Named("workspaceID", workspaceID),
+		sql.Named("until", until), sql.Named("riskAccepted", vulnerabilityEnums.RiskAccepted),
+		sql.Named("limit", digestEnums.RiskAcceptancesLimit)).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListRiskAcceptances() string {
+	return `
+		SELECT analysis.repository_name, vulnerabilities.vuln_hash, vulnerabilities.severity,
+			acceptances.account_username, acceptances.expires_at
+		FROM %s AS acceptances
+		JOIN vulnerabilities ON vulnerabilities.vulnerability_id = acceptances.vulnerability_id
+		JOIN analysis ON analysis.analysis_id = acceptances.analysis_id
+		WHERE analysis.workspace_id = @workspaceID AND acceptances.expires_at <= @until
+		AND vulnerabilities.type = @riskAccepted
+		ORDER BY acceptances.expires_at
+		LIMIT @limit
+	`
+}
+
+func (r *Repository) getFilterArgs(filter *dashboard.Filter) []interface{} {
+	return []interface{}{
+		sql.Named("workspaceID", filter.WorkspaceID),
+		sql.Named("startTime", filter.StartTime),
+		sql.Named("endTime", filter.EndTime),
+	}
+}
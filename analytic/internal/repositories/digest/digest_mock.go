@@ -0,0 +1,90 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"time"
+
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/digest"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) IsAvailable() bool {
+	args := m.MethodCalled("IsAvailable")
+	return args.Get(0).(bool)
+}
+
+func (m *Mock) GetSubscription(_, _ uuid.UUID) (*digest.Subscription, error) {
+	args := m.MethodCalled("GetSubscription")
+	return args.Get(0).(*digest.Subscription), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) SaveSubscription(_ *digest.Subscription) error {
+	args := m.MethodCalled("SaveSubscription")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) DeleteSubscription(_, _ uuid.UUID) error {
+	args := m.MethodCalled("DeleteSubscription")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListSubscriptions() ([]*digest.Subscription, error) {
+	args := m.MethodCalled("ListSubscriptions")
+	return args.Get(0).([]*digest.Subscription), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) MarkAsSent(_ *digest.Subscription, _ time.Time) (bool, error) {
+	args := m.MethodCalled("MarkAsSent")
+	return args.Get(0).(bool), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) UnmarkAsSent(_ *digest.Subscription, _ time.Time) error {
+	args := m.MethodCalled("UnmarkAsSent")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) GetRecipient(_, _ uuid.UUID) (*digest.Recipient, error) {
+	args := m.MethodCalled("GetRecipient")
+	return args.Get(0).(*digest.Recipient), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListNewFindings(_ *dashboard.Filter) ([]*digest.FindingsBySeverity, error) {
+	args := m.MethodCalled("ListNewFindings")
+	return args.Get(0).([]*digest.FindingsBySeverity), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListFixedFindings(_ *dashboard.Filter) ([]*digest.FindingsBySeverity, error) {
+	args := m.MethodCalled("ListFixedFindings")
+	return args.Get(0).([]*digest.FindingsBySeverity), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListTopRepositories(_ *dashboard.Filter) ([]*digest.TopRepository, error) {
+	args := m.MethodCalled("ListTopRepositories")
+	return args.Get(0).([]*digest.TopRepository), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListRiskAcceptances(_ uuid.UUID, _ time.Time) ([]*digest.RiskAcceptance, error) {
+	args := m.MethodCalled("ListRiskAcceptances")
+	return args.Get(0).([]*digest.RiskAcceptance), utilsMock.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,270 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/digest"
+	digestEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/digest"
+	rebuildRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/rebuild"
+)
+
+func newRepository(analyticMock, platformMock *database.Mock) IRepository {
+	return NewDigestRepository(&database.Connection{Read: analyticMock, Write: analyticMock},
+		&rebuildRepository.PlatformConnection{Read: platformMock, Write: platformMock})
+}
+
+func TestIsAvailable(t *testing.T) {
+	t.Run("should return true when connected to the platform database", func(t *testing.T) {
+		assert.True(t, newRepository(&database.Mock{}, &database.Mock{}).IsAvailable())
+	})
+
+	t.Run("should return false when not connected to the platform database", func(t *testing.T) {
+		repository := NewDigestRepository(&database.Connection{}, &rebuildRepository.PlatformConnection{})
+
+		assert.False(t, repository.IsAvailable())
+	})
+}
+
+func TestGetSubscription(t *testing.T) {
+	t.Run("should return the subscription of the account", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("First").Return(response.NewResponse(1, nil,
+			&digest.Subscription{Frequency: digestEnums.Daily}))
+
+		subscription, err := newRepository(analyticMock, &database.Mock{}).GetSubscription(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, digestEnums.Daily, subscription.Frequency)
+	})
+
+	t.Run("should return error when not subscribed", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("First").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		_, err := newRepository(analyticMock, &database.Mock{}).GetSubscription(uuid.New(), uuid.New())
+		assert.Equal(t, enums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestSaveSubscription(t *testing.T) {
+	t.Run("should update the preferences when already subscribed", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Update").Return(response.NewResponse(1, nil, nil))
+
+		assert.NoError(t, newRepository(analyticMock, &database.Mock{}).SaveSubscription(&digest.Subscription{}))
+		analyticMock.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("should create the subscription when not subscribed", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Update").Return(response.NewResponse(0, nil, nil))
+		analyticMock.On("Create").Return(response.NewResponse(1, nil, nil))
+
+		assert.NoError(t, newRepository(analyticMock, &database.Mock{}).SaveSubscription(&digest.Subscription{}))
+		analyticMock.AssertCalled(t, "Create")
+	})
+
+	t.Run("should return error when failed to update", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, newRepository(analyticMock, &database.Mock{}).SaveSubscription(&digest.Subscription{}))
+		analyticMock.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("should return error when failed to create", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Update").Return(response.NewResponse(0, nil, nil))
+		analyticMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, newRepository(analyticMock, &database.Mock{}).SaveSubscription(&digest.Subscription{}))
+	})
+}
+
+func TestDeleteSubscription(t *testing.T) {
+	t.Run("should delete the subscription without errors", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Delete").Return(response.NewResponse(1, nil, nil))
+
+		assert.NoError(t, newRepository(analyticMock, &database.Mock{}).DeleteSubscription(uuid.New(), uuid.New()))
+	})
+}
+
+func TestListSubscriptions(t *testing.T) {
+	t.Run("should return the subscriptions", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Find").Return(response.NewResponse(1, nil, []*digest.Subscription{{}}))
+
+		subscriptions, err := newRepository(analyticMock, &database.Mock{}).ListSubscriptions()
+		assert.NoError(t, err)
+		assert.Len(t, subscriptions, 1)
+	})
+
+	t.Run("should return no error when there are no subscriptions", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Find").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		subscriptions, err := newRepository(analyticMock, &database.Mock{}).ListSubscriptions()
+		assert.NoError(t, err)
+		assert.Empty(t, subscriptions)
+	})
+}
+
+func TestMarkAsSent(t *testing.T) {
+	t.Run("should return true when registered the digest sent", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Update").Return(response.NewResponse(1, nil, nil))
+
+		sent, err := newRepository(analyticMock, &database.Mock{}).MarkAsSent(&digest.Subscription{}, time.Now())
+		assert.NoError(t, err)
+		assert.True(t, sent)
+	})
+
+	t.Run("should return false when already sent by another instance", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Update").Return(response.NewResponse(0, nil, nil))
+
+		sent, err := newRepository(analyticMock, &database.Mock{}).MarkAsSent(&digest.Subscription{}, time.Now())
+		assert.NoError(t, err)
+		assert.False(t, sent)
+	})
+}
+
+func TestUnmarkAsSent(t *testing.T) {
+	t.Run("should restore the last digest sent without errors", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Update").Return(response.NewResponse(1, nil, nil))
+
+		assert.NoError(t, newRepository(analyticMock, &database.Mock{}).UnmarkAsSent(&digest.Subscription{},
+			time.Now()))
+	})
+
+	t.Run("should return error when failed to restore the last digest sent", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, newRepository(analyticMock, &database.Mock{}).UnmarkAsSent(&digest.Subscription{},
+			time.Now()))
+	})
+}
+
+func TestGetRecipient(t *testing.T) {
+	t.Run("should return the recipient of the digest", func(t *testing.T) {
+		platformMock := &database.Mock{}
+		platformMock.On("Raw").Return(response.NewResponse(1, nil, &digest.Recipient{Email: "test@horusec.io"}))
+
+		recipient, err := newRepository(&database.Mock{}, platformMock).GetRecipient(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, "test@horusec.io", recipient.Email)
+	})
+
+	t.Run("should return nil when the account is not admin of the workspace", func(t *testing.T) {
+		platformMock := &database.Mock{}
+		platformMock.On("Raw").Return(response.NewResponse(0, nil, nil))
+
+		recipient, err := newRepository(&database.Mock{}, platformMock).GetRecipient(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.Nil(t, recipient)
+	})
+
+	t.Run("should return error when failed to get", func(t *testing.T) {
+		platformMock := &database.Mock{}
+		platformMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := newRepository(&database.Mock{}, platformMock).GetRecipient(uuid.New(), uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestListFindings(t *testing.T) {
+	rows := []*digest.FindingsBySeverity{{Severity: severities.High, Total: 1}}
+
+	t.Run("should return the new and fixed findings", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Raw").Return(response.NewResponse(1, nil, rows))
+		repository := newRepository(analyticMock, &database.Mock{})
+
+		result, err := repository.ListNewFindings(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Equal(t, rows, result)
+
+		result, err = repository.ListFixedFindings(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Equal(t, rows, result)
+	})
+
+	t.Run("should return error when failed to list", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+		repository := newRepository(analyticMock, &database.Mock{})
+
+		_, err := repository.ListNewFindings(&dashboard.Filter{})
+		assert.Error(t, err)
+
+		_, err = repository.ListFixedFindings(&dashboard.Filter{})
+		assert.Error(t, err)
+	})
+}
+
+func TestListTopRepositories(t *testing.T) {
+	t.Run("should return the repositories with more vulnerabilities", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Raw").Return(response.NewResponse(1, nil,
+			[]*digest.TopRepository{{RepositoryName: "horusec"}}))
+
+		result, err := newRepository(analyticMock, &database.Mock{}).ListTopRepositories(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("should return error when failed to list", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := newRepository(analyticMock, &database.Mock{}).ListTopRepositories(&dashboard.Filter{})
+		assert.Error(t, err)
+	})
+}
+
+func TestListRiskAcceptances(t *testing.T) {
+	t.Run("should return the risk acceptances of the platform database", func(t *testing.T) {
+		platformMock := &database.Mock{}
+		platformMock.On("Raw").Return(response.NewResponse(1, nil,
+			[]*digest.RiskAcceptance{{RepositoryName: "horusec"}}))
+
+		result, err := newRepository(&database.Mock{}, platformMock).ListRiskAcceptances(uuid.New(), time.Now())
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("should return error when failed to list", func(t *testing.T) {
+		platformMock := &database.Mock{}
+		platformMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := newRepository(&database.Mock{}, platformMock).ListRiskAcceptances(uuid.New(), time.Now())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+	ledgerEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/ledger"
+)
+
+type IRepository interface {
+	GetLedger(analysisID uuid.UUID, projection string) (*ledger.Ledger, error)
+	SaveProjection(previous, current *ledger.Ledger, rows interface{}) error
+}
+
+type Repository struct {
+	databaseRead  database.IDatabaseRead
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewLedgerRepository(connection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead:  connection.Read,
+		databaseWrite: connection.Write,
+	}
+}
+
+// GetLedger returns the ledger of the analysis in the analytic table, or nil when the analysis was never projected
+func (r *Repository) GetLedger(analysisID uuid.UUID, projection string) (*ledger.Ledger, error) {
+	entity := &ledger.Ledger{}
+
+	err := r.databaseRead.First(entity, map[string]interface{}{"analysis_id": analysisID, "projection": projection},
+		ledgerEnums.TableAnalysisLedger).GetError()
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return nil, nil
+	}
+
+	return entity, err
+}
+
+// SaveProjection replaces the rows of the previous revision of the analysis by the new ones and records the new
+// revision in the ledger, all in a single transaction. When another consumer changed or created the ledger since it
+// was read nothing is saved and an error is returned
+func (r *Repository) SaveProjection(previous, current *ledger.Ledger, rows interface{}) error {
+	transaction := r.databaseWrite.StartTransaction()
+
+	if err := r.saveProjection(previous, current, rows, transaction); err != nil {
+		logger.LogError(ledgerEnums.MessageFailedToRollbackProjection, transaction.RollbackTransaction().GetError())
+
+		return err
+	}
+
+	return transaction.CommitTransaction().GetError()
+}
+
+func (r *Repository) saveProjection(previous, current *ledger.Ledger, rows interface{},
+	transaction database.IDatabaseWrite) error {
+	if err := r.saveLedger(previous, current, transaction); err != nil {
+		return err
+	}
+
+	for _, filter := range current.GetReplacedRowsFilters(previous) {
+		if err := transaction.Delete(filter, current.Projection).GetError(); err != nil {
+			return err
+		}
+	}
+
+	return transaction.Create(rows, current.Projection).GetError()
+}
+
+func (r *Repository) saveLedger(previous, current *ledger.Ledger, transaction database.IDatabaseWrite) error {
+	if previous == nil {
+		return transaction.Create(current, ledgerEnums.TableAnalysisLedger).GetError()
+	}
+
+	result := transaction.Update(current.ToUpdateMap(), previous.ToFilter(), ledgerEnums.TableAnalysisLedger)
+	if result.GetError() != nil {
+		return result.GetError()
+	}
+
+	if result.GetRowsAffected() == 0 {
+		return ledgerEnums.ErrorLedgerConflict
+	}
+
+	return nil
+}
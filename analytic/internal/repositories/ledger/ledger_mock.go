@@ -0,0 +1,37 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GetLedger(_ uuid.UUID, _ string) (*ledger.Ledger, error) {
+	args := m.MethodCalled("GetLedger")
+	return args.Get(0).(*ledger.Ledger), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) SaveProjection(previous, current *ledger.Ledger, rows interface{}) error {
+	args := m.MethodCalled("SaveProjection", previous, current, rows)
+	return utilsMock.ReturnNilOrError(args, 0)
+}
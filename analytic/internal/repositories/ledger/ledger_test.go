@@ -0,0 +1,154 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+	ledgerEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/ledger"
+)
+
+func newRepository(databaseMock *database.Mock) IRepository {
+	return NewLedgerRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+}
+
+func newLedger(revision int, createdAt time.Time) *ledger.Ledger {
+	return &ledger.Ledger{AnalysisID: uuid.New(), Projection: "test", Revision: revision, CreatedAt: createdAt}
+}
+
+func TestGetLedger(t *testing.T) {
+	t.Run("should return the ledger of the analysis", func(t *testing.T) {
+		expected := newLedger(2, time.Now())
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("First").Return(response.NewResponse(1, nil, expected))
+
+		result, err := newRepository(databaseMock).GetLedger(expected.AnalysisID, "test")
+		assert.NoError(t, err)
+		assert.Equal(t, expected.AnalysisID, result.AnalysisID)
+		assert.Equal(t, 2, result.Revision)
+	})
+
+	t.Run("should return nil when the analysis was never projected", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("First").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		result, err := newRepository(databaseMock).GetLedger(uuid.New(), "test")
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when failed to get the ledger", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("First").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := newRepository(databaseMock).GetLedger(uuid.New(), "test")
+		assert.Error(t, err)
+	})
+}
+
+func TestSaveProjection(t *testing.T) {
+	t.Run("should create the ledger and the rows when never projected", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Delete").Return(response.NewResponse(0, nil, nil))
+		databaseMock.On("Create").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("CommitTransaction").Return(response.NewResponse(0, nil, nil))
+
+		assert.NoError(t, newRepository(databaseMock).SaveProjection(nil, newLedger(0, time.Now()), []string{}))
+		databaseMock.AssertNotCalled(t, "Update")
+		databaseMock.AssertNumberOfCalls(t, "Delete", 2)
+		databaseMock.AssertNumberOfCalls(t, "Create", 2)
+		databaseMock.AssertCalled(t, "CommitTransaction")
+	})
+
+	t.Run("should update the ledger and replace the rows of the analysis", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Delete").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Create").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("CommitTransaction").Return(response.NewResponse(0, nil, nil))
+
+		err := newRepository(databaseMock).SaveProjection(newLedger(1, time.Now().Add(-time.Hour)),
+			newLedger(2, time.Now()), []string{})
+		assert.NoError(t, err)
+		databaseMock.AssertNumberOfCalls(t, "Delete", 1)
+		databaseMock.AssertNumberOfCalls(t, "Create", 1)
+		databaseMock.AssertCalled(t, "CommitTransaction")
+	})
+
+	t.Run("should return conflict when another consumer changed the ledger", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(response.NewResponse(0, nil, nil))
+		databaseMock.On("RollbackTransaction").Return(response.NewResponse(0, nil, nil))
+
+		err := newRepository(databaseMock).SaveProjection(newLedger(1, time.Now()), newLedger(2, time.Now()),
+			[]string{})
+		assert.Equal(t, ledgerEnums.ErrorLedgerConflict, err)
+		databaseMock.AssertNotCalled(t, "Delete")
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+	})
+
+	t.Run("should rollback when failed to update the ledger", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(response.NewResponse(0, nil, nil))
+
+		err := newRepository(databaseMock).SaveProjection(newLedger(1, time.Now()), newLedger(2, time.Now()),
+			[]string{})
+		assert.Error(t, err)
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+	})
+
+	t.Run("should rollback when failed to delete the previous rows", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Create").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Delete").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(response.NewResponse(0, nil, nil))
+
+		assert.Error(t, newRepository(databaseMock).SaveProjection(nil, newLedger(0, time.Now()), []string{}))
+		databaseMock.AssertNumberOfCalls(t, "Create", 1)
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+	})
+
+	t.Run("should rollback when failed to create the rows", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Delete").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(response.NewResponse(0, nil, nil))
+
+		err := newRepository(databaseMock).SaveProjection(newLedger(1, time.Now()), newLedger(2, time.Now()),
+			[]string{})
+		assert.Error(t, err)
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+	})
+}
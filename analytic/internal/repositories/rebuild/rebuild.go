@@ -0,0 +1,119 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	"fmt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseConfig "github.com/ZupIT/horusec-devkit/pkg/services/database/config"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/rebuild"
+	rebuildEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/rebuild"
+)
+
+// PlatformConnection is the connection with the horusec platform database, where the analysis are stored
+type PlatformConnection database.Connection
+
+// NewPlatformConnection connects with the platform database when its uri is informed, otherwise the rebuild is
+// disabled and an empty connection is returned
+func NewPlatformConnection() (*PlatformConnection, error) {
+	uri := env.GetEnvOrDefault(rebuildEnums.EnvHorusecDatabaseURI, "")
+	if uri == "" {
+		return &PlatformConnection{}, nil
+	}
+
+	config := databaseConfig.NewDatabaseConfig()
+	config.SetURI(uri)
+
+	connection, err := database.NewDatabaseReadAndWrite(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return (*PlatformConnection)(connection), nil
+}
+
+type IRepository interface {
+	IsAvailable() bool
+	ListAnalysisIDs(scope *rebuild.Scope) ([]uuid.UUID, error)
+	GetAnalysis(analysisID uuid.UUID) (*analysis.Analysis, error)
+	CountProjectionRows(scope *rebuild.Scope) (map[string]int, error)
+}
+
+type Repository struct {
+	databaseRead database.IDatabaseRead
+	platformRead database.IDatabaseRead
+}
+
+func NewRebuildRepository(connection *database.Connection, platformConnection *PlatformConnection) IRepository {
+	return &Repository{
+		databaseRead: connection.Read,
+		platformRead: platformConnection.Read,
+	}
+}
+
+func (r *Repository) IsAvailable() bool {
+	return r.platformRead != nil
+}
+
+func (r *Repository) ListAnalysisIDs(scope *rebuild.Scope) (analysisIDs []uuid.UUID, err error) {
+	query := fmt.Sprintf(r.queryListAnalysisIDs(), rebuildEnums.TableAnalysis, scope.GetCondition())
+
+	return analysisIDs, r.platformRead.Raw(query, &analysisIDs, scope.GetArgs()...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListAnalysisIDs() string {
+	return `
+		SELECT analysis_id
+		FROM %s
+		WHERE %s
+		ORDER BY created_at ASC
+	`
+}
+
+func (r *Repository) GetAnalysis(analysisID uuid.UUID) (*analysis.Analysis, error) {
+	entity := &analysis.Analysis{}
+
+	preloads := map[string][]interface{}{
+		"AnalysisVulnerabilities":               {},
+		"AnalysisVulnerabilities.Vulnerability": {},
+	}
+
+	return entity, r.platformRead.FindPreload(entity, map[string]interface{}{"analysis_id": analysisID},
+		preloads, rebuildEnums.TableAnalysis).GetError()
+}
+
+// CountProjectionRows returns how many rows of each analytic table are inside the scope
+func (r *Repository) CountProjectionRows(scope *rebuild.Scope) (map[string]int, error) {
+	rows := map[string]int{}
+
+	for _, table := range ledger.ProjectionTables() {
+		count := 0
+
+		query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", table, scope.GetCondition())
+		if err := r.databaseRead.Raw(query, &count, scope.GetArgs()...).GetErrorExceptNotFound(); err != nil {
+			return nil, err
+		}
+
+		rows[table] = count
+	}
+
+	return rows, nil
+}
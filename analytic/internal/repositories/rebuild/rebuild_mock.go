@@ -0,0 +1,48 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/rebuild"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) IsAvailable() bool {
+	args := m.MethodCalled("IsAvailable")
+	return args.Get(0).(bool)
+}
+
+func (m *Mock) ListAnalysisIDs(_ *rebuild.Scope) ([]uuid.UUID, error) {
+	args := m.MethodCalled("ListAnalysisIDs")
+	return args.Get(0).([]uuid.UUID), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetAnalysis(_ uuid.UUID) (*analysis.Analysis, error) {
+	args := m.MethodCalled("GetAnalysis")
+	return args.Get(0).(*analysis.Analysis), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) CountProjectionRows(_ *rebuild.Scope) (map[string]int, error) {
+	args := m.MethodCalled("CountProjectionRows")
+	return args.Get(0).(map[string]int), utilsMock.ReturnNilOrError(args, 1)
+}
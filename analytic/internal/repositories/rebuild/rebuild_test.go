@@ -0,0 +1,130 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/ledger"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/rebuild"
+)
+
+func newRepository(analyticMock, platformMock *database.Mock) IRepository {
+	return NewRebuildRepository(&database.Connection{Read: analyticMock, Write: analyticMock},
+		&PlatformConnection{Read: platformMock, Write: platformMock})
+}
+
+func TestNewPlatformConnection(t *testing.T) {
+	t.Run("should return an empty connection when the uri is not informed", func(t *testing.T) {
+		connection, err := NewPlatformConnection()
+		assert.NoError(t, err)
+		assert.Nil(t, connection.Read)
+	})
+}
+
+func TestIsAvailable(t *testing.T) {
+	t.Run("should return true when connected to the platform database", func(t *testing.T) {
+		assert.True(t, newRepository(&database.Mock{}, &database.Mock{}).IsAvailable())
+	})
+
+	t.Run("should return false when not connected to the platform database", func(t *testing.T) {
+		repository := NewRebuildRepository(&database.Connection{}, &PlatformConnection{})
+
+		assert.False(t, repository.IsAvailable())
+	})
+}
+
+func TestListAnalysisIDs(t *testing.T) {
+	t.Run("should return the analysis ids of the platform database", func(t *testing.T) {
+		ids := []uuid.UUID{uuid.New(), uuid.New()}
+
+		platformMock := &database.Mock{}
+		platformMock.On("Raw").Return(response.NewResponse(2, nil, ids))
+
+		result, err := newRepository(&database.Mock{}, platformMock).ListAnalysisIDs(
+			&rebuild.Scope{WorkspaceID: uuid.New()})
+		assert.NoError(t, err)
+		assert.Equal(t, ids, result)
+	})
+
+	t.Run("should return error when failed to list", func(t *testing.T) {
+		platformMock := &database.Mock{}
+		platformMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := newRepository(&database.Mock{}, platformMock).ListAnalysisIDs(&rebuild.Scope{})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return empty when there are no analysis", func(t *testing.T) {
+		platformMock := &database.Mock{}
+		platformMock.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		result, err := newRepository(&database.Mock{}, platformMock).ListAnalysisIDs(&rebuild.Scope{})
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+func TestGetAnalysis(t *testing.T) {
+	t.Run("should return the analysis with its vulnerabilities", func(t *testing.T) {
+		entity := &analysis.Analysis{ID: uuid.New()}
+
+		platformMock := &database.Mock{}
+		platformMock.On("FindPreload").Return(response.NewResponse(1, nil, entity))
+
+		result, err := newRepository(&database.Mock{}, platformMock).GetAnalysis(entity.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.ID, result.ID)
+	})
+
+	t.Run("should return error when the analysis was not found", func(t *testing.T) {
+		platformMock := &database.Mock{}
+		platformMock.On("FindPreload").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		_, err := newRepository(&database.Mock{}, platformMock).GetAnalysis(uuid.New())
+		assert.Equal(t, enums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestCountProjectionRows(t *testing.T) {
+	t.Run("should return the count of rows of every analytic table", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Raw").Return(response.NewResponse(1, nil, 5))
+
+		result, err := newRepository(analyticMock, &database.Mock{}).CountProjectionRows(&rebuild.Scope{})
+		assert.NoError(t, err)
+		assert.Len(t, result, len(ledger.ProjectionTables()))
+
+		for _, table := range ledger.ProjectionTables() {
+			assert.Equal(t, 5, result[table])
+		}
+	})
+
+	t.Run("should return error when failed to count", func(t *testing.T) {
+		analyticMock := &database.Mock{}
+		analyticMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := newRepository(analyticMock, &database.Mock{}).CountProjectionRows(&rebuild.Scope{})
+		assert.Error(t, err)
+	})
+}
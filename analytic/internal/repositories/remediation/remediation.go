@@ -0,0 +1,206 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/remediation"
+	remediationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/remediation"
+)
+
+type IRepository interface {
+	ListByRepository(repositoryID uuid.UUID) ([]*remediation.Remediation, error)
+	GetCheckpoint(repositoryID uuid.UUID) (*remediation.Checkpoint, error)
+	SaveChanges(previous, current *remediation.Checkpoint, changes *remediation.Changes) error
+	ListResolvedBySeverity(filter *dashboard.Filter) ([]*remediation.ResolvedBySeverity, error)
+	ListOpenByAge(filter *dashboard.Filter) ([]*remediation.OpenByAge, error)
+}
+
+type Repository struct {
+	databaseRead  database.IDatabaseRead
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewRemediationRepository(connection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead:  connection.Read,
+		databaseWrite: connection.Write,
+	}
+}
+
+func (r *Repository) ListByRepository(repositoryID uuid.UUID) (remediations []*remediation.Remediation, err error) {
+	return remediations, r.databaseRead.Find(&remediations, map[string]interface{}{"repository_id": repositoryID},
+		remediationEnums.TableVulnerabilitiesRemediation).GetErrorExceptNotFound()
+}
+
+// GetCheckpoint returns the last analysis applied to the remediation of the repository, or nil when none was
+func (r *Repository) GetCheckpoint(repositoryID uuid.UUID) (*remediation.Checkpoint, error) {
+	checkpoint := &remediation.Checkpoint{}
+
+	err := r.databaseRead.First(checkpoint, map[string]interface{}{"repository_id": repositoryID},
+		remediationEnums.TableRemediationCheckpoints).GetError()
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return nil, nil
+	}
+
+	return checkpoint, err
+}
+
+// SaveChanges saves all the remediation changes of an analysis and its checkpoint or none of them. When another
+// consumer changed or created the checkpoint since it was read nothing is saved and an error is returned
+func (r *Repository) SaveChanges(previous, current *remediation.Checkpoint, changes *remediation.Changes) error {
+	transaction := r.databaseWrite.StartTransaction()
+
+	if err := r.saveChanges(previous, current, changes, transaction); err != nil {
+		logger.LogError(remediationEnums.MessageFailedToRollbackRemediation,
+			transaction.RollbackTransaction().GetError())
+
+		return err
+	}
+
+	return transaction.CommitTransaction().GetError()
+}
+
+func (r *Repository) saveChanges(previous, current *remediation.Checkpoint, changes *remediation.Changes,
+	transaction database.IDatabaseWrite) error {
+	if err := r.saveCheckpoint(previous, current, transaction); err != nil {
+		return err
+	}
+
+	if err := r.upsertCreated(changes.Created, transaction); err != nil {
+		return err
+	}
+
+	for _, updated := range changes.Updated {
+		if err := transaction.Update(updated.ToUpdateMap(), updated.ToFilter(),
+			remediationEnums.TableVulnerabilitiesRemediation).GetError(); err != nil {
+			return err
+		}
+	}
+
+	for _, deleted := range changes.Deleted {
+		if err := transaction.Delete(deleted.ToFilter(),
+			remediationEnums.TableVulnerabilitiesRemediation).GetError(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) saveCheckpoint(previous, current *remediation.Checkpoint,
+	transaction database.IDatabaseWrite) error {
+	if previous == nil {
+		return transaction.Create(current, remediationEnums.TableRemediationCheckpoints).GetError()
+	}
+
+	result := transaction.Update(current.ToUpdateMap(), previous.ToFilter(),
+		remediationEnums.TableRemediationCheckpoints)
+	if result.GetError() != nil {
+		return result.GetError()
+	}
+
+	if result.GetRowsAffected() == 0 {
+		return remediationEnums.ErrorCheckpointConflict
+	}
+
+	return nil
+}
+
+// upsertCreated updates the remediations already in the repository instead of failing on their unique key
+func (r *Repository) upsertCreated(created []*remediation.Remediation, transaction database.IDatabaseWrite) error {
+	for _, entity := range created {
+		result := transaction.Update(entity.ToUpdateMap(), entity.ToUpsertFilter(),
+			remediationEnums.TableVulnerabilitiesRemediation)
+		if result.GetError() != nil {
+			return result.GetError()
+		}
+
+		if result.GetRowsAffected() > 0 {
+			continue
+		}
+
+		if err := transaction.Create(entity, remediationEnums.TableVulnerabilitiesRemediation).GetError(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) ListResolvedBySeverity(
+	filter *dashboard.Filter) (resolved []*remediation.ResolvedBySeverity, err error) {
+	query := fmt.Sprintf(r.queryListResolvedBySeverity(), remediationEnums.TableVulnerabilitiesRemediation,
+		r.getRepositoryCondition(filter))
+
+	return resolved, r.databaseRead.Raw(query, &resolved, r.getFilterArgs(filter)...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListResolvedBySeverity() string {
+	return `
+		SELECT severity, COUNT(*) AS total_resolved,
+			AVG(EXTRACT(EPOCH FROM (resolved_at - first_seen_at))) / 3600 AS mttr_hours
+		FROM %[1]s
+		WHERE workspace_id = @workspaceID
+		%[2]s
+		AND resolved_at IS NOT NULL AND resolved_at >= @startTime AND resolved_at <= @endTime
+		GROUP BY severity
+	`
+}
+
+func (r *Repository) ListOpenByAge(filter *dashboard.Filter) (open []*remediation.OpenByAge, err error) {
+	query := fmt.Sprintf(r.queryListOpenByAge(), remediationEnums.TableVulnerabilitiesRemediation,
+		r.getRepositoryCondition(filter))
+
+	return open, r.databaseRead.Raw(query, &open, r.getFilterArgs(filter)...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListOpenByAge() string {
+	return `
+		SELECT severity, CAST(FLOOR(EXTRACT(EPOCH FROM (CAST(@endTime AS TIMESTAMP) - first_seen_at)) / 86400) AS INT)
+			AS age_days, COUNT(*) AS total
+		FROM %[1]s
+		WHERE workspace_id = @workspaceID
+		%[2]s
+		AND first_seen_at <= @endTime AND (resolved_at IS NULL OR resolved_at > @endTime)
+		GROUP BY severity, age_days
+	`
+}
+
+func (r *Repository) getRepositoryCondition(filter *dashboard.Filter) string {
+	if filter.RepositoryID == uuid.Nil {
+		return ""
+	}
+
+	return "AND repository_id = @repositoryID"
+}
+
+func (r *Repository) getFilterArgs(filter *dashboard.Filter) []interface{} {
+	return []interface{}{
+		sql.Named("workspaceID", filter.WorkspaceID),
+		sql.Named("repositoryID", filter.RepositoryID),
+		sql.Named("startTime", filter.StartTime),
+		sql.Named("endTime", filter.EndTime),
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/remediation"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) ListByRepository(_ uuid.UUID) ([]*remediation.Remediation, error) {
+	args := m.MethodCalled("ListByRepository")
+	return args.Get(0).([]*remediation.Remediation), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetCheckpoint(_ uuid.UUID) (*remediation.Checkpoint, error) {
+	args := m.MethodCalled("GetCheckpoint")
+	return args.Get(0).(*remediation.Checkpoint), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) SaveChanges(_, _ *remediation.Checkpoint, changes *remediation.Changes) error {
+	args := m.MethodCalled("SaveChanges", changes)
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListResolvedBySeverity(_ *dashboard.Filter) ([]*remediation.ResolvedBySeverity, error) {
+	args := m.MethodCalled("ListResolvedBySeverity")
+	return args.Get(0).([]*remediation.ResolvedBySeverity), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListOpenByAge(_ *dashboard.Filter) ([]*remediation.OpenByAge, error) {
+	args := m.MethodCalled("ListOpenByAge")
+	return args.Get(0).([]*remediation.OpenByAge), utilsMock.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,256 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/remediation"
+	remediationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/remediation"
+)
+
+func newChanges() *remediation.Changes {
+	return &remediation.Changes{
+		Created: []*remediation.Remediation{{RemediationID: uuid.New()}},
+		Updated: []*remediation.Remediation{{RemediationID: uuid.New()}, {RemediationID: uuid.New()}},
+		Deleted: []*remediation.Remediation{{RemediationID: uuid.New()}},
+	}
+}
+
+func TestListByRepository(t *testing.T) {
+	t.Run("should return the remediations of the repository", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, []*remediation.Remediation{{VulnHash: "hash"}}))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListByRepository(uuid.New())
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "hash", result[0].VulnHash)
+	})
+
+	t.Run("should return empty when the repository has no remediations", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListByRepository(uuid.New())
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+func TestGetCheckpoint(t *testing.T) {
+	t.Run("should return the checkpoint of the repository", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("First").Return(response.NewResponse(1, nil, &remediation.Checkpoint{Revision: 2}))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.GetCheckpoint(uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Revision)
+	})
+
+	t.Run("should return nil when the repository was never tracked", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("First").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.GetCheckpoint(uuid.New())
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when failed to get the checkpoint", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("First").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.GetCheckpoint(uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestSaveChanges(t *testing.T) {
+	t.Run("should create the checkpoint and save all changes in a transaction", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Create").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Delete").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("CommitTransaction").Return(response.NewResponse(0, nil, nil))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		assert.NoError(t, repository.SaveChanges(nil, &remediation.Checkpoint{}, newChanges()))
+		databaseMock.AssertNumberOfCalls(t, "Create", 1)
+		databaseMock.AssertNumberOfCalls(t, "Update", 3)
+		databaseMock.AssertNumberOfCalls(t, "Delete", 1)
+		databaseMock.AssertCalled(t, "CommitTransaction")
+	})
+
+	t.Run("should create the remediation when it is not in the repository yet", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Create").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Update").Return(response.NewResponse(0, nil, nil))
+		databaseMock.On("Delete").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("CommitTransaction").Return(response.NewResponse(0, nil, nil))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		assert.NoError(t, repository.SaveChanges(nil, &remediation.Checkpoint{}, newChanges()))
+		databaseMock.AssertNumberOfCalls(t, "Create", 2)
+		databaseMock.AssertCalled(t, "CommitTransaction")
+	})
+
+	t.Run("should update the checkpoint when it was not changed since read", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Delete").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("CommitTransaction").Return(response.NewResponse(0, nil, nil))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		assert.NoError(t, repository.SaveChanges(&remediation.Checkpoint{}, &remediation.Checkpoint{Revision: 1},
+			newChanges()))
+		databaseMock.AssertNumberOfCalls(t, "Update", 4)
+		databaseMock.AssertNotCalled(t, "Create")
+		databaseMock.AssertCalled(t, "CommitTransaction")
+	})
+
+	t.Run("should rollback and return conflict when the checkpoint was changed", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(response.NewResponse(0, nil, nil))
+		databaseMock.On("RollbackTransaction").Return(response.NewResponse(0, nil, nil))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		assert.Equal(t, remediationEnums.ErrorCheckpointConflict, repository.SaveChanges(&remediation.Checkpoint{},
+			&remediation.Checkpoint{Revision: 1}, newChanges()))
+		databaseMock.AssertNumberOfCalls(t, "Update", 1)
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
+	})
+
+	t.Run("should rollback when failed to create", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(response.NewResponse(0, nil, nil))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		assert.Error(t, repository.SaveChanges(nil, &remediation.Checkpoint{}, newChanges()))
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
+	})
+
+	t.Run("should rollback when failed to update", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Create").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(response.NewResponse(0, nil, nil))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		assert.Error(t, repository.SaveChanges(nil, &remediation.Checkpoint{}, newChanges()))
+		databaseMock.AssertNumberOfCalls(t, "Update", 1)
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+	})
+
+	t.Run("should rollback when failed to delete", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Create").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Delete").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(response.NewResponse(0, nil, nil))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		assert.Error(t, repository.SaveChanges(nil, &remediation.Checkpoint{}, newChanges()))
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+	})
+}
+
+func TestListResolvedBySeverity(t *testing.T) {
+	t.Run("should return the resolved vulnerabilities of the workspace", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, []*remediation.ResolvedBySeverity{
+			{Severity: severities.Critical, TotalResolved: 1, MTTRHours: 10},
+		}))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListResolvedBySeverity(&dashboard.Filter{WorkspaceID: uuid.New(),
+			StartTime: time.Now(), EndTime: time.Now()})
+		assert.NoError(t, err)
+		assert.Equal(t, float64(10), result[0].MTTRHours)
+	})
+
+	t.Run("should return error when failed to list resolved vulnerabilities", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.ListResolvedBySeverity(&dashboard.Filter{RepositoryID: uuid.New()})
+		assert.Error(t, err)
+	})
+}
+
+func TestListOpenByAge(t *testing.T) {
+	t.Run("should return the open vulnerabilities of the repository", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, []*remediation.OpenByAge{
+			{Severity: severities.High, AgeDays: 3, Total: 2},
+		}))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListOpenByAge(&dashboard.Filter{RepositoryID: uuid.New()})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result[0].Total)
+	})
+
+	t.Run("should return error when failed to list open vulnerabilities", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewRemediationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.ListOpenByAge(&dashboard.Filter{})
+		assert.Error(t, err)
+	})
+}
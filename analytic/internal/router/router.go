@@ -23,8 +23,13 @@ import (
 	"github.com/ZupIT/horusec-platform/analytic/docs"
 	"github.com/ZupIT/horusec-platform/analytic/internal/enums/routes"
 	eventsdashboard "github.com/ZupIT/horusec-platform/analytic/internal/events/dashboard"
+	eventsremediation "github.com/ZupIT/horusec-platform/analytic/internal/events/remediation"
 	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/digest"
 	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/health"
+	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/rebuild"
+	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/remediation"
+	digestJob "github.com/ZupIT/horusec-platform/analytic/internal/jobs/digest"
 )
 
 type IRouter interface {
@@ -38,10 +43,20 @@ type Router struct {
 	healthHandler    *health.Handler
 	dashboardHandler *dashboard.Handler
 	dashboardEvents  *eventsdashboard.Events
+
+	remediationHandler *remediation.Handler
+	remediationEvents  *eventsremediation.Events
+
+	rebuildHandler *rebuild.Handler
+
+	digestHandler *digest.Handler
+	digestJob     digestJob.IJob
 }
 
 func NewHTTPRouter(route router.IRouter, authzMiddleware middlewares.IAuthzMiddleware,
-	healthHandler *health.Handler, dashboardHandler *dashboard.Handler, eventsDashboard *eventsdashboard.Events) IRouter {
+	healthHandler *health.Handler, dashboardHandler *dashboard.Handler, eventsDashboard *eventsdashboard.Events,
+	remediationHandler *remediation.Handler, eventsRemediation *eventsremediation.Events,
+	rebuildHandler *rebuild.Handler, digestHandler *digest.Handler, digestJob digestJob.IJob) IRouter {
 	requestRouter := &Router{
 		IRouter:          route,
 		IAuthzMiddleware: authzMiddleware,
@@ -49,6 +64,14 @@ func NewHTTPRouter(route router.IRouter, authzMiddleware middlewares.IAuthzMiddl
 		healthHandler:    healthHandler,
 		dashboardHandler: dashboardHandler,
 		dashboardEvents:  eventsDashboard,
+
+		remediationHandler: remediationHandler,
+		remediationEvents:  eventsRemediation,
+
+		rebuildHandler: rebuildHandler,
+
+		digestHandler: digestHandler,
+		digestJob:     digestJob,
 	}
 
 	return requestRouter.setRoutes()
@@ -80,5 +103,20 @@ func (r *Router) routerDashboardWorkspace() {
 		router.Options("/", r.dashboardHandler.Options)
 		router.With(r.IsWorkspaceAdmin).Get("/", r.dashboardHandler.GetAllChartsByWorkspace)
 		router.With(r.IsRepositoryMember).Get("/{repositoryID}", r.dashboardHandler.GetAllChartsByRepository)
+		router.With(r.IsWorkspaceAdmin).Get("/remediation", r.remediationHandler.GetRemediationByWorkspace)
+		router.With(r.IsRepositoryMember).Get("/{repositoryID}/remediation",
+			r.remediationHandler.GetRemediationByRepository)
+		router.With(r.IsWorkspaceAdmin).Get("/security-tools", r.dashboardHandler.GetSecurityToolChartsByWorkspace)
+		router.With(r.IsRepositoryMember).Get("/{repositoryID}/security-tools",
+			r.dashboardHandler.GetSecurityToolChartsByRepository)
+		router.With(r.IsWorkspaceAdmin).Get("/files", r.dashboardHandler.GetFileChartsByWorkspace)
+		router.With(r.IsRepositoryMember).Get("/{repositoryID}/files", r.dashboardHandler.GetFileChartsByRepository)
+		router.Options("/rebuild", r.rebuildHandler.Options)
+		router.With(r.IsWorkspaceAdmin).Post("/rebuild", r.rebuildHandler.StartRebuild)
+		router.With(r.IsWorkspaceAdmin).Get("/rebuild/{jobID}", r.rebuildHandler.GetRebuildJob)
+		router.Options("/digest", r.digestHandler.Options)
+		router.With(r.IsWorkspaceAdmin).Get("/digest", r.digestHandler.GetSubscription)
+		router.With(r.IsWorkspaceAdmin).Put("/digest", r.digestHandler.SaveSubscription)
+		router.With(r.IsWorkspaceAdmin).Delete("/digest", r.digestHandler.DeleteSubscription)
 	})
 }
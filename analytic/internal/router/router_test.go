@@ -23,8 +23,13 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	eventDashboard "github.com/ZupIT/horusec-platform/analytic/internal/events/dashboard"
+	eventRemediation "github.com/ZupIT/horusec-platform/analytic/internal/events/remediation"
 	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/digest"
 	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/health"
+	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/rebuild"
+	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/remediation"
+	digestJob "github.com/ZupIT/horusec-platform/analytic/internal/jobs/digest"
 )
 
 func TestNewHTTPRouter(t *testing.T) {
@@ -34,7 +39,9 @@ func TestNewHTTPRouter(t *testing.T) {
 		dashboardHandlerMock := &dashboard.Handler{}
 		middlewareMock := &middlewares.AuthzMiddleware{}
 		eventMock := &eventDashboard.Events{}
-		instance := NewHTTPRouter(routerConn, middlewareMock, healthMock, dashboardHandlerMock, eventMock)
+		instance := NewHTTPRouter(routerConn, middlewareMock, healthMock, dashboardHandlerMock, eventMock,
+			&remediation.Handler{}, &eventRemediation.Events{}, &rebuild.Handler{},
+			&digest.Handler{}, &digestJob.Job{})
 		assert.NotEmpty(t, instance)
 	})
 }
@@ -19,9 +19,11 @@ import (
 
 	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
 	"github.com/google/uuid"
 
 	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	enumsDashboard "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
 )
 
 type IUseCases interface {
@@ -30,6 +32,9 @@ type IUseCases interface {
 	ParseAnalysisToVulnerabilitiesByRepository(entity *analysis.Analysis) []*dashboard.VulnerabilitiesByRepository
 	ParseAnalysisToVulnerabilitiesByLanguage(entity *analysis.Analysis) []*dashboard.VulnerabilitiesByLanguage
 	ParseAnalysisToVulnerabilitiesByTime(entity *analysis.Analysis) *dashboard.VulnerabilitiesByTime
+	ParseAnalysisToVulnerabilitiesBySecurityTool(entity *analysis.Analysis) []*dashboard.VulnerabilitiesBySecurityTool
+	ParseAnalysisToVulnerabilitiesByFile(entity *analysis.Analysis) []*dashboard.VulnerabilitiesByFile
+	ParseAnalysisToProjection(entity *analysis.Analysis, table string) interface{}
 }
 type UseCases struct{}
 
@@ -220,6 +225,129 @@ func (u *UseCases) emptyAnalysisResponseByLanguage(
 	}
 }
 
+func (u *UseCases) ParseAnalysisToVulnerabilitiesBySecurityTool(
+	entity *analysis.Analysis) []*dashboard.VulnerabilitiesBySecurityTool {
+	if len(entity.AnalysisVulnerabilities) == 0 {
+		return u.emptyAnalysisResponseBySecurityTool(entity)
+	}
+
+	return u.processVulnerabilitiesBySecurityTool(entity)
+}
+
+func (u *UseCases) processVulnerabilitiesBySecurityTool(
+	entity *analysis.Analysis) []*dashboard.VulnerabilitiesBySecurityTool {
+	mapVulnBySecurityTool := map[tools.Tool]*dashboard.VulnerabilitiesBySecurityTool{}
+
+	for index := range entity.AnalysisVulnerabilities {
+		vuln := entity.AnalysisVulnerabilities[index].Vulnerability
+
+		if vulnBySecurityTool, ok := mapVulnBySecurityTool[vuln.SecurityTool]; ok {
+			vulnBySecurityTool.AddCountVulnerabilityBySeverity(vuln.Severity, vuln.Type)
+
+			continue
+		}
+
+		mapVulnBySecurityTool[vuln.SecurityTool] = u.newVulnerabilitiesBySecurityTool(entity, index)
+	}
+
+	return u.mapVulnBySecurityToolToSlice(mapVulnBySecurityTool)
+}
+
+func (u *UseCases) newVulnerabilitiesBySecurityTool(entity *analysis.Analysis,
+	index int) *dashboard.VulnerabilitiesBySecurityTool {
+	vulnBySecurityTool := &dashboard.VulnerabilitiesBySecurityTool{
+		SecurityTool:  entity.AnalysisVulnerabilities[index].Vulnerability.SecurityTool,
+		Vulnerability: u.newVulnerabilityFromAnalysis(entity),
+	}
+
+	vulnBySecurityTool.AddCountVulnerabilityBySeverity(entity.AnalysisVulnerabilities[index].Vulnerability.Severity,
+		entity.AnalysisVulnerabilities[index].Vulnerability.Type)
+
+	return vulnBySecurityTool
+}
+
+func (u *UseCases) mapVulnBySecurityToolToSlice(
+	mapVulnBySecurityTool map[tools.Tool]*dashboard.VulnerabilitiesBySecurityTool) (
+	sliceVulnsBySecurityTool []*dashboard.VulnerabilitiesBySecurityTool) {
+	for _, vulnsBySecurityTool := range mapVulnBySecurityTool {
+		sliceVulnsBySecurityTool = append(sliceVulnsBySecurityTool, vulnsBySecurityTool)
+	}
+
+	return sliceVulnsBySecurityTool
+}
+
+func (u *UseCases) emptyAnalysisResponseBySecurityTool(
+	entity *analysis.Analysis) []*dashboard.VulnerabilitiesBySecurityTool {
+	return []*dashboard.VulnerabilitiesBySecurityTool{
+		{
+			SecurityTool:  "",
+			Vulnerability: u.newVulnerabilityFromAnalysis(entity),
+		},
+	}
+}
+
+func (u *UseCases) ParseAnalysisToVulnerabilitiesByFile(
+	entity *analysis.Analysis) []*dashboard.VulnerabilitiesByFile {
+	if len(entity.AnalysisVulnerabilities) == 0 {
+		return u.emptyAnalysisResponseByFile(entity)
+	}
+
+	return u.processVulnerabilitiesByFile(entity)
+}
+
+func (u *UseCases) processVulnerabilitiesByFile(
+	entity *analysis.Analysis) []*dashboard.VulnerabilitiesByFile {
+	mapVulnByFile := map[string]*dashboard.VulnerabilitiesByFile{}
+
+	for index := range entity.AnalysisVulnerabilities {
+		vulnByFile := u.newVulnerabilitiesByFile(entity, index)
+
+		if existing, ok := mapVulnByFile[vulnByFile.File]; ok {
+			existing.AddCountVulnerabilityBySeverity(entity.AnalysisVulnerabilities[index].Vulnerability.Severity,
+				entity.AnalysisVulnerabilities[index].Vulnerability.Type)
+
+			continue
+		}
+
+		mapVulnByFile[vulnByFile.File] = vulnByFile
+	}
+
+	return u.mapVulnByFileToSlice(mapVulnByFile)
+}
+
+func (u *UseCases) newVulnerabilitiesByFile(entity *analysis.Analysis,
+	index int) *dashboard.VulnerabilitiesByFile {
+	vulnByFile := &dashboard.VulnerabilitiesByFile{
+		Vulnerability: u.newVulnerabilityFromAnalysis(entity),
+	}
+
+	vulnByFile.SetFile(entity.AnalysisVulnerabilities[index].Vulnerability.File)
+	vulnByFile.AddCountVulnerabilityBySeverity(entity.AnalysisVulnerabilities[index].Vulnerability.Severity,
+		entity.AnalysisVulnerabilities[index].Vulnerability.Type)
+
+	return vulnByFile
+}
+
+func (u *UseCases) mapVulnByFileToSlice(mapVulnByFile map[string]*dashboard.VulnerabilitiesByFile) (
+	sliceVulnsByFile []*dashboard.VulnerabilitiesByFile) {
+	for _, vulnsByFile := range mapVulnByFile {
+		sliceVulnsByFile = append(sliceVulnsByFile, vulnsByFile)
+	}
+
+	return sliceVulnsByFile
+}
+
+func (u *UseCases) emptyAnalysisResponseByFile(
+	entity *analysis.Analysis) []*dashboard.VulnerabilitiesByFile {
+	return []*dashboard.VulnerabilitiesByFile{
+		{
+			File:          "",
+			Directory:     "",
+			Vulnerability: u.newVulnerabilityFromAnalysis(entity),
+		},
+	}
+}
+
 func (u *UseCases) ParseAnalysisToVulnerabilitiesByTime(
 	entity *analysis.Analysis) *dashboard.VulnerabilitiesByTime {
 	vulnsByTime := &dashboard.VulnerabilitiesByTime{
@@ -237,6 +365,7 @@ func (u *UseCases) ParseAnalysisToVulnerabilitiesByTime(
 func (u *UseCases) newVulnerabilityFromAnalysis(entity *analysis.Analysis) dashboard.Vulnerability {
 	return dashboard.Vulnerability{
 		VulnerabilityID: uuid.New(),
+		AnalysisID:      entity.ID,
 		CreatedAt:       entity.CreatedAt,
 		WorkspaceID:     entity.WorkspaceID,
 		RepositoryID:    entity.RepositoryID,
@@ -256,3 +385,23 @@ func (u *UseCases) FilterFromRequest(request *http.Request) (*dashboard.Filter,
 
 	return filter, filter.Validate()
 }
+
+// ParseAnalysisToProjection returns the rows of the analysis for the analytic table informed
+func (u *UseCases) ParseAnalysisToProjection(entity *analysis.Analysis, table string) interface{} {
+	switch table {
+	case enumsDashboard.TableVulnerabilitiesByAuthor:
+		return u.ParseAnalysisToVulnerabilitiesByAuthor(entity)
+	case enumsDashboard.TableVulnerabilitiesByRepository:
+		return u.ParseAnalysisToVulnerabilitiesByRepository(entity)
+	case enumsDashboard.TableVulnerabilitiesByLanguage:
+		return u.ParseAnalysisToVulnerabilitiesByLanguage(entity)
+	case enumsDashboard.TableVulnerabilitiesByTime:
+		return u.ParseAnalysisToVulnerabilitiesByTime(entity)
+	case enumsDashboard.TableVulnerabilitiesBySecurityTool:
+		return u.ParseAnalysisToVulnerabilitiesBySecurityTool(entity)
+	case enumsDashboard.TableVulnerabilitiesByFile:
+		return u.ParseAnalysisToVulnerabilitiesByFile(entity)
+	}
+
+	return nil
+}
@@ -32,6 +32,9 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	enumsDashboard "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
 )
 
 func getAnalysisMock() *analysisEntities.Analysis {
@@ -166,6 +169,92 @@ func TestParseAnalysisToVulnerabilitiesByTime(t *testing.T) {
 	})
 }
 
+func TestParseAnalysisToVulnerabilitiesBySecurityTool(t *testing.T) {
+	t.Run("should success parse without errors", func(t *testing.T) {
+		useCases := NewUseCaseDashboard()
+
+		result := useCases.ParseAnalysisToVulnerabilitiesBySecurityTool(getAnalysisMock())
+		assert.Len(t, result, 1)
+		assert.Equal(t, tools.HorusecEngine, result[0].SecurityTool)
+		assert.Equal(t, 2, result[0].CriticalVulnerability)
+	})
+
+	t.Run("should return empty analysis response", func(t *testing.T) {
+		useCases := NewUseCaseDashboard()
+
+		assert.Len(t, useCases.ParseAnalysisToVulnerabilitiesBySecurityTool(getEmptyAnalysisMock()), 1)
+	})
+}
+
+func TestParseAnalysisToVulnerabilitiesByFile(t *testing.T) {
+	t.Run("should success parse without errors", func(t *testing.T) {
+		useCases := NewUseCaseDashboard()
+
+		result := useCases.ParseAnalysisToVulnerabilitiesByFile(getAnalysisMock())
+		assert.Len(t, result, 2)
+
+		for _, vulnsByFile := range result {
+			assert.Equal(t, "/deployments", vulnsByFile.Directory)
+			assert.Equal(t, 1, vulnsByFile.CriticalVulnerability)
+		}
+	})
+
+	t.Run("should group vulnerabilities of the same file", func(t *testing.T) {
+		useCases := NewUseCaseDashboard()
+
+		analysis := getAnalysisMock()
+		analysis.AnalysisVulnerabilities[1].Vulnerability.File = "/deployments/cert.pem"
+		analysis.AnalysisVulnerabilities[1].Vulnerability.Type = vulnerabilityEnum.FalsePositive
+
+		result := useCases.ParseAnalysisToVulnerabilitiesByFile(analysis)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "/deployments/cert.pem", result[0].File)
+		assert.Equal(t, 1, result[0].CriticalVulnerability)
+		assert.Equal(t, 1, result[0].CriticalFalsePositive)
+	})
+
+	t.Run("should return empty analysis response", func(t *testing.T) {
+		useCases := NewUseCaseDashboard()
+
+		assert.Len(t, useCases.ParseAnalysisToVulnerabilitiesByFile(getEmptyAnalysisMock()), 1)
+	})
+}
+
+func TestParseAnalysisToProjection(t *testing.T) {
+	t.Run("should return the rows of the analysis for every analytic table", func(t *testing.T) {
+		useCases := NewUseCaseDashboard()
+
+		assert.IsType(t, []*dashboard.VulnerabilitiesByAuthor{}, useCases.ParseAnalysisToProjection(
+			getAnalysisMock(), enumsDashboard.TableVulnerabilitiesByAuthor))
+		assert.IsType(t, []*dashboard.VulnerabilitiesByRepository{}, useCases.ParseAnalysisToProjection(
+			getAnalysisMock(), enumsDashboard.TableVulnerabilitiesByRepository))
+		assert.IsType(t, []*dashboard.VulnerabilitiesByLanguage{}, useCases.ParseAnalysisToProjection(
+			getAnalysisMock(), enumsDashboard.TableVulnerabilitiesByLanguage))
+		assert.IsType(t, &dashboard.VulnerabilitiesByTime{}, useCases.ParseAnalysisToProjection(
+			getAnalysisMock(), enumsDashboard.TableVulnerabilitiesByTime))
+		assert.IsType(t, []*dashboard.VulnerabilitiesBySecurityTool{}, useCases.ParseAnalysisToProjection(
+			getAnalysisMock(), enumsDashboard.TableVulnerabilitiesBySecurityTool))
+		assert.IsType(t, []*dashboard.VulnerabilitiesByFile{}, useCases.ParseAnalysisToProjection(
+			getAnalysisMock(), enumsDashboard.TableVulnerabilitiesByFile))
+	})
+
+	t.Run("should return nil when the table is unknown", func(t *testing.T) {
+		assert.Nil(t, NewUseCaseDashboard().ParseAnalysisToProjection(getAnalysisMock(), "test"))
+	})
+
+	t.Run("should record the analysis id in the rows", func(t *testing.T) {
+		entity := getAnalysisMock()
+
+		byTime := NewUseCaseDashboard().ParseAnalysisToProjection(entity, enumsDashboard.TableVulnerabilitiesByTime)
+		assert.Equal(t, entity.ID, byTime.(*dashboard.VulnerabilitiesByTime).AnalysisID)
+
+		byAuthor := NewUseCaseDashboard().ParseAnalysisToVulnerabilitiesByAuthor(entity)
+		for _, row := range byAuthor {
+			assert.Equal(t, entity.ID, row.AnalysisID)
+		}
+	})
+}
+
 func TestFilterFromRequest(t *testing.T) {
 	layoutDateTime := "2006-01-02T15:04:05Z"
 	startTime, _ := time.Parse(layoutDateTime, "2020-01-01T00:00:00Z")
@@ -0,0 +1,60 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"net/http"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/digest"
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+)
+
+type IUseCases interface {
+	WorkspaceIDFromRequest(request *http.Request) (uuid.UUID, error)
+	SubscriptionDataFromRequest(request *http.Request) (*digest.SubscriptionData, error)
+}
+
+type UseCases struct{}
+
+func NewUseCaseDigest() IUseCases {
+	return &UseCases{}
+}
+
+func (u *UseCases) WorkspaceIDFromRequest(request *http.Request) (uuid.UUID, error) {
+	workspaceID, err := uuid.Parse(chi.URLParam(request, dashboardEnums.WorkspaceID))
+	if err != nil {
+		return uuid.Nil, dashboardEnums.ErrorInvalidWorkspaceID
+	}
+
+	return workspaceID, nil
+}
+
+func (u *UseCases) SubscriptionDataFromRequest(request *http.Request) (*digest.SubscriptionData, error) {
+	data := &digest.SubscriptionData{}
+
+	if err := parser.ParseBodyToEntity(request.Body, data); err != nil {
+		return nil, err
+	}
+
+	if err := data.SetDataFromRequest(request); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	digestEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/digest"
+)
+
+func newRequest(body string, params map[string]string) *http.Request {
+	ctx := chi.NewRouteContext()
+	for key, value := range params {
+		ctx.URLParams.Add(key, value)
+	}
+
+	r, _ := http.NewRequest(http.MethodPut, "/test", bytes.NewBufferString(body))
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func TestWorkspaceIDFromRequest(t *testing.T) {
+	t.Run("should return the workspace id of the url", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		result, err := NewUseCaseDigest().WorkspaceIDFromRequest(
+			newRequest("", map[string]string{dashboardEnums.WorkspaceID: workspaceID.String()}))
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceID, result)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		_, err := NewUseCaseDigest().WorkspaceIDFromRequest(newRequest("", nil))
+		assert.Equal(t, dashboardEnums.ErrorInvalidWorkspaceID, err)
+	})
+}
+
+func TestSubscriptionDataFromRequest(t *testing.T) {
+	t.Run("should parse the subscription data with the workspace of the url", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		data, err := NewUseCaseDigest().SubscriptionDataFromRequest(newRequest(`{"frequency": "daily"}`,
+			map[string]string{dashboardEnums.WorkspaceID: workspaceID.String()}))
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceID, data.WorkspaceID)
+		assert.Equal(t, digestEnums.Daily, data.Frequency)
+	})
+
+	t.Run("should return error when invalid body", func(t *testing.T) {
+		_, err := NewUseCaseDigest().SubscriptionDataFromRequest(newRequest("test",
+			map[string]string{dashboardEnums.WorkspaceID: uuid.NewString()}))
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		_, err := NewUseCaseDigest().SubscriptionDataFromRequest(newRequest(`{"frequency": "daily"}`, nil))
+		assert.Equal(t, dashboardEnums.ErrorInvalidWorkspaceID, err)
+	})
+
+	t.Run("should return error when invalid frequency", func(t *testing.T) {
+		_, err := NewUseCaseDigest().SubscriptionDataFromRequest(newRequest(`{"frequency": "yearly"}`,
+			map[string]string{dashboardEnums.WorkspaceID: uuid.NewString()}))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	"net/http"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/rebuild"
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	rebuildEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/rebuild"
+)
+
+type IUseCases interface {
+	ScopeFromRequest(request *http.Request) (*rebuild.Scope, error)
+	JobFromRequest(request *http.Request) (workspaceID, jobID uuid.UUID, err error)
+}
+
+type UseCases struct{}
+
+func NewUseCaseRebuild() IUseCases {
+	return &UseCases{}
+}
+
+// ScopeFromRequest parses the rebuild options of the body, the workspace is always the one of the url
+func (u *UseCases) ScopeFromRequest(request *http.Request) (*rebuild.Scope, error) {
+	scope := &rebuild.Scope{}
+
+	if err := parser.ParseBodyToEntity(request.Body, scope); err != nil {
+		return nil, err
+	}
+
+	workspaceID, err := uuid.Parse(chi.URLParam(request, dashboardEnums.WorkspaceID))
+	if err != nil {
+		return nil, dashboardEnums.ErrorInvalidWorkspaceID
+	}
+
+	scope.WorkspaceID = workspaceID
+
+	return scope, scope.Validate()
+}
+
+func (u *UseCases) JobFromRequest(request *http.Request) (workspaceID, jobID uuid.UUID, err error) {
+	workspaceID, err = uuid.Parse(chi.URLParam(request, dashboardEnums.WorkspaceID))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, dashboardEnums.ErrorInvalidWorkspaceID
+	}
+
+	jobID, err = uuid.Parse(chi.URLParam(request, rebuildEnums.JobID))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, rebuildEnums.ErrorJobNotFound
+	}
+
+	return workspaceID, jobID, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebuild
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	rebuildEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/rebuild"
+)
+
+func newRequest(body string, params map[string]string) *http.Request {
+	ctx := chi.NewRouteContext()
+	for key, value := range params {
+		ctx.URLParams.Add(key, value)
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(body))
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func TestScopeFromRequest(t *testing.T) {
+	t.Run("should parse the scope with the workspace of the url", func(t *testing.T) {
+		workspaceID := uuid.New()
+		repositoryID := uuid.New()
+
+		body := `{"workspaceID": "` + uuid.NewString() + `", "repositoryID": "` + repositoryID.String() + `",
+			"startTime": "2021-01-01T00:00:00Z", "endTime": "2021-02-01T00:00:00Z", "dryRun": true}`
+
+		scope, err := NewUseCaseRebuild().ScopeFromRequest(
+			newRequest(body, map[string]string{dashboardEnums.WorkspaceID: workspaceID.String()}))
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceID, scope.WorkspaceID)
+		assert.Equal(t, repositoryID, scope.RepositoryID)
+		assert.Equal(t, 2021, scope.StartTime.Year())
+		assert.True(t, scope.DryRun)
+	})
+
+	t.Run("should return error when invalid body", func(t *testing.T) {
+		_, err := NewUseCaseRebuild().ScopeFromRequest(
+			newRequest("invalid", map[string]string{dashboardEnums.WorkspaceID: uuid.NewString()}))
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		_, err := NewUseCaseRebuild().ScopeFromRequest(
+			newRequest("{}", map[string]string{dashboardEnums.WorkspaceID: "test"}))
+		assert.Equal(t, dashboardEnums.ErrorInvalidWorkspaceID, err)
+	})
+
+	t.Run("should return error when invalid time range", func(t *testing.T) {
+		body := `{"startTime": "2021-02-01T00:00:00Z", "endTime": "2021-01-01T00:00:00Z"}`
+
+		_, err := NewUseCaseRebuild().ScopeFromRequest(
+			newRequest(body, map[string]string{dashboardEnums.WorkspaceID: uuid.NewString()}))
+		assert.Equal(t, rebuildEnums.ErrorInvalidTimeRange, err)
+	})
+}
+
+func TestJobFromRequest(t *testing.T) {
+	t.Run("should return the workspace and job ids of the url", func(t *testing.T) {
+		workspaceID := uuid.New()
+		jobID := uuid.New()
+
+		resultWorkspaceID, resultJobID, err := NewUseCaseRebuild().JobFromRequest(newRequest("", map[string]string{
+			dashboardEnums.WorkspaceID: workspaceID.String(), rebuildEnums.JobID: jobID.String()}))
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceID, resultWorkspaceID)
+		assert.Equal(t, jobID, resultJobID)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		_, _, err := NewUseCaseRebuild().JobFromRequest(newRequest("", map[string]string{
+			dashboardEnums.WorkspaceID: "test", rebuildEnums.JobID: uuid.NewString()}))
+		assert.Equal(t, dashboardEnums.ErrorInvalidWorkspaceID, err)
+	})
+
+	t.Run("should return not found when invalid job id", func(t *testing.T) {
+		_, _, err := NewUseCaseRebuild().JobFromRequest(newRequest("", map[string]string{
+			dashboardEnums.WorkspaceID: uuid.NewString(), rebuildEnums.JobID: "test"}))
+		assert.Equal(t, rebuildEnums.ErrorJobNotFound, err)
+	})
+}
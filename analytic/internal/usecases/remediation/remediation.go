@@ -0,0 +1,125 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"net/http"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/remediation"
+)
+
+type IUseCases interface {
+	FilterFromRequest(request *http.Request) (*dashboard.Filter, error)
+	ParseAnalysisToRemediationChanges(entity *analysis.Analysis,
+		tracked []*remediation.Remediation) *remediation.Changes
+}
+
+type UseCases struct{}
+
+func NewUseCaseRemediation() IUseCases {
+	return &UseCases{}
+}
+
+func (u *UseCases) FilterFromRequest(request *http.Request) (*dashboard.Filter, error) {
+	filter := &dashboard.Filter{}
+
+	if err := filter.SetWorkspaceAndRepositoryID(request); err != nil {
+		return nil, err
+	}
+
+	if err := filter.SetDateRangeAndPagination(request); err != nil {
+		return nil, err
+	}
+
+	return filter, filter.Validate()
+}
+
+// ParseAnalysisToRemediationChanges compares the vulnerabilities of the analysis with the ones tracked for the
+// repository. Unclassified vulnerabilities are opened or seen again, the open ones no longer found or corrected are
+// resolved and the open ones classified as false positive or risk accepted stop being tracked, since they will not
+// be remediated
+func (u *UseCases) ParseAnalysisToRemediationChanges(entity *analysis.Analysis,
+	tracked []*remediation.Remediation) *remediation.Changes {
+	changes := &remediation.Changes{}
+	trackedByHash := u.mapTrackedByHash(tracked)
+	open, dismissed := u.splitVulnerabilities(entity)
+
+	for hash, vuln := range open {
+		if existing, ok := trackedByHash[hash]; ok {
+			existing.See(entity.CreatedAt, vuln.Severity)
+			changes.Updated = append(changes.Updated, existing)
+
+			continue
+		}
+
+		changes.Created = append(changes.Created, remediation.NewRemediation(entity, vuln))
+	}
+
+	u.setNotFoundChanges(entity, tracked, open, dismissed, changes)
+
+	return changes
+}
+
+func (u *UseCases) setNotFoundChanges(entity *analysis.Analysis, tracked []*remediation.Remediation,
+	open map[string]*vulnerability.Vulnerability, dismissed map[string]bool, changes *remediation.Changes) {
+	for _, existing := range tracked {
+		if _, ok := open[existing.VulnHash]; ok || !existing.IsOpen() {
+			continue
+		}
+
+		if dismissed[existing.VulnHash] {
+			changes.Deleted = append(changes.Deleted, existing)
+
+			continue
+		}
+
+		existing.Resolve(entity.CreatedAt)
+		changes.Updated = append(changes.Updated, existing)
+	}
+}
+
+func (u *UseCases) mapTrackedByHash(tracked []*remediation.Remediation) map[string]*remediation.Remediation {
+	trackedByHash := map[string]*remediation.Remediation{}
+
+	for _, existing := range tracked {
+		trackedByHash[existing.VulnHash] = existing
+	}
+
+	return trackedByHash
+}
+
+func (u *UseCases) splitVulnerabilities(entity *analysis.Analysis) (
+	open map[string]*vulnerability.Vulnerability, dismissed map[string]bool) {
+	open, dismissed = map[string]*vulnerability.Vulnerability{}, map[string]bool{}
+
+	for index := range entity.AnalysisVulnerabilities {
+		vuln := &entity.AnalysisVulnerabilities[index].Vulnerability
+
+		//nolint:exhaustive // corrected vulnerabilities are resolved as the ones no longer found
+		switch vuln.Type {
+		case vulnerabilityEnums.Vulnerability:
+			open[vuln.VulnHash] = vuln
+		case vulnerabilityEnums.FalsePositive, vulnerabilityEnums.RiskAccepted:
+			dismissed[vuln.VulnHash] = true
+		}
+	}
+
+	return open, dismissed
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnum "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/remediation"
+)
+
+func newRequest(url string, params map[string]string) *http.Request {
+	ctx := chi.NewRouteContext()
+	for key, value := range params {
+		ctx.URLParams.Add(key, value)
+	}
+
+	r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func TestFilterFromRequest(t *testing.T) {
+	url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s", "2020-01-01T00:00:00Z", "2022-01-01T00:00:00Z")
+
+	t.Run("should success create a new filter from request data", func(t *testing.T) {
+		id := uuid.New()
+
+		filter, err := NewUseCaseRemediation().FilterFromRequest(newRequest(url,
+			map[string]string{"workspaceID": id.String()}))
+		assert.NoError(t, err)
+		assert.Equal(t, id, filter.WorkspaceID)
+		assert.Equal(t, uuid.Nil, filter.RepositoryID)
+		assert.Equal(t, 2022, filter.EndTime.Year())
+	})
+
+	t.Run("should return error when failed to parse the dates", func(t *testing.T) {
+		filter, err := NewUseCaseRemediation().FilterFromRequest(newRequest("/test?initialDate=test",
+			map[string]string{"workspaceID": uuid.NewString()}))
+		assert.Error(t, err)
+		assert.Nil(t, filter)
+	})
+
+	t.Run("should return error when failed to workspace id", func(t *testing.T) {
+		filter, err := NewUseCaseRemediation().FilterFromRequest(newRequest(url,
+			map[string]string{"workspaceID": "test"}))
+		assert.Error(t, err)
+		assert.Nil(t, filter)
+	})
+}
+
+func TestParseAnalysisToRemediationChanges(t *testing.T) {
+	newAnalysis := func() *analysis.Analysis {
+		return &analysis.Analysis{
+			WorkspaceID:  uuid.New(),
+			RepositoryID: uuid.New(),
+			CreatedAt:    time.Now(),
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+				{Vulnerability: vulnerability.Vulnerability{VulnHash: "new", Severity: severities.Critical,
+					Type: vulnerabilityEnum.Vulnerability}},
+				{Vulnerability: vulnerability.Vulnerability{VulnHash: "open", Severity: severities.High,
+					Type: vulnerabilityEnum.Vulnerability}},
+				{Vulnerability: vulnerability.Vulnerability{VulnHash: "corrected", Severity: severities.High,
+					Type: vulnerabilityEnum.Corrected}},
+				{Vulnerability: vulnerability.Vulnerability{VulnHash: "false-positive", Severity: severities.Low,
+					Type: vulnerabilityEnum.FalsePositive}},
+			},
+		}
+	}
+
+	t.Run("should open, see, resolve and stop tracking the vulnerabilities", func(t *testing.T) {
+		entity := newAnalysis()
+		firstSeenAt := entity.CreatedAt.Add(-time.Hour)
+		tracked := []*remediation.Remediation{
+			{VulnHash: "open", Severity: severities.Medium, FirstSeenAt: firstSeenAt},
+			{VulnHash: "corrected", FirstSeenAt: firstSeenAt},
+			{VulnHash: "not-found", FirstSeenAt: firstSeenAt},
+			{VulnHash: "false-positive", FirstSeenAt: firstSeenAt},
+		}
+
+		changes := NewUseCaseRemediation().ParseAnalysisToRemediationChanges(entity, tracked)
+
+		assert.Len(t, changes.Created, 1)
+		assert.Equal(t, "new", changes.Created[0].VulnHash)
+		assert.Len(t, changes.Updated, 3)
+		assert.Equal(t, severities.High, tracked[0].Severity)
+		assert.Equal(t, firstSeenAt, tracked[0].FirstSeenAt)
+		assert.True(t, tracked[0].IsOpen())
+		assert.Equal(t, entity.CreatedAt, *tracked[1].ResolvedAt)
+		assert.Equal(t, entity.CreatedAt, *tracked[2].ResolvedAt)
+		assert.Len(t, changes.Deleted, 1)
+		assert.Equal(t, "false-positive", changes.Deleted[0].VulnHash)
+	})
+
+	t.Run("should reopen a resolved vulnerability found again", func(t *testing.T) {
+		entity := newAnalysis()
+		resolvedAt := entity.CreatedAt.Add(-time.Hour)
+		tracked := []*remediation.Remediation{{VulnHash: "new", ResolvedAt: &resolvedAt}}
+
+		changes := NewUseCaseRemediation().ParseAnalysisToRemediationChanges(entity, tracked)
+
+		assert.Len(t, changes.Created, 1)
+		assert.Len(t, changes.Updated, 1)
+		assert.True(t, tracked[0].IsOpen())
+		assert.Equal(t, entity.CreatedAt, tracked[0].FirstSeenAt)
+	})
+
+	t.Run("should not change resolved vulnerabilities that are still not found", func(t *testing.T) {
+		entity := &analysis.Analysis{CreatedAt: time.Now()}
+		resolvedAt := entity.CreatedAt.Add(-time.Hour)
+
+		changes := NewUseCaseRemediation().ParseAnalysisToRemediationChanges(entity,
+			[]*remediation.Remediation{{VulnHash: "old", ResolvedAt: &resolvedAt}})
+
+		assert.Empty(t, changes.Created)
+		assert.Empty(t, changes.Updated)
+		assert.Empty(t, changes.Deleted)
+	})
+}
@@ -25,7 +25,10 @@ import (
 	healthHandler "github.com/ZupIT/horusec-platform/api/internal/handlers/health"
 	"github.com/ZupIT/horusec-platform/api/internal/middelwares/token"
 	"github.com/ZupIT/horusec-platform/api/internal/repositories/analysis"
+	"github.com/ZupIT/horusec-platform/api/internal/repositories/policy"
+	"github.com/ZupIT/horusec-platform/api/internal/repositories/propagation"
 	"github.com/ZupIT/horusec-platform/api/internal/repositories/repository"
+	"github.com/ZupIT/horusec-platform/api/internal/repositories/suppression"
 	repositoriesToken "github.com/ZupIT/horusec-platform/api/internal/repositories/token"
 	"github.com/ZupIT/horusec-platform/api/internal/router"
 
@@ -51,6 +54,9 @@ var providers = wire.NewSet(
 	proto.NewAuthServiceClient,
 	token.NewTokenAuthz,
 	analysis.NewRepositoriesAnalysis,
+	policy.NewRepositoriesPolicy,
+	suppression.NewRepositoriesSuppression,
+	propagation.NewRepositoriesPropagation,
 	repository.NewRepositoriesRepository,
 	repositoriesToken.NewRepositoriesToken,
 	cors.NewCorsConfig,
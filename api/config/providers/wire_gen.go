@@ -23,7 +23,10 @@ import (
 	"github.com/ZupIT/horusec-platform/api/internal/handlers/health"
 	token2 "github.com/ZupIT/horusec-platform/api/internal/middelwares/token"
 	"github.com/ZupIT/horusec-platform/api/internal/repositories/analysis"
+	"github.com/ZupIT/horusec-platform/api/internal/repositories/policy"
+	"github.com/ZupIT/horusec-platform/api/internal/repositories/propagation"
 	"github.com/ZupIT/horusec-platform/api/internal/repositories/repository"
+	"github.com/ZupIT/horusec-platform/api/internal/repositories/suppression"
 	"github.com/ZupIT/horusec-platform/api/internal/repositories/token"
 	"github.com/ZupIT/horusec-platform/api/internal/router"
 )
@@ -50,7 +53,10 @@ func Initialize(defaultPort string) (router.IRouter, error) {
 	appIConfig := app.NewAppConfig(authServiceClient)
 	iRepository := repository.NewRepositoriesRepository(connection)
 	iAnalysis := analysis.NewRepositoriesAnalysis(connection)
-	iController := analysis2.NewAnalysisController(iBroker, appIConfig, iRepository, iAnalysis)
+	iPolicy := policy.NewRepositoriesPolicy(connection)
+	iSuppression := suppression.NewRepositoriesSuppression(connection)
+	iPropagation := propagation.NewRepositoriesPropagation(connection)
+	iController := analysis2.NewAnalysisController(iBroker, appIConfig, iRepository, iAnalysis, iPolicy, iSuppression, iPropagation)
 	handler := analysis3.NewAnalysisHandler(iController)
 	healthHandler := health.NewHealthHandler(iBroker, configIConfig, connection, clientConnInterface, appIConfig)
 	routerIRouter := router.NewHTTPRouter(iRouter, iTokenAuthz, handler, healthHandler)
@@ -59,4 +65,4 @@ func Initialize(defaultPort string) (router.IRouter, error) {
 
 // wire.go:
 
-var providers = wire.NewSet(config2.NewBrokerConfig, broker.NewBroker, config.NewDatabaseConfig, database.NewDatabaseReadAndWrite, auth.NewAuthGRPCConnection, proto.NewAuthServiceClient, token2.NewTokenAuthz, analysis.NewRepositoriesAnalysis, repository.NewRepositoriesRepository, token.NewRepositoriesToken, cors.NewCorsConfig, router2.NewHTTPRouter, app.NewAppConfig, analysis2.NewAnalysisController, analysis3.NewAnalysisHandler, health.NewHealthHandler, router.NewHTTPRouter)
+var providers = wire.NewSet(config2.NewBrokerConfig, broker.NewBroker, config.NewDatabaseConfig, database.NewDatabaseReadAndWrite, auth.NewAuthGRPCConnection, proto.NewAuthServiceClient, token2.NewTokenAuthz, analysis.NewRepositoriesAnalysis, policy.NewRepositoriesPolicy, suppression.NewRepositoriesSuppression, propagation.NewRepositoriesPropagation, repository.NewRepositoriesRepository, token.NewRepositoriesToken, cors.NewCorsConfig, router2.NewHTTPRouter, app.NewAppConfig, analysis2.NewAnalysisController, analysis3.NewAnalysisHandler, health.NewHealthHandler, router.NewHTTPRouter)
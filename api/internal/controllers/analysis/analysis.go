@@ -23,37 +23,70 @@ import (
 
 	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
 	appConfiguration "github.com/ZupIT/horusec-devkit/pkg/services/app"
 	brokerService "github.com/ZupIT/horusec-devkit/pkg/services/broker"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 
+	policyEntities "github.com/ZupIT/horusec-platform/api/internal/entities/policy"
+	propagationEntities "github.com/ZupIT/horusec-platform/api/internal/entities/propagation"
+	suppressionEntities "github.com/ZupIT/horusec-platform/api/internal/entities/suppression"
+	policyEnums "github.com/ZupIT/horusec-platform/api/internal/enums/policy"
+	propagationEnums "github.com/ZupIT/horusec-platform/api/internal/enums/propagation"
+	suppressionEnums "github.com/ZupIT/horusec-platform/api/internal/enums/suppression"
 	repoAnalysis "github.com/ZupIT/horusec-platform/api/internal/repositories/analysis"
+	repoPolicy "github.com/ZupIT/horusec-platform/api/internal/repositories/policy"
+	repoPropagation "github.com/ZupIT/horusec-platform/api/internal/repositories/propagation"
 	"github.com/ZupIT/horusec-platform/api/internal/repositories/repository"
+	repoSuppression "github.com/ZupIT/horusec-platform/api/internal/repositories/suppression"
 )
 
 type IController interface {
-	GetAnalysis(analysisID uuid.UUID) (*analysis.Analysis, error)
+	GetAnalysis(analysisID uuid.UUID) (*policyEntities.AnalysisResponse, error)
 	SaveAnalysis(analysisEntity *analysis.Analysis) (uuid.UUID, error)
 }
 
 type Controller struct {
-	broker         brokerService.IBroker
-	repoRepository repository.IRepository
-	repoAnalysis   repoAnalysis.IAnalysis
-	appConfig      appConfiguration.IConfig
+	broker          brokerService.IBroker
+	repoRepository  repository.IRepository
+	repoAnalysis    repoAnalysis.IAnalysis
+	repoPolicy      repoPolicy.IPolicy
+	repoSuppression repoSuppression.ISuppression
+	repoPropagation repoPropagation.IPropagation
+	appConfig       appConfiguration.IConfig
 }
 
 func NewAnalysisController(broker brokerService.IBroker, appConfig appConfiguration.IConfig,
-	repositoriesRepository repository.IRepository, repositoriesAnalysis repoAnalysis.IAnalysis) IController {
+	repositoriesRepository repository.IRepository, repositoriesAnalysis repoAnalysis.IAnalysis,
+	repositoriesPolicy repoPolicy.IPolicy, repositoriesSuppression repoSuppression.ISuppression,
+	repositoriesPropagation repoPropagation.IPropagation) IController {
 	return &Controller{
-		repoRepository: repositoriesRepository,
-		repoAnalysis:   repositoriesAnalysis,
-		appConfig:      appConfig,
-		broker:         broker,
+		repoRepository:  repositoriesRepository,
+		repoAnalysis:    repositoriesAnalysis,
+		repoPolicy:      repositoriesPolicy,
+		repoSuppression: repositoriesSuppression,
+		repoPropagation: repositoriesPropagation,
+		appConfig:       appConfig,
+		broker:          broker,
 	}
 }
 
-func (c *Controller) GetAnalysis(analysisID uuid.UUID) (*analysis.Analysis, error) {
+func (c *Controller) GetAnalysis(analysisID uuid.UUID) (*policyEntities.AnalysisResponse, error) {
+	entity, err := c.getAnalysis(analysisID)
+	if err != nil {
+		return nil, err
+	}
+
+	verdict, err := c.repoPolicy.FindVerdict(analysisID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policyEntities.AnalysisResponse{Analysis: entity, PolicyVerdict: verdict}, nil
+}
+
+func (c *Controller) getAnalysis(analysisID uuid.UUID) (*analysis.Analysis, error) {
 	res := c.repoAnalysis.FindAnalysisByID(analysisID)
 	if res.GetError() != nil {
 		return nil, res.GetError()
@@ -74,12 +107,191 @@ func (c *Controller) SaveAnalysis(analysisEntity *analysis.Analysis) (uuid.UUID,
 	if err != nil {
 		return uuid.Nil, err
 	}
-	if err := c.publishInBroker(analysisDecorated.ID); err != nil {
+	analysisSaved, err := c.getAnalysis(analysisDecorated.ID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	c.applySuppressionRules(analysisSaved)
+	c.propagateTriage(analysisSaved)
+	c.evaluatePolicy(analysisSaved)
+	if err := c.publishInBroker(analysisSaved); err != nil {
 		return uuid.Nil, err
 	}
 	return analysisDecorated.ID, nil
 }
 
+// applySuppressionRules classifies the unclassified vulnerabilities of the analysis matched by the active suppression
+// rules of the repository or of the workspace. The classification is also set on the analysis, so the policy and the
+// published analysis reflect it, failures are only logged because the analysis was already saved
+func (c *Controller) applySuppressionRules(analysisEntity *analysis.Analysis) {
+	if err := c.saveSuppressions(analysisEntity); err != nil {
+		logger.LogError(suppressionEnums.MessageFailedToApplySuppressionRules, err)
+	}
+}
+
+func (c *Controller) saveSuppressions(analysisEntity *analysis.Analysis) error {
+	if len(analysisEntity.AnalysisVulnerabilities) == 0 {
+		return nil
+	}
+
+	rules, err := c.repoSuppression.ListActiveRules(analysisEntity.WorkspaceID, analysisEntity.RepositoryID)
+	if err != nil || len(rules) == 0 {
+		return err
+	}
+
+	suppressions := c.getSuppressions(analysisEntity, rules)
+	if len(suppressions) == 0 {
+		return nil
+	}
+
+	if err := c.repoSuppression.SaveSuppressions(analysisEntity.ID, suppressions); err != nil {
+		return err
+	}
+
+	for index := range suppressions {
+		suppressions[index].Apply()
+	}
+
+	return nil
+}
+
+// getSuppressions returns the unclassified vulnerabilities matched by a rule, the first matching rule is applied
+func (c *Controller) getSuppressions(analysisEntity *analysis.Analysis,
+	rules suppressionEntities.Rules) (suppressions []suppressionEntities.Suppression) {
+	for index := range analysisEntity.AnalysisVulnerabilities {
+		vuln := &analysisEntity.AnalysisVulnerabilities[index].Vulnerability
+		if vuln.Type != vulnerabilityEnums.Vulnerability {
+			continue
+		}
+
+		if rule := rules.FindMatch(vuln); rule != nil {
+			suppressions = append(suppressions, suppressionEntities.Suppression{Vulnerability: vuln, Rule: rule})
+		}
+	}
+
+	return suppressions
+}
+
+// propagateTriage classifies the unclassified vulnerabilities of the analysis with the triage of the same
+// vulnerabilities in the other repositories of the workspace, when the workspace enabled the propagation. It runs
+// after the suppression rules, so the rules of the repository have priority, failures are only logged
+func (c *Controller) propagateTriage(analysisEntity *analysis.Analysis) {
+	if err := c.savePropagations(analysisEntity); err != nil {
+		logger.LogError(propagationEnums.MessageFailedToPropagateTriage, err)
+	}
+}
+
+func (c *Controller) savePropagations(analysisEntity *analysis.Analysis) error {
+	settings, err := c.repoPropagation.FindSettings(analysisEntity.WorkspaceID)
+	if err != nil || !settings.IsEnabled {
+		return err
+	}
+
+	keys := c.getPropagationKeys(analysisEntity, settings)
+	if len(keys) == 0 {
+		return nil
+	}
+
+	decisions, err := c.repoPropagation.ListDecisions(analysisEntity.WorkspaceID, analysisEntity.RepositoryID,
+		settings, keys)
+	if err != nil || len(decisions) == 0 {
+		return err
+	}
+
+	return c.applyPropagations(analysisEntity, c.getPropagations(analysisEntity, settings, decisions))
+}
+
+func (c *Controller) applyPropagations(analysisEntity *analysis.Analysis,
+	propagations []propagationEntities.Propagation) error {
+	if len(propagations) == 0 {
+		return nil
+	}
+
+	if err := c.repoPropagation.SavePropagations(analysisEntity.ID, propagations); err != nil {
+		return err
+	}
+
+	for index := range propagations {
+		propagations[index].Apply()
+	}
+
+	return nil
+}
+
+// getPropagationKeys returns the distinct match keys of the unclassified vulnerabilities of the analysis
+func (c *Controller) getPropagationKeys(analysisEntity *analysis.Analysis,
+	settings *propagationEntities.Settings) (keys []string) {
+	existingKeys := map[string]bool{}
+
+	for index := range analysisEntity.AnalysisVulnerabilities {
+		vuln := &analysisEntity.AnalysisVulnerabilities[index].Vulnerability
+		key := settings.GetMatchKey(vuln)
+		if vuln.Type != vulnerabilityEnums.Vulnerability || key == "" || existingKeys[key] {
+			continue
+		}
+
+		existingKeys[key] = true
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// getPropagations returns the unclassified vulnerabilities matched by a decision, the most recent one is applied
+func (c *Controller) getPropagations(analysisEntity *analysis.Analysis, settings *propagationEntities.Settings,
+	decisions propagationEntities.Decisions) (propagations []propagationEntities.Propagation) {
+	for index := range analysisEntity.AnalysisVulnerabilities {
+		vuln := &analysisEntity.AnalysisVulnerabilities[index].Vulnerability
+		if vuln.Type != vulnerabilityEnums.Vulnerability {
+			continue
+		}
+
+		if decision := decisions.FindMatch(vuln, settings); decision != nil {
+			propagations = append(propagations,
+				propagationEntities.Propagation{Vulnerability: vuln, Decision: decision})
+		}
+	}
+
+	return propagations
+}
+
+// evaluatePolicy saves on the analysis the verdict of the active policy of the repository or of the workspace.
+// The analysis saved is used since it has the vulnerability types already classified on the platform, failures
+// are only logged because the analysis was already saved
+func (c *Controller) evaluatePolicy(analysisEntity *analysis.Analysis) {
+	if err := c.saveVerdict(analysisEntity); err != nil {
+		logger.LogError(policyEnums.MessageFailedToEvaluatePolicy, err)
+	}
+}
+
+func (c *Controller) saveVerdict(analysisEntity *analysis.Analysis) error {
+	policy, err := c.repoPolicy.FindActivePolicy(analysisEntity.WorkspaceID, analysisEntity.RepositoryID)
+	if err != nil || policy == nil {
+		return err
+	}
+
+	firstSeenDates, err := c.getFirstSeenDates(policy, analysisEntity)
+	if err != nil {
+		return err
+	}
+
+	return c.repoPolicy.SaveVerdict(analysisEntity.ID, policy.Evaluate(analysisEntity, firstSeenDates))
+}
+
+func (c *Controller) getFirstSeenDates(policy *policyEntities.Policy,
+	analysisEntity *analysis.Analysis) (map[string]time.Time, error) {
+	if !policy.RequiresFirstSeenDates() || len(analysisEntity.AnalysisVulnerabilities) == 0 {
+		return map[string]time.Time{}, nil
+	}
+
+	var hashes []string
+	for index := range analysisEntity.AnalysisVulnerabilities {
+		hashes = append(hashes, analysisEntity.AnalysisVulnerabilities[index].Vulnerability.VulnHash)
+	}
+
+	return c.repoPolicy.ListFirstSeenDates(analysisEntity.RepositoryID, hashes)
+}
+
 func (c *Controller) createRepositoryIfNotExists(analysisEntity *analysis.Analysis) (*analysis.Analysis, error) {
 	if analysisEntity.RepositoryID == uuid.Nil {
 		analysisEntity.SetRepositoryID(uuid.New())
@@ -153,14 +365,9 @@ func (c *Controller) hasDuplicatedHash(
 	return false
 }
 
-func (c *Controller) publishInBroker(analysisID uuid.UUID) error {
-	res, err := c.GetAnalysis(analysisID)
-	if err != nil {
-		return err
-	}
-
+func (c *Controller) publishInBroker(analysisEntity *analysis.Analysis) error {
 	return c.broker.Publish("", exchange.NewAnalysis,
-		exchange.Fanout, res.ToBytes())
+		exchange.Fanout, analysisEntity.ToBytes())
 }
 
 // TODO:REMOVE ALL BELOW AFTER v2.10.0
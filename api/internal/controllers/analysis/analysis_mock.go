@@ -20,6 +20,8 @@ import (
 
 	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	policyEntities "github.com/ZupIT/horusec-platform/api/internal/entities/policy"
 )
 
 type Mock struct {
@@ -31,7 +33,7 @@ func (m *Mock) SaveAnalysis(_ *analysis.Analysis) (uuid.UUID, error) {
 	return args.Get(0).(uuid.UUID), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetAnalysis(_ uuid.UUID) (*analysis.Analysis, error) {
+func (m *Mock) GetAnalysis(_ uuid.UUID) (*policyEntities.AnalysisResponse, error) {
 	args := m.MethodCalled("GetAnalysis")
-	return args.Get(0).(*analysis.Analysis), mockUtils.ReturnNilOrError(args, 1)
+	return args.Get(0).(*policyEntities.AnalysisResponse), mockUtils.ReturnNilOrError(args, 1)
 }
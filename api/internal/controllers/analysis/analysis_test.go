@@ -21,9 +21,18 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
+	policyEntities "github.com/ZupIT/horusec-platform/api/internal/entities/policy"
+	propagationEntities "github.com/ZupIT/horusec-platform/api/internal/entities/propagation"
+	suppressionEntities "github.com/ZupIT/horusec-platform/api/internal/entities/suppression"
+	policyEnums "github.com/ZupIT/horusec-platform/api/internal/enums/policy"
+	propagationEnums "github.com/ZupIT/horusec-platform/api/internal/enums/propagation"
 	repoAnalysis "github.com/ZupIT/horusec-platform/api/internal/repositories/analysis"
+	repoPolicy "github.com/ZupIT/horusec-platform/api/internal/repositories/policy"
+	repoPropagation "github.com/ZupIT/horusec-platform/api/internal/repositories/propagation"
 	"github.com/ZupIT/horusec-platform/api/internal/repositories/repository"
+	repoSuppression "github.com/ZupIT/horusec-platform/api/internal/repositories/suppression"
 
 	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
@@ -39,6 +48,25 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
 )
 
+func newRepoPolicyMockWithoutPolicy() *repoPolicy.Mock {
+	repoPolicyMock := &repoPolicy.Mock{}
+	repoPolicyMock.On("FindActivePolicy").Return((*policyEntities.Policy)(nil), nil)
+	repoPolicyMock.On("FindVerdict").Return((*policyEntities.Verdict)(nil), nil)
+	return repoPolicyMock
+}
+
+func newRepoSuppressionMockWithoutRules() *repoSuppression.Mock {
+	repoSuppressionMock := &repoSuppression.Mock{}
+	repoSuppressionMock.On("ListActiveRules").Return(suppressionEntities.Rules{}, nil)
+	return repoSuppressionMock
+}
+
+func newRepoPropagationMockDisabled() *repoPropagation.Mock {
+	repoPropagationMock := &repoPropagation.Mock{}
+	repoPropagationMock.On("FindSettings").Return(&propagationEntities.Settings{}, nil)
+	return repoPropagationMock
+}
+
 func TestController_GetAnalysis(t *testing.T) {
 	t.Run("Should return analysis existing from database", func(t *testing.T) {
 		brokerMock := &broker.Mock{}
@@ -57,6 +85,9 @@ func TestController_GetAnalysis(t *testing.T) {
 			mockAppConfig,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.GetAnalysis(uuid.New())
 		assert.NoError(t, err)
@@ -73,6 +104,9 @@ func TestController_GetAnalysis(t *testing.T) {
 			mockAppConfig,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.GetAnalysis(uuid.New())
 		assert.Error(t, err)
@@ -89,6 +123,9 @@ func TestController_GetAnalysis(t *testing.T) {
 			mockAppConfig,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.GetAnalysis(uuid.New())
 		assert.Error(t, err)
@@ -106,6 +143,9 @@ func TestController_GetAnalysis(t *testing.T) {
 			mockAppConfig,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.GetAnalysis(uuid.New())
 		assert.Error(t, err)
@@ -135,6 +175,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -170,6 +213,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -233,6 +279,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		dataToSave := &analysis.Analysis{
 			ID:             uuid.New(),
@@ -320,6 +369,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -347,6 +399,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -374,6 +429,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -402,6 +460,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -438,6 +499,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -473,6 +537,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -502,6 +569,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -537,6 +607,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -583,6 +656,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -656,6 +732,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -724,6 +803,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -797,6 +879,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -865,6 +950,9 @@ func TestController_SaveAnalysis(t *testing.T) {
 			appConfigMock,
 			repoRepositoryMock,
 			repoAnalysisMock,
+			newRepoPolicyMockWithoutPolicy(),
+			newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled(),
 		)
 		res, err := controller.SaveAnalysis(&analysis.Analysis{
 			ID:             uuid.New(),
@@ -908,3 +996,366 @@ func TestController_SaveAnalysis(t *testing.T) {
 		assert.NotEqual(t, res, uuid.Nil)
 	})
 }
+
+func TestController_GetAnalysisVerdict(t *testing.T) {
+	t.Run("Should return analysis with the policy verdict", func(t *testing.T) {
+		repoAnalysisMock := &repoAnalysis.Mock{}
+		repoAnalysisMock.On("FindAnalysisByID").Return(response.NewResponse(1, nil, &analysis.Analysis{
+			ID: uuid.New(),
+		}))
+		repoPolicyMock := &repoPolicy.Mock{}
+		repoPolicyMock.On("FindVerdict").Return(&policyEntities.Verdict{Status: policyEnums.Failed}, nil)
+		controller := NewAnalysisController(&broker.Mock{}, &appConfiguration.Mock{}, &repository.Mock{},
+			repoAnalysisMock, repoPolicyMock, newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled())
+		res, err := controller.GetAnalysis(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, res.Analysis)
+		assert.Equal(t, policyEnums.Failed, res.PolicyVerdict.Status)
+	})
+	t.Run("Should return error when get policy verdict from database", func(t *testing.T) {
+		repoAnalysisMock := &repoAnalysis.Mock{}
+		repoAnalysisMock.On("FindAnalysisByID").Return(response.NewResponse(1, nil, &analysis.Analysis{
+			ID: uuid.New(),
+		}))
+		repoPolicyMock := &repoPolicy.Mock{}
+		repoPolicyMock.On("FindVerdict").Return((*policyEntities.Verdict)(nil), errors.New("unexpected error"))
+		controller := NewAnalysisController(&broker.Mock{}, &appConfiguration.Mock{}, &repository.Mock{},
+			repoAnalysisMock, repoPolicyMock, newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled())
+		res, err := controller.GetAnalysis(uuid.New())
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestController_SaveAnalysisPolicy(t *testing.T) {
+	newAnalysis := func() *analysis.Analysis {
+		return &analysis.Analysis{
+			ID:             uuid.New(),
+			WorkspaceID:    uuid.New(),
+			WorkspaceName:  uuid.NewString(),
+			RepositoryID:   uuid.New(),
+			RepositoryName: uuid.NewString(),
+			Status:         analysisEnum.Success,
+			CreatedAt:      time.Now(),
+			FinishedAt:     time.Now(),
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+				{Vulnerability: vulnerability.Vulnerability{VulnHash: "1", Severity: severities.Critical,
+					Type: vulnerabilityEnum.Vulnerability}},
+			},
+		}
+	}
+	newRepoAnalysisMock := func(entity *analysis.Analysis) *repoAnalysis.Mock {
+		repoAnalysisMock := &repoAnalysis.Mock{}
+		repoAnalysisMock.On("CreateFullAnalysisResponse").Return(nil)
+		repoAnalysisMock.On("CreateFullAnalysisArguments").Return(func(any *analysis.Analysis) {})
+		repoAnalysisMock.On("FindAnalysisByID").Return(response.NewResponse(1, nil, entity))
+		return repoAnalysisMock
+	}
+	newBrokerMock := func() *broker.Mock {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+		return brokerMock
+	}
+
+	t.Run("Should save the failed verdict of the active policy", func(t *testing.T) {
+		entity := newAnalysis()
+		repoPolicyMock := &repoPolicy.Mock{}
+		repoPolicyMock.On("FindActivePolicy").Return(&policyEntities.Policy{
+			Rules: policyEntities.Rules{{Type: policyEnums.SeverityCount, Severity: severities.Critical}},
+		}, nil)
+		repoPolicyMock.On("SaveVerdict", mock.MatchedBy(func(verdict *policyEntities.Verdict) bool {
+			return verdict.Status == policyEnums.Failed && len(verdict.Violations) == 1
+		})).Return(nil)
+		controller := NewAnalysisController(newBrokerMock(), &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), repoPolicyMock, newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled())
+		res, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.ID, res)
+		repoPolicyMock.AssertNotCalled(t, "ListFirstSeenDates")
+		repoPolicyMock.AssertCalled(t, "SaveVerdict", mock.Anything)
+	})
+	t.Run("Should list first seen dates when policy checks the age of the findings", func(t *testing.T) {
+		entity := newAnalysis()
+		repoPolicyMock := &repoPolicy.Mock{}
+		repoPolicyMock.On("FindActivePolicy").Return(&policyEntities.Policy{
+			Rules: policyEntities.Rules{{Type: policyEnums.FindingAge, MaxDays: 30}},
+		}, nil)
+		repoPolicyMock.On("ListFirstSeenDates").Return(map[string]time.Time{"1": time.Now().AddDate(0, 0, -31)}, nil)
+		repoPolicyMock.On("SaveVerdict", mock.MatchedBy(func(verdict *policyEntities.Verdict) bool {
+			return verdict.Status == policyEnums.Failed
+		})).Return(nil)
+		controller := NewAnalysisController(newBrokerMock(), &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), repoPolicyMock, newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled())
+		_, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		repoPolicyMock.AssertCalled(t, "SaveVerdict", mock.Anything)
+	})
+	t.Run("Should not save verdict when there is no active policy", func(t *testing.T) {
+		entity := newAnalysis()
+		repoPolicyMock := newRepoPolicyMockWithoutPolicy()
+		controller := NewAnalysisController(newBrokerMock(), &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), repoPolicyMock, newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled())
+		_, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		repoPolicyMock.AssertNotCalled(t, "SaveVerdict", mock.Anything)
+	})
+	t.Run("Should save analysis and publish it even when policy evaluation fails", func(t *testing.T) {
+		entity := newAnalysis()
+		brokerMock := newBrokerMock()
+		repoPolicyMock := &repoPolicy.Mock{}
+		repoPolicyMock.On("FindActivePolicy").Return(&policyEntities.Policy{
+			Rules: policyEntities.Rules{{Type: policyEnums.FindingAge, MaxDays: 30}},
+		}, nil)
+		repoPolicyMock.On("ListFirstSeenDates").Return(map[string]time.Time{}, errors.New("unexpected error"))
+		controller := NewAnalysisController(brokerMock, &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), repoPolicyMock, newRepoSuppressionMockWithoutRules(),
+			newRepoPropagationMockDisabled())
+		res, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.ID, res)
+		brokerMock.AssertCalled(t, "Publish")
+		repoPolicyMock.AssertNotCalled(t, "SaveVerdict", mock.Anything)
+	})
+}
+
+func TestController_SaveAnalysisSuppression(t *testing.T) {
+	newAnalysis := func() *analysis.Analysis {
+		return &analysis.Analysis{
+			ID:           uuid.New(),
+			WorkspaceID:  uuid.New(),
+			RepositoryID: uuid.New(),
+			Status:       analysisEnum.Success,
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+				{Vulnerability: vulnerability.Vulnerability{VulnHash: "1", File: "test/main_test.go",
+					Severity: severities.Critical, Type: vulnerabilityEnum.Vulnerability}},
+				{Vulnerability: vulnerability.Vulnerability{VulnHash: "2", File: "test/other_test.go",
+					Severity: severities.Critical, Type: vulnerabilityEnum.RiskAccepted}},
+				{Vulnerability: vulnerability.Vulnerability{VulnHash: "3", File: "main.go",
+					Severity: severities.Critical, Type: vulnerabilityEnum.Vulnerability}},
+			},
+		}
+	}
+	newRepoAnalysisMock := func(entity *analysis.Analysis) *repoAnalysis.Mock {
+		repoAnalysisMock := &repoAnalysis.Mock{}
+		repoAnalysisMock.On("CreateFullAnalysisResponse").Return(nil)
+		repoAnalysisMock.On("CreateFullAnalysisArguments").Return(func(any *analysis.Analysis) {})
+		repoAnalysisMock.On("FindAnalysisByID").Return(response.NewResponse(1, nil, entity))
+		return repoAnalysisMock
+	}
+	newRepoSuppressionMock := func() *repoSuppression.Mock {
+		repoSuppressionMock := &repoSuppression.Mock{}
+		repoSuppressionMock.On("ListActiveRules").Return(suppressionEntities.Rules{
+			{Name: "test files", FilePattern: "test/**", Type: vulnerabilityEnum.FalsePositive},
+		}, nil)
+		return repoSuppressionMock
+	}
+	newBrokerMock := func() *broker.Mock {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+		return brokerMock
+	}
+
+	t.Run("Should classify only the unclassified vulnerabilities matched by the rules", func(t *testing.T) {
+		entity := newAnalysis()
+		repoSuppressionMock := newRepoSuppressionMock()
+		repoSuppressionMock.On("SaveSuppressions", mock.MatchedBy(
+			func(suppressions []suppressionEntities.Suppression) bool {
+				return len(suppressions) == 1 && suppressions[0].Vulnerability.VulnHash == "1"
+			})).Return(nil)
+		controller := NewAnalysisController(newBrokerMock(), &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), newRepoPolicyMockWithoutPolicy(), repoSuppressionMock,
+			newRepoPropagationMockDisabled())
+		_, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		repoSuppressionMock.AssertCalled(t, "SaveSuppressions", mock.Anything)
+		assert.Equal(t, vulnerabilityEnum.FalsePositive, entity.AnalysisVulnerabilities[0].Vulnerability.Type)
+		assert.Equal(t, vulnerabilityEnum.RiskAccepted, entity.AnalysisVulnerabilities[1].Vulnerability.Type)
+		assert.Equal(t, vulnerabilityEnum.Vulnerability, entity.AnalysisVulnerabilities[2].Vulnerability.Type)
+	})
+	t.Run("Should evaluate policy with the vulnerabilities classified by the rules", func(t *testing.T) {
+		entity := newAnalysis()
+		repoSuppressionMock := newRepoSuppressionMock()
+		repoSuppressionMock.On("SaveSuppressions", mock.Anything).Return(nil)
+		repoPolicyMock := &repoPolicy.Mock{}
+		repoPolicyMock.On("FindActivePolicy").Return(&policyEntities.Policy{
+			Rules: policyEntities.Rules{{Type: policyEnums.SeverityCount, Severity: severities.Critical,
+				VulnerabilityType: vulnerabilityEnum.FalsePositive}},
+		}, nil)
+		repoPolicyMock.On("SaveVerdict", mock.MatchedBy(func(verdict *policyEntities.Verdict) bool {
+			return verdict.Status == policyEnums.Failed
+		})).Return(nil)
+		controller := NewAnalysisController(newBrokerMock(), &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), repoPolicyMock, repoSuppressionMock,
+			newRepoPropagationMockDisabled())
+		_, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		repoPolicyMock.AssertCalled(t, "SaveVerdict", mock.Anything)
+	})
+	t.Run("Should not save suppressions when no vulnerability is matched", func(t *testing.T) {
+		entity := newAnalysis()
+		entity.AnalysisVulnerabilities = entity.AnalysisVulnerabilities[2:]
+		repoSuppressionMock := newRepoSuppressionMock()
+		controller := NewAnalysisController(newBrokerMock(), &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), newRepoPolicyMockWithoutPolicy(), repoSuppressionMock,
+			newRepoPropagationMockDisabled())
+		_, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		repoSuppressionMock.AssertNotCalled(t, "SaveSuppressions", mock.Anything)
+	})
+	t.Run("Should save analysis and keep the vulnerabilities when save suppressions fails", func(t *testing.T) {
+		entity := newAnalysis()
+		brokerMock := newBrokerMock()
+		repoSuppressionMock := newRepoSuppressionMock()
+		repoSuppressionMock.On("SaveSuppressions", mock.Anything).Return(errors.New("unexpected error"))
+		controller := NewAnalysisController(brokerMock, &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), newRepoPolicyMockWithoutPolicy(), repoSuppressionMock,
+			newRepoPropagationMockDisabled())
+		res, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.ID, res)
+		brokerMock.AssertCalled(t, "Publish")
+		assert.Equal(t, vulnerabilityEnum.Vulnerability, entity.AnalysisVulnerabilities[0].Vulnerability.Type)
+	})
+	t.Run("Should save analysis when list rules fails", func(t *testing.T) {
+		entity := newAnalysis()
+		repoSuppressionMock := &repoSuppression.Mock{}
+		repoSuppressionMock.On("ListActiveRules").Return(suppressionEntities.Rules{}, errors.New("unexpected error"))
+		controller := NewAnalysisController(newBrokerMock(), &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), newRepoPolicyMockWithoutPolicy(), repoSuppressionMock,
+			newRepoPropagationMockDisabled())
+		_, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		repoSuppressionMock.AssertNotCalled(t, "SaveSuppressions", mock.Anything)
+	})
+}
+
+func TestController_SaveAnalysisPropagation(t *testing.T) {
+	newAnalysis := func() *analysis.Analysis {
+		return &analysis.Analysis{
+			ID:           uuid.New(),
+			WorkspaceID:  uuid.New(),
+			RepositoryID: uuid.New(),
+			Status:       analysisEnum.Success,
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+				{Vulnerability: vulnerability.Vulnerability{VulnHash: "1", Code: "a :=  b", File: "fork/main.go",
+					Severity: severities.Critical, Type: vulnerabilityEnum.Vulnerability}},
+				{Vulnerability: vulnerability.Vulnerability{VulnHash: "2", File: "test/other_test.go",
+					Severity: severities.Critical, Type: vulnerabilityEnum.RiskAccepted}},
+				{Vulnerability: vulnerability.Vulnerability{VulnHash: "1", Code: "a := b", File: "main.go",
+					Severity: severities.Critical, Type: vulnerabilityEnum.Vulnerability}},
+			},
+		}
+	}
+	newRepoAnalysisMock := func(entity *analysis.Analysis) *repoAnalysis.Mock {
+		repoAnalysisMock := &repoAnalysis.Mock{}
+		repoAnalysisMock.On("CreateFullAnalysisResponse").Return(nil)
+		repoAnalysisMock.On("CreateFullAnalysisArguments").Return(func(any *analysis.Analysis) {})
+		repoAnalysisMock.On("FindAnalysisByID").Return(response.NewResponse(1, nil, entity))
+		return repoAnalysisMock
+	}
+	newRepoPropagationMock := func(matchBy string) *repoPropagation.Mock {
+		repoPropagationMock := &repoPropagation.Mock{}
+		repoPropagationMock.On("FindSettings").Return(&propagationEntities.Settings{
+			IsEnabled: true, MatchBy: matchBy}, nil)
+		repoPropagationMock.On("ListDecisions", mock.Anything).Return(propagationEntities.Decisions{
+			{VulnerabilityID: uuid.New(), VulnHash: "1", Code: "a := b", File: "src/main.go",
+				Type: vulnerabilityEnum.FalsePositive},
+			{VulnerabilityID: uuid.New(), VulnHash: "1", Code: "a := b", File: "main.go",
+				Type: vulnerabilityEnum.RiskAccepted},
+		}, nil)
+		return repoPropagationMock
+	}
+	newBrokerMock := func() *broker.Mock {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+		return brokerMock
+	}
+
+	t.Run("Should propagate the most recent decision matched by hash", func(t *testing.T) {
+		entity := newAnalysis()
+		repoPropagationMock := newRepoPropagationMock(propagationEnums.MatchByHash)
+		repoPropagationMock.On("SavePropagations", mock.MatchedBy(
+			func(propagations []propagationEntities.Propagation) bool {
+				return len(propagations) == 2
+			})).Return(nil)
+		controller := NewAnalysisController(newBrokerMock(), &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), newRepoPolicyMockWithoutPolicy(), newRepoSuppressionMockWithoutRules(),
+			repoPropagationMock)
+		_, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		repoPropagationMock.AssertCalled(t, "ListDecisions", []string{"1"})
+		assert.Equal(t, vulnerabilityEnum.FalsePositive, entity.AnalysisVulnerabilities[0].Vulnerability.Type)
+		assert.Equal(t, vulnerabilityEnum.RiskAccepted, entity.AnalysisVulnerabilities[1].Vulnerability.Type)
+		assert.Equal(t, vulnerabilityEnum.FalsePositive, entity.AnalysisVulnerabilities[2].Vulnerability.Type)
+	})
+	t.Run("Should propagate the decision matched by normalized code and file name", func(t *testing.T) {
+		entity := newAnalysis()
+		repoPropagationMock := newRepoPropagationMock(propagationEnums.MatchByCode)
+		repoPropagationMock.On("SavePropagations", mock.Anything).Return(nil)
+		controller := NewAnalysisController(newBrokerMock(), &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), newRepoPolicyMockWithoutPolicy(), newRepoSuppressionMockWithoutRules(),
+			repoPropagationMock)
+		_, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		repoPropagationMock.AssertCalled(t, "ListDecisions", []string{"a := b"})
+		assert.Equal(t, vulnerabilityEnum.FalsePositive, entity.AnalysisVulnerabilities[0].Vulnerability.Type)
+		assert.Equal(t, vulnerabilityEnum.FalsePositive, entity.AnalysisVulnerabilities[2].Vulnerability.Type)
+	})
+	t.Run("Should not propagate vulnerabilities classified by the suppression rules", func(t *testing.T) {
+		entity := newAnalysis()
+		repoSuppressionMock := &repoSuppression.Mock{}
+		repoSuppressionMock.On("ListActiveRules").Return(suppressionEntities.Rules{
+			{Name: "all", FilePattern: "**", Type: vulnerabilityEnum.Corrected},
+		}, nil)
+		repoSuppressionMock.On("SaveSuppressions", mock.Anything).Return(nil)
+		repoPropagationMock := newRepoPropagationMock(propagationEnums.MatchByHash)
+		controller := NewAnalysisController(newBrokerMock(), &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), newRepoPolicyMockWithoutPolicy(), repoSuppressionMock, repoPropagationMock)
+		_, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		repoPropagationMock.AssertNotCalled(t, "ListDecisions", mock.Anything)
+		assert.Equal(t, vulnerabilityEnum.Corrected, entity.AnalysisVulnerabilities[0].Vulnerability.Type)
+	})
+	t.Run("Should not list decisions when the propagation is disabled", func(t *testing.T) {
+		entity := newAnalysis()
+		repoPropagationMock := newRepoPropagationMockDisabled()
+		controller := NewAnalysisController(newBrokerMock(), &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), newRepoPolicyMockWithoutPolicy(), newRepoSuppressionMockWithoutRules(),
+			repoPropagationMock)
+		_, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		repoPropagationMock.AssertNotCalled(t, "ListDecisions", mock.Anything)
+		assert.Equal(t, vulnerabilityEnum.Vulnerability, entity.AnalysisVulnerabilities[0].Vulnerability.Type)
+	})
+	t.Run("Should save analysis and keep the vulnerabilities when save propagations fails", func(t *testing.T) {
+		entity := newAnalysis()
+		brokerMock := newBrokerMock()
+		repoPropagationMock := newRepoPropagationMock(propagationEnums.MatchByHash)
+		repoPropagationMock.On("SavePropagations", mock.Anything).Return(errors.New("unexpected error"))
+		controller := NewAnalysisController(brokerMock, &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), newRepoPolicyMockWithoutPolicy(), newRepoSuppressionMockWithoutRules(),
+			repoPropagationMock)
+		res, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.ID, res)
+		brokerMock.AssertCalled(t, "Publish")
+		assert.Equal(t, vulnerabilityEnum.Vulnerability, entity.AnalysisVulnerabilities[0].Vulnerability.Type)
+	})
+	t.Run("Should save analysis when find settings fails", func(t *testing.T) {
+		entity := newAnalysis()
+		repoPropagationMock := &repoPropagation.Mock{}
+		repoPropagationMock.On("FindSettings").Return(&propagationEntities.Settings{},
+			errors.New("unexpected error"))
+		controller := NewAnalysisController(newBrokerMock(), &appConfiguration.Mock{}, &repository.Mock{},
+			newRepoAnalysisMock(entity), newRepoPolicyMockWithoutPolicy(), newRepoSuppressionMockWithoutRules(),
+			repoPropagationMock)
+		_, err := controller.SaveAnalysis(entity)
+		assert.NoError(t, err)
+		repoPropagationMock.AssertNotCalled(t, "ListDecisions", mock.Anything)
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+
+	policyEnums "github.com/ZupIT/horusec-platform/api/internal/enums/policy"
+)
+
+type Policy struct {
+	PolicyID     uuid.UUID  `json:"policyID" gorm:"Column:policy_id"`
+	WorkspaceID  uuid.UUID  `json:"workspaceID" gorm:"Column:workspace_id"`
+	RepositoryID *uuid.UUID `json:"repositoryID" gorm:"Column:repository_id"`
+	Name         string     `json:"name" gorm:"Column:name"`
+	Rules        Rules      `json:"rules" gorm:"Column:rules"`
+}
+
+type FirstSeen struct {
+	VulnHash    string    `json:"vulnHash" gorm:"Column:vuln_hash"`
+	FirstSeenAt time.Time `json:"firstSeenAt" gorm:"Column:first_seen_at"`
+}
+
+// Evaluate checks all rules of the policy against the analysis, the analysis fails when any rule is broken
+func (p *Policy) Evaluate(entity *analysis.Analysis, firstSeenDates map[string]time.Time) *Verdict {
+	verdict := &Verdict{
+		PolicyID:    p.PolicyID,
+		PolicyName:  p.Name,
+		Status:      policyEnums.Passed,
+		Violations:  []Violation{},
+		EvaluatedAt: time.Now(),
+	}
+
+	for index := range p.Rules {
+		if violation := p.Rules[index].Evaluate(entity, firstSeenDates, verdict.EvaluatedAt); violation != nil {
+			verdict.Violations = append(verdict.Violations, *violation)
+			verdict.Status = policyEnums.Failed
+		}
+	}
+
+	return verdict
+}
+
+// RequiresFirstSeenDates informs if any rule checks the age of the findings, that depends on the previous analyses
+func (p *Policy) RequiresFirstSeenDates() bool {
+	for index := range p.Rules {
+		if p.Rules[index].Type == policyEnums.FindingAge {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+
+	policyEnums "github.com/ZupIT/horusec-platform/api/internal/enums/policy"
+)
+
+func TestPolicy_Evaluate(t *testing.T) {
+	t.Run("Should return failed verdict with the broken rules", func(t *testing.T) {
+		policy := &Policy{
+			PolicyID: uuid.New(),
+			Name:     "test",
+			Rules: Rules{
+				{Type: policyEnums.SeverityCount, Severity: severities.Critical},
+				{Type: policyEnums.SeverityCount, Severity: severities.High, MaxCount: 5},
+			},
+		}
+
+		verdict := policy.Evaluate(newAnalysisWithVulnerabilities(), map[string]time.Time{})
+		assert.Equal(t, policyEnums.Failed, verdict.Status)
+		assert.False(t, verdict.IsPassed())
+		assert.Equal(t, policy.PolicyID, verdict.PolicyID)
+		assert.Equal(t, policy.Name, verdict.PolicyName)
+		assert.Len(t, verdict.Violations, 1)
+		assert.False(t, verdict.EvaluatedAt.IsZero())
+	})
+	t.Run("Should return passed verdict when no rule is broken", func(t *testing.T) {
+		policy := &Policy{Rules: Rules{{Type: policyEnums.SeverityCount, Severity: severities.Critical, MaxCount: 1}}}
+
+		verdict := policy.Evaluate(newAnalysisWithVulnerabilities(), map[string]time.Time{})
+		assert.True(t, verdict.IsPassed())
+		assert.Empty(t, verdict.Violations)
+	})
+}
+
+func TestPolicy_RequiresFirstSeenDates(t *testing.T) {
+	t.Run("Should require first seen dates only when there is a finding age rule", func(t *testing.T) {
+		assert.False(t, (&Policy{Rules: Rules{{Type: policyEnums.SeverityCount}}}).RequiresFirstSeenDates())
+		assert.True(t, (&Policy{Rules: Rules{{Type: policyEnums.SeverityCount}, {Type: policyEnums.FindingAge}}}).
+			RequiresFirstSeenDates())
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	policyEnums "github.com/ZupIT/horusec-platform/api/internal/enums/policy"
+)
+
+type Rule struct {
+	Type              policyEnums.RuleType `json:"type"`
+	Severity          severities.Severity  `json:"severity"`
+	VulnerabilityType vulnerability.Type   `json:"vulnerabilityType"`
+	MaxCount          int                  `json:"maxCount"`
+	MaxDays           int                  `json:"maxDays"`
+	Language          languages.Language   `json:"language"`
+	SecurityTool      tools.Tool           `json:"securityTool"`
+}
+
+type Rules []Rule
+
+func (r Rules) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+func (r *Rules) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("[]byte assertion failed")
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// Evaluate counts the findings of the analysis that break the rule, returning nil when the rule is satisfied.
+// The first seen dates by vulnerability hash are used to check the age of the findings, findings without
+// a date are considered new
+func (r *Rule) Evaluate(entity *analysis.Analysis, firstSeenDates map[string]time.Time, now time.Time) *Violation {
+	count := 0
+	for index := range entity.AnalysisVulnerabilities {
+		vuln := &entity.AnalysisVulnerabilities[index].Vulnerability
+		if r.matchVulnerability(vuln) && r.isOlderThanMaxDays(firstSeenDates[vuln.VulnHash], now) {
+			count++
+		}
+	}
+
+	if r.isSatisfied(count) {
+		return nil
+	}
+
+	return &Violation{Rule: *r, Count: count, Message: r.getViolationMessage(count)}
+}
+
+// matchVulnerability checks the fields set on the rule, when the vulnerability type is empty only the
+// unresolved vulnerabilities are matched
+func (r *Rule) matchVulnerability(vuln *vulnerabilityEntities.Vulnerability) bool {
+	return vuln.Type == r.getVulnerabilityType() &&
+		(r.Severity == "" || vuln.Severity == r.Severity) &&
+		(r.Language == "" || vuln.Language == r.Language) &&
+		(r.SecurityTool == "" || vuln.SecurityTool == r.SecurityTool)
+}
+
+func (r *Rule) isOlderThanMaxDays(firstSeenAt, now time.Time) bool {
+	if r.Type != policyEnums.FindingAge {
+		return true
+	}
+
+	if firstSeenAt.IsZero() {
+		return false
+	}
+
+	return now.Sub(firstSeenAt) > time.Duration(r.MaxDays*policyEnums.HoursInDay)*time.Hour
+}
+
+func (r *Rule) isSatisfied(count int) bool {
+	if r.Type == policyEnums.SeverityCount {
+		return count <= r.MaxCount
+	}
+
+	return count == 0
+}
+
+func (r *Rule) getVulnerabilityType() vulnerability.Type {
+	if r.VulnerabilityType == "" {
+		return vulnerability.Vulnerability
+	}
+
+	return r.VulnerabilityType
+}
+
+func (r *Rule) getViolationMessage(count int) string {
+	switch r.Type {
+	case policyEnums.SeverityCount:
+		return fmt.Sprintf(policyEnums.MessageSeverityCountViolation, count, r.Severity, r.getVulnerabilityType(),
+			r.MaxCount)
+	case policyEnums.FindingAge:
+		return fmt.Sprintf(policyEnums.MessageFindingAgeViolation, count, r.getVulnerabilityType(), r.MaxDays)
+	default:
+		return fmt.Sprintf(policyEnums.MessageBlockedToolViolation, count, r.getVulnerabilityType(), r.Language,
+			r.SecurityTool)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	vulnerabilityEnum "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	policyEnums "github.com/ZupIT/horusec-platform/api/internal/enums/policy"
+)
+
+func newAnalysisWithVulnerabilities() *analysis.Analysis {
+	return &analysis.Analysis{
+		AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+			{Vulnerability: vulnerability.Vulnerability{VulnHash: "1", Severity: severities.Critical,
+				Type: vulnerabilityEnum.Vulnerability, Language: languages.Go, SecurityTool: tools.GoSec}},
+			{Vulnerability: vulnerability.Vulnerability{VulnHash: "2", Severity: severities.High,
+				Type: vulnerabilityEnum.Vulnerability, Language: languages.Go, SecurityTool: tools.HorusecEngine}},
+			{Vulnerability: vulnerability.Vulnerability{VulnHash: "3", Severity: severities.High,
+				Type: vulnerabilityEnum.Vulnerability, Language: languages.Java, SecurityTool: tools.HorusecEngine}},
+			{Vulnerability: vulnerability.Vulnerability{VulnHash: "4", Severity: severities.Critical,
+				Type: vulnerabilityEnum.RiskAccepted, Language: languages.Go, SecurityTool: tools.GoSec}},
+		},
+	}
+}
+
+func TestRule_Evaluate(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Should fail when there are more findings of the severity than allowed", func(t *testing.T) {
+		rule := &Rule{Type: policyEnums.SeverityCount, Severity: severities.Critical}
+
+		violation := rule.Evaluate(newAnalysisWithVulnerabilities(), map[string]time.Time{}, now)
+		assert.NotNil(t, violation)
+		assert.Equal(t, 1, violation.Count)
+		assert.Equal(t, *rule, violation.Rule)
+		assert.Equal(t, "1 findings of severity CRITICAL and type Vulnerability, the max allowed is 0",
+			violation.Message)
+	})
+	t.Run("Should pass when the findings of the severity are up to the max allowed", func(t *testing.T) {
+		rule := &Rule{Type: policyEnums.SeverityCount, Severity: severities.High, MaxCount: 2}
+
+		assert.Nil(t, rule.Evaluate(newAnalysisWithVulnerabilities(), map[string]time.Time{}, now))
+	})
+	t.Run("Should count only the findings of the vulnerability type of the rule", func(t *testing.T) {
+		rule := &Rule{Type: policyEnums.SeverityCount, Severity: severities.Critical,
+			VulnerabilityType: vulnerabilityEnum.RiskAccepted, MaxCount: 1}
+
+		assert.Nil(t, rule.Evaluate(newAnalysisWithVulnerabilities(), map[string]time.Time{}, now))
+	})
+	t.Run("Should fail when findings are unresolved for more than the max days", func(t *testing.T) {
+		rule := &Rule{Type: policyEnums.FindingAge, MaxDays: 30}
+		firstSeenDates := map[string]time.Time{
+			"1": now.AddDate(0, 0, -31),
+			"2": now.AddDate(0, 0, -29),
+			"4": now.AddDate(0, 0, -60),
+		}
+
+		violation := rule.Evaluate(newAnalysisWithVulnerabilities(), firstSeenDates, now)
+		assert.NotNil(t, violation)
+		assert.Equal(t, 1, violation.Count)
+		assert.Equal(t, "1 findings of type Vulnerability unresolved for more than 30 days", violation.Message)
+	})
+	t.Run("Should pass when findings are new", func(t *testing.T) {
+		rule := &Rule{Type: policyEnums.FindingAge, MaxDays: 30}
+
+		assert.Nil(t, rule.Evaluate(newAnalysisWithVulnerabilities(), map[string]time.Time{}, now))
+	})
+	t.Run("Should fail when findings are reported to blocked language and tool", func(t *testing.T) {
+		rule := &Rule{Type: policyEnums.BlockedTool, Language: languages.Go, SecurityTool: tools.HorusecEngine}
+
+		violation := rule.Evaluate(newAnalysisWithVulnerabilities(), map[string]time.Time{}, now)
+		assert.NotNil(t, violation)
+		assert.Equal(t, 1, violation.Count)
+		assert.Equal(t, `1 findings of type Vulnerability reported to the blocked language "Go" and tool "HorusecEngine"`,
+			violation.Message)
+	})
+	t.Run("Should block all findings of the language when tool is empty", func(t *testing.T) {
+		rule := &Rule{Type: policyEnums.BlockedTool, Language: languages.Go}
+
+		assert.Equal(t, 2, rule.Evaluate(newAnalysisWithVulnerabilities(), map[string]time.Time{}, now).Count)
+	})
+	t.Run("Should pass when there are no findings of the blocked tool", func(t *testing.T) {
+		rule := &Rule{Type: policyEnums.BlockedTool, SecurityTool: tools.Trivy}
+
+		assert.Nil(t, rule.Evaluate(newAnalysisWithVulnerabilities(), map[string]time.Time{}, now))
+	})
+}
+
+func TestRules_ValueAndScan(t *testing.T) {
+	t.Run("Should parse rules to database value and back", func(t *testing.T) {
+		rules := Rules{{Type: policyEnums.FindingAge, MaxDays: 30}}
+
+		value, err := rules.Value()
+		assert.NoError(t, err)
+
+		result := Rules{}
+		assert.NoError(t, result.Scan(value))
+		assert.Equal(t, rules, result)
+	})
+	t.Run("Should ignore nil value and return error when value is not bytes", func(t *testing.T) {
+		result := Rules{}
+		assert.NoError(t, result.Scan(nil))
+		assert.Error(t, result.Scan("test"))
+	})
+}
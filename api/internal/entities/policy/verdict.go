@@ -0,0 +1,62 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	policyEnums "github.com/ZupIT/horusec-platform/api/internal/enums/policy"
+)
+
+type Verdict struct {
+	PolicyID    uuid.UUID                 `json:"policyID"`
+	PolicyName  string                    `json:"policyName"`
+	Status      policyEnums.VerdictStatus `json:"status" enums:"passed,failed" example:"failed"`
+	Violations  []Violation               `json:"violations"`
+	EvaluatedAt time.Time                 `json:"evaluatedAt"`
+}
+
+type Violation struct {
+	Rule    Rule   `json:"rule"`
+	Count   int    `json:"count"`
+	Message string `json:"message"`
+}
+
+func (v Verdict) Value() (driver.Value, error) {
+	return json.Marshal(v)
+}
+
+func (v *Verdict) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("[]byte assertion failed")
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+// IsPassed informs if the analysis did not break any rule of the policy, so it can be used to gate merges
+func (v *Verdict) IsPassed() bool {
+	return v.Status == policyEnums.Passed
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+
+	policyEnums "github.com/ZupIT/horusec-platform/api/internal/enums/policy"
+)
+
+func TestVerdict_ValueAndScan(t *testing.T) {
+	t.Run("Should parse verdict to database value and back", func(t *testing.T) {
+		verdict := Verdict{PolicyID: uuid.New(), Status: policyEnums.Failed,
+			Violations: []Violation{{Count: 1, Message: "test"}}}
+
+		value, err := verdict.Value()
+		assert.NoError(t, err)
+
+		result := &Verdict{}
+		assert.NoError(t, result.Scan(value))
+		assert.Equal(t, verdict.PolicyID, result.PolicyID)
+		assert.Equal(t, verdict.Violations, result.Violations)
+	})
+	t.Run("Should ignore nil value and return error when value is not bytes", func(t *testing.T) {
+		result := &Verdict{}
+		assert.NoError(t, result.Scan(nil))
+		assert.Error(t, result.Scan("test"))
+	})
+}
+
+func TestAnalysisResponse(t *testing.T) {
+	t.Run("Should marshal the analysis fields with the policy verdict", func(t *testing.T) {
+		entity := &AnalysisResponse{
+			Analysis:      &analysis.Analysis{ID: uuid.New()},
+			PolicyVerdict: &Verdict{Status: policyEnums.Passed},
+		}
+
+		bytes, err := json.Marshal(entity)
+		assert.NoError(t, err)
+
+		result := map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal(bytes, &result))
+		assert.Equal(t, entity.ID.String(), result["id"])
+		assert.Equal(t, "passed", result["policyVerdict"].(map[string]interface{})["status"])
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+)
+
+// Decision is the triage of a vulnerability of another repository of the workspace, with the account and the
+// justification of its latest change and the expiry when it is a risk accepted for a limited time
+type Decision struct {
+	VulnerabilityID uuid.UUID               `gorm:"Column:vulnerability_id"`
+	VulnHash        string                  `gorm:"Column:vuln_hash"`
+	Code            string                  `gorm:"Column:code"`
+	File            string                  `gorm:"Column:file"`
+	Type            vulnerabilityEnums.Type `gorm:"Column:type"`
+	Justification   string                  `gorm:"Column:justification"`
+	AccountID       uuid.UUID               `gorm:"Column:account_id"`
+	AccountEmail    string                  `gorm:"Column:account_email"`
+	AccountUsername string                  `gorm:"Column:account_username"`
+	Approver        string                  `gorm:"Column:approver"`
+	ExpiresAt       *time.Time              `gorm:"Column:expires_at"`
+}
+
+// Matches checks if the decision is of the same vulnerability, by hash or by the normalized code and file name
+func (d *Decision) Matches(vuln *vulnerability.Vulnerability, settings *Settings) bool {
+	if !settings.IsMatchByCode() {
+		return d.VulnHash == vuln.VulnHash
+	}
+
+	return NormalizeCode(vuln.Code) != "" && NormalizeCode(d.Code) == NormalizeCode(vuln.Code) &&
+		GetFileName(d.File) == GetFileName(vuln.File)
+}
+
+type Decisions []Decision
+
+// FindMatch returns the first decision of the same vulnerability, nil when there is none
+func (d Decisions) FindMatch(vuln *vulnerability.Vulnerability, settings *Settings) *Decision {
+	for index := range d {
+		if d[index].Matches(vuln, settings) {
+			return &d[index]
+		}
+	}
+
+	return nil
+}
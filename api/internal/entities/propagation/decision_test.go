@@ -0,0 +1,58 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+
+	propagationEnums "github.com/ZupIT/horusec-platform/api/internal/enums/propagation"
+)
+
+func TestDecisions_FindMatch(t *testing.T) {
+	decisions := Decisions{
+		{VulnerabilityID: uuid.New(), VulnHash: "hash1", Code: "a  := b", File: "fork/src/main.go"},
+		{VulnerabilityID: uuid.New(), VulnHash: "hash2", Code: "c := d", File: "src/main.go"},
+	}
+
+	t.Run("Should find the decision with the same hash", func(t *testing.T) {
+		settings := &Settings{MatchBy: propagationEnums.MatchByHash}
+		decision := decisions.FindMatch(&vulnerability.Vulnerability{VulnHash: "hash2"}, settings)
+		assert.Equal(t, decisions[1].VulnerabilityID, decision.VulnerabilityID)
+	})
+	t.Run("Should return nil when there is no decision with the same hash", func(t *testing.T) {
+		settings := &Settings{MatchBy: propagationEnums.MatchByHash}
+		assert.Nil(t, decisions.FindMatch(&vulnerability.Vulnerability{VulnHash: "hash3"}, settings))
+	})
+	t.Run("Should find the decision with the same normalized code and file name", func(t *testing.T) {
+		settings := &Settings{MatchBy: propagationEnums.MatchByCode}
+		decision := decisions.FindMatch(&vulnerability.Vulnerability{VulnHash: "other", Code: "a := b\n",
+			File: "src/main.go"}, settings)
+		assert.Equal(t, decisions[0].VulnerabilityID, decision.VulnerabilityID)
+	})
+	t.Run("Should return nil when the file name is different", func(t *testing.T) {
+		settings := &Settings{MatchBy: propagationEnums.MatchByCode}
+		assert.Nil(t, decisions.FindMatch(&vulnerability.Vulnerability{Code: "a := b", File: "src/other.go"},
+			settings))
+	})
+	t.Run("Should return nil when the vulnerability has no code", func(t *testing.T) {
+		settings := &Settings{MatchBy: propagationEnums.MatchByCode}
+		assert.Nil(t, Decisions{{Code: " "}}.FindMatch(&vulnerability.Vulnerability{Code: ""}, settings))
+	})
+}
@@ -0,0 +1,112 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+)
+
+// Propagation is a vulnerability of a new analysis that receives the triage of another repository
+type Propagation struct {
+	Vulnerability *vulnerability.Vulnerability
+	Decision      *Decision
+}
+
+// Event is the record of the vulnerability history created by the propagation, registered with the account of the
+// triage and referencing the triaged vulnerability, so it can be undone by the vulnerability service
+type Event struct {
+	EventID          uuid.UUID               `gorm:"Column:event_id"`
+	VulnerabilityID  uuid.UUID               `gorm:"Column:vulnerability_id"`
+	AnalysisID       uuid.UUID               `gorm:"Column:analysis_id"`
+	AccountID        uuid.UUID               `gorm:"Column:account_id"`
+	AccountEmail     string                  `gorm:"Column:account_email"`
+	AccountUsername  string                  `gorm:"Column:account_username"`
+	OldType          vulnerabilityEnums.Type `gorm:"Column:old_type"`
+	NewType          vulnerabilityEnums.Type `gorm:"Column:new_type"`
+	OldSeverity      severities.Severity     `gorm:"Column:old_severity"`
+	NewSeverity      severities.Severity     `gorm:"Column:new_severity"`
+	Justification    string                  `gorm:"Column:justification"`
+	PropagatedFromID *uuid.UUID              `gorm:"Column:propagated_from_id"`
+	CreatedAt        time.Time               `gorm:"Column:created_at"`
+}
+
+// RiskAcceptance keeps the expiry of the triage on the vulnerability, so it is reviewed again with the triaged one
+type RiskAcceptance struct {
+	VulnerabilityID uuid.UUID `gorm:"Column:vulnerability_id"`
+	AnalysisID      uuid.UUID `gorm:"Column:analysis_id"`
+	AccountID       uuid.UUID `gorm:"Column:account_id"`
+	AccountEmail    string    `gorm:"Column:account_email"`
+	AccountUsername string    `gorm:"Column:account_username"`
+	Approver        string    `gorm:"Column:approver"`
+	ExpiresAt       time.Time `gorm:"Column:expires_at"`
+	CreatedAt       time.Time `gorm:"Column:created_at"`
+}
+
+func (p *Propagation) ToEvent(analysisID uuid.UUID) *Event {
+	propagatedFromID := p.Decision.VulnerabilityID
+
+	return &Event{
+		EventID:          uuid.New(),
+		VulnerabilityID:  p.Vulnerability.VulnerabilityID,
+		AnalysisID:       analysisID,
+		AccountID:        p.Decision.AccountID,
+		AccountEmail:     p.Decision.AccountEmail,
+		AccountUsername:  p.Decision.AccountUsername,
+		OldType:          p.Vulnerability.Type,
+		NewType:          p.Decision.Type,
+		OldSeverity:      p.Vulnerability.Severity,
+		NewSeverity:      p.Vulnerability.Severity,
+		Justification:    p.Decision.Justification,
+		PropagatedFromID: &propagatedFromID,
+		CreatedAt:        time.Now(),
+	}
+}
+
+// ToRiskAcceptance returns the expiry of the propagated triage, nil when the triage does not expire
+func (p *Propagation) ToRiskAcceptance(analysisID uuid.UUID) *RiskAcceptance {
+	if p.Decision.ExpiresAt == nil {
+		return nil
+	}
+
+	return &RiskAcceptance{
+		VulnerabilityID: p.Vulnerability.VulnerabilityID,
+		AnalysisID:      analysisID,
+		AccountID:       p.Decision.AccountID,
+		AccountEmail:    p.Decision.AccountEmail,
+		AccountUsername: p.Decision.AccountUsername,
+		Approver:        p.Decision.Approver,
+		ExpiresAt:       *p.Decision.ExpiresAt,
+		CreatedAt:       time.Now(),
+	}
+}
+
+func (p *Propagation) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{"type": p.Decision.Type}
+}
+
+func (p *Propagation) ToFilter() map[string]interface{} {
+	return map[string]interface{}{"vulnerability_id": p.Vulnerability.VulnerabilityID}
+}
+
+// Apply sets the type of the triage on the vulnerability, so the triage is reflected on the saved analysis
+func (p *Propagation) Apply() {
+	p.Vulnerability.Type = p.Decision.Type
+}
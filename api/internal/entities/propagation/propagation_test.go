@@ -0,0 +1,80 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+)
+
+func newPropagation() *Propagation {
+	return &Propagation{
+		Vulnerability: &vulnerability.Vulnerability{VulnerabilityID: uuid.New(), Severity: severities.High,
+			Type: vulnerabilityEnums.Vulnerability},
+		Decision: &Decision{VulnerabilityID: uuid.New(), Type: vulnerabilityEnums.RiskAccepted,
+			Justification: "test", AccountID: uuid.New(), AccountEmail: "test@horusec.io", AccountUsername: "test",
+			Approver: "approver"},
+	}
+}
+
+func TestPropagation_ToEvent(t *testing.T) {
+	t.Run("Should create the history event with the account of the decision", func(t *testing.T) {
+		propagation := newPropagation()
+		analysisID := uuid.New()
+		event := propagation.ToEvent(analysisID)
+		assert.NotEqual(t, uuid.Nil, event.EventID)
+		assert.Equal(t, propagation.Vulnerability.VulnerabilityID, event.VulnerabilityID)
+		assert.Equal(t, analysisID, event.AnalysisID)
+		assert.Equal(t, propagation.Decision.AccountID, event.AccountID)
+		assert.Equal(t, vulnerabilityEnums.Vulnerability, event.OldType)
+		assert.Equal(t, vulnerabilityEnums.RiskAccepted, event.NewType)
+		assert.Equal(t, severities.High, event.NewSeverity)
+		assert.Equal(t, "test", event.Justification)
+		assert.Equal(t, propagation.Decision.VulnerabilityID, *event.PropagatedFromID)
+	})
+}
+
+func TestPropagation_ToRiskAcceptance(t *testing.T) {
+	t.Run("Should return nil when the decision does not expire", func(t *testing.T) {
+		assert.Nil(t, newPropagation().ToRiskAcceptance(uuid.New()))
+	})
+	t.Run("Should return the risk acceptance with the expiry of the decision", func(t *testing.T) {
+		propagation := newPropagation()
+		expiresAt := time.Now().Add(time.Hour)
+		propagation.Decision.ExpiresAt = &expiresAt
+		acceptance := propagation.ToRiskAcceptance(uuid.New())
+		assert.Equal(t, propagation.Vulnerability.VulnerabilityID, acceptance.VulnerabilityID)
+		assert.Equal(t, expiresAt, acceptance.ExpiresAt)
+		assert.Equal(t, "approver", acceptance.Approver)
+		assert.Equal(t, propagation.Decision.AccountID, acceptance.AccountID)
+	})
+}
+
+func TestPropagation_Apply(t *testing.T) {
+	t.Run("Should set the type of the decision on the vulnerability", func(t *testing.T) {
+		propagation := newPropagation()
+		propagation.Apply()
+		assert.Equal(t, vulnerabilityEnums.RiskAccepted, propagation.Vulnerability.Type)
+		assert.Equal(t, vulnerabilityEnums.RiskAccepted, propagation.ToUpdateMap()["type"])
+		assert.Equal(t, propagation.Vulnerability.VulnerabilityID, propagation.ToFilter()["vulnerability_id"])
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+
+	propagationEnums "github.com/ZupIT/horusec-platform/api/internal/enums/propagation"
+)
+
+// Settings is the opt-in of the workspace managed by the vulnerability service, when enabled the new unclassified
+// vulnerabilities receive the triage of the same vulnerability in the other repositories of the workspace
+type Settings struct {
+	IsEnabled bool   `gorm:"Column:is_enabled"`
+	MatchBy   string `gorm:"Column:match_by"`
+}
+
+func (s *Settings) IsMatchByCode() bool {
+	return s.MatchBy == propagationEnums.MatchByCode
+}
+
+// GetMatchKey returns the value compared with the triaged vulnerabilities, the hash or the code without its
+// whitespace differences, with the same normalization used by the vulnerability service
+func (s *Settings) GetMatchKey(vuln *vulnerability.Vulnerability) string {
+	if !s.IsMatchByCode() {
+		return vuln.VulnHash
+	}
+
+	return NormalizeCode(vuln.Code)
+}
+
+// NormalizeCode replaces each sequence of whitespaces of the code by a single space, trimming the result
+func NormalizeCode(code string) string {
+	return strings.Join(strings.Fields(code), " ")
+}
+
+// GetFileName returns the file without its folders, so the same file is matched in forks with other structures
+func GetFileName(file string) string {
+	return file[strings.LastIndex(file, "/")+1:]
+}
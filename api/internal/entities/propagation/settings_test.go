@@ -0,0 +1,48 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+
+	propagationEnums "github.com/ZupIT/horusec-platform/api/internal/enums/propagation"
+)
+
+func TestSettings_GetMatchKey(t *testing.T) {
+	vuln := &vulnerability.Vulnerability{VulnHash: "hash", Code: "  if (a ==\n\tb) {  "}
+
+	t.Run("Should return the hash when matching by hash", func(t *testing.T) {
+		settings := &Settings{MatchBy: propagationEnums.MatchByHash}
+		assert.False(t, settings.IsMatchByCode())
+		assert.Equal(t, "hash", settings.GetMatchKey(vuln))
+	})
+	t.Run("Should return the normalized code when matching by code", func(t *testing.T) {
+		settings := &Settings{MatchBy: propagationEnums.MatchByCode}
+		assert.True(t, settings.IsMatchByCode())
+		assert.Equal(t, "if (a == b) {", settings.GetMatchKey(vuln))
+	})
+}
+
+func TestGetFileName(t *testing.T) {
+	t.Run("Should return the file without its folders", func(t *testing.T) {
+		assert.Equal(t, "main.go", GetFileName("src/cmd/main.go"))
+		assert.Equal(t, "main.go", GetFileName("main.go"))
+		assert.Equal(t, "", GetFileName(""))
+	})
+}
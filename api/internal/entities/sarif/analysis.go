@@ -0,0 +1,302 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarif
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/cli"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	analysisEnum "github.com/ZupIT/horusec-devkit/pkg/enums/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	vulnerabilityEnum "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/google/uuid"
+)
+
+const (
+	levelError   = "error"
+	levelWarning = "warning"
+	levelNote    = "note"
+	levelNone    = "none"
+
+	propertySecuritySeverity = "security-severity"
+	propertyPrecision        = "precision"
+)
+
+// ToAnalysisData maps all runs of the report to a single analysis, each run result is a vulnerability
+func (r *Report) ToAnalysisData(repositoryName string) *cli.AnalysisData {
+	entity := &analysis.Analysis{
+		ID:             uuid.New(),
+		RepositoryName: repositoryName,
+		Status:         analysisEnum.Success,
+	}
+
+	entity.CreatedAt, entity.FinishedAt = r.getAnalysisTime()
+	for index := range r.Runs {
+		entity.AnalysisVulnerabilities = append(entity.AnalysisVulnerabilities,
+			r.Runs[index].toAnalysisVulnerabilities(entity)...)
+	}
+
+	return &cli.AnalysisData{Analysis: entity, RepositoryName: repositoryName}
+}
+
+func (r *Report) getAnalysisTime() (createdAt, finishedAt time.Time) {
+	now := time.Now()
+	createdAt, finishedAt = now, time.Time{}
+
+	for index := range r.Runs {
+		for _, invocation := range r.Runs[index].Invocations {
+			if invocation.StartTimeUTC != nil && invocation.StartTimeUTC.Before(createdAt) {
+				createdAt = *invocation.StartTimeUTC
+			}
+
+			if invocation.EndTimeUTC != nil && invocation.EndTimeUTC.After(finishedAt) {
+				finishedAt = *invocation.EndTimeUTC
+			}
+		}
+	}
+
+	if finishedAt.IsZero() {
+		finishedAt = now
+	}
+
+	if createdAt.After(finishedAt) {
+		createdAt = finishedAt
+	}
+
+	return createdAt, finishedAt
+}
+
+func (r *Run) toAnalysisVulnerabilities(entity *analysis.Analysis) (manyToMany []analysis.AnalysisVulnerabilities) {
+	for index := range r.Results {
+		vuln := r.toVulnerability(&r.Results[index])
+		manyToMany = append(manyToMany, analysis.AnalysisVulnerabilities{
+			VulnerabilityID: vuln.VulnerabilityID,
+			AnalysisID:      entity.ID,
+			CreatedAt:       entity.CreatedAt,
+			Vulnerability:   vuln,
+		})
+	}
+
+	return manyToMany
+}
+
+func (r *Run) toVulnerability(result *Result) vulnerability.Vulnerability {
+	rule := r.GetRule(result)
+	location := result.GetPhysicalLocation()
+	file := getFile(location.ArtifactLocation.URI)
+
+	return vulnerability.Vulnerability{
+		VulnerabilityID: uuid.New(),
+		Line:            strconv.Itoa(location.Region.StartLine),
+		Column:          strconv.Itoa(location.Region.StartColumn),
+		Confidence:      getConfidence(rule),
+		File:            file,
+		Code:            location.Region.Snippet.Text,
+		Details:         getDetails(rule, result),
+		SecurityTool:    r.getSecurityTool(),
+		Language:        getLanguage(file),
+		Severity:        getSeverity(rule, result),
+		Type:            vulnerabilityEnum.Vulnerability,
+		VulnHash:        r.getVulnHash(rule, result, file),
+	}
+}
+
+// getSecurityTool returns the horusec tool when the driver is a tool known by horusec, as Semgrep or Trivy,
+// otherwise the driver name is used
+func (r *Run) getSecurityTool() tools.Tool {
+	name := strings.ToLower(strings.TrimSpace(r.Tool.Driver.Name))
+	for _, tool := range tools.Values() {
+		if strings.HasPrefix(name, strings.ToLower(tool.ToString())) {
+			return tool
+		}
+	}
+
+	return tools.Tool(strings.TrimSpace(r.Tool.Driver.Name))
+}
+
+// getVulnHash generates a stable hash to the result, using the fingerprints calculated by the tool when
+// available, since they don't change when the code moves on the file. Otherwise, the location is used
+func (r *Run) getVulnHash(rule *Rule, result *Result, file string) string {
+	values := []string{r.getSecurityTool().ToString(), rule.ID, file}
+
+	fingerprints := getFingerprints(result)
+	if len(fingerprints) > 0 {
+		values = append(values, fingerprints...)
+	} else {
+		region := result.GetPhysicalLocation().Region
+		values = append(values, strconv.Itoa(region.StartLine), strconv.Itoa(region.StartColumn),
+			region.Snippet.Text, result.Message.Text)
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join(values, "|")))
+	return hex.EncodeToString(hash[:])
+}
+
+func getFingerprints(result *Result) (fingerprints []string) {
+	for key, value := range result.Fingerprints {
+		fingerprints = append(fingerprints, key+"="+value)
+	}
+
+	for key, value := range result.PartialFingerprints {
+		fingerprints = append(fingerprints, key+"="+value)
+	}
+
+	sort.Strings(fingerprints)
+	return fingerprints
+}
+
+func getFile(uri string) string {
+	return strings.TrimPrefix(uri, "file://")
+}
+
+func getDetails(rule *Rule, result *Result) string {
+	title := rule.ShortDescription.Text
+	if title == "" {
+		title = rule.Name
+	}
+
+	if title == "" {
+		return fmt.Sprintf("(%s) %s", rule.ID, result.Message.Text)
+	}
+
+	return fmt.Sprintf("(%s) %s\n%s", rule.ID, title, result.Message.Text)
+}
+
+// getSeverity uses the security severity score when informed, as CodeQL and Trivy do,
+// otherwise the level of the result or the default level of the rule is used
+func getSeverity(rule *Rule, result *Result) severities.Severity {
+	if score, err := getSecuritySeverityScore(rule, result); err == nil {
+		return getSeverityByScore(score)
+	}
+
+	return getSeverityByLevel(getLevel(rule, result))
+}
+
+func getSecuritySeverityScore(rule *Rule, result *Result) (float64, error) {
+	score := result.Properties.GetString(propertySecuritySeverity)
+	if score == "" {
+		score = rule.Properties.GetString(propertySecuritySeverity)
+	}
+
+	return strconv.ParseFloat(score, 64)
+}
+
+// getSeverityByScore uses the CVSS v3 qualitative severity rating scale
+func getSeverityByScore(score float64) severities.Severity {
+	switch {
+	case score >= 9.0:
+		return severities.Critical
+	case score >= 7.0:
+		return severities.High
+	case score >= 4.0:
+		return severities.Medium
+	case score >= 0.1:
+		return severities.Low
+	default:
+		return severities.Info
+	}
+}
+
+func getLevel(rule *Rule, result *Result) string {
+	if result.Level != "" {
+		return result.Level
+	}
+
+	if rule.DefaultConfiguration.Level != "" {
+		return rule.DefaultConfiguration.Level
+	}
+
+	return levelWarning
+}
+
+func getSeverityByLevel(level string) severities.Severity {
+	switch strings.ToLower(level) {
+	case levelError:
+		return severities.High
+	case levelWarning:
+		return severities.Medium
+	case levelNote:
+		return severities.Low
+	case levelNone:
+		return severities.Info
+	default:
+		return severities.Unknown
+	}
+}
+
+func getConfidence(rule *Rule) confidence.Confidence {
+	switch strings.ToLower(rule.Properties.GetString(propertyPrecision)) {
+	case "very-high", "high":
+		return confidence.High
+	case "low":
+		return confidence.Low
+	default:
+		return confidence.Medium
+	}
+}
+
+// nolint:gocyclo // map of extensions is not necessary broken
+func getLanguage(file string) languages.Language {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".go":
+		return languages.Go
+	case ".cs":
+		return languages.CSharp
+	case ".dart":
+		return languages.Dart
+	case ".rb":
+		return languages.Ruby
+	case ".py":
+		return languages.Python
+	case ".java":
+		return languages.Java
+	case ".kt", ".kts":
+		return languages.Kotlin
+	case ".js", ".jsx", ".mjs":
+		return languages.Javascript
+	case ".ts", ".tsx":
+		return languages.Typescript
+	case ".tf", ".hcl":
+		return languages.HCL
+	case ".c", ".h", ".cc", ".cpp", ".hpp":
+		return languages.C
+	case ".php":
+		return languages.PHP
+	case ".html", ".htm":
+		return languages.HTML
+	case ".yaml", ".yml":
+		return languages.Yaml
+	case ".ex", ".exs":
+		return languages.Elixir
+	case ".sh", ".bash":
+		return languages.Shell
+	case ".swift":
+		return languages.Swift
+	default:
+		return languages.Unknown
+	}
+}
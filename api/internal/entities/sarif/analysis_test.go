@@ -0,0 +1,179 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarif
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	analysisEnum "github.com/ZupIT/horusec-devkit/pkg/enums/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	vulnerabilityEnum "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/stretchr/testify/assert"
+)
+
+func newReportFixture(t *testing.T) *Report {
+	content, err := os.ReadFile("sarif_mock.json")
+	assert.NoError(t, err)
+	report := &Report{}
+	assert.NoError(t, json.Unmarshal(content, report))
+	return report
+}
+
+func TestReport_ToAnalysisData(t *testing.T) {
+	t.Run("Should map all runs results to analysis vulnerabilities", func(t *testing.T) {
+		analysisData := newReportFixture(t).ToAnalysisData("my-repository")
+
+		assert.Equal(t, "my-repository", analysisData.RepositoryName)
+		assert.Equal(t, "my-repository", analysisData.Analysis.RepositoryName)
+		assert.Equal(t, analysisEnum.Success, analysisData.Analysis.Status)
+		assert.Equal(t, time.Date(2021, 12, 30, 10, 0, 0, 0, time.UTC), analysisData.Analysis.CreatedAt.UTC())
+		assert.Equal(t, time.Date(2021, 12, 30, 10, 5, 0, 0, time.UTC), analysisData.Analysis.FinishedAt.UTC())
+		assert.Len(t, analysisData.Analysis.AnalysisVulnerabilities, 2)
+		for _, item := range analysisData.Analysis.AnalysisVulnerabilities {
+			assert.Equal(t, analysisData.Analysis.ID, item.AnalysisID)
+			assert.Equal(t, item.VulnerabilityID, item.Vulnerability.VulnerabilityID)
+			assert.Equal(t, vulnerabilityEnum.Vulnerability, item.Vulnerability.Type)
+		}
+	})
+	t.Run("Should map result with rule and security severity", func(t *testing.T) {
+		vuln := newReportFixture(t).ToAnalysisData("").Analysis.AnalysisVulnerabilities[0].Vulnerability
+
+		assert.Equal(t, tools.Tool("CodeQL"), vuln.SecurityTool)
+		assert.Equal(t, severities.High, vuln.Severity)
+		assert.Equal(t, confidence.High, vuln.Confidence)
+		assert.Equal(t, languages.Go, vuln.Language)
+		assert.Equal(t, "internal/repository/user.go", vuln.File)
+		assert.Equal(t, "42", vuln.Line)
+		assert.Equal(t, "7", vuln.Column)
+		assert.Equal(t, "db.Query(query)", vuln.Code)
+		assert.Equal(t, "(go/sql-injection) Database query built from user-controlled sources\n"+
+			"This query depends on a user-provided value.", vuln.Details)
+		assert.Len(t, vuln.VulnHash, 64)
+	})
+	t.Run("Should map result of horusec known tool with level", func(t *testing.T) {
+		vuln := newReportFixture(t).ToAnalysisData("").Analysis.AnalysisVulnerabilities[1].Vulnerability
+
+		assert.Equal(t, tools.Semgrep, vuln.SecurityTool)
+		assert.Equal(t, severities.Medium, vuln.Severity)
+		assert.Equal(t, confidence.Medium, vuln.Confidence)
+		assert.Equal(t, languages.Javascript, vuln.Language)
+		assert.Equal(t, "src/app.js", vuln.File)
+		assert.Equal(t, "(javascript.eval) Detected eval with user input.", vuln.Details)
+	})
+	t.Run("Should generate the same hashes for the same report", func(t *testing.T) {
+		first := newReportFixture(t).ToAnalysisData("").Analysis.AnalysisVulnerabilities
+		second := newReportFixture(t).ToAnalysisData("").Analysis.AnalysisVulnerabilities
+
+		assert.Equal(t, first[0].Vulnerability.VulnHash, second[0].Vulnerability.VulnHash)
+		assert.Equal(t, first[1].Vulnerability.VulnHash, second[1].Vulnerability.VulnHash)
+		assert.NotEqual(t, first[0].Vulnerability.VulnHash, first[1].Vulnerability.VulnHash)
+		assert.NotEqual(t, first[0].Vulnerability.VulnerabilityID, second[0].Vulnerability.VulnerabilityID)
+	})
+	t.Run("Should keep hash when result with fingerprints moves on the file", func(t *testing.T) {
+		report := newReportFixture(t)
+		before := report.ToAnalysisData("").Analysis.AnalysisVulnerabilities[0].Vulnerability.VulnHash
+
+		report.Runs[0].Results[0].Locations[0].PhysicalLocation.Region.StartLine = 50
+		after := report.ToAnalysisData("").Analysis.AnalysisVulnerabilities[0].Vulnerability.VulnHash
+		assert.Equal(t, before, after)
+	})
+	t.Run("Should change hash when result without fingerprints moves on the file", func(t *testing.T) {
+		report := newReportFixture(t)
+		before := report.ToAnalysisData("").Analysis.AnalysisVulnerabilities[1].Vulnerability.VulnHash
+
+		report.Runs[1].Results[0].Locations[0].PhysicalLocation.Region.StartLine = 50
+		after := report.ToAnalysisData("").Analysis.AnalysisVulnerabilities[1].Vulnerability.VulnHash
+		assert.NotEqual(t, before, after)
+	})
+	t.Run("Should use current time when report has no invocations", func(t *testing.T) {
+		analysisData := (&Report{Version: "2.1.0", Runs: []Run{{}}}).ToAnalysisData("")
+		assert.WithinDuration(t, time.Now(), analysisData.Analysis.CreatedAt, time.Minute)
+		assert.WithinDuration(t, time.Now(), analysisData.Analysis.FinishedAt, time.Minute)
+		assert.Empty(t, analysisData.Analysis.AnalysisVulnerabilities)
+	})
+	t.Run("Should not set created at after finished at", func(t *testing.T) {
+		endTime := time.Now().Add(-time.Hour)
+		analysisData := (&Report{Runs: []Run{{Invocations: []Invocation{{EndTimeUTC: &endTime}}}}}).ToAnalysisData("")
+		assert.Equal(t, endTime, analysisData.Analysis.CreatedAt)
+		assert.Equal(t, endTime, analysisData.Analysis.FinishedAt)
+	})
+}
+
+func TestGetSeverity(t *testing.T) {
+	t.Run("Should get severity by security severity score", func(t *testing.T) {
+		assert.Equal(t, severities.Critical, getSeverity(&Rule{}, &Result{Properties: Properties{
+			propertySecuritySeverity: 9.8}}))
+		assert.Equal(t, severities.High, getSeverity(&Rule{Properties: Properties{propertySecuritySeverity: "7.0"}},
+			&Result{}))
+		assert.Equal(t, severities.Medium, getSeverity(&Rule{Properties: Properties{propertySecuritySeverity: "5.3"}},
+			&Result{Level: levelError}))
+		assert.Equal(t, severities.Low, getSeverity(&Rule{Properties: Properties{propertySecuritySeverity: "2"}},
+			&Result{}))
+		assert.Equal(t, severities.Info, getSeverity(&Rule{Properties: Properties{propertySecuritySeverity: "0"}},
+			&Result{}))
+	})
+	t.Run("Should get severity by level", func(t *testing.T) {
+		assert.Equal(t, severities.High, getSeverity(&Rule{}, &Result{Level: levelError}))
+		assert.Equal(t, severities.Medium, getSeverity(&Rule{}, &Result{Level: levelWarning}))
+		assert.Equal(t, severities.Low, getSeverity(&Rule{}, &Result{Level: levelNote}))
+		assert.Equal(t, severities.Info, getSeverity(&Rule{}, &Result{Level: levelNone}))
+		assert.Equal(t, severities.Unknown, getSeverity(&Rule{}, &Result{Level: "test"}))
+	})
+	t.Run("Should get severity by rule default level or warning when level is not set", func(t *testing.T) {
+		assert.Equal(t, severities.Low, getSeverity(&Rule{DefaultConfiguration: Configuration{Level: levelNote}},
+			&Result{}))
+		assert.Equal(t, severities.Medium, getSeverity(&Rule{}, &Result{}))
+	})
+}
+
+func TestGetConfidence(t *testing.T) {
+	t.Run("Should get confidence by rule precision", func(t *testing.T) {
+		assert.Equal(t, confidence.High, getConfidence(&Rule{Properties: Properties{propertyPrecision: "very-high"}}))
+		assert.Equal(t, confidence.High, getConfidence(&Rule{Properties: Properties{propertyPrecision: "high"}}))
+		assert.Equal(t, confidence.Medium, getConfidence(&Rule{Properties: Properties{propertyPrecision: "medium"}}))
+		assert.Equal(t, confidence.Low, getConfidence(&Rule{Properties: Properties{propertyPrecision: "low"}}))
+		assert.Equal(t, confidence.Medium, getConfidence(&Rule{}))
+	})
+}
+
+func TestGetLanguage(t *testing.T) {
+	t.Run("Should get language by file extension", func(t *testing.T) {
+		files := map[string]languages.Language{
+			"main.go": languages.Go, "Program.cs": languages.CSharp, "main.dart": languages.Dart,
+			"app.rb": languages.Ruby, "app.py": languages.Python, "App.java": languages.Java,
+			"App.kt": languages.Kotlin, "app.jsx": languages.Javascript, "app.ts": languages.Typescript,
+			"main.tf": languages.HCL, "main.cpp": languages.C, "index.php": languages.PHP,
+			"index.html": languages.HTML, "values.yaml": languages.Yaml, "mix.exs": languages.Elixir,
+			"run.sh": languages.Shell, "App.swift": languages.Swift, "Dockerfile": languages.Unknown,
+		}
+		for file, language := range files {
+			assert.Equal(t, language, getLanguage(file), file)
+		}
+	})
+}
+
+func TestRun_getSecurityTool(t *testing.T) {
+	t.Run("Should get horusec tool ignoring case or driver name", func(t *testing.T) {
+		assert.Equal(t, tools.Trivy, (&Run{Tool: Tool{Driver: Driver{Name: "trivy"}}}).getSecurityTool())
+		assert.Equal(t, tools.Semgrep, (&Run{Tool: Tool{Driver: Driver{Name: "Semgrep OSS"}}}).getSecurityTool())
+		assert.Equal(t, tools.Tool("CodeQL"), (&Run{Tool: Tool{Driver: Driver{Name: " CodeQL "}}}).getSecurityTool())
+	})
+}
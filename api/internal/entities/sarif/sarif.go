@@ -0,0 +1,138 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarif
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Report is the subset of the SARIF 2.1.0 log format used to create a horusec analysis,
+// see https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type Report struct {
+	Version string `json:"version" example:"2.1.0"`
+	Schema  string `json:"$schema"`
+	Runs    []Run  `json:"runs"`
+}
+
+type Run struct {
+	Tool        Tool         `json:"tool"`
+	Results     []Result     `json:"results"`
+	Invocations []Invocation `json:"invocations"`
+}
+
+type Tool struct {
+	Driver Driver `json:"driver"`
+}
+
+type Driver struct {
+	Name            string `json:"name" example:"CodeQL"`
+	SemanticVersion string `json:"semanticVersion"`
+	Rules           []Rule `json:"rules"`
+}
+
+type Rule struct {
+	ID                   string        `json:"id"`
+	Name                 string        `json:"name"`
+	ShortDescription     Message       `json:"shortDescription"`
+	FullDescription      Message       `json:"fullDescription"`
+	Help                 Message       `json:"help"`
+	DefaultConfiguration Configuration `json:"defaultConfiguration"`
+	Properties           Properties    `json:"properties"`
+}
+
+type Configuration struct {
+	Level string `json:"level" example:"warning" enums:"none,note,warning,error"`
+}
+
+type Invocation struct {
+	StartTimeUTC *time.Time `json:"startTimeUtc"`
+	EndTimeUTC   *time.Time `json:"endTimeUtc"`
+}
+
+type Result struct {
+	RuleID              string            `json:"ruleId"`
+	RuleIndex           *int              `json:"ruleIndex"`
+	Level               string            `json:"level" example:"warning" enums:"none,note,warning,error"`
+	Message             Message           `json:"message"`
+	Locations           []Location        `json:"locations"`
+	PartialFingerprints map[string]string `json:"partialFingerprints"`
+	Fingerprints        map[string]string `json:"fingerprints"`
+	Properties          Properties        `json:"properties"`
+}
+
+type Message struct {
+	Text string `json:"text"`
+}
+
+type Location struct {
+	PhysicalLocation PhysicalLocation `json:"physicalLocation"`
+}
+
+type PhysicalLocation struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+	Region           Region           `json:"region"`
+}
+
+type ArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type Region struct {
+	StartLine   int     `json:"startLine"`
+	StartColumn int     `json:"startColumn"`
+	Snippet     Message `json:"snippet"`
+}
+
+type Properties map[string]interface{}
+
+// GetString returns the property value as string, numbers are formatted without the trailing zeros
+func (p Properties) GetString(key string) string {
+	switch value := p[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// GetRule returns the rule of the result, searching first by the rule index and then by the rule id
+func (r *Run) GetRule(result *Result) *Rule {
+	rules := r.Tool.Driver.Rules
+	if result.RuleIndex != nil && *result.RuleIndex >= 0 && *result.RuleIndex < len(rules) {
+		return &rules[*result.RuleIndex]
+	}
+
+	for index := range rules {
+		if rules[index].ID == result.RuleID {
+			return &rules[index]
+		}
+	}
+
+	return &Rule{ID: result.RuleID}
+}
+
+func (r *Result) GetPhysicalLocation() PhysicalLocation {
+	if len(r.Locations) == 0 {
+		return PhysicalLocation{}
+	}
+
+	return r.Locations[0].PhysicalLocation
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarif
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProperties_GetString(t *testing.T) {
+	t.Run("Should return property value as string", func(t *testing.T) {
+		properties := Properties{"string": "8.8", "number": 9.1, "bool": true}
+		assert.Equal(t, "8.8", properties.GetString("string"))
+		assert.Equal(t, "9.1", properties.GetString("number"))
+		assert.Equal(t, "true", properties.GetString("bool"))
+		assert.Equal(t, "", properties.GetString("missing"))
+	})
+}
+
+func TestRun_GetRule(t *testing.T) {
+	run := &Run{Tool: Tool{Driver: Driver{Rules: []Rule{{ID: "rule-0"}, {ID: "rule-1"}}}}}
+
+	t.Run("Should return rule by index", func(t *testing.T) {
+		index := 1
+		assert.Equal(t, "rule-1", run.GetRule(&Result{RuleID: "rule-0", RuleIndex: &index}).ID)
+	})
+	t.Run("Should return rule by id when index is invalid", func(t *testing.T) {
+		index := 10
+		assert.Equal(t, "rule-0", run.GetRule(&Result{RuleID: "rule-0", RuleIndex: &index}).ID)
+	})
+	t.Run("Should return rule with result rule id when rule is not declared", func(t *testing.T) {
+		rule := run.GetRule(&Result{RuleID: "rule-2"})
+		assert.Equal(t, "rule-2", rule.ID)
+		assert.Empty(t, rule.ShortDescription.Text)
+	})
+}
+
+func TestResult_GetPhysicalLocation(t *testing.T) {
+	t.Run("Should return first location of result", func(t *testing.T) {
+		result := &Result{Locations: []Location{
+			{PhysicalLocation: PhysicalLocation{ArtifactLocation: ArtifactLocation{URI: "first.go"}}},
+			{PhysicalLocation: PhysicalLocation{ArtifactLocation: ArtifactLocation{URI: "second.go"}}},
+		}}
+		assert.Equal(t, "first.go", result.GetPhysicalLocation().ArtifactLocation.URI)
+	})
+	t.Run("Should return empty location when result has no locations", func(t *testing.T) {
+		assert.Empty(t, (&Result{}).GetPhysicalLocation().ArtifactLocation.URI)
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+)
+
+// Rule is a suppression rule managed by the vulnerability service, it classifies the new unclassified
+// vulnerabilities matched by all its informed matchers
+type Rule struct {
+	RuleID          uuid.UUID               `json:"ruleID" gorm:"Column:rule_id"`
+	WorkspaceID     uuid.UUID               `json:"workspaceID" gorm:"Column:workspace_id"`
+	RepositoryID    *uuid.UUID              `json:"repositoryID" gorm:"Column:repository_id"`
+	Name            string                  `json:"name" gorm:"Column:name"`
+	FilePattern     string                  `json:"filePattern" gorm:"Column:file_pattern"`
+	Details         string                  `json:"details" gorm:"Column:details"`
+	SecurityTool    string                  `json:"securityTool" gorm:"Column:security_tool"`
+	Language        string                  `json:"language" gorm:"Column:language"`
+	VulnHash        string                  `json:"vulnHash" gorm:"Column:vuln_hash"`
+	Type            vulnerabilityEnums.Type `json:"type" gorm:"Column:type"`
+	Justification   string                  `json:"justification" gorm:"Column:justification"`
+	AccountID       uuid.UUID               `json:"accountID" gorm:"Column:account_id"`
+	AccountEmail    string                  `json:"accountEmail" gorm:"Column:account_email"`
+	AccountUsername string                  `json:"accountUsername" gorm:"Column:account_username"`
+
+	filePatternRegex *regexp.Regexp
+}
+
+// Matches checks the vulnerability against the informed matchers of the rule, with the same semantics used by the
+// vulnerability service to preview the rule: the file pattern is a glob and the details are matched when containing
+// the text, case insensitive
+func (r *Rule) Matches(vuln *vulnerability.Vulnerability) bool {
+	return r.matchesFilePattern(vuln.File) &&
+		r.matchesDetails(vuln.Details) &&
+		r.matchesValue(r.SecurityTool, string(vuln.SecurityTool)) &&
+		r.matchesValue(r.Language, string(vuln.Language)) &&
+		r.matchesValue(r.VulnHash, vuln.VulnHash)
+}
+
+func (r *Rule) matchesFilePattern(file string) bool {
+	if r.FilePattern == "" {
+		return true
+	}
+
+	if r.filePatternRegex == nil {
+		r.filePatternRegex = regexp.MustCompile(globToRegex(r.FilePattern))
+	}
+
+	return r.filePatternRegex.MatchString(file)
+}
+
+func (r *Rule) matchesDetails(details string) bool {
+	return r.Details == "" || strings.Contains(strings.ToLower(details), strings.ToLower(r.Details))
+}
+
+func (r *Rule) matchesValue(expected, value string) bool {
+	return expected == "" || expected == value
+}
+
+// globToRegex converts a file glob to a regex, where ** matches any path, * matches any name inside a directory
+// and ? matches a single character of a name
+func globToRegex(glob string) string {
+	replacer := strings.NewReplacer(`\*\*`, ".*", `\*`, "[^/]*", `\?`, "[^/]")
+
+	return "^" + replacer.Replace(regexp.QuoteMeta(glob)) + "$"
+}
+
+type Rules []Rule
+
+// FindMatch returns the first rule that matches the vulnerability, nil when there is none
+func (r Rules) FindMatch(vuln *vulnerability.Vulnerability) *Rule {
+	for index := range r {
+		if r[index].Matches(vuln) {
+			return &r[index]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+)
+
+func newVulnerability() *vulnerability.Vulnerability {
+	return &vulnerability.Vulnerability{
+		File:         "internal/test/main_test.go",
+		Details:      "HS-GO-1: Hardcoded credentials",
+		SecurityTool: tools.HorusecEngine,
+		Language:     languages.Go,
+		VulnHash:     "1234",
+	}
+}
+
+func TestRule_Matches(t *testing.T) {
+	t.Run("Should match when all informed matchers match", func(t *testing.T) {
+		rule := &Rule{FilePattern: "internal/**/*_test.go", Details: "hs-go-1", SecurityTool: "HorusecEngine",
+			Language: "Go", VulnHash: "1234"}
+		assert.True(t, rule.Matches(newVulnerability()))
+	})
+	t.Run("Should not match when any informed matcher does not match", func(t *testing.T) {
+		assert.False(t, (&Rule{FilePattern: "test/**"}).Matches(newVulnerability()))
+		assert.False(t, (&Rule{FilePattern: "internal/*_test.go"}).Matches(newVulnerability()))
+		assert.False(t, (&Rule{Details: "HS-GO-2"}).Matches(newVulnerability()))
+		assert.False(t, (&Rule{SecurityTool: "GoSec"}).Matches(newVulnerability()))
+		assert.False(t, (&Rule{Language: "Python"}).Matches(newVulnerability()))
+		assert.False(t, (&Rule{FilePattern: "internal/**", VulnHash: "4321"}).Matches(newVulnerability()))
+	})
+	t.Run("Should match glob special characters literally", func(t *testing.T) {
+		vuln := newVulnerability()
+		vuln.File = "internal/test/main_test+go"
+		assert.False(t, (&Rule{FilePattern: "internal/**/*.go"}).Matches(vuln))
+		assert.True(t, (&Rule{FilePattern: "internal/test/main_tes?+go"}).Matches(vuln))
+	})
+}
+
+func TestRules_FindMatch(t *testing.T) {
+	t.Run("Should return the first matching rule", func(t *testing.T) {
+		rules := Rules{{Name: "other", Language: "Python"}, {Name: "first", Language: "Go"}, {Name: "second"}}
+		rule := rules.FindMatch(newVulnerability())
+		assert.NotNil(t, rule)
+		assert.Equal(t, "first", rule.Name)
+	})
+	t.Run("Should return nil when no rule matches", func(t *testing.T) {
+		rules := Rules{{Language: "Python"}}
+		assert.Nil(t, rules.FindMatch(newVulnerability()))
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+)
+
+// Suppression is a vulnerability of a new analysis classified by a rule
+type Suppression struct {
+	Vulnerability *vulnerability.Vulnerability
+	Rule          *Rule
+}
+
+// Event is the record of the vulnerability history created by the suppression, registered with the account that
+// saved the rule
+type Event struct {
+	EventID           uuid.UUID               `gorm:"Column:event_id"`
+	VulnerabilityID   uuid.UUID               `gorm:"Column:vulnerability_id"`
+	AnalysisID        uuid.UUID               `gorm:"Column:analysis_id"`
+	AccountID         uuid.UUID               `gorm:"Column:account_id"`
+	AccountEmail      string                  `gorm:"Column:account_email"`
+	AccountUsername   string                  `gorm:"Column:account_username"`
+	OldType           vulnerabilityEnums.Type `gorm:"Column:old_type"`
+	NewType           vulnerabilityEnums.Type `gorm:"Column:new_type"`
+	OldSeverity       severities.Severity     `gorm:"Column:old_severity"`
+	NewSeverity       severities.Severity     `gorm:"Column:new_severity"`
+	Justification     string                  `gorm:"Column:justification"`
+	SuppressionRuleID *uuid.UUID              `gorm:"Column:suppression_rule_id"`
+	CreatedAt         time.Time               `gorm:"Column:created_at"`
+}
+
+func (s *Suppression) ToEvent(analysisID uuid.UUID) *Event {
+	ruleID := s.Rule.RuleID
+
+	return &Event{
+		EventID:           uuid.New(),
+		VulnerabilityID:   s.Vulnerability.VulnerabilityID,
+		AnalysisID:        analysisID,
+		AccountID:         s.Rule.AccountID,
+		AccountEmail:      s.Rule.AccountEmail,
+		AccountUsername:   s.Rule.AccountUsername,
+		OldType:           s.Vulnerability.Type,
+		NewType:           s.Rule.Type,
+		OldSeverity:       s.Vulnerability.Severity,
+		NewSeverity:       s.Vulnerability.Severity,
+		Justification:     s.Rule.Justification,
+		SuppressionRuleID: &ruleID,
+		CreatedAt:         time.Now(),
+	}
+}
+
+func (s *Suppression) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{"type": s.Rule.Type}
+}
+
+func (s *Suppression) ToFilter() map[string]interface{} {
+	return map[string]interface{}{"vulnerability_id": s.Vulnerability.VulnerabilityID}
+}
+
+// Apply sets the type of the rule on the vulnerability, so the classification is reflected on the saved analysis
+func (s *Suppression) Apply() {
+	s.Vulnerability.Type = s.Rule.Type
+}
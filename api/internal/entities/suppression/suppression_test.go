@@ -0,0 +1,62 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+)
+
+func newSuppression() *Suppression {
+	return &Suppression{
+		Vulnerability: &vulnerability.Vulnerability{VulnerabilityID: uuid.New(), Severity: severities.High,
+			Type: vulnerabilityEnums.Vulnerability},
+		Rule: &Rule{RuleID: uuid.New(), Type: vulnerabilityEnums.FalsePositive, Justification: "test",
+			AccountID: uuid.New(), AccountEmail: "test@horusec.io", AccountUsername: "test"},
+	}
+}
+
+func TestSuppression_ToEvent(t *testing.T) {
+	t.Run("Should create the history event with the account of the rule", func(t *testing.T) {
+		suppression := newSuppression()
+		analysisID := uuid.New()
+		event := suppression.ToEvent(analysisID)
+		assert.NotEqual(t, uuid.Nil, event.EventID)
+		assert.Equal(t, suppression.Vulnerability.VulnerabilityID, event.VulnerabilityID)
+		assert.Equal(t, analysisID, event.AnalysisID)
+		assert.Equal(t, suppression.Rule.AccountID, event.AccountID)
+		assert.Equal(t, vulnerabilityEnums.Vulnerability, event.OldType)
+		assert.Equal(t, vulnerabilityEnums.FalsePositive, event.NewType)
+		assert.Equal(t, severities.High, event.NewSeverity)
+		assert.Equal(t, "test", event.Justification)
+		assert.Equal(t, suppression.Rule.RuleID, *event.SuppressionRuleID)
+	})
+}
+
+func TestSuppression_Apply(t *testing.T) {
+	t.Run("Should set the type of the rule on the vulnerability", func(t *testing.T) {
+		suppression := newSuppression()
+		suppression.Apply()
+		assert.Equal(t, vulnerabilityEnums.FalsePositive, suppression.Vulnerability.Type)
+		assert.Equal(t, vulnerabilityEnums.FalsePositive, suppression.ToUpdateMap()["type"])
+		assert.Equal(t, suppression.Vulnerability.VulnerabilityID, suppression.ToFilter()["vulnerability_id"])
+	})
+}
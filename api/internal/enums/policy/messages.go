@@ -0,0 +1,22 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+const (
+	MessageFailedToEvaluatePolicy = "{HORUSEC_API} failed to evaluate the quality gate policy of the analysis"
+	MessageSeverityCountViolation = "%d findings of severity %s and type %s, the max allowed is %d"
+	MessageFindingAgeViolation    = "%d findings of type %s unresolved for more than %d days"
+	MessageBlockedToolViolation   = "%d findings of type %s reported to the blocked language %q and tool %q"
+)
@@ -21,4 +21,8 @@ const (
 	AnalysisRouter          = BaseRouter + "/analysis"
 	DatabaseRepositoryTable = "repositories"
 	DatabaseWorkspaceTable  = "workspaces"
+	HeaderContentType       = "Content-Type"
+	ContentTypeSARIF        = "application/sarif+json"
+	SARIFVersion            = "2.1.0"
+	RepositoryNameQuery     = "repositoryName"
 )
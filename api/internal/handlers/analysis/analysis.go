@@ -21,6 +21,7 @@ import (
 	"github.com/google/uuid"
 
 	analysisController "github.com/ZupIT/horusec-platform/api/internal/controllers/analysis"
+	_ "github.com/ZupIT/horusec-platform/api/internal/entities/policy" // [swagger-import]
 	handlersEnums "github.com/ZupIT/horusec-platform/api/internal/handlers/analysis/enums"
 	tokenMiddlewareEnum "github.com/ZupIT/horusec-platform/api/internal/middelwares/token/enums"
 	analysisUseCases "github.com/ZupIT/horusec-platform/api/internal/usecases/analysis"
@@ -53,11 +54,13 @@ func (h *Handler) Options(w netHTTP.ResponseWriter, _ *netHTTP.Request) {
 // Post
 // @Tags Analysis
 // @Security ApiKeyAuth
-// @Description Start new analysis
+// @Description Start new analysis, SARIF 2.1.0 reports are accepted with the application/sarif+json content type
 // @ID start-new-analysis
 // @Accept  json
+// @Accept  application/sarif+json
 // @Produce  json
 // @Param SendNewAnalysis body cli.AnalysisData true "send new analysis info"
+// @Param repositoryName query string false "repository name of SARIF reports sent with a workspace token"
 // @Success 201 {object} entities.Response{content=string} "CREATED"
 // @Success 400 {object} entities.Response{content=string} "BAD REQUEST"
 // @Success 404 {object} entities.Response{content=string} "NOT FOUND"
@@ -126,12 +129,12 @@ func (h *Handler) saveAnalysis(w netHTTP.ResponseWriter, analysisEntity *analysi
 // Get
 // @Tags Analysis
 // @Security ApiKeyAuth
-// @Description Get analysis on database
+// @Description Get analysis on database with the verdict of the quality gate policy evaluated when it was saved
 // @ID get-one-analysis
 // @Accept  json
 // @Produce  json
 // @Param analysisID path string true "analysisID of the analysis"
-// @Success 200 {object} entities.Response{content=analysisEntities.Analysis} "OK"
+// @Success 200 {object} entities.Response{content=policy.AnalysisResponse} "OK"
 // @Success 400 {object} entities.Response{content=string} "BAD REQUEST"
 // @Success 404 {object} entities.Response{content=string} "NOT FOUND"
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
@@ -32,6 +32,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	analysisController "github.com/ZupIT/horusec-platform/api/internal/controllers/analysis"
+	policyEntities "github.com/ZupIT/horusec-platform/api/internal/entities/policy"
 
 	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	"github.com/ZupIT/horusec-devkit/pkg/entities/cli"
@@ -59,7 +60,7 @@ func TestHandler_Options(t *testing.T) {
 func TestHandler_Get(t *testing.T) {
 	t.Run("should return 200 with analysis created", func(t *testing.T) {
 		controllerMock := &analysisController.Mock{}
-		controllerMock.On("GetAnalysis").Return(&analysis.Analysis{}, nil)
+		controllerMock.On("GetAnalysis").Return(&policyEntities.AnalysisResponse{}, nil)
 		handler := NewAnalysisHandler(controllerMock)
 		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
 		w := httptest.NewRecorder()
@@ -74,7 +75,7 @@ func TestHandler_Get(t *testing.T) {
 	})
 	t.Run("should return 400 when not exists analysisID", func(t *testing.T) {
 		controllerMock := &analysisController.Mock{}
-		controllerMock.On("GetAnalysis").Return(&analysis.Analysis{}, nil)
+		controllerMock.On("GetAnalysis").Return(&policyEntities.AnalysisResponse{}, nil)
 		handler := NewAnalysisHandler(controllerMock)
 		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
 		w := httptest.NewRecorder()
@@ -85,7 +86,7 @@ func TestHandler_Get(t *testing.T) {
 	})
 	t.Run("should return 404 when not exists analysis", func(t *testing.T) {
 		controllerMock := &analysisController.Mock{}
-		controllerMock.On("GetAnalysis").Return(&analysis.Analysis{}, enums.ErrorNotFoundRecords)
+		controllerMock.On("GetAnalysis").Return(&policyEntities.AnalysisResponse{}, enums.ErrorNotFoundRecords)
 		handler := NewAnalysisHandler(controllerMock)
 		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
 		w := httptest.NewRecorder()
@@ -100,7 +101,7 @@ func TestHandler_Get(t *testing.T) {
 	})
 	t.Run("should return 500 when return error unexpected", func(t *testing.T) {
 		controllerMock := &analysisController.Mock{}
-		controllerMock.On("GetAnalysis").Return(&analysis.Analysis{}, errors.New("unexpected error"))
+		controllerMock.On("GetAnalysis").Return(&policyEntities.AnalysisResponse{}, errors.New("unexpected error"))
 		handler := NewAnalysisHandler(controllerMock)
 		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
 		w := httptest.NewRecorder()
@@ -0,0 +1,111 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	policyEntities "github.com/ZupIT/horusec-platform/api/internal/entities/policy"
+	policyEnums "github.com/ZupIT/horusec-platform/api/internal/enums/policy"
+)
+
+type IPolicy interface {
+	FindActivePolicy(workspaceID, repositoryID uuid.UUID) (*policyEntities.Policy, error)
+	ListFirstSeenDates(repositoryID uuid.UUID, hashes []string) (map[string]time.Time, error)
+	SaveVerdict(analysisID uuid.UUID, verdict *policyEntities.Verdict) error
+	FindVerdict(analysisID uuid.UUID) (*policyEntities.Verdict, error)
+}
+
+type Policy struct {
+	databaseWrite database.IDatabaseWrite
+	databaseRead  database.IDatabaseRead
+}
+
+type analysisVerdict struct {
+	PolicyVerdict *policyEntities.Verdict `json:"policyVerdict" gorm:"Column:policy_verdict"`
+}
+
+func NewRepositoriesPolicy(connection *database.Connection) IPolicy {
+	return &Policy{
+		databaseWrite: connection.Write,
+		databaseRead:  connection.Read,
+	}
+}
+
+// FindActivePolicy returns the active policy of the repository or, when the repository has none, the active
+// policy of the workspace. Nil is returned when there is no active policy
+func (p *Policy) FindActivePolicy(workspaceID, repositoryID uuid.UUID) (*policyEntities.Policy, error) {
+	query := `
+		SELECT policy_id, workspace_id, repository_id, name, rules
+		FROM policies
+		WHERE workspace_id = ? AND is_active = TRUE AND (repository_id = ? OR repository_id IS NULL)
+		ORDER BY repository_id IS NULL
+		LIMIT 1
+	`
+
+	policy := &policyEntities.Policy{}
+	res := p.databaseRead.Raw(query, policy, workspaceID, repositoryID)
+	if res.GetError() == databaseEnums.ErrorNotFoundRecords {
+		return nil, nil
+	}
+
+	return policy, res.GetError()
+}
+
+// ListFirstSeenDates returns the date that each vulnerability hash was found by the first time in the repository
+func (p *Policy) ListFirstSeenDates(repositoryID uuid.UUID, hashes []string) (map[string]time.Time, error) {
+	query := `
+		SELECT vulnerabilities.vuln_hash AS vuln_hash, MIN(analysis_vulnerabilities.created_at) AS first_seen_at
+		FROM vulnerabilities
+		INNER JOIN analysis_vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id
+		INNER JOIN analysis ON analysis_vulnerabilities.analysis_id = analysis.analysis_id
+		WHERE analysis.repository_id = ? AND vulnerabilities.vuln_hash IN ?
+		GROUP BY vulnerabilities.vuln_hash
+	`
+
+	firstSeen := &[]policyEntities.FirstSeen{}
+	if err := p.databaseRead.Raw(query, firstSeen, repositoryID, hashes).GetErrorExceptNotFound(); err != nil {
+		return nil, err
+	}
+
+	dates := map[string]time.Time{}
+	for _, value := range *firstSeen {
+		dates[value.VulnHash] = value.FirstSeenAt
+	}
+
+	return dates, nil
+}
+
+func (p *Policy) SaveVerdict(analysisID uuid.UUID, verdict *policyEntities.Verdict) error {
+	return p.databaseWrite.Update(map[string]interface{}{policyEnums.PolicyVerdictColumn: verdict},
+		map[string]interface{}{"analysis_id": analysisID}, policyEnums.DatabaseAnalysisTable).GetError()
+}
+
+// FindVerdict returns the verdict saved on the analysis, that is nil when no policy was evaluated
+func (p *Policy) FindVerdict(analysisID uuid.UUID) (*policyEntities.Verdict, error) {
+	query := `SELECT policy_verdict FROM analysis WHERE analysis_id = ?`
+
+	entity := &analysisVerdict{}
+	if err := p.databaseRead.Raw(query, entity, analysisID).GetErrorExceptNotFound(); err != nil {
+		return nil, err
+	}
+
+	return entity.PolicyVerdict, nil
+}
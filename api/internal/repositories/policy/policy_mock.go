@@ -0,0 +1,50 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	policyEntities "github.com/ZupIT/horusec-platform/api/internal/entities/policy"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) FindActivePolicy(_, _ uuid.UUID) (*policyEntities.Policy, error) {
+	args := m.MethodCalled("FindActivePolicy")
+	return args.Get(0).(*policyEntities.Policy), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListFirstSeenDates(_ uuid.UUID, _ []string) (map[string]time.Time, error) {
+	args := m.MethodCalled("ListFirstSeenDates")
+	return args.Get(0).(map[string]time.Time), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) SaveVerdict(_ uuid.UUID, verdict *policyEntities.Verdict) error {
+	args := m.MethodCalled("SaveVerdict", verdict)
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) FindVerdict(_ uuid.UUID) (*policyEntities.Verdict, error) {
+	args := m.MethodCalled("FindVerdict")
+	return args.Get(0).(*policyEntities.Verdict), utilsMock.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	policyEntities "github.com/ZupIT/horusec-platform/api/internal/entities/policy"
+	policyEnums "github.com/ZupIT/horusec-platform/api/internal/enums/policy"
+)
+
+func TestPolicy_FindActivePolicy(t *testing.T) {
+	t.Run("Should find active policy with success", func(t *testing.T) {
+		data := &policyEntities.Policy{
+			PolicyID: uuid.New(),
+			Name:     "test",
+			Rules:    policyEntities.Rules{{Type: policyEnums.SeverityCount, Severity: severities.Critical}},
+		}
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(1, nil, data))
+		res, err := NewRepositoriesPolicy(&database.Connection{Read: mockRead}).FindActivePolicy(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, data.PolicyID, res.PolicyID)
+		assert.Equal(t, data.Rules, res.Rules)
+	})
+	t.Run("Should return nil when there is no active policy", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+		res, err := NewRepositoriesPolicy(&database.Connection{Read: mockRead}).FindActivePolicy(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.Nil(t, res)
+	})
+	t.Run("Should return error when find active policy", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		_, err := NewRepositoriesPolicy(&database.Connection{Read: mockRead}).FindActivePolicy(uuid.New(), uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestPolicy_ListFirstSeenDates(t *testing.T) {
+	t.Run("Should list first seen dates by vulnerability hash", func(t *testing.T) {
+		firstSeenAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(1, nil, &[]policyEntities.FirstSeen{
+			{VulnHash: "1", FirstSeenAt: firstSeenAt},
+		}))
+		res, err := NewRepositoriesPolicy(&database.Connection{Read: mockRead}).
+			ListFirstSeenDates(uuid.New(), []string{"1", "2"})
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, firstSeenAt, res["1"])
+	})
+	t.Run("Should return empty dates when vulnerabilities were not found", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+		res, err := NewRepositoriesPolicy(&database.Connection{Read: mockRead}).
+			ListFirstSeenDates(uuid.New(), []string{"1"})
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+	})
+	t.Run("Should return error when list first seen dates", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		_, err := NewRepositoriesPolicy(&database.Connection{Read: mockRead}).
+			ListFirstSeenDates(uuid.New(), []string{"1"})
+		assert.Error(t, err)
+	})
+}
+
+func TestPolicy_SaveVerdict(t *testing.T) {
+	t.Run("Should save verdict on the analysis", func(t *testing.T) {
+		mockWrite := &database.Mock{}
+		mockWrite.On("Update").Return(response.NewResponse(1, nil, nil))
+		err := NewRepositoriesPolicy(&database.Connection{Write: mockWrite}).
+			SaveVerdict(uuid.New(), &policyEntities.Verdict{Status: policyEnums.Passed})
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when save verdict", func(t *testing.T) {
+		mockWrite := &database.Mock{}
+		mockWrite.On("Update").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		err := NewRepositoriesPolicy(&database.Connection{Write: mockWrite}).
+			SaveVerdict(uuid.New(), &policyEntities.Verdict{Status: policyEnums.Passed})
+		assert.Error(t, err)
+	})
+}
+
+func TestPolicy_FindVerdict(t *testing.T) {
+	t.Run("Should find verdict of the analysis", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(1, nil, &analysisVerdict{
+			PolicyVerdict: &policyEntities.Verdict{Status: policyEnums.Failed},
+		}))
+		res, err := NewRepositoriesPolicy(&database.Connection{Read: mockRead}).FindVerdict(uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, policyEnums.Failed, res.Status)
+	})
+	t.Run("Should return nil when analysis has no verdict", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(1, nil, &analysisVerdict{}))
+		res, err := NewRepositoriesPolicy(&database.Connection{Read: mockRead}).FindVerdict(uuid.New())
+		assert.NoError(t, err)
+		assert.Nil(t, res)
+	})
+	t.Run("Should return error when find verdict", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		_, err := NewRepositoriesPolicy(&database.Connection{Read: mockRead}).FindVerdict(uuid.New())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,156 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	propagationEntities "github.com/ZupIT/horusec-platform/api/internal/entities/propagation"
+	propagationEnums "github.com/ZupIT/horusec-platform/api/internal/enums/propagation"
+)
+
+type IPropagation interface {
+	FindSettings(workspaceID uuid.UUID) (*propagationEntities.Settings, error)
+	ListDecisions(workspaceID, repositoryID uuid.UUID, settings *propagationEntities.Settings,
+		keys []string) (propagationEntities.Decisions, error)
+	SavePropagations(analysisID uuid.UUID, propagations []propagationEntities.Propagation) error
+}
+
+type Propagation struct {
+	databaseWrite database.IDatabaseWrite
+	databaseRead  database.IDatabaseRead
+}
+
+func NewRepositoriesPropagation(connection *database.Connection) IPropagation {
+	return &Propagation{
+		databaseWrite: connection.Write,
+		databaseRead:  connection.Read,
+	}
+}
+
+// FindSettings returns the propagation settings of the workspace, the propagation is disabled when the workspace
+// never enabled it
+func (p *Propagation) FindSettings(workspaceID uuid.UUID) (*propagationEntities.Settings, error) {
+	query := `
+		SELECT is_enabled, match_by
+		FROM triage_propagation_settings
+		WHERE workspace_id = ?
+	`
+
+	settings := &propagationEntities.Settings{MatchBy: propagationEnums.MatchByHash}
+	return settings, p.databaseRead.Raw(query, settings, workspaceID).GetErrorExceptNotFound()
+}
+
+// ListDecisions returns the triaged vulnerabilities of the other repositories of the workspace matched by the keys,
+// with the account and justification of their latest change. Risks accepted with an expired date are ignored and
+// the most recent decisions are returned first, so they have priority when the same vulnerability has many ones
+func (p *Propagation) ListDecisions(workspaceID, repositoryID uuid.UUID, settings *propagationEntities.Settings,
+	keys []string) (propagationEntities.Decisions, error) {
+	decisions := propagationEntities.Decisions{}
+
+	return decisions, p.databaseRead.Raw(p.getListDecisionsQuery(settings), &decisions, workspaceID, repositoryID,
+		vulnerabilityEnums.Vulnerability, keys).GetErrorExceptNotFound()
+}
+
+func (p *Propagation) getListDecisionsQuery(settings *propagationEntities.Settings) string {
+	return fmt.Sprintf(`
+		SELECT decisions.* FROM (
+			SELECT DISTINCT ON (vulnerabilities.vulnerability_id) vulnerabilities.vulnerability_id,
+				vulnerabilities.vuln_hash, vulnerabilities.code, vulnerabilities.file, vulnerabilities.type,
+				events.justification, events.account_id, events.account_email, events.account_username,
+				COALESCE(acceptances.approver, '') AS approver, acceptances.expires_at,
+				events.created_at AS decided_at
+			FROM analysis
+			JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id
+			JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id
+			JOIN LATERAL (
+				SELECT vulnerability_events.new_type, vulnerability_events.justification,
+					vulnerability_events.account_id, vulnerability_events.account_email,
+					vulnerability_events.account_username, vulnerability_events.created_at
+				FROM vulnerability_events
+				WHERE vulnerability_events.vulnerability_id = vulnerabilities.vulnerability_id
+				ORDER BY vulnerability_events.created_at DESC
+				LIMIT 1
+			) AS events ON events.new_type = vulnerabilities.type
+			LEFT JOIN vulnerability_risk_acceptances AS acceptances
+				ON acceptances.vulnerability_id = vulnerabilities.vulnerability_id
+			WHERE analysis.workspace_id = ? AND analysis.repository_id <> ? AND vulnerabilities.type <> ?
+				AND (acceptances.expires_at IS NULL OR acceptances.expires_at > NOW()) AND %[1]s IN (?)
+		) AS decisions
+		ORDER BY decisions.decided_at DESC
+	`, p.getMatchKeyColumn(settings))
+}
+
+// getMatchKeyColumn returns the column compared with the keys, normalizing the code the same way as the entities
+func (p *Propagation) getMatchKeyColumn(settings *propagationEntities.Settings) string {
+	if !settings.IsMatchByCode() {
+		return "vulnerabilities.vuln_hash"
+	}
+
+	return `btrim(regexp_replace(vulnerabilities.code, '\s+', ' ', 'g'))`
+}
+
+// SavePropagations classifies the vulnerabilities with the triage of the other repositories and registers them in
+// the history of the vulnerabilities, all propagations of the analysis are saved or none of them
+func (p *Propagation) SavePropagations(analysisID uuid.UUID, propagations []propagationEntities.Propagation) error {
+	transaction := p.databaseWrite.StartTransaction()
+
+	for index := range propagations {
+		if err := p.savePropagation(analysisID, &propagations[index], transaction); err != nil {
+			logger.LogError(propagationEnums.MessageFailedToRollbackPropagations,
+				transaction.RollbackTransaction().GetError())
+			return err
+		}
+	}
+
+	return transaction.CommitTransaction().GetError()
+}
+
+func (p *Propagation) savePropagation(analysisID uuid.UUID, propagation *propagationEntities.Propagation,
+	transaction database.IDatabaseWrite) error {
+	if err := transaction.Create(propagation.ToEvent(analysisID),
+		propagationEnums.DatabaseEventsTable).GetError(); err != nil {
+		return err
+	}
+
+	if err := transaction.Update(propagation.ToUpdateMap(), propagation.ToFilter(),
+		propagationEnums.DatabaseVulnerabilitiesTable).GetError(); err != nil {
+		return err
+	}
+
+	return p.saveRiskAcceptance(analysisID, propagation, transaction)
+}
+
+// saveRiskAcceptance replaces the expiry of the vulnerability by the one of the propagated triage, when it has one
+func (p *Propagation) saveRiskAcceptance(analysisID uuid.UUID, propagation *propagationEntities.Propagation,
+	transaction database.IDatabaseWrite) error {
+	acceptance := propagation.ToRiskAcceptance(analysisID)
+	if acceptance == nil {
+		return nil
+	}
+
+	if err := transaction.Delete(propagation.ToFilter(),
+		propagationEnums.DatabaseRiskAcceptancesTable).GetErrorExceptNotFound(); err != nil {
+		return err
+	}
+
+	return transaction.Create(acceptance, propagationEnums.DatabaseRiskAcceptancesTable).GetError()
+}
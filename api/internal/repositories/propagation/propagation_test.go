@@ -0,0 +1,164 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	propagationEntities "github.com/ZupIT/horusec-platform/api/internal/entities/propagation"
+	propagationEnums "github.com/ZupIT/horusec-platform/api/internal/enums/propagation"
+)
+
+func newPropagations(expiresAt *time.Time) []propagationEntities.Propagation {
+	return []propagationEntities.Propagation{
+		{Vulnerability: &vulnerability.Vulnerability{VulnerabilityID: uuid.New()},
+			Decision: &propagationEntities.Decision{VulnerabilityID: uuid.New(), ExpiresAt: expiresAt}},
+		{Vulnerability: &vulnerability.Vulnerability{VulnerabilityID: uuid.New()},
+			Decision: &propagationEntities.Decision{VulnerabilityID: uuid.New(), ExpiresAt: expiresAt}},
+	}
+}
+
+func TestPropagation_FindSettings(t *testing.T) {
+	t.Run("Should find the settings of the workspace", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(1, nil, propagationEntities.Settings{
+			IsEnabled: true, MatchBy: propagationEnums.MatchByCode}))
+		res, err := NewRepositoriesPropagation(&database.Connection{Read: mockRead}).FindSettings(uuid.New())
+		assert.NoError(t, err)
+		assert.True(t, res.IsEnabled)
+		assert.Equal(t, propagationEnums.MatchByCode, res.MatchBy)
+	})
+	t.Run("Should return disabled settings when the workspace never enabled it", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+		res, err := NewRepositoriesPropagation(&database.Connection{Read: mockRead}).FindSettings(uuid.New())
+		assert.NoError(t, err)
+		assert.False(t, res.IsEnabled)
+	})
+	t.Run("Should return error when find settings", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		_, err := NewRepositoriesPropagation(&database.Connection{Read: mockRead}).FindSettings(uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestPropagation_ListDecisions(t *testing.T) {
+	t.Run("Should list decisions matching by hash with success", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(1, nil, propagationEntities.Decisions{
+			{VulnerabilityID: uuid.New(), VulnHash: "hash"},
+		}))
+		res, err := NewRepositoriesPropagation(&database.Connection{Read: mockRead}).ListDecisions(uuid.New(),
+			uuid.New(), &propagationEntities.Settings{MatchBy: propagationEnums.MatchByHash}, []string{"hash"})
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, "hash", res[0].VulnHash)
+	})
+	t.Run("Should return empty decisions when matching by code without decisions", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+		res, err := NewRepositoriesPropagation(&database.Connection{Read: mockRead}).ListDecisions(uuid.New(),
+			uuid.New(), &propagationEntities.Settings{MatchBy: propagationEnums.MatchByCode}, []string{"code"})
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+	})
+	t.Run("Should return error when list decisions", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		_, err := NewRepositoriesPropagation(&database.Connection{Read: mockRead}).ListDecisions(uuid.New(),
+			uuid.New(), &propagationEntities.Settings{}, []string{"hash"})
+		assert.Error(t, err)
+	})
+}
+
+func TestPropagation_SavePropagations(t *testing.T) {
+	t.Run("Should save events and classify vulnerabilities in a transaction", func(t *testing.T) {
+		mockWrite := &database.Mock{}
+		mockWrite.On("StartTransaction").Return(mockWrite)
+		mockWrite.On("Create").Return(response.NewResponse(1, nil, nil))
+		mockWrite.On("Update").Return(response.NewResponse(1, nil, nil))
+		mockWrite.On("CommitTransaction").Return(response.NewResponse(0, nil, nil))
+		err := NewRepositoriesPropagation(&database.Connection{Write: mockWrite}).
+			SavePropagations(uuid.New(), newPropagations(nil))
+		assert.NoError(t, err)
+		mockWrite.AssertNumberOfCalls(t, "Create", 2)
+		mockWrite.AssertNumberOfCalls(t, "Update", 2)
+		mockWrite.AssertNotCalled(t, "Delete")
+		mockWrite.AssertCalled(t, "CommitTransaction")
+	})
+	t.Run("Should replace the risk acceptances when the decisions expire", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour)
+		mockWrite := &database.Mock{}
+		mockWrite.On("StartTransaction").Return(mockWrite)
+		mockWrite.On("Create").Return(response.NewResponse(1, nil, nil))
+		mockWrite.On("Update").Return(response.NewResponse(1, nil, nil))
+		mockWrite.On("Delete").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+		mockWrite.On("CommitTransaction").Return(response.NewResponse(0, nil, nil))
+		err := NewRepositoriesPropagation(&database.Connection{Write: mockWrite}).
+			SavePropagations(uuid.New(), newPropagations(&expiresAt))
+		assert.NoError(t, err)
+		mockWrite.AssertNumberOfCalls(t, "Create", 4)
+		mockWrite.AssertNumberOfCalls(t, "Delete", 2)
+		mockWrite.AssertCalled(t, "CommitTransaction")
+	})
+	t.Run("Should rollback when create event fails", func(t *testing.T) {
+		mockWrite := &database.Mock{}
+		mockWrite.On("StartTransaction").Return(mockWrite)
+		mockWrite.On("Create").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		mockWrite.On("RollbackTransaction").Return(response.NewResponse(0, nil, nil))
+		err := NewRepositoriesPropagation(&database.Connection{Write: mockWrite}).
+			SavePropagations(uuid.New(), newPropagations(nil))
+		assert.Error(t, err)
+		mockWrite.AssertCalled(t, "RollbackTransaction")
+		mockWrite.AssertNotCalled(t, "CommitTransaction")
+	})
+	t.Run("Should rollback when classify vulnerability fails", func(t *testing.T) {
+		mockWrite := &database.Mock{}
+		mockWrite.On("StartTransaction").Return(mockWrite)
+		mockWrite.On("Create").Return(response.NewResponse(1, nil, nil))
+		mockWrite.On("Update").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		mockWrite.On("RollbackTransaction").Return(response.NewResponse(0, nil, nil))
+		err := NewRepositoriesPropagation(&database.Connection{Write: mockWrite}).
+			SavePropagations(uuid.New(), newPropagations(nil))
+		assert.Error(t, err)
+		mockWrite.AssertNumberOfCalls(t, "Update", 1)
+		mockWrite.AssertCalled(t, "RollbackTransaction")
+	})
+	t.Run("Should rollback when replace risk acceptance fails", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour)
+		mockWrite := &database.Mock{}
+		mockWrite.On("StartTransaction").Return(mockWrite)
+		mockWrite.On("Create").Return(response.NewResponse(1, nil, nil))
+		mockWrite.On("Update").Return(response.NewResponse(1, nil, nil))
+		mockWrite.On("Delete").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		mockWrite.On("RollbackTransaction").Return(response.NewResponse(0, nil, nil))
+		err := NewRepositoriesPropagation(&database.Connection{Write: mockWrite}).
+			SavePropagations(uuid.New(), newPropagations(&expiresAt))
+		assert.Error(t, err)
+		mockWrite.AssertCalled(t, "RollbackTransaction")
+		mockWrite.AssertNotCalled(t, "CommitTransaction")
+	})
+}
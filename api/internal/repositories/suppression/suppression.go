@@ -0,0 +1,84 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	suppressionEntities "github.com/ZupIT/horusec-platform/api/internal/entities/suppression"
+	suppressionEnums "github.com/ZupIT/horusec-platform/api/internal/enums/suppression"
+)
+
+type ISuppression interface {
+	ListActiveRules(workspaceID, repositoryID uuid.UUID) (suppressionEntities.Rules, error)
+	SaveSuppressions(analysisID uuid.UUID, suppressions []suppressionEntities.Suppression) error
+}
+
+type Suppression struct {
+	databaseWrite database.IDatabaseWrite
+	databaseRead  database.IDatabaseRead
+}
+
+func NewRepositoriesSuppression(connection *database.Connection) ISuppression {
+	return &Suppression{
+		databaseWrite: connection.Write,
+		databaseRead:  connection.Read,
+	}
+}
+
+// ListActiveRules returns the active rules of the repository followed by the active rules of the workspace, so
+// the rules of the repository have priority when both match the same vulnerability
+func (s *Suppression) ListActiveRules(workspaceID, repositoryID uuid.UUID) (suppressionEntities.Rules, error) {
+	query := `
+		SELECT rule_id, workspace_id, repository_id, name, file_pattern, details, security_tool, language, vuln_hash,
+			type, justification, account_id, account_email, account_username
+		FROM suppression_rules
+		WHERE workspace_id = ? AND is_active = TRUE AND (repository_id = ? OR repository_id IS NULL)
+		ORDER BY repository_id IS NULL, created_at
+	`
+
+	rules := suppressionEntities.Rules{}
+	return rules, s.databaseRead.Raw(query, &rules, workspaceID, repositoryID).GetErrorExceptNotFound()
+}
+
+// SaveSuppressions classifies the vulnerabilities and registers them in the history of the vulnerabilities, all
+// suppressions of the analysis are saved or none of them
+func (s *Suppression) SaveSuppressions(analysisID uuid.UUID, suppressions []suppressionEntities.Suppression) error {
+	transaction := s.databaseWrite.StartTransaction()
+
+	for index := range suppressions {
+		if err := s.saveSuppression(analysisID, &suppressions[index], transaction); err != nil {
+			logger.LogError(suppressionEnums.MessageFailedToRollbackSuppressions,
+				transaction.RollbackTransaction().GetError())
+			return err
+		}
+	}
+
+	return transaction.CommitTransaction().GetError()
+}
+
+func (s *Suppression) saveSuppression(analysisID uuid.UUID, suppression *suppressionEntities.Suppression,
+	transaction database.IDatabaseWrite) error {
+	if err := transaction.Create(suppression.ToEvent(analysisID),
+		suppressionEnums.DatabaseEventsTable).GetError(); err != nil {
+		return err
+	}
+
+	return transaction.Update(suppression.ToUpdateMap(), suppression.ToFilter(),
+		suppressionEnums.DatabaseVulnerabilitiesTable).GetError()
+}
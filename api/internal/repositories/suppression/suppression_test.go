@@ -0,0 +1,107 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	suppressionEntities "github.com/ZupIT/horusec-platform/api/internal/entities/suppression"
+)
+
+func newSuppressions() []suppressionEntities.Suppression {
+	return []suppressionEntities.Suppression{
+		{Vulnerability: &vulnerability.Vulnerability{VulnerabilityID: uuid.New()},
+			Rule: &suppressionEntities.Rule{RuleID: uuid.New()}},
+		{Vulnerability: &vulnerability.Vulnerability{VulnerabilityID: uuid.New()},
+			Rule: &suppressionEntities.Rule{RuleID: uuid.New()}},
+	}
+}
+
+func TestSuppression_ListActiveRules(t *testing.T) {
+	t.Run("Should list active rules with success", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(1, nil, suppressionEntities.Rules{
+			{RuleID: uuid.New(), FilePattern: "test/**"},
+		}))
+		res, err := NewRepositoriesSuppression(&database.Connection{Read: mockRead}).
+			ListActiveRules(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, "test/**", res[0].FilePattern)
+	})
+	t.Run("Should return empty rules when there are no active rules", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+		res, err := NewRepositoriesSuppression(&database.Connection{Read: mockRead}).
+			ListActiveRules(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+	})
+	t.Run("Should return error when list active rules", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		_, err := NewRepositoriesSuppression(&database.Connection{Read: mockRead}).
+			ListActiveRules(uuid.New(), uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestSuppression_SaveSuppressions(t *testing.T) {
+	t.Run("Should save events and classify vulnerabilities in a transaction", func(t *testing.T) {
+		mockWrite := &database.Mock{}
+		mockWrite.On("StartTransaction").Return(mockWrite)
+		mockWrite.On("Create").Return(response.NewResponse(1, nil, nil))
+		mockWrite.On("Update").Return(response.NewResponse(1, nil, nil))
+		mockWrite.On("CommitTransaction").Return(response.NewResponse(0, nil, nil))
+		err := NewRepositoriesSuppression(&database.Connection{Write: mockWrite}).
+			SaveSuppressions(uuid.New(), newSuppressions())
+		assert.NoError(t, err)
+		mockWrite.AssertNumberOfCalls(t, "Create", 2)
+		mockWrite.AssertNumberOfCalls(t, "Update", 2)
+		mockWrite.AssertCalled(t, "CommitTransaction")
+	})
+	t.Run("Should rollback when create event fails", func(t *testing.T) {
+		mockWrite := &database.Mock{}
+		mockWrite.On("StartTransaction").Return(mockWrite)
+		mockWrite.On("Create").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		mockWrite.On("RollbackTransaction").Return(response.NewResponse(0, nil, nil))
+		err := NewRepositoriesSuppression(&database.Connection{Write: mockWrite}).
+			SaveSuppressions(uuid.New(), newSuppressions())
+		assert.Error(t, err)
+		mockWrite.AssertCalled(t, "RollbackTransaction")
+		mockWrite.AssertNotCalled(t, "CommitTransaction")
+	})
+	t.Run("Should rollback when classify vulnerability fails", func(t *testing.T) {
+		mockWrite := &database.Mock{}
+		mockWrite.On("StartTransaction").Return(mockWrite)
+		mockWrite.On("Create").Return(response.NewResponse(1, nil, nil))
+		mockWrite.On("Update").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		mockWrite.On("RollbackTransaction").Return(response.NewResponse(0, nil, nil))
+		err := NewRepositoriesSuppression(&database.Connection{Write: mockWrite}).
+			SaveSuppressions(uuid.New(), newSuppressions())
+		assert.Error(t, err)
+		mockWrite.AssertNumberOfCalls(t, "Update", 1)
+		mockWrite.AssertCalled(t, "RollbackTransaction")
+	})
+}
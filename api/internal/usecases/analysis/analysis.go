@@ -15,10 +15,13 @@
 package analysis
 
 import (
+	"mime"
 	netHTTP "net/http"
 	"strings"
 
 	analysisv1 "github.com/ZupIT/horusec-platform/api/internal/entities/analysis_v1"
+	"github.com/ZupIT/horusec-platform/api/internal/entities/sarif"
+	apiEnums "github.com/ZupIT/horusec-platform/api/internal/enums"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/confidence"
 
@@ -58,11 +61,40 @@ func (au *UseCases) DecodeAnalysisDataFromIoRead(r *netHTTP.Request) (
 	if r.Body == nil {
 		return nil, enums.ErrorBodyEmpty
 	}
+	if au.isSARIF(r) {
+		return au.decodeSARIFFromIoRead(r)
+	}
 	analysisData, err = au.parseBodyToAnalysis(r)
 	if err != nil {
 		return nil, err
 	}
-	return analysisData, au.validateAnalysisData(analysisData)
+	return analysisData, au.validateAnalysisData(analysisData, false)
+}
+
+// decodeSARIFFromIoRead maps the SARIF report sent to an analysis, since the report has no repository info
+// the repository name of workspace tokens is sent as query param
+func (au *UseCases) decodeSARIFFromIoRead(r *netHTTP.Request) (*cli.AnalysisData, error) {
+	report := &sarif.Report{}
+	if err := parser.ParseBodyToEntity(r.Body, report); err != nil {
+		return nil, err
+	}
+	if err := au.validateSARIFReport(report); err != nil {
+		return nil, err
+	}
+	analysisData := report.ToAnalysisData(r.URL.Query().Get(apiEnums.RepositoryNameQuery))
+	return analysisData, au.validateAnalysisData(analysisData, true)
+}
+
+func (au *UseCases) validateSARIFReport(report *sarif.Report) error {
+	return validation.ValidateStruct(report,
+		validation.Field(&report.Version, validation.Required, validation.In(apiEnums.SARIFVersion)),
+		validation.Field(&report.Runs, validation.Required),
+	)
+}
+
+func (au *UseCases) isSARIF(r *netHTTP.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get(apiEnums.HeaderContentType))
+	return err == nil && strings.EqualFold(mediaType, apiEnums.ContentTypeSARIF)
 }
 
 func (au *UseCases) parseBodyToAnalysis(r *netHTTP.Request) (analysisData *cli.AnalysisData, err error) {
@@ -82,17 +114,17 @@ func (au *UseCases) parseBodyToAnalysis(r *netHTTP.Request) (analysisData *cli.A
 	return analysisData, nil
 }
 
-func (au *UseCases) validateAnalysisData(analysisData *cli.AnalysisData) error {
+func (au *UseCases) validateAnalysisData(analysisData *cli.AnalysisData, isSARIF bool) error {
 	err := validation.ValidateStruct(analysisData,
 		validation.Field(&analysisData.Analysis, validation.Required),
 	)
 	if err != nil {
 		return err
 	}
-	return au.validateAnalysisToCLIv2(analysisData.Analysis)
+	return au.validateAnalysisToCLIv2(analysisData.Analysis, isSARIF)
 }
 
-func (au *UseCases) validateAnalysisToCLIv2(analysis *analysisEntity.Analysis) error {
+func (au *UseCases) validateAnalysisToCLIv2(analysis *analysisEntity.Analysis, isSARIF bool) error {
 	return validation.ValidateStruct(analysis,
 		validation.Field(&analysis.ID, validation.Required, is.UUID),
 		validation.Field(&analysis.Status, validation.Required,
@@ -100,15 +132,16 @@ func (au *UseCases) validateAnalysisToCLIv2(analysis *analysisEntity.Analysis) e
 		validation.Field(&analysis.CreatedAt, validation.Required, validation.NilOrNotEmpty),
 		validation.Field(&analysis.FinishedAt, validation.Required, validation.NilOrNotEmpty),
 		validation.Field(&analysis.AnalysisVulnerabilities,
-			validation.By(au.validateVulnerabilities(analysis.AnalysisVulnerabilities))),
+			validation.By(au.validateVulnerabilities(analysis.AnalysisVulnerabilities, isSARIF))),
 	)
 }
 
-func (au *UseCases) validateVulnerabilities(
-	analysisVulnerabilities []analysisEntity.AnalysisVulnerabilities) validation.RuleFunc {
+func (au *UseCases) validateVulnerabilities(analysisVulnerabilities []analysisEntity.AnalysisVulnerabilities,
+	isSARIF bool) validation.RuleFunc {
 	return func(value interface{}) error {
 		for key := range analysisVulnerabilities {
-			if err := au.setupValidationVulnerabilities(&analysisVulnerabilities[key].Vulnerability); err != nil {
+			vuln := &analysisVulnerabilities[key].Vulnerability
+			if err := au.setupValidationVulnerabilities(vuln, isSARIF); err != nil {
 				return err
 			}
 		}
@@ -116,9 +149,12 @@ func (au *UseCases) validateVulnerabilities(
 	}
 }
 
-func (au *UseCases) setupValidationVulnerabilities(vul *vulnerability.Vulnerability) error {
+// setupValidationVulnerabilities accepts any security tool on SARIF reports, since they are sent by tools
+// that are not executed by horusec cli, like CodeQL
+func (au *UseCases) setupValidationVulnerabilities(vul *vulnerability.Vulnerability, isSARIF bool) error {
 	return validation.ValidateStruct(vul,
-		validation.Field(&vul.SecurityTool, validation.Required, validation.In(au.sliceTools()...)),
+		validation.Field(&vul.SecurityTool, validation.Required,
+			validation.When(!isSARIF, validation.In(au.sliceTools()...))),
 		validation.Field(&vul.VulnHash, validation.Required),
 		validation.Field(&vul.Confidence, validation.Required, validation.In(au.sliceConfidence()...)),
 		validation.Field(&vul.Language, validation.Required, validation.In(au.sliceLanguages()...)),
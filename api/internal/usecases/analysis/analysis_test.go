@@ -209,4 +209,45 @@ func TestUseCases_DecodeAnalysisDataFromIoRead(t *testing.T) {
 			assert.NotEmpty(t, item.VulnerabilityID)
 		}
 	})
+	t.Run("Should decode SARIF report with success", func(t *testing.T) {
+		content, err := os.ReadFile(filepath.Join("..", "..", "entities", "sarif", "sarif_mock.json"))
+		assert.NoError(t, err)
+		r, _ := http.NewRequest(http.MethodPost, "/test?repositoryName=my-repository", bytes.NewReader(content))
+		r.Header.Set("Content-Type", "application/sarif+json; charset=utf-8")
+		dataToCheck, err := NewAnalysisUseCases().DecodeAnalysisDataFromIoRead(r)
+		assert.NoError(t, err)
+		assert.Equal(t, "my-repository", dataToCheck.RepositoryName)
+		assert.Len(t, dataToCheck.Analysis.AnalysisVulnerabilities, 2)
+		vuln := dataToCheck.Analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, tools.Tool("CodeQL"), vuln.SecurityTool)
+	})
+	t.Run("Should return error when SARIF report is invalid", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString("some incorrect body"))
+		r.Header.Set("Content-Type", "application/sarif+json")
+		_, err := NewAnalysisUseCases().DecodeAnalysisDataFromIoRead(r)
+		assert.Equal(t, enums.ErrorBodyInvalid, err)
+	})
+	t.Run("Should return error when SARIF version is not supported", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(`{"version": "1.0.0", "runs": [{}]}`))
+		r.Header.Set("Content-Type", "application/sarif+json")
+		_, err := NewAnalysisUseCases().DecodeAnalysisDataFromIoRead(r)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "version: must be a valid value")
+	})
+	t.Run("Should return error when SARIF report has no runs", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(`{"version": "2.1.0", "runs": []}`))
+		r.Header.Set("Content-Type", "application/sarif+json")
+		_, err := NewAnalysisUseCases().DecodeAnalysisDataFromIoRead(r)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "runs: cannot be blank")
+	})
+	t.Run("Should return error when SARIF run has no tool name", func(t *testing.T) {
+		body := `{"version": "2.1.0", "runs": [{"tool": {"driver": {}},
+			"results": [{"ruleId": "rule", "message": {"text": "test"}}]}]}`
+		r, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(body))
+		r.Header.Set("Content-Type", "application/sarif+json")
+		_, err := NewAnalysisUseCases().DecodeAnalysisDataFromIoRead(r)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "securityTool: cannot be blank")
+	})
 }
@@ -739,6 +739,9 @@ var doc = `{
                 "email": {
                     "type": "string"
                 },
+                "language": {
+                    "type": "string"
+                },
                 "password": {
                     "type": "string"
                 },
@@ -780,6 +783,9 @@ var doc = `{
                 "email": {
                     "type": "string"
                 },
+                "language": {
+                    "type": "string"
+                },
                 "username": {
                     "type": "string"
                 }
@@ -33,6 +33,7 @@ type Account struct {
 	Username           string    `json:"username"`
 	IsConfirmed        bool      `json:"isConfirmed"`
 	IsApplicationAdmin bool      `json:"isApplicationAdmin"`
+	Language           string    `json:"language"`
 	CreatedAt          time.Time `json:"createdAt"`
 	UpdatedAt          time.Time `json:"updatedAt"`
 }
@@ -44,6 +45,7 @@ func (a *Account) ToResponse() *Response {
 		Username:           a.Username,
 		IsConfirmed:        a.IsConfirmed,
 		IsApplicationAdmin: a.IsApplicationAdmin,
+		Language:           a.Language,
 		CreatedAt:          a.CreatedAt,
 		UpdatedAt:          a.UpdatedAt,
 	}
@@ -122,6 +124,10 @@ func (a *Account) UpdateFromUpdateAccountData(data *UpdateAccount) {
 	a.Email = data.Email
 	a.Username = data.Username
 	a.IsConfirmed = data.IsConfirmed
+
+	if data.Language != "" {
+		a.Language = data.Language
+	}
 }
 
 func (a *Account) SetApplicationAdminTrue() *Account {
@@ -210,6 +210,16 @@ func TestUpdateFromUpdateAccountData(t *testing.T) {
 		assert.Equal(t, data.Username, account.Username)
 		assert.Equal(t, data.IsConfirmed, account.IsConfirmed)
 	})
+
+	t.Run("should update the language only when informed", func(t *testing.T) {
+		account := &Account{Language: "pt-BR"}
+
+		account.UpdateFromUpdateAccountData(&UpdateAccount{})
+		assert.Equal(t, "pt-BR", account.Language)
+
+		account.UpdateFromUpdateAccountData(&UpdateAccount{Language: "es-ES"})
+		assert.Equal(t, "es-ES", account.Language)
+	})
 }
 
 func TestSetApplicationAdminTrue(t *testing.T) {
@@ -16,6 +16,7 @@ package account
 
 import (
 	"encoding/json"
+	"regexp"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/ozzovalidation"
 
@@ -23,12 +24,15 @@ import (
 
 	utils "github.com/ZupIT/horusec-devkit/pkg/utils/validation"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
 )
 
 type Data struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 	Username string `json:"username"`
+	Language string `json:"language"`
 }
 
 func (u *Data) Validate() error {
@@ -38,6 +42,7 @@ func (u *Data) Validate() error {
 		validation.Field(&u.Password, utils.PasswordValidationRules()...),
 		validation.Field(&u.Username, validation.Required,
 			validation.Length(ozzovalidation.Length0, ozzovalidation.Length255)),
+		validation.Field(&u.Language, validation.Match(regexp.MustCompile(accountEnums.LanguagePattern))),
 	)
 }
 
@@ -46,6 +51,7 @@ func (u *Data) ToAccount() *Account {
 		Email:    u.Email,
 		Password: u.Password,
 		Username: u.Username,
+		Language: u.Language,
 	}
 
 	return account.SetNewAccountData()
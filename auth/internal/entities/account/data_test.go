@@ -31,6 +31,28 @@ func TestValidate(t *testing.T) {
 		assert.NoError(t, data.Validate())
 	})
 
+	t.Run("should return no error when valid language", func(t *testing.T) {
+		data := &Data{
+			Email:    "test@test.com",
+			Password: "Test@123",
+			Username: "test",
+			Language: "pt-BR",
+		}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid language", func(t *testing.T) {
+		data := &Data{
+			Email:    "test@test.com",
+			Password: "Test@123",
+			Username: "test",
+			Language: "../pt-BR",
+		}
+
+		assert.Error(t, data.Validate())
+	})
+
 	t.Run("should return error when invalid data email", func(t *testing.T) {
 		data := &Data{
 			Email:    "test",
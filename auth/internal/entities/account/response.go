@@ -26,6 +26,7 @@ type Response struct {
 	Username           string    `json:"username"`
 	IsConfirmed        bool      `json:"isConfirmed"`
 	IsApplicationAdmin bool      `json:"isApplicationAdmin"`
+	Language           string    `json:"language"`
 	CreatedAt          time.Time `json:"createdAt"`
 	UpdatedAt          time.Time `json:"updatedAt"`
 }
@@ -16,6 +16,7 @@ package account
 
 import (
 	"encoding/json"
+	"regexp"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/ozzovalidation"
 
@@ -23,6 +24,8 @@ import (
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/google/uuid"
+
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
 )
 
 type UpdateAccount struct {
@@ -30,6 +33,7 @@ type UpdateAccount struct {
 	Email       string    `json:"email"`
 	Username    string    `json:"username"`
 	IsConfirmed bool      `json:"isConfirmed" swaggerignore:"true"`
+	Language    string    `json:"language"`
 }
 
 func (u *UpdateAccount) Validate() error {
@@ -38,6 +42,7 @@ func (u *UpdateAccount) Validate() error {
 			validation.Length(ozzovalidation.Length0, ozzovalidation.Length255)),
 		validation.Field(&u.Username, validation.Required,
 			validation.Length(ozzovalidation.Length0, ozzovalidation.Length255)),
+		validation.Field(&u.Language, validation.Match(regexp.MustCompile(accountEnums.LanguagePattern))),
 	)
 }
 
@@ -40,6 +40,26 @@ func TestValidateUpdateAccount(t *testing.T) {
 		assert.Error(t, data.Validate())
 	})
 
+	t.Run("should return no error when valid language", func(t *testing.T) {
+		data := &UpdateAccount{
+			Email:    "test@test.com",
+			Username: "test",
+			Language: "es",
+		}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid language", func(t *testing.T) {
+		data := &UpdateAccount{
+			Email:    "test@test.com",
+			Username: "test",
+			Language: "portuguese of brazil",
+		}
+
+		assert.Error(t, data.Validate())
+	})
+
 	t.Run("should return error when invalid username", func(t *testing.T) {
 		data := &UpdateAccount{
 			Email:    "test@test.com",
@@ -24,4 +24,5 @@ const (
 	ID                             = "accountID"
 	ResetPasswordCharset           = "1234567890abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 	ResetPasswordCodeDuration      = time.Minute * 10
+	LanguagePattern                = `^[a-zA-Z]{2,3}([-_][a-zA-Z0-9]{2,8})?$`
 )
@@ -135,7 +135,7 @@ func (u *UseCases) NewAccountValidationEmail(account *accountEntities.Account) [
 		To:           account.Email,
 		Subject:      "[Horusec] Account Confirmation Email",
 		TemplateName: emailEnums.AccountConfirmation,
-		Data: map[string]interface{}{"Username": account.Username,
+		Data: map[string]interface{}{"Username": account.Username, "Locale": account.Language,
 			"URL": u.getAccountValidationEmailURL(account.AccountID)},
 	}
 
@@ -173,7 +173,7 @@ func (u *UseCases) NewResetPasswordCodeEmail(account *accountEntities.Account, c
 		Subject:      "[Horusec] Reset Password",
 		TemplateName: emailEnums.ResetPassword,
 		Data: map[string]interface{}{"Username": account.Username, "Code": code,
-			"URL": u.getResetPasswordCodeEmailURL(account.Email, code)},
+			"Locale": account.Language, "URL": u.getResetPasswordCodeEmailURL(account.Email, code)},
 	}
 
 	return message.ToBytes()
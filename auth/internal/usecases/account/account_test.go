@@ -226,6 +226,7 @@ func TestNewAccountValidationEmail(t *testing.T) {
 		account := &accountEntities.Account{
 			Email:    "test@test.com",
 			Username: "test",
+			Language: "pt-BR",
 		}
 
 		emailBytes := useCases.NewAccountValidationEmail(account)
@@ -245,6 +246,7 @@ func TestNewAccountValidationEmail(t *testing.T) {
 			assert.Equal(t, "http://localhost:8006/auth/account/validate/"+
 				"00000000-0000-0000-0000-000000000000", data["URL"])
 			assert.Equal(t, "test", data["Username"])
+			assert.Equal(t, "pt-BR", data["Locale"])
 
 		})
 	})
@@ -316,6 +318,7 @@ func TestNewResetPasswordCodeEmail(t *testing.T) {
 				"check-code?email=test@test.com&code=123456", data["URL"])
 			assert.Equal(t, "test", data["Username"])
 			assert.Equal(t, "123456", data["Code"])
+			assert.Equal(t, "", data["Locale"])
 
 		})
 	})
@@ -31,14 +31,20 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
 
 	"github.com/ZupIT/horusec-platform/core/config/cors"
+	policyController "github.com/ZupIT/horusec-platform/core/internal/controllers/policy"
 	repositoryController "github.com/ZupIT/horusec-platform/core/internal/controllers/repository"
+	tokenController "github.com/ZupIT/horusec-platform/core/internal/controllers/token"
 	workspaceController "github.com/ZupIT/horusec-platform/core/internal/controllers/workspace"
 	healthHandler "github.com/ZupIT/horusec-platform/core/internal/handlers/health"
+	policyHandler "github.com/ZupIT/horusec-platform/core/internal/handlers/policy"
 	repositoryHandler "github.com/ZupIT/horusec-platform/core/internal/handlers/repository"
 	workspaceHandler "github.com/ZupIT/horusec-platform/core/internal/handlers/workspace"
+	tokenJob "github.com/ZupIT/horusec-platform/core/internal/jobs/token"
 	repositoryRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/repository"
+	tokenRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/token"
 	workspaceRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/workspace"
 	"github.com/ZupIT/horusec-platform/core/internal/router"
+	policyUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/policy"
 	repositoryUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/repository"
 	roleUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/role"
 	"github.com/ZupIT/horusec-platform/core/internal/usecases/token"
@@ -65,12 +71,15 @@ var configProviders = wire.NewSet(
 var controllerProviders = wire.NewSet(
 	workspaceController.NewWorkspaceController,
 	repositoryController.NewRepositoryController,
+	policyController.NewPolicyController,
+	tokenController.NewTokenController,
 )
 
 var handleProviders = wire.NewSet(
 	workspaceHandler.NewWorkspaceHandler,
 	repositoryHandler.NewRepositoryHandler,
 	healthHandler.NewHealthHandler,
+	policyHandler.NewPolicyHandler,
 )
 
 var useCasesProviders = wire.NewSet(
@@ -78,16 +87,22 @@ var useCasesProviders = wire.NewSet(
 	repositoryUseCases.NewRepositoryUseCases,
 	roleUseCases.NewRoleUseCases,
 	token.NewTokenUseCases,
+	policyUseCases.NewPolicyUseCases,
 )
 
 var repositoriesProviders = wire.NewSet(
 	workspaceRepository.NewWorkspaceRepository,
 	repositoryRepository.NewRepositoryRepository,
+	tokenRepository.NewTokenRepository,
+)
+
+var jobsProviders = wire.NewSet(
+	tokenJob.NewExpiringTokenJob,
 )
 
 func Initialize(_ string) (router.IRouter, error) {
 	wire.Build(devKitProviders, configProviders, controllerProviders, handleProviders,
-		useCasesProviders, repositoriesProviders)
+		useCasesProviders, repositoriesProviders, jobsProviders)
 
 	return &router.Router{}, nil
 }
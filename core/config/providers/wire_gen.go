@@ -19,14 +19,20 @@ import (
 	"github.com/google/wire"
 
 	"github.com/ZupIT/horusec-platform/core/config/cors"
+	policy2 "github.com/ZupIT/horusec-platform/core/internal/controllers/policy"
 	repository3 "github.com/ZupIT/horusec-platform/core/internal/controllers/repository"
+	token3 "github.com/ZupIT/horusec-platform/core/internal/controllers/token"
 	workspace3 "github.com/ZupIT/horusec-platform/core/internal/controllers/workspace"
 	"github.com/ZupIT/horusec-platform/core/internal/handlers/health"
+	policy3 "github.com/ZupIT/horusec-platform/core/internal/handlers/policy"
 	repository4 "github.com/ZupIT/horusec-platform/core/internal/handlers/repository"
 	workspace4 "github.com/ZupIT/horusec-platform/core/internal/handlers/workspace"
+	token4 "github.com/ZupIT/horusec-platform/core/internal/jobs/token"
 	repository2 "github.com/ZupIT/horusec-platform/core/internal/repositories/repository"
+	token2 "github.com/ZupIT/horusec-platform/core/internal/repositories/token"
 	workspace2 "github.com/ZupIT/horusec-platform/core/internal/repositories/workspace"
 	"github.com/ZupIT/horusec-platform/core/internal/router"
+	"github.com/ZupIT/horusec-platform/core/internal/usecases/policy"
 	"github.com/ZupIT/horusec-platform/core/internal/usecases/repository"
 	"github.com/ZupIT/horusec-platform/core/internal/usecases/role"
 	"github.com/ZupIT/horusec-platform/core/internal/usecases/token"
@@ -63,7 +69,13 @@ func Initialize(string2 string) (router.IRouter, error) {
 	repositoryIController := repository3.NewRepositoryController(iBroker, connection, appIConfig, repositoryIUseCases, repositoryIRepository, tokenIUseCases, iRepository)
 	repositoryHandler := repository4.NewRepositoryHandler(repositoryIUseCases, repositoryIController, appIConfig, authServiceClient, roleIUseCases, tokenIUseCases)
 	healthHandler := health.NewHealthHandler(connection, iBroker)
-	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, repositoryHandler, healthHandler)
+	policyIUseCases := policy.NewPolicyUseCases()
+	policyIController := policy2.NewPolicyController(connection, policyIUseCases, repositoryIRepository)
+	policyHandler := policy3.NewPolicyHandler(policyIController, policyIUseCases)
+	tokenIRepository := token2.NewTokenRepository(connection)
+	tokenIController := token3.NewTokenController(iBroker, tokenIRepository)
+	iJob := token4.NewExpiringTokenJob(tokenIController)
+	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, repositoryHandler, healthHandler, policyHandler, iJob)
 	return routerIRouter, nil
 }
 
@@ -73,10 +85,12 @@ var devKitProviders = wire.NewSet(config.NewBrokerConfig, broker.NewBroker, conf
 
 var configProviders = wire.NewSet(cors.NewCorsConfig, router.NewHTTPRouter)
 
-var controllerProviders = wire.NewSet(workspace3.NewWorkspaceController, repository3.NewRepositoryController)
+var controllerProviders = wire.NewSet(workspace3.NewWorkspaceController, repository3.NewRepositoryController, policy2.NewPolicyController, token3.NewTokenController)
 
-var handleProviders = wire.NewSet(workspace4.NewWorkspaceHandler, repository4.NewRepositoryHandler, health.NewHealthHandler)
+var handleProviders = wire.NewSet(workspace4.NewWorkspaceHandler, repository4.NewRepositoryHandler, health.NewHealthHandler, policy3.NewPolicyHandler)
 
-var useCasesProviders = wire.NewSet(workspace.NewWorkspaceUseCases, repository.NewRepositoryUseCases, role.NewRoleUseCases, token.NewTokenUseCases)
+var useCasesProviders = wire.NewSet(workspace.NewWorkspaceUseCases, repository.NewRepositoryUseCases, role.NewRoleUseCases, token.NewTokenUseCases, policy.NewPolicyUseCases)
 
-var repositoriesProviders = wire.NewSet(workspace2.NewWorkspaceRepository, repository2.NewRepositoryRepository)
+var repositoriesProviders = wire.NewSet(workspace2.NewWorkspaceRepository, repository2.NewRepositoryRepository, token2.NewTokenRepository)
+
+var jobsProviders = wire.NewSet(token4.NewExpiringTokenJob)
@@ -0,0 +1,155 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	policyEntities "github.com/ZupIT/horusec-platform/core/internal/entities/policy"
+	policyEnums "github.com/ZupIT/horusec-platform/core/internal/enums/policy"
+	repositoryRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/repository"
+	policyUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/policy"
+)
+
+type IController interface {
+	Create(data *policyEntities.Data) (*policyEntities.Policy, error)
+	Get(data *policyEntities.Data) (*policyEntities.Policy, error)
+	Update(data *policyEntities.Data) (*policyEntities.Policy, error)
+	Delete(data *policyEntities.Data) error
+	List(workspaceID uuid.UUID) (*[]policyEntities.Policy, error)
+}
+
+type Controller struct {
+	databaseRead  database.IDatabaseRead
+	databaseWrite database.IDatabaseWrite
+	useCases      policyUseCases.IUseCases
+	repository    repositoryRepository.IRepository
+}
+
+func NewPolicyController(databaseConnection *database.Connection, useCases policyUseCases.IUseCases,
+	repository repositoryRepository.IRepository) IController {
+	return &Controller{
+		databaseRead:  databaseConnection.Read,
+		databaseWrite: databaseConnection.Write,
+		useCases:      useCases,
+		repository:    repository,
+	}
+}
+
+func (c *Controller) Create(data *policyEntities.Data) (*policyEntities.Policy, error) {
+	if err := c.checkRepositoryFromWorkspace(data); err != nil {
+		return nil, err
+	}
+
+	policy := data.ToPolicy()
+
+	return policy, c.saveTransaction(policy, func(transaction database.IDatabaseWrite) error {
+		return transaction.Create(policy, policyEnums.DatabasePolicyTable).GetError()
+	})
+}
+
+func (c *Controller) checkRepositoryFromWorkspace(data *policyEntities.Data) error {
+	if !data.IsRepositoryPolicy() {
+		return nil
+	}
+
+	repository, err := c.repository.GetRepository(data.RepositoryID)
+	if err != nil {
+		if err == databaseEnums.ErrorNotFoundRecords {
+			return policyEnums.ErrorRepositoryDoesNotBelongToWorkspace
+		}
+
+		return err
+	}
+
+	if repository.WorkspaceID != data.WorkspaceID {
+		return policyEnums.ErrorRepositoryDoesNotBelongToWorkspace
+	}
+
+	return nil
+}
+
+// saveTransaction deactivates the other policies of the same scope when the policy is active,
+// since only one policy is evaluated by analysis
+func (c *Controller) saveTransaction(policy *policyEntities.Policy,
+	save func(transaction database.IDatabaseWrite) error) error {
+	transaction := c.databaseWrite.StartTransaction()
+
+	if err := c.deactivatePoliciesOfScope(policy, transaction); err != nil {
+		logger.LogError(policyEnums.ErrorRollbackSave, transaction.RollbackTransaction().GetError())
+		return err
+	}
+
+	if err := save(transaction); err != nil {
+		logger.LogError(policyEnums.ErrorRollbackSave, transaction.RollbackTransaction().GetError())
+		return err
+	}
+
+	return transaction.CommitTransaction().GetError()
+}
+
+func (c *Controller) deactivatePoliciesOfScope(policy *policyEntities.Policy,
+	transaction database.IDatabaseWrite) error {
+	if !policy.IsActive {
+		return nil
+	}
+
+	return transaction.Update(map[string]interface{}{"is_active": false, "updated_at": time.Now()},
+		c.useCases.FilterActivePoliciesByScope(policy.WorkspaceID, policy.RepositoryID),
+		policyEnums.DatabasePolicyTable).GetError()
+}
+
+func (c *Controller) Get(data *policyEntities.Data) (*policyEntities.Policy, error) {
+	policy := &policyEntities.Policy{}
+
+	return policy, c.databaseRead.Find(policy, c.useCases.FilterPolicyByID(data.WorkspaceID, data.PolicyID),
+		policyEnums.DatabasePolicyTable).GetError()
+}
+
+func (c *Controller) Update(data *policyEntities.Data) (*policyEntities.Policy, error) {
+	policy, err := c.Get(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.checkRepositoryFromWorkspace(data); err != nil {
+		return nil, err
+	}
+
+	policy.Update(data)
+
+	return policy, c.saveTransaction(policy, func(transaction database.IDatabaseWrite) error {
+		return transaction.Update(policy.ToUpdateMap(), c.useCases.FilterPolicyByID(data.WorkspaceID, data.PolicyID),
+			policyEnums.DatabasePolicyTable).GetError()
+	})
+}
+
+func (c *Controller) Delete(data *policyEntities.Data) error {
+	return c.databaseWrite.Delete(c.useCases.FilterPolicyByID(data.WorkspaceID, data.PolicyID),
+		policyEnums.DatabasePolicyTable).GetError()
+}
+
+func (c *Controller) List(workspaceID uuid.UUID) (*[]policyEntities.Policy, error) {
+	policies := &[]policyEntities.Policy{}
+
+	return policies, c.databaseRead.Find(policies, c.useCases.FilterListPolicies(workspaceID),
+		policyEnums.DatabasePolicyTable).GetErrorExceptNotFound()
+}
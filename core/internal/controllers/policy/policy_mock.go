@@ -0,0 +1,53 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	policyEntities "github.com/ZupIT/horusec-platform/core/internal/entities/policy"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Create(_ *policyEntities.Data) (*policyEntities.Policy, error) {
+	args := m.MethodCalled("Create")
+	return args.Get(0).(*policyEntities.Policy), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Get(_ *policyEntities.Data) (*policyEntities.Policy, error) {
+	args := m.MethodCalled("Get")
+	return args.Get(0).(*policyEntities.Policy), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Update(_ *policyEntities.Data) (*policyEntities.Policy, error) {
+	args := m.MethodCalled("Update")
+	return args.Get(0).(*policyEntities.Policy), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Delete(_ *policyEntities.Data) error {
+	args := m.MethodCalled("Delete")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) List(_ uuid.UUID) (*[]policyEntities.Policy, error) {
+	args := m.MethodCalled("List")
+	return args.Get(0).(*[]policyEntities.Policy), mockUtils.ReturnNilOrError(args, 1)
+}
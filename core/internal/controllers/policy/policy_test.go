@@ -0,0 +1,257 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	policyEntities "github.com/ZupIT/horusec-platform/core/internal/entities/policy"
+	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
+	policyEnums "github.com/ZupIT/horusec-platform/core/internal/enums/policy"
+	repositoryRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/repository"
+	policyUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/policy"
+)
+
+func newPolicyData() *policyEntities.Data {
+	return &policyEntities.Data{
+		WorkspaceID: uuid.New(),
+		PolicyID:    uuid.New(),
+		Name:        "test",
+		IsActive:    true,
+		Rules: policyEntities.Rules{
+			{Type: policyEnums.SeverityCount, Severity: severities.Critical},
+		},
+	}
+}
+
+func newControllerWithMocks(databaseMock *database.Mock, repositoryMock *repositoryRepository.Mock) IController {
+	return NewPolicyController(&database.Connection{Read: databaseMock, Write: databaseMock},
+		policyUseCases.NewPolicyUseCases(), repositoryMock)
+}
+
+func TestNewPolicyController(t *testing.T) {
+	t.Run("should success create a new controller", func(t *testing.T) {
+		assert.NotNil(t, NewPolicyController(&database.Connection{}, nil, nil))
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("should success create a workspace policy deactivating the others", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		data := newPolicyData()
+
+		result, err := newControllerWithMocks(databaseMock, &repositoryRepository.Mock{}).Create(data)
+		assert.NoError(t, err)
+		assert.Equal(t, data.Name, result.Name)
+		assert.Nil(t, result.RepositoryID)
+		databaseMock.AssertCalled(t, "Update")
+	})
+
+	t.Run("should success create an inactive policy without deactivating the others", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		data := newPolicyData()
+		data.IsActive = false
+
+		_, err := newControllerWithMocks(databaseMock, &repositoryRepository.Mock{}).Create(data)
+		assert.NoError(t, err)
+		databaseMock.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("should success create a repository policy", func(t *testing.T) {
+		data := newPolicyData()
+		data.RepositoryID = uuid.New()
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(
+			&repositoryEntities.Repository{WorkspaceID: data.WorkspaceID}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		result, err := newControllerWithMocks(databaseMock, repositoryMock).Create(data)
+		assert.NoError(t, err)
+		assert.Equal(t, data.RepositoryID, *result.RepositoryID)
+	})
+
+	t.Run("should return error when repository is from another workspace", func(t *testing.T) {
+		data := newPolicyData()
+		data.RepositoryID = uuid.New()
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: uuid.New()}, nil)
+
+		_, err := newControllerWithMocks(&database.Mock{}, repositoryMock).Create(data)
+		assert.Equal(t, policyEnums.ErrorRepositoryDoesNotBelongToWorkspace, err)
+	})
+
+	t.Run("should return error when repository was not found", func(t *testing.T) {
+		data := newPolicyData()
+		data.RepositoryID = uuid.New()
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(
+			&repositoryEntities.Repository{}, databaseEnums.ErrorNotFoundRecords)
+
+		_, err := newControllerWithMocks(&database.Mock{}, repositoryMock).Create(data)
+		assert.Equal(t, policyEnums.ErrorRepositoryDoesNotBelongToWorkspace, err)
+	})
+
+	t.Run("should return error when failed to get repository", func(t *testing.T) {
+		data := newPolicyData()
+		data.RepositoryID = uuid.New()
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{}, errors.New("test"))
+
+		_, err := newControllerWithMocks(&database.Mock{}, repositoryMock).Create(data)
+		assert.Equal(t, errors.New("test"), err)
+	})
+
+	t.Run("should return error and rollback when failed to deactivate policies", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		_, err := newControllerWithMocks(databaseMock, &repositoryRepository.Mock{}).Create(newPolicyData())
+		assert.Equal(t, errors.New("test"), err)
+		databaseMock.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("should return error and rollback when failed to create policy", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		_, err := newControllerWithMocks(databaseMock, &repositoryRepository.Mock{}).Create(newPolicyData())
+		assert.Equal(t, errors.New("test"), err)
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Run("should success get a policy", func(t *testing.T) {
+		policy := newPolicyData().ToPolicy()
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, policy))
+
+		result, err := newControllerWithMocks(databaseMock, &repositoryRepository.Mock{}).Get(newPolicyData())
+		assert.NoError(t, err)
+		assert.Equal(t, policy.PolicyID, result.PolicyID)
+		assert.Equal(t, policy.Rules, result.Rules)
+	})
+
+	t.Run("should return error when policy was not found", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(0, databaseEnums.ErrorNotFoundRecords, nil))
+
+		_, err := newControllerWithMocks(databaseMock, &repositoryRepository.Mock{}).Get(newPolicyData())
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("should success update a policy", func(t *testing.T) {
+		data := newPolicyData()
+		data.Name = "updated"
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, newPolicyData().ToPolicy()))
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		result, err := newControllerWithMocks(databaseMock, &repositoryRepository.Mock{}).Update(data)
+		assert.NoError(t, err)
+		assert.Equal(t, "updated", result.Name)
+		databaseMock.AssertNumberOfCalls(t, "Update", 2)
+	})
+
+	t.Run("should return error when policy was not found", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(0, databaseEnums.ErrorNotFoundRecords, nil))
+
+		_, err := newControllerWithMocks(databaseMock, &repositoryRepository.Mock{}).Update(newPolicyData())
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+
+	t.Run("should return error when repository is from another workspace", func(t *testing.T) {
+		data := newPolicyData()
+		data.RepositoryID = uuid.New()
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, newPolicyData().ToPolicy()))
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: uuid.New()}, nil)
+
+		_, err := newControllerWithMocks(databaseMock, repositoryMock).Update(data)
+		assert.Equal(t, policyEnums.ErrorRepositoryDoesNotBelongToWorkspace, err)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("should success delete a policy", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(&response.Response{})
+
+		assert.NoError(t, newControllerWithMocks(databaseMock, &repositoryRepository.Mock{}).Delete(newPolicyData()))
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("should success list policies", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil,
+			&[]policyEntities.Policy{*newPolicyData().ToPolicy()}))
+
+		result, err := newControllerWithMocks(databaseMock, &repositoryRepository.Mock{}).List(uuid.New())
+		assert.NoError(t, err)
+		assert.Len(t, *result, 1)
+	})
+
+	t.Run("should return empty list when there is no policy", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(0, databaseEnums.ErrorNotFoundRecords, nil))
+
+		result, err := newControllerWithMocks(databaseMock, &repositoryRepository.Mock{}).List(uuid.New())
+		assert.NoError(t, err)
+		assert.Empty(t, *result)
+	})
+}
@@ -107,7 +107,20 @@ func (c *Controller) createTransaction(accountID uuid.UUID,
 		return nil, err
 	}
 
-	return repository.ToRepositoryResponse(accountEnums.Admin), transaction.CommitTransaction().GetError()
+	if err := transaction.CommitTransaction().GetError(); err != nil {
+		return nil, err
+	}
+
+	return c.publishRepositoryCreated(repository.ToRepositoryResponse(accountEnums.Admin)), nil
+}
+
+func (c *Controller) publishRepositoryCreated(response *repositoryEntities.Response) *repositoryEntities.Response {
+	if err := c.broker.Publish(repositoryEnums.WebhookEventsQueue, "", "",
+		c.useCases.NewRepositoryCreatedWebhookEvent(response)); err != nil {
+		logger.LogError(repositoryEnums.MessageFailedToPublishWebhookEvent, err)
+	}
+
+	return response
 }
 
 func (c *Controller) Get(data *repositoryEntities.Data) (*repositoryEntities.Response, error) {
@@ -224,16 +237,21 @@ func (c *Controller) createRepositoryRelationAndSendEmail(data *roleEntities.Use
 	}
 
 	return accountRepository.ToResponseWithEmailAndUsername(data.Email, data.Username),
-		c.sendInviteUserEmail(data.Email, data.Username, repository.Name)
+		c.sendInviteUserEmail(data, repository.Name)
 }
 
-func (c *Controller) sendInviteUserEmail(email, username, repositoryName string) error {
+func (c *Controller) sendInviteUserEmail(data *roleEntities.UserData, repositoryName string) error {
 	if c.appConfig.IsEmailsDisabled() {
 		return nil
 	}
 
+	language, err := c.workspaceRepository.GetAccountLanguage(data.AccountID)
+	if err != nil {
+		return err
+	}
+
 	return c.broker.Publish(queues.HorusecEmail.ToString(), "", "",
-		c.useCases.NewRepositoryInviteEmail(email, username, repositoryName))
+		c.useCases.NewRepositoryInviteEmail(data.Email, data.Username, language, repositoryName))
 }
 
 func (c *Controller) GetUsers(repositoryID uuid.UUID) (*[]roleEntities.Response, error) {
@@ -76,8 +76,39 @@ func TestCreate(t *testing.T) {
 		databaseMock.On("StartTransaction").Return(databaseMock)
 		databaseMock.On("CommitTransaction").Return(&response.Response{})
 
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
-		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
+		controller := NewRepositoryController(brokerMock, databaseConnection, appConfig,
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{},
+			workspaceRepositoryMock)
+
+		result, err := controller.Create(data)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		brokerMock.AssertCalled(t, "Publish")
+	})
+
+	t.Run("should success create a new repository when failed to publish webhook event", func(t *testing.T) {
+		workspaceRepositoryMock := &workspaceRepository.Mock{}
+		appConfig := &app.Mock{}
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepositoryByName").Return(
+			&repositoryEntities.Repository{}, databaseEnums.ErrorNotFoundRecords)
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(errors.New("test"))
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewRepositoryController(brokerMock, databaseConnection, appConfig,
 			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{},
 			workspaceRepositoryMock)
 
@@ -86,6 +117,30 @@ func TestCreate(t *testing.T) {
 		assert.NotNil(t, result)
 	})
 
+	t.Run("should return error when failed to commit transaction", func(t *testing.T) {
+		workspaceRepositoryMock := &workspaceRepository.Mock{}
+		appConfig := &app.Mock{}
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepositoryByName").Return(
+			&repositoryEntities.Repository{}, databaseEnums.ErrorNotFoundRecords)
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("CommitTransaction").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{},
+			workspaceRepositoryMock)
+
+		result, err := controller.Create(data)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
 	t.Run("should success create a new repository with the workspace groups", func(t *testing.T) {
 		workspaceRepositoryMock := &workspaceRepository.Mock{}
 
@@ -110,8 +165,11 @@ func TestCreate(t *testing.T) {
 
 		appConfig := &app.Mock{}
 
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
-		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
+		controller := NewRepositoryController(brokerMock, databaseConnection, appConfig,
 			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{},
 			workspaceRepositoryMock)
 
@@ -666,6 +724,7 @@ func TestInviteUser(t *testing.T) {
 
 	t.Run("should success invite user with broker enabled", func(t *testing.T) {
 		workspaceRepositoryMock := &workspaceRepository.Mock{}
+		workspaceRepositoryMock.On("GetAccountLanguage").Return("pt-BR", nil)
 
 		appConfig := &app.Mock{}
 		appConfig.On("IsEmailsDisabled").Return(false)
@@ -690,6 +749,32 @@ func TestInviteUser(t *testing.T) {
 		assert.NotNil(t, result)
 	})
 
+	t.Run("should return error when failed to get the language of the invited account", func(t *testing.T) {
+		workspaceRepositoryMock := &workspaceRepository.Mock{}
+		workspaceRepositoryMock.On("GetAccountLanguage").Return("", errors.New("test"))
+
+		appConfig := &app.Mock{}
+		appConfig.On("IsEmailsDisabled").Return(false)
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("IsNotMemberOfWorkspace").Return(false)
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		brokerMock := &broker.Mock{}
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewRepositoryController(brokerMock, databaseConnection, appConfig,
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{},
+			workspaceRepositoryMock)
+
+		_, err := controller.InviteUser(data)
+		assert.Error(t, err)
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+
 	t.Run("should success invite user with broker disabled", func(t *testing.T) {
 		workspaceRepositoryMock := &workspaceRepository.Mock{}
 
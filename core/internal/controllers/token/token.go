@@ -0,0 +1,74 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"time"
+
+	brokerService "github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
+	tokenRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/token"
+)
+
+type IController interface {
+	NotifyExpiringTokens() error
+}
+
+type Controller struct {
+	broker     brokerService.IBroker
+	repository tokenRepository.IRepository
+}
+
+func NewTokenController(broker brokerService.IBroker, repository tokenRepository.IRepository) IController {
+	return &Controller{
+		broker:     broker,
+		repository: repository,
+	}
+}
+
+// NotifyExpiringTokens publishes a token expiring webhook event once for each token close to its expiration.
+// Each token is claimed before publishing, so only one instance notifies it
+func (c *Controller) NotifyExpiringTokens() error {
+	now := time.Now()
+
+	tokens, err := c.repository.ListExpiringTokens(now)
+	if err != nil {
+		return err
+	}
+
+	for index := range *tokens {
+		if err := c.notifyExpiringToken(&(*tokens)[index], now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Controller) notifyExpiringToken(token *tokenEntities.Token, now time.Time) error {
+	claimed, err := c.repository.MarkExpiringNotified(token.TokenID, now)
+	if err != nil || !claimed {
+		return err
+	}
+
+	if err := c.broker.Publish(tokenEnums.WebhookEventsQueue, "", "", token.ToExpiringWebhookEvent()); err != nil {
+		logger.LogError(tokenEnums.MessageFailedToPublishWebhookEvent, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+
+	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+	tokenRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/token"
+)
+
+func TestNewTokenController(t *testing.T) {
+	t.Run("should success create a new controller", func(t *testing.T) {
+		assert.NotNil(t, NewTokenController(&broker.Mock{}, &tokenRepository.Mock{}))
+	})
+}
+
+func TestNotifyExpiringTokens(t *testing.T) {
+	tokens := &[]tokenEntities.Token{{TokenID: uuid.New(), WorkspaceID: uuid.New()}}
+
+	t.Run("should publish an event for each claimed expiring token", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		repositoryMock := &tokenRepository.Mock{}
+		repositoryMock.On("ListExpiringTokens").Return(tokens, nil)
+		repositoryMock.On("MarkExpiringNotified").Return(true, nil)
+
+		controller := NewTokenController(brokerMock, repositoryMock)
+
+		assert.NoError(t, controller.NotifyExpiringTokens())
+		brokerMock.AssertNumberOfCalls(t, "Publish", 1)
+	})
+
+	t.Run("should not publish when token was claimed by another instance", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+
+		repositoryMock := &tokenRepository.Mock{}
+		repositoryMock.On("ListExpiringTokens").Return(tokens, nil)
+		repositoryMock.On("MarkExpiringNotified").Return(false, nil)
+
+		controller := NewTokenController(brokerMock, repositoryMock)
+
+		assert.NoError(t, controller.NotifyExpiringTokens())
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should not return error when failed to publish event", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(errors.New("test"))
+
+		repositoryMock := &tokenRepository.Mock{}
+		repositoryMock.On("ListExpiringTokens").Return(tokens, nil)
+		repositoryMock.On("MarkExpiringNotified").Return(true, nil)
+
+		controller := NewTokenController(brokerMock, repositoryMock)
+
+		assert.NoError(t, controller.NotifyExpiringTokens())
+	})
+
+	t.Run("should return error when failed to claim token", func(t *testing.T) {
+		repositoryMock := &tokenRepository.Mock{}
+		repositoryMock.On("ListExpiringTokens").Return(tokens, nil)
+		repositoryMock.On("MarkExpiringNotified").Return(false, errors.New("test"))
+
+		controller := NewTokenController(&broker.Mock{}, repositoryMock)
+
+		assert.Error(t, controller.NotifyExpiringTokens())
+	})
+
+	t.Run("should return error when failed to list expiring tokens", func(t *testing.T) {
+		repositoryMock := &tokenRepository.Mock{}
+		repositoryMock.On("ListExpiringTokens").Return(&[]tokenEntities.Token{}, errors.New("test"))
+
+		controller := NewTokenController(&broker.Mock{}, repositoryMock)
+
+		assert.Error(t, controller.NotifyExpiringTokens())
+	})
+}
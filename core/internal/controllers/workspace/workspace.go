@@ -181,16 +181,21 @@ func (c *Controller) InviteUser(data *roleEntities.UserData) (*roleEntities.Resp
 	}
 
 	return accountWorkspace.ToResponseWithEmailAndUsername(data.Email, data.Username),
-		c.sendInviteUserEmail(data.Email, data.Username, workspace.Name)
+		c.sendInviteUserEmail(data, workspace.Name)
 }
 
-func (c *Controller) sendInviteUserEmail(email, username, workspaceName string) error {
+func (c *Controller) sendInviteUserEmail(data *roleEntities.UserData, workspaceName string) error {
 	if c.appConfig.IsEmailsDisabled() {
 		return nil
 	}
 
+	language, err := c.repository.GetAccountLanguage(data.AccountID)
+	if err != nil {
+		return err
+	}
+
 	return c.broker.Publish(queues.HorusecEmail.ToString(), "", "",
-		c.useCases.NewOrganizationInviteEmail(email, username, workspaceName))
+		c.useCases.NewOrganizationInviteEmail(data.Email, data.Username, language, workspaceName))
 }
 
 func (c *Controller) GetUsers(workspaceID, noBelongRepositoryID uuid.UUID) (*[]roleEntities.Response, error) {
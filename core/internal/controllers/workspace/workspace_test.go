@@ -523,6 +523,7 @@ func TestInviteUser(t *testing.T) {
 	t.Run("should success create new account workspace with email", func(t *testing.T) {
 		repositoryMock := &workspaceRepository.Mock{}
 		repositoryMock.On("GetWorkspace").Return(workspace, nil)
+		repositoryMock.On("GetAccountLanguage").Return("pt-BR", nil)
 
 		databaseMock := &database.Mock{}
 		databaseMock.On("Create").Return(&response.Response{})
@@ -542,6 +543,28 @@ func TestInviteUser(t *testing.T) {
 		assert.NotNil(t, result)
 	})
 
+	t.Run("should return error when failed to get the language of the invited account", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("GetWorkspace").Return(workspace, nil)
+		repositoryMock.On("GetAccountLanguage").Return("", errors.New("test"))
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		appConfig := &app.Mock{}
+		appConfig.On("IsEmailsDisabled").Return(false)
+
+		brokerMock := &broker.Mock{}
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewWorkspaceController(brokerMock, databaseConnection, appConfig,
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+
+		_, err := controller.InviteUser(data)
+		assert.Error(t, err)
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+
 	t.Run("should return error when failed to create account workspace", func(t *testing.T) {
 		repositoryMock := &workspaceRepository.Mock{}
 		repositoryMock.On("GetWorkspace").Return(workspace, nil)
@@ -0,0 +1,111 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"encoding/json"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/ozzovalidation"
+
+	policyEnums "github.com/ZupIT/horusec-platform/core/internal/enums/policy"
+)
+
+type Data struct {
+	PolicyID     uuid.UUID `json:"policyID" swaggerignore:"true"`
+	WorkspaceID  uuid.UUID `json:"workspaceID" swaggerignore:"true"`
+	RepositoryID uuid.UUID `json:"repositoryID"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	Rules        Rules     `json:"rules"`
+	IsActive     bool      `json:"isActive"`
+}
+
+func (d *Data) Validate() error {
+	return validation.ValidateStruct(d,
+		validation.Field(&d.PolicyID, is.UUID),
+		validation.Field(&d.WorkspaceID, is.UUID),
+		validation.Field(&d.RepositoryID, is.UUID),
+		validation.Field(&d.Name, validation.Required,
+			validation.Length(ozzovalidation.Length1, ozzovalidation.Length255)),
+		validation.Field(&d.Description, validation.Length(ozzovalidation.Length0, ozzovalidation.Length255)),
+		validation.Field(&d.Rules, validation.By(d.validateRules)),
+	)
+}
+
+// validateRules validates each rule by hand, since the ozzo validation rules would use the database value of the
+// rules, that is its json, instead of the slice
+func (d *Data) validateRules(_ interface{}) error {
+	if len(d.Rules) == 0 || len(d.Rules) > policyEnums.MaxRules {
+		return policyEnums.ErrorInvalidRulesLength
+	}
+
+	for index := range d.Rules {
+		if err := d.Rules[index].Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Data) SetWorkspaceID(workspaceID uuid.UUID) *Data {
+	d.WorkspaceID = workspaceID
+
+	return d
+}
+
+func (d *Data) SetIDs(workspaceID, policyID uuid.UUID) *Data {
+	d.WorkspaceID = workspaceID
+	d.PolicyID = policyID
+
+	return d
+}
+
+func (d *Data) ToPolicy() *Policy {
+	return &Policy{
+		PolicyID:     uuid.New(),
+		WorkspaceID:  d.WorkspaceID,
+		RepositoryID: d.getRepositoryID(),
+		Name:         d.Name,
+		Description:  d.Description,
+		Rules:        d.Rules,
+		IsActive:     d.IsActive,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+func (d *Data) ToBytes() []byte {
+	bytes, _ := json.Marshal(d)
+
+	return bytes
+}
+
+func (d *Data) IsRepositoryPolicy() bool {
+	return d.RepositoryID != uuid.Nil
+}
+
+func (d *Data) getRepositoryID() *uuid.UUID {
+	if d.RepositoryID == uuid.Nil {
+		return nil
+	}
+
+	return &d.RepositoryID
+}
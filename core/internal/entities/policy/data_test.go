@@ -0,0 +1,120 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+
+	policyEnums "github.com/ZupIT/horusec-platform/core/internal/enums/policy"
+)
+
+func newValidData() *Data {
+	return &Data{
+		Name:     "test",
+		IsActive: true,
+		Rules:    Rules{{Type: policyEnums.SeverityCount, Severity: severities.Critical}},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		assert.NoError(t, newValidData().Validate())
+	})
+
+	t.Run("should return error when missing name", func(t *testing.T) {
+		data := newValidData()
+		data.Name = ""
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when missing rules", func(t *testing.T) {
+		data := newValidData()
+		data.Rules = nil
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when more rules than allowed", func(t *testing.T) {
+		data := newValidData()
+		for len(data.Rules) <= policyEnums.MaxRules {
+			data.Rules = append(data.Rules, data.Rules[0])
+		}
+
+		assert.ErrorIs(t, data.Validate().(validation.Errors)["rules"], policyEnums.ErrorInvalidRulesLength)
+	})
+
+	t.Run("should return error when any rule is invalid", func(t *testing.T) {
+		data := newValidData()
+		data.Rules = append(data.Rules, Rule{Type: policyEnums.FindingAge})
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestSetWorkspaceID(t *testing.T) {
+	t.Run("should success set workspace id", func(t *testing.T) {
+		data := &Data{}
+		id := uuid.New()
+
+		_ = data.SetWorkspaceID(id)
+		assert.Equal(t, id, data.WorkspaceID)
+	})
+}
+
+func TestSetIDs(t *testing.T) {
+	t.Run("should success set workspace and policy id", func(t *testing.T) {
+		data := &Data{}
+		workspaceID := uuid.New()
+		policyID := uuid.New()
+
+		_ = data.SetIDs(workspaceID, policyID)
+		assert.Equal(t, workspaceID, data.WorkspaceID)
+		assert.Equal(t, policyID, data.PolicyID)
+	})
+}
+
+func TestToPolicy(t *testing.T) {
+	t.Run("should success parse data to a workspace policy", func(t *testing.T) {
+		data := newValidData()
+		data.WorkspaceID = uuid.New()
+
+		policy := data.ToPolicy()
+		assert.NotEqual(t, uuid.Nil, policy.PolicyID)
+		assert.Equal(t, data.WorkspaceID, policy.WorkspaceID)
+		assert.Nil(t, policy.RepositoryID)
+		assert.Equal(t, data.Name, policy.Name)
+		assert.Equal(t, data.Rules, policy.Rules)
+		assert.True(t, policy.IsActive)
+		assert.False(t, policy.IsRepositoryPolicy())
+		assert.False(t, policy.CreatedAt.IsZero())
+	})
+
+	t.Run("should success parse data to a repository policy", func(t *testing.T) {
+		data := newValidData()
+		data.RepositoryID = uuid.New()
+
+		policy := data.ToPolicy()
+		assert.True(t, data.IsRepositoryPolicy())
+		assert.True(t, policy.IsRepositoryPolicy())
+		assert.Equal(t, data.RepositoryID, *policy.RepositoryID)
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Policy struct {
+	PolicyID     uuid.UUID  `json:"policyID" gorm:"primary_key"`
+	WorkspaceID  uuid.UUID  `json:"workspaceID"`
+	RepositoryID *uuid.UUID `json:"repositoryID"`
+	Name         string     `json:"name"`
+	Description  string     `json:"description"`
+	Rules        Rules      `json:"rules" gorm:"type:jsonb"`
+	IsActive     bool       `json:"isActive"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+}
+
+func (p *Policy) Update(data *Data) *Policy {
+	p.RepositoryID = data.getRepositoryID()
+	p.Name = data.Name
+	p.Description = data.Description
+	p.Rules = data.Rules
+	p.IsActive = data.IsActive
+	p.UpdatedAt = time.Now()
+
+	return p
+}
+
+func (p *Policy) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"repository_id": p.RepositoryID,
+		"name":          p.Name,
+		"description":   p.Description,
+		"rules":         p.Rules,
+		"is_active":     p.IsActive,
+		"updated_at":    p.UpdatedAt,
+	}
+}
+
+// IsRepositoryPolicy informs if the policy is applied only to the analyses of one repository,
+// otherwise it is applied to all repositories of the workspace without a policy of their own
+func (p *Policy) IsRepositoryPolicy() bool {
+	return p.RepositoryID != nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Run("should success update policy data", func(t *testing.T) {
+		policy := &Policy{Name: "old"}
+		data := newValidData()
+		data.RepositoryID = uuid.New()
+
+		_ = policy.Update(data)
+		assert.Equal(t, data.Name, policy.Name)
+		assert.Equal(t, data.Rules, policy.Rules)
+		assert.Equal(t, data.RepositoryID, *policy.RepositoryID)
+		assert.True(t, policy.IsActive)
+		assert.False(t, policy.UpdatedAt.IsZero())
+	})
+}
+
+func TestToUpdateMap(t *testing.T) {
+	t.Run("should success parse policy to update map", func(t *testing.T) {
+		policy := newValidData().ToPolicy()
+
+		updateMap := policy.ToUpdateMap()
+		assert.Equal(t, policy.Name, updateMap["name"])
+		assert.Equal(t, policy.Rules, updateMap["rules"])
+		assert.Equal(t, true, updateMap["is_active"])
+		assert.Contains(t, updateMap, "repository_id")
+	})
+}
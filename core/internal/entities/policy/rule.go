@@ -0,0 +1,107 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	policyEnums "github.com/ZupIT/horusec-platform/core/internal/enums/policy"
+)
+
+type Rule struct {
+	Type              policyEnums.RuleType `json:"type" example:"severity-count" enums:"severity-count, finding-age, blocked-tool"`                           //nolint:lll // notations
+	Severity          severities.Severity  `json:"severity" example:"CRITICAL" enums:"CRITICAL, HIGH, MEDIUM, LOW, UNKNOWN, INFO"`                            //nolint:lll // notations
+	VulnerabilityType vulnerability.Type   `json:"vulnerabilityType" example:"Vulnerability" enums:"Vulnerability, Risk Accepted, False Positive, Corrected"` //nolint:lll // notations
+	MaxCount          int                  `json:"maxCount" example:"0"`
+	MaxDays           int                  `json:"maxDays" example:"30"`
+	Language          languages.Language   `json:"language" example:"Go"`
+	SecurityTool      tools.Tool           `json:"securityTool" example:"GoSec"`
+}
+
+type Rules []Rule
+
+func (r Rules) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+func (r *Rules) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("[]byte assertion failed")
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// Validate checks the fields used by each rule type, the severity is required to count findings, the max days to
+// check the age of the findings and at least the language or the security tool to block findings
+func (r Rule) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Type, validation.Required, validation.In(policyEnums.RuleTypeValues()...)),
+		validation.Field(&r.Severity, validation.When(r.Type == policyEnums.SeverityCount, validation.Required),
+			validation.In(r.getValidSeverities()...)),
+		validation.Field(&r.VulnerabilityType, validation.In(r.getValidVulnerabilityTypes()...)),
+		validation.Field(&r.MaxCount, validation.Min(0)),
+		validation.Field(&r.MaxDays, validation.When(r.Type == policyEnums.FindingAge,
+			validation.Required, validation.Min(1))),
+		validation.Field(&r.Language, validation.In(r.getValidLanguages()...)),
+		validation.Field(&r.SecurityTool, validation.By(r.validateBlockedTool)),
+	)
+}
+
+func (r *Rule) validateBlockedTool(_ interface{}) error {
+	if r.Type == policyEnums.BlockedTool && r.Language == "" && r.SecurityTool == "" {
+		return policyEnums.ErrorBlockedRuleWithoutLanguageOrTool
+	}
+
+	return nil
+}
+
+func (r *Rule) getValidSeverities() (values []interface{}) {
+	for _, severity := range severities.Values() {
+		values = append(values, severity)
+	}
+
+	return values
+}
+
+func (r *Rule) getValidVulnerabilityTypes() (values []interface{}) {
+	for _, vulnerabilityType := range vulnerability.Values() {
+		values = append(values, vulnerabilityType)
+	}
+
+	return values
+}
+
+func (r *Rule) getValidLanguages() (values []interface{}) {
+	for _, language := range languages.Values() {
+		values = append(values, language)
+	}
+
+	return values
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	policyEnums "github.com/ZupIT/horusec-platform/core/internal/enums/policy"
+)
+
+func TestRuleValidate(t *testing.T) {
+	t.Run("should return no error when valid severity count rule", func(t *testing.T) {
+		rule := Rule{Type: policyEnums.SeverityCount, Severity: severities.High, MaxCount: 5,
+			VulnerabilityType: vulnerability.Vulnerability}
+
+		assert.NoError(t, rule.Validate())
+	})
+
+	t.Run("should return no error when valid finding age rule", func(t *testing.T) {
+		assert.NoError(t, Rule{Type: policyEnums.FindingAge, MaxDays: 30}.Validate())
+	})
+
+	t.Run("should return no error when valid blocked tool rule", func(t *testing.T) {
+		assert.NoError(t, Rule{Type: policyEnums.BlockedTool, Language: languages.Go}.Validate())
+		assert.NoError(t, Rule{Type: policyEnums.BlockedTool, SecurityTool: tools.GoSec}.Validate())
+	})
+
+	t.Run("should return error when invalid rule type", func(t *testing.T) {
+		assert.Error(t, Rule{}.Validate())
+		assert.Error(t, Rule{Type: "test"}.Validate())
+	})
+
+	t.Run("should return error when severity count rule without valid severity", func(t *testing.T) {
+		assert.Error(t, Rule{Type: policyEnums.SeverityCount}.Validate())
+		assert.Error(t, Rule{Type: policyEnums.SeverityCount, Severity: "test"}.Validate())
+	})
+
+	t.Run("should return error when invalid max count", func(t *testing.T) {
+		assert.Error(t, Rule{Type: policyEnums.SeverityCount, Severity: severities.High, MaxCount: -1}.Validate())
+	})
+
+	t.Run("should return error when finding age rule without max days", func(t *testing.T) {
+		assert.Error(t, Rule{Type: policyEnums.FindingAge}.Validate())
+	})
+
+	t.Run("should return error when blocked tool rule without language and tool", func(t *testing.T) {
+		assert.Error(t, Rule{Type: policyEnums.BlockedTool}.Validate())
+	})
+
+	t.Run("should return error when invalid language or vulnerability type", func(t *testing.T) {
+		assert.Error(t, Rule{Type: policyEnums.BlockedTool, Language: "test"}.Validate())
+		assert.Error(t, Rule{Type: policyEnums.FindingAge, MaxDays: 1, VulnerabilityType: "test"}.Validate())
+	})
+}
+
+func TestRulesValueAndScan(t *testing.T) {
+	t.Run("should success parse rules to database value and back", func(t *testing.T) {
+		rules := Rules{{Type: policyEnums.FindingAge, MaxDays: 30}}
+
+		value, err := rules.Value()
+		assert.NoError(t, err)
+
+		result := Rules{}
+		assert.NoError(t, result.Scan(value))
+		assert.Equal(t, rules, result)
+	})
+
+	t.Run("should return no error when scanning nil value", func(t *testing.T) {
+		result := Rules{}
+		assert.NoError(t, result.Scan(nil))
+		assert.Empty(t, result)
+	})
+
+	t.Run("should return error when scanning invalid value", func(t *testing.T) {
+		result := Rules{}
+		assert.Error(t, result.Scan("test"))
+	})
+}
@@ -18,6 +18,9 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
 )
 
 type Token struct {
@@ -31,3 +34,36 @@ type Token struct {
 	CreatedAt    time.Time  `json:"createdAt"`
 	ExpiresAt    time.Time  `json:"expiresAt"`
 }
+
+func (t *Token) ToResponse() *Response {
+	response := &Response{
+		TokenID:     t.TokenID,
+		WorkspaceID: t.WorkspaceID,
+		Description: t.Description,
+		SuffixValue: t.SuffixValue,
+		IsExpirable: t.IsExpirable,
+		CreatedAt:   t.CreatedAt,
+		ExpiresAt:   t.ExpiresAt,
+	}
+
+	if t.RepositoryID != nil {
+		response.RepositoryID = t.RepositoryID.String()
+	}
+
+	return response
+}
+
+// ToExpiringWebhookEvent never sends the token value, only the suffix used to identify it
+func (t *Token) ToExpiringWebhookEvent() []byte {
+	event := &repositoryEntities.WebhookEvent{
+		Type:        tokenEnums.WebhookEventTokenExpiring,
+		WorkspaceID: t.WorkspaceID,
+		Payload:     t.ToResponse(),
+	}
+
+	if t.RepositoryID != nil {
+		event.RepositoryID = *t.RepositoryID
+	}
+
+	return event.ToBytes()
+}
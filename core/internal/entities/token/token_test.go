@@ -0,0 +1,66 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
+)
+
+func TestToResponse(t *testing.T) {
+	t.Run("should parse token to response without value", func(t *testing.T) {
+		repositoryID := uuid.New()
+		token := &Token{TokenID: uuid.New(), RepositoryID: &repositoryID, Value: "test", SuffixValue: "st"}
+
+		response := token.ToResponse()
+		assert.Equal(t, token.TokenID, response.TokenID)
+		assert.Equal(t, repositoryID.String(), response.RepositoryID)
+		assert.Equal(t, "st", response.SuffixValue)
+	})
+
+	t.Run("should parse workspace token to response with empty repository id", func(t *testing.T) {
+		token := &Token{TokenID: uuid.New()}
+
+		assert.Empty(t, token.ToResponse().RepositoryID)
+	})
+}
+
+func TestToExpiringWebhookEvent(t *testing.T) {
+	t.Run("should parse repository token to expiring webhook event", func(t *testing.T) {
+		repositoryID := uuid.New()
+		token := &Token{WorkspaceID: uuid.New(), RepositoryID: &repositoryID, Value: "secret"}
+
+		event := &repositoryEntities.WebhookEvent{}
+		assert.NoError(t, json.Unmarshal(token.ToExpiringWebhookEvent(), event))
+		assert.Equal(t, tokenEnums.WebhookEventTokenExpiring, event.Type)
+		assert.Equal(t, token.WorkspaceID, event.WorkspaceID)
+		assert.Equal(t, repositoryID, event.RepositoryID)
+		assert.NotContains(t, string(token.ToExpiringWebhookEvent()), "secret")
+	})
+
+	t.Run("should parse workspace token to expiring webhook event", func(t *testing.T) {
+		token := &Token{WorkspaceID: uuid.New()}
+
+		event := &repositoryEntities.WebhookEvent{}
+		assert.NoError(t, json.Unmarshal(token.ToExpiringWebhookEvent(), event))
+		assert.Equal(t, uuid.Nil, event.RepositoryID)
+	})
+}
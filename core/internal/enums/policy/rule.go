@@ -0,0 +1,34 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+type RuleType string
+
+const (
+	// SeverityCount fails when the findings of a severity and type are more than the max count allowed
+	SeverityCount RuleType = "severity-count"
+	// FindingAge fails when any unresolved finding was first found more than the max days ago
+	FindingAge RuleType = "finding-age"
+	// BlockedTool fails when any unresolved finding was reported for a language and/or security tool
+	BlockedTool RuleType = "blocked-tool"
+)
+
+func (r RuleType) ToString() string {
+	return string(r)
+}
+
+func RuleTypeValues() []interface{} {
+	return []interface{}{SeverityCount, FindingAge, BlockedTool}
+}
@@ -15,5 +15,6 @@
 package repository
 
 const (
-	ErrorRollbackCreate = "{CORE_REPOSITORY} transaction rollback returned a error while creating repository"
+	ErrorRollbackCreate                = "{CORE_REPOSITORY} transaction rollback returned a error while creating repository"
+	MessageFailedToPublishWebhookEvent = "{CORE_REPOSITORY} failed to publish repository created webhook event"
 )
@@ -22,4 +22,6 @@ const (
 	Page                           = "page"
 	Size                           = "size"
 	Search                         = "search"
+	WebhookEventsQueue             = "horusec-webhook::events"
+	WebhookEventRepositoryCreated  = "repository-created"
 )
@@ -17,5 +17,6 @@ package routes
 const (
 	WorkspaceHandler  = "/core/workspaces"
 	RepositoryHandler = "/core/workspaces/{workspaceID}/repositories"
+	PolicyHandler     = "/core/workspaces/{workspaceID}/policies"
 	HealthHandler     = "/core/health"
 )
@@ -14,7 +14,17 @@
 
 package token
 
+import "time"
+
 const (
 	DatabaseTokens = "tokens"
 	ID             = "tokenID"
 )
+
+const (
+	ExpiringJobInterval       = time.Hour
+	ExpiringWindow            = 7 * 24 * time.Hour
+	ExpiringBatchLimit        = 100
+	WebhookEventsQueue        = "horusec-webhook::events"
+	WebhookEventTokenExpiring = "token-expiring"
+)
@@ -0,0 +1,247 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // swagger import
+
+	policyController "github.com/ZupIT/horusec-platform/core/internal/controllers/policy"
+	policyEntities "github.com/ZupIT/horusec-platform/core/internal/entities/policy"
+	policyEnums "github.com/ZupIT/horusec-platform/core/internal/enums/policy"
+	workspaceEnums "github.com/ZupIT/horusec-platform/core/internal/enums/workspace"
+	policyUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/policy"
+)
+
+type Handler struct {
+	controller policyController.IController
+	useCases   policyUseCases.IUseCases
+}
+
+func NewPolicyHandler(controller policyController.IController, useCases policyUseCases.IUseCases) *Handler {
+	return &Handler{
+		controller: controller,
+		useCases:   useCases,
+	}
+}
+
+func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
+	httpUtil.StatusNoContent(w)
+}
+
+// @Tags Policy
+// @Description Create a quality gate policy, without repository it is applied to the whole workspace
+// @ID create-policy
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param Policy body policyEntities.Data true "create policy data"
+// @Success 201 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/policies [post]
+// @Security ApiKeyAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getCreateData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	policy, err := h.controller.Create(data)
+	if err != nil {
+		h.checkPolicyErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusCreated(w, policy)
+}
+
+func (h *Handler) getCreateData(r *http.Request) (*policyEntities.Data, error) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := h.useCases.PolicyDataFromIOReadCloser(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.SetWorkspaceID(workspaceID), nil
+}
+
+func (h *Handler) checkPolicyErrors(w http.ResponseWriter, err error) {
+	switch err {
+	case policyEnums.ErrorRepositoryDoesNotBelongToWorkspace:
+		httpUtil.StatusBadRequest(w, err)
+	case databaseEnums.ErrorNotFoundRecords:
+		httpUtil.StatusNotFound(w, err)
+	default:
+		httpUtil.StatusInternalServerError(w, err)
+	}
+}
+
+// @Tags Policy
+// @Description Search for a existing policy by id
+// @ID get-policy
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param policyID path string true "ID of the policy"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/policies/{policyID} [get]
+// @Security ApiKeyAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getByIDData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	policy, err := h.controller.Get(data)
+	if err != nil {
+		h.checkPolicyErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, policy)
+}
+
+func (h *Handler) getByIDData(r *http.Request) (*policyEntities.Data, error) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	policyID, err := uuid.Parse(chi.URLParam(r, policyEnums.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	return (&policyEntities.Data{}).SetIDs(workspaceID, policyID), nil
+}
+
+// @Tags Policy
+// @Description Updates a existing policy by id
+// @ID update-policy
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param policyID path string true "ID of the policy"
+// @Param Policy body policyEntities.Data true "update policy data"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/policies/{policyID} [patch]
+// @Security ApiKeyAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getUpdateData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	policy, err := h.controller.Update(data)
+	if err != nil {
+		h.checkPolicyErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, policy)
+}
+
+func (h *Handler) getUpdateData(r *http.Request) (*policyEntities.Data, error) {
+	policyID, err := uuid.Parse(chi.URLParam(r, policyEnums.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := h.getCreateData(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.SetIDs(data.WorkspaceID, policyID), nil
+}
+
+// @Tags Policy
+// @Description Delete a policy by id
+// @ID delete-policy
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param policyID path string true "ID of the policy"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/policies/{policyID} [delete]
+// @Security ApiKeyAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getByIDData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.controller.Delete(data); err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusNoContent(w)
+}
+
+// @Tags Policy
+// @Description List all policies of a workspace, including the ones applied to a single repository
+// @ID list-policies
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/policies [get]
+// @Security ApiKeyAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	policies, err := h.controller.List(workspaceID)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, policies)
+}
@@ -0,0 +1,287 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	policyController "github.com/ZupIT/horusec-platform/core/internal/controllers/policy"
+	policyEntities "github.com/ZupIT/horusec-platform/core/internal/entities/policy"
+	policyEnums "github.com/ZupIT/horusec-platform/core/internal/enums/policy"
+	policyUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/policy"
+)
+
+func newRequest(method string, body []byte, workspaceID, policyID string) *http.Request {
+	r, _ := http.NewRequest(method, "test", bytes.NewReader(body))
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("workspaceID", workspaceID)
+	ctx.URLParams.Add("policyID", policyID)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func newPolicyBody() []byte {
+	data := &policyEntities.Data{
+		Name:  "test",
+		Rules: policyEntities.Rules{{Type: policyEnums.SeverityCount, Severity: severities.Critical}},
+	}
+
+	return data.ToBytes()
+}
+
+func TestNewPolicyHandler(t *testing.T) {
+	t.Run("should success create a new handler", func(t *testing.T) {
+		assert.NotNil(t, NewPolicyHandler(&policyController.Mock{}, policyUseCases.NewPolicyUseCases()))
+	})
+}
+
+func TestOptions(t *testing.T) {
+	t.Run("should return 204 when options", func(t *testing.T) {
+		handler := NewPolicyHandler(&policyController.Mock{}, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Options(w, newRequest(http.MethodOptions, nil, "", ""))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("should return 201 when everything it is ok", func(t *testing.T) {
+		controllerMock := &policyController.Mock{}
+		controllerMock.On("Create").Return(&policyEntities.Policy{}, nil)
+
+		handler := NewPolicyHandler(controllerMock, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Create(w, newRequest(http.MethodPost, newPolicyBody(), uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should return 400 when repository does not belong to workspace", func(t *testing.T) {
+		controllerMock := &policyController.Mock{}
+		controllerMock.On("Create").Return(&policyEntities.Policy{},
+			policyEnums.ErrorRepositoryDoesNotBelongToWorkspace)
+
+		handler := NewPolicyHandler(controllerMock, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Create(w, newRequest(http.MethodPost, newPolicyBody(), uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &policyController.Mock{}
+		controllerMock.On("Create").Return(&policyEntities.Policy{}, errors.New("test"))
+
+		handler := NewPolicyHandler(controllerMock, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Create(w, newRequest(http.MethodPost, newPolicyBody(), uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid body", func(t *testing.T) {
+		handler := NewPolicyHandler(&policyController.Mock{}, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Create(w, newRequest(http.MethodPost, []byte("{}"), uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		handler := NewPolicyHandler(&policyController.Mock{}, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Create(w, newRequest(http.MethodPost, newPolicyBody(), "test", ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &policyController.Mock{}
+		controllerMock.On("Get").Return(&policyEntities.Policy{}, nil)
+
+		handler := NewPolicyHandler(controllerMock, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Get(w, newRequest(http.MethodGet, nil, uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when policy was not found", func(t *testing.T) {
+		controllerMock := &policyController.Mock{}
+		controllerMock.On("Get").Return(&policyEntities.Policy{}, databaseEnums.ErrorNotFoundRecords)
+
+		handler := NewPolicyHandler(controllerMock, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Get(w, newRequest(http.MethodGet, nil, uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 400 when invalid policy id", func(t *testing.T) {
+		handler := NewPolicyHandler(&policyController.Mock{}, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Get(w, newRequest(http.MethodGet, nil, uuid.NewString(), "test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		handler := NewPolicyHandler(&policyController.Mock{}, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Get(w, newRequest(http.MethodGet, nil, "test", uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &policyController.Mock{}
+		controllerMock.On("Update").Return(&policyEntities.Policy{}, nil)
+
+		handler := NewPolicyHandler(controllerMock, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Update(w, newRequest(http.MethodPatch, newPolicyBody(), uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when policy was not found", func(t *testing.T) {
+		controllerMock := &policyController.Mock{}
+		controllerMock.On("Update").Return(&policyEntities.Policy{}, databaseEnums.ErrorNotFoundRecords)
+
+		handler := NewPolicyHandler(controllerMock, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Update(w, newRequest(http.MethodPatch, newPolicyBody(), uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 400 when invalid policy id", func(t *testing.T) {
+		handler := NewPolicyHandler(&policyController.Mock{}, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Update(w, newRequest(http.MethodPatch, newPolicyBody(), uuid.NewString(), "test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid body", func(t *testing.T) {
+		handler := NewPolicyHandler(&policyController.Mock{}, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Update(w, newRequest(http.MethodPatch, []byte("{}"), uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("should return 204 when everything it is ok", func(t *testing.T) {
+		controllerMock := &policyController.Mock{}
+		controllerMock.On("Delete").Return(nil)
+
+		handler := NewPolicyHandler(controllerMock, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Delete(w, newRequest(http.MethodDelete, nil, uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &policyController.Mock{}
+		controllerMock.On("Delete").Return(errors.New("test"))
+
+		handler := NewPolicyHandler(controllerMock, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Delete(w, newRequest(http.MethodDelete, nil, uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid policy id", func(t *testing.T) {
+		handler := NewPolicyHandler(&policyController.Mock{}, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.Delete(w, newRequest(http.MethodDelete, nil, uuid.NewString(), "test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &policyController.Mock{}
+		controllerMock.On("List").Return(&[]policyEntities.Policy{}, nil)
+
+		handler := NewPolicyHandler(controllerMock, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.List(w, newRequest(http.MethodGet, nil, uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &policyController.Mock{}
+		controllerMock.On("List").Return(&[]policyEntities.Policy{}, errors.New("test"))
+
+		handler := NewPolicyHandler(controllerMock, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.List(w, newRequest(http.MethodGet, nil, uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		handler := NewPolicyHandler(&policyController.Mock{}, policyUseCases.NewPolicyUseCases())
+
+		w := httptest.NewRecorder()
+		handler.List(w, newRequest(http.MethodGet, nil, "test", ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
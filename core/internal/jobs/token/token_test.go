@@ -0,0 +1,49 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	tokenController "github.com/ZupIT/horusec-platform/core/internal/controllers/token"
+)
+
+func TestNewExpiringTokenJob(t *testing.T) {
+	t.Run("should start job without panics", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			assert.NotNil(t, NewExpiringTokenJob(&tokenController.Mock{}))
+		})
+	})
+}
+
+func TestRun(t *testing.T) {
+	t.Run("should notify expiring tokens without panics", func(t *testing.T) {
+		controllerMock := &tokenController.Mock{}
+		controllerMock.On("NotifyExpiringTokens").Return(nil)
+		job := &Job{controller: controllerMock}
+		assert.NotPanics(t, job.run)
+		controllerMock.AssertCalled(t, "NotifyExpiringTokens")
+	})
+
+	t.Run("should log error when notify expiring tokens fails", func(t *testing.T) {
+		controllerMock := &tokenController.Mock{}
+		controllerMock.On("NotifyExpiringTokens").Return(errors.New("test"))
+		job := &Job{controller: controllerMock}
+		assert.NotPanics(t, job.run)
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
+)
+
+type IRepository interface {
+	ListExpiringTokens(now time.Time) (*[]tokenEntities.Token, error)
+	MarkExpiringNotified(tokenID uuid.UUID, notifiedAt time.Time) (bool, error)
+}
+
+type Repository struct {
+	databaseRead  database.IDatabaseRead
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewTokenRepository(databaseConnection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead:  databaseConnection.Read,
+		databaseWrite: databaseConnection.Write,
+	}
+}
+
+func (r *Repository) ListExpiringTokens(now time.Time) (*[]tokenEntities.Token, error) {
+	tokens := &[]tokenEntities.Token{}
+
+	return tokens, r.databaseRead.Raw(r.queryListExpiringTokens(), tokens, sql.Named("now", now),
+		sql.Named("until", now.Add(tokenEnums.ExpiringWindow)),
+		sql.Named("limit", tokenEnums.ExpiringBatchLimit)).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListExpiringTokens() string {
+	return `
+		SELECT token_id, workspace_id, repository_id, description, suffix_value, is_expirable, created_at, expires_at
+		FROM tokens
+		WHERE is_expirable = TRUE
+		AND expires_at > @now
+		AND expires_at <= @until
+		AND expiring_notified_at IS NULL
+		ORDER BY expires_at
+		LIMIT @limit
+	`
+}
+
+// MarkExpiringNotified claims the token notification, returning false when another instance already claimed it
+func (r *Repository) MarkExpiringNotified(tokenID uuid.UUID, notifiedAt time.Time) (bool, error) {
+	condition := map[string]interface{}{"token_id": tokenID, "expiring_notified_at": nil}
+
+	result := r.databaseWrite.Update(map[string]interface{}{"expiring_notified_at": notifiedAt},
+		condition, tokenEnums.DatabaseTokens)
+	if err := result.GetError(); err != nil {
+		return false, err
+	}
+
+	return result.GetRowsAffected() > 0, nil
+}
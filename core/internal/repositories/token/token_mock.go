@@ -0,0 +1,40 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) ListExpiringTokens(_ time.Time) (*[]tokenEntities.Token, error) {
+	args := m.MethodCalled("ListExpiringTokens")
+	return args.Get(0).(*[]tokenEntities.Token), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) MarkExpiringNotified(_ uuid.UUID, _ time.Time) (bool, error) {
+	args := m.MethodCalled("MarkExpiringNotified")
+	return args.Get(0).(bool), mockUtils.ReturnNilOrError(args, 1)
+}
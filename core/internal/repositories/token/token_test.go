@@ -0,0 +1,94 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+)
+
+func TestNewTokenRepository(t *testing.T) {
+	t.Run("should success create a token repository", func(t *testing.T) {
+		assert.NotNil(t, NewTokenRepository(&database.Connection{}))
+	})
+}
+
+func TestListExpiringTokens(t *testing.T) {
+	t.Run("should success list expiring tokens", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").
+			Return(response.NewResponse(1, nil, &[]tokenEntities.Token{{TokenID: uuid.New()}}))
+
+		repository := NewTokenRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListExpiringTokens(time.Now())
+		assert.NoError(t, err)
+		assert.Len(t, *result, 1)
+	})
+
+	t.Run("should return error when failed to list expiring tokens", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewTokenRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.ListExpiringTokens(time.Now())
+		assert.Error(t, err)
+	})
+}
+
+func TestMarkExpiringNotified(t *testing.T) {
+	t.Run("should return true when token notification was claimed", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+
+		repository := NewTokenRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		claimed, err := repository.MarkExpiringNotified(uuid.New(), time.Now())
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+	})
+
+	t.Run("should return false when token notification was already claimed", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, nil, nil))
+
+		repository := NewTokenRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		claimed, err := repository.MarkExpiringNotified(uuid.New(), time.Now())
+		assert.NoError(t, err)
+		assert.False(t, claimed)
+	})
+
+	t.Run("should return error when failed to claim token notification", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewTokenRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		claimed, err := repository.MarkExpiringNotified(uuid.New(), time.Now())
+		assert.Error(t, err)
+		assert.False(t, claimed)
+	})
+}
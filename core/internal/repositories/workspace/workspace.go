@@ -39,6 +39,7 @@ type IRepository interface {
 	IsWorkspaceAdmin(accountID, workspaceID uuid.UUID) bool
 	ListWorkspaceUsersNoBelong(workspaceID, repositoryID uuid.UUID) (*[]roleEntities.Response, error)
 	GetWorkspaceLdap(workspaceID uuid.UUID, permissions []string) (*workspaceEntities.Response, error)
+	GetAccountLanguage(accountID uuid.UUID) (string, error)
 }
 
 type Repository struct {
@@ -203,3 +204,17 @@ func (r *Repository) queryGetWorkspaceLdap() string {
 		WHERE workspace_id = @workspaceID
 	`
 }
+
+// GetAccountLanguage returns the language preference of the account, empty when it has none or does not exist
+func (r *Repository) GetAccountLanguage(accountID uuid.UUID) (language string, err error) {
+	return language, r.databaseRead.Raw(r.queryGetAccountLanguage(), &language,
+		sql.Named("accountID", accountID)).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryGetAccountLanguage() string {
+	return `
+		SELECT language
+		FROM accounts
+		WHERE account_id = @accountID
+	`
+}
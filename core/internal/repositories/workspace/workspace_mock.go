@@ -72,3 +72,8 @@ func (m *Mock) GetWorkspaceLdap(_ uuid.UUID, _ []string) (*workspaceEntities.Res
 	args := m.MethodCalled("GetWorkspaceLdap")
 	return args.Get(0).(*workspaceEntities.Response), mockUtils.ReturnNilOrError(args, 1)
 }
+
+func (m *Mock) GetAccountLanguage(_ uuid.UUID) (string, error) {
+	args := m.MethodCalled("GetAccountLanguage")
+	return args.Get(0).(string), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -15,6 +15,7 @@
 package workspace
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/uuid"
@@ -179,3 +180,28 @@ func TestListWorkspaceUsersNoBelong(t *testing.T) {
 		assert.NotNil(t, result)
 	})
 }
+
+func TestGetAccountLanguage(t *testing.T) {
+	t.Run("should success get the language of the account", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, "pt-BR"))
+
+		repository := NewWorkspaceRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			workspaceUseCases.NewWorkspaceUseCases())
+
+		result, err := repository.GetAccountLanguage(uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, "pt-BR", result)
+	})
+
+	t.Run("should return error when failed to get the language of the account", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewWorkspaceRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			workspaceUseCases.NewWorkspaceUseCases())
+
+		_, err := repository.GetAccountLanguage(uuid.New())
+		assert.Error(t, err)
+	})
+}
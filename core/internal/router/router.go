@@ -24,8 +24,10 @@ import (
 	"github.com/ZupIT/horusec-platform/core/docs"
 	"github.com/ZupIT/horusec-platform/core/internal/enums/routes"
 	"github.com/ZupIT/horusec-platform/core/internal/handlers/health"
+	"github.com/ZupIT/horusec-platform/core/internal/handlers/policy"
 	"github.com/ZupIT/horusec-platform/core/internal/handlers/repository"
 	"github.com/ZupIT/horusec-platform/core/internal/handlers/workspace"
+	tokenJob "github.com/ZupIT/horusec-platform/core/internal/jobs/token"
 )
 
 type IRouter interface {
@@ -38,11 +40,14 @@ type Router struct {
 	workspaceHandler  *workspace.Handler
 	repositoryHandler *repository.Handler
 	healthHandler     *health.Handler
+	policyHandler     *policy.Handler
+	tokenJob          tokenJob.IJob
 	swagger.ISwagger
 }
 
 func NewHTTPRouter(router httpRouter.IRouter, authzMiddleware middlewares.IAuthzMiddleware,
-	workspaceHandler *workspace.Handler, repositoryHandler *repository.Handler, healthHandler *health.Handler) IRouter {
+	workspaceHandler *workspace.Handler, repositoryHandler *repository.Handler, healthHandler *health.Handler,
+	policyHandler *policy.Handler, tokenJob tokenJob.IJob) IRouter {
 	httpRoutes := &Router{
 		IRouter:           router,
 		IAuthzMiddleware:  authzMiddleware,
@@ -50,6 +55,8 @@ func NewHTTPRouter(router httpRouter.IRouter, authzMiddleware middlewares.IAuthz
 		workspaceHandler:  workspaceHandler,
 		repositoryHandler: repositoryHandler,
 		healthHandler:     healthHandler,
+		policyHandler:     policyHandler,
+		tokenJob:          tokenJob,
 	}
 
 	return httpRoutes.setRoutes()
@@ -59,6 +66,7 @@ func (r *Router) setRoutes() IRouter {
 	r.swaggerRoutes()
 	r.workspaceRoutes()
 	r.repositoryRoutes()
+	r.policyRoutes()
 	r.healthRoutes()
 
 	return r
@@ -100,6 +108,17 @@ func (r *Router) repositoryRoutes() {
 	})
 }
 
+func (r *Router) policyRoutes() {
+	r.Route(routes.PolicyHandler, func(router chi.Router) {
+		router.Options("/", r.policyHandler.Options)
+		router.With(r.IsWorkspaceAdmin).Post("/", r.policyHandler.Create)
+		router.With(r.IsWorkspaceAdmin).Get("/", r.policyHandler.List)
+		router.With(r.IsWorkspaceAdmin).Get("/{policyID}", r.policyHandler.Get)
+		router.With(r.IsWorkspaceAdmin).Patch("/{policyID}", r.policyHandler.Update)
+		router.With(r.IsWorkspaceAdmin).Delete("/{policyID}", r.policyHandler.Delete)
+	})
+}
+
 func (r *Router) healthRoutes() {
 	r.Route(routes.HealthHandler, func(router chi.Router) {
 		router.Options("/", r.healthHandler.Options)
@@ -24,8 +24,10 @@ import (
 
 	"github.com/ZupIT/horusec-platform/core/config/cors"
 	"github.com/ZupIT/horusec-platform/core/internal/handlers/health"
+	"github.com/ZupIT/horusec-platform/core/internal/handlers/policy"
 	"github.com/ZupIT/horusec-platform/core/internal/handlers/repository"
 	"github.com/ZupIT/horusec-platform/core/internal/handlers/workspace"
+	"github.com/ZupIT/horusec-platform/core/internal/jobs/token"
 )
 
 func TestNewHTTPRouter(t *testing.T) {
@@ -35,10 +37,11 @@ func TestNewHTTPRouter(t *testing.T) {
 		workspaceHandler := &workspace.Handler{}
 		repositoryHandler := &repository.Handler{}
 		healthHandler := &health.Handler{}
+		policyHandler := &policy.Handler{}
 
 		assert.NotPanics(t, func() {
 			assert.NotNil(t, NewHTTPRouter(routerService, middlewareService, workspaceHandler,
-				repositoryHandler, healthHandler))
+				repositoryHandler, healthHandler, policyHandler, &token.Job{}))
 		})
 	})
 }
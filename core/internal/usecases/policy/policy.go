@@ -0,0 +1,69 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	policyEntities "github.com/ZupIT/horusec-platform/core/internal/entities/policy"
+)
+
+type IUseCases interface {
+	PolicyDataFromIOReadCloser(body io.ReadCloser) (*policyEntities.Data, error)
+	FilterPolicyByID(workspaceID, policyID uuid.UUID) map[string]interface{}
+	FilterListPolicies(workspaceID uuid.UUID) map[string]interface{}
+	FilterActivePoliciesByScope(workspaceID uuid.UUID, repositoryID *uuid.UUID) map[string]interface{}
+}
+
+type UseCases struct {
+}
+
+func NewPolicyUseCases() IUseCases {
+	return &UseCases{}
+}
+
+func (u *UseCases) PolicyDataFromIOReadCloser(body io.ReadCloser) (*policyEntities.Data, error) {
+	data := &policyEntities.Data{}
+
+	if err := parser.ParseBodyToEntity(body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (u *UseCases) FilterPolicyByID(workspaceID, policyID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"workspace_id": workspaceID, "policy_id": policyID}
+}
+
+func (u *UseCases) FilterListPolicies(workspaceID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"workspace_id": workspaceID}
+}
+
+// FilterActivePoliciesByScope filters the active policies of a repository or, when the repository is nil,
+// the active policies of the workspace that are not from any repository
+func (u *UseCases) FilterActivePoliciesByScope(workspaceID uuid.UUID,
+	repositoryID *uuid.UUID) map[string]interface{} {
+	filter := map[string]interface{}{"workspace_id": workspaceID, "repository_id": nil, "is_active": true}
+	if repositoryID != nil {
+		filter["repository_id"] = *repositoryID
+	}
+
+	return filter
+}
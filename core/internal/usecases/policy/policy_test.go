@@ -0,0 +1,109 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	policyEntities "github.com/ZupIT/horusec-platform/core/internal/entities/policy"
+	policyEnums "github.com/ZupIT/horusec-platform/core/internal/enums/policy"
+)
+
+func TestNewPolicyUseCases(t *testing.T) {
+	t.Run("should success create a new use cases", func(t *testing.T) {
+		assert.NotNil(t, NewPolicyUseCases())
+	})
+}
+
+func TestPolicyDataFromIOReadCloser(t *testing.T) {
+	t.Run("should success get policy data from request body", func(t *testing.T) {
+		useCases := NewPolicyUseCases()
+
+		data := &policyEntities.Data{
+			Name:  "test",
+			Rules: policyEntities.Rules{{Type: policyEnums.SeverityCount, Severity: severities.Critical}},
+		}
+
+		readCloser, err := parser.ParseEntityToIOReadCloser(data)
+		assert.NoError(t, err)
+
+		response, err := useCases.PolicyDataFromIOReadCloser(readCloser)
+		assert.NoError(t, err)
+		assert.Equal(t, data.Name, response.Name)
+		assert.Equal(t, data.Rules, response.Rules)
+	})
+
+	t.Run("should return error when failed to parse body to entity", func(t *testing.T) {
+		useCases := NewPolicyUseCases()
+
+		readCloser, err := parser.ParseEntityToIOReadCloser("")
+		assert.NoError(t, err)
+
+		_, err = useCases.PolicyDataFromIOReadCloser(readCloser)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid policy data", func(t *testing.T) {
+		useCases := NewPolicyUseCases()
+
+		readCloser, err := parser.ParseEntityToIOReadCloser(&policyEntities.Data{})
+		assert.NoError(t, err)
+
+		_, err = useCases.PolicyDataFromIOReadCloser(readCloser)
+		assert.Error(t, err)
+	})
+}
+
+func TestFilterPolicyByID(t *testing.T) {
+	t.Run("should success create a filter by policy id", func(t *testing.T) {
+		workspaceID := uuid.New()
+		policyID := uuid.New()
+
+		filter := NewPolicyUseCases().FilterPolicyByID(workspaceID, policyID)
+		assert.Equal(t, workspaceID, filter["workspace_id"])
+		assert.Equal(t, policyID, filter["policy_id"])
+	})
+}
+
+func TestFilterListPolicies(t *testing.T) {
+	t.Run("should success create a filter by workspace id", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		assert.Equal(t, workspaceID, NewPolicyUseCases().FilterListPolicies(workspaceID)["workspace_id"])
+	})
+}
+
+func TestFilterActivePoliciesByScope(t *testing.T) {
+	t.Run("should filter active policies of the workspace without repository", func(t *testing.T) {
+		filter := NewPolicyUseCases().FilterActivePoliciesByScope(uuid.New(), nil)
+
+		assert.Nil(t, filter["repository_id"])
+		assert.Contains(t, filter, "repository_id")
+		assert.Equal(t, true, filter["is_active"])
+	})
+
+	t.Run("should filter active policies of the repository", func(t *testing.T) {
+		repositoryID := uuid.New()
+
+		filter := NewPolicyUseCases().FilterActivePoliciesByScope(uuid.New(), &repositoryID)
+		assert.Equal(t, repositoryID, filter["repository_id"])
+	})
+}
@@ -27,6 +27,7 @@ import (
 
 	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
 	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
 )
 
 type IUseCases interface {
@@ -37,7 +38,8 @@ type IUseCases interface {
 		accountData *proto.GetAccountDataResponse) *repositoryEntities.Data
 	FilterRepositoryByID(repositoryID uuid.UUID) map[string]interface{}
 	FilterAccountRepositoryByID(accountID, repositoryID uuid.UUID) map[string]interface{}
-	NewRepositoryInviteEmail(email, username, repositoryName string) []byte
+	NewRepositoryInviteEmail(email, username, language, repositoryName string) []byte
+	NewRepositoryCreatedWebhookEvent(response *repositoryEntities.Response) []byte
 	InheritWorkspaceGroups(repository *repositoryEntities.Repository,
 		workspace *workspaceEntities.Workspace) *repositoryEntities.Repository
 }
@@ -92,7 +94,7 @@ func (u *UseCases) FilterAccountRepositoryByID(accountID, repositoryID uuid.UUID
 	return map[string]interface{}{"account_id": accountID, "repository_id": repositoryID}
 }
 
-func (u *UseCases) NewRepositoryInviteEmail(email, username, repositoryName string) []byte {
+func (u *UseCases) NewRepositoryInviteEmail(email, username, language, repositoryName string) []byte {
 	emailMessage := &emailEntities.Message{
 		To:           email,
 		TemplateName: emailEnums.RepositoryInvite,
@@ -100,12 +102,24 @@ func (u *UseCases) NewRepositoryInviteEmail(email, username, repositoryName stri
 		Data: map[string]interface{}{
 			"repositoryName": repositoryName,
 			"username":       username,
+			"Locale":         language,
 		},
 	}
 
 	return emailMessage.ToBytes()
 }
 
+func (u *UseCases) NewRepositoryCreatedWebhookEvent(response *repositoryEntities.Response) []byte {
+	event := &repositoryEntities.WebhookEvent{
+		Type:         repositoryEnums.WebhookEventRepositoryCreated,
+		WorkspaceID:  response.WorkspaceID,
+		RepositoryID: response.RepositoryID,
+		Payload:      response,
+	}
+
+	return event.ToBytes()
+}
+
 func (u *UseCases) InheritWorkspaceGroups(repository *repositoryEntities.Repository,
 	workspace *workspaceEntities.Workspace) *repositoryEntities.Repository {
 	if !repository.ContainsAllAuthzGroups() {
@@ -30,6 +30,7 @@ import (
 
 	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
 	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
 )
 
 func TestNewRepositoryUseCases(t *testing.T) {
@@ -148,7 +149,7 @@ func TestNewOrganizationInviteEmail(t *testing.T) {
 	t.Run("should success create a new repository invite email", func(t *testing.T) {
 		useCases := NewRepositoryUseCases()
 
-		emailBytes := useCases.NewRepositoryInviteEmail("test@test.com", "test", "test")
+		emailBytes := useCases.NewRepositoryInviteEmail("test@test.com", "test", "pt-BR", "test")
 		assert.NotNil(t, emailBytes)
 		assert.NotEmpty(t, emailBytes)
 
@@ -164,6 +165,7 @@ func TestNewOrganizationInviteEmail(t *testing.T) {
 
 			assert.Equal(t, "test", data["repositoryName"])
 			assert.Equal(t, "test", data["username"])
+			assert.Equal(t, "pt-BR", data["Locale"])
 
 		})
 	})
@@ -263,3 +265,18 @@ func TestInheritWorkspaceGroups(t *testing.T) {
 		assert.NotEqual(t, workspace.AuthzMember, repository.AuthzMember)
 	})
 }
+
+func TestNewRepositoryCreatedWebhookEvent(t *testing.T) {
+	t.Run("should success create a new repository created webhook event", func(t *testing.T) {
+		useCases := NewRepositoryUseCases()
+		response := &repositoryEntities.Response{WorkspaceID: uuid.New(), RepositoryID: uuid.New()}
+
+		event := &repositoryEntities.WebhookEvent{}
+		assert.NoError(t, json.Unmarshal(useCases.NewRepositoryCreatedWebhookEvent(response), event))
+
+		assert.Equal(t, repositoryEnums.WebhookEventRepositoryCreated, event.Type)
+		assert.Equal(t, response.WorkspaceID, event.WorkspaceID)
+		assert.Equal(t, response.RepositoryID, event.RepositoryID)
+		assert.NotNil(t, event.Payload)
+	})
+}
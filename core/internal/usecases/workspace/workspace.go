@@ -35,7 +35,7 @@ type IUseCases interface {
 	FilterAccountWorkspaceByID(accountID, workspaceID uuid.UUID) map[string]interface{}
 	FilterWorkspaceByID(workspaceID uuid.UUID) map[string]interface{}
 	NewWorkspaceData(workspaceID uuid.UUID, accountData *proto.GetAccountDataResponse) *workspace.Data
-	NewOrganizationInviteEmail(email, username, workspaceName string) []byte
+	NewOrganizationInviteEmail(email, username, language, workspaceName string) []byte
 	VerifyErrorForGRPCOnGetDataByEmail(err error) error
 }
 
@@ -72,7 +72,7 @@ func (u *UseCases) NewWorkspaceData(workspaceID uuid.UUID, accountData *proto.Ge
 	return data.SetAccountData(accountData)
 }
 
-func (u *UseCases) NewOrganizationInviteEmail(email, username, workspaceName string) []byte {
+func (u *UseCases) NewOrganizationInviteEmail(email, username, language, workspaceName string) []byte {
 	emailMessage := &emailEntities.Message{
 		To:           email,
 		TemplateName: emailEnums.OrganizationInvite,
@@ -80,6 +80,7 @@ func (u *UseCases) NewOrganizationInviteEmail(email, username, workspaceName str
 		Data: map[string]interface{}{
 			"WorkspaceName": workspaceName,
 			"Username":      username,
+			"Locale":        language,
 			"URL":           envUtils.GetHorusecManagerURL()},
 	}
 
@@ -120,7 +120,7 @@ func TestNewOrganizationInviteEmail(t *testing.T) {
 	t.Run("should success create a new organization invite email", func(t *testing.T) {
 		useCases := NewWorkspaceUseCases()
 
-		emailBytes := useCases.NewOrganizationInviteEmail("test@test.com", "test", "test")
+		emailBytes := useCases.NewOrganizationInviteEmail("test@test.com", "test", "pt-BR", "test")
 		assert.NotNil(t, emailBytes)
 		assert.NotEmpty(t, emailBytes)
 
@@ -136,6 +136,7 @@ func TestNewOrganizationInviteEmail(t *testing.T) {
 
 			assert.Equal(t, "test", data["WorkspaceName"])
 			assert.Equal(t, "test", data["Username"])
+			assert.Equal(t, "pt-BR", data["Locale"])
 			assert.Equal(t, "http://localhost:8043", data["URL"])
 		})
 	})
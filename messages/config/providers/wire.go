@@ -29,6 +29,7 @@ import (
 	"github.com/ZupIT/horusec-platform/messages/internal/handlers/health"
 	"github.com/ZupIT/horusec-platform/messages/internal/router"
 	"github.com/ZupIT/horusec-platform/messages/internal/services/mailer"
+	"github.com/ZupIT/horusec-platform/messages/internal/services/renderer"
 )
 
 var devKitProviders = wire.NewSet(
@@ -56,6 +57,7 @@ var eventProviders = wire.NewSet(
 
 var serviceProviders = wire.NewSet(
 	mailer.NewMailerService,
+	renderer.NewRendererService,
 )
 
 func Initialize(_ string) (router.IRouter, error) {
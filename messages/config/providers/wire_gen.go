@@ -17,6 +17,7 @@ import (
 	"github.com/ZupIT/horusec-platform/messages/internal/handlers/health"
 	"github.com/ZupIT/horusec-platform/messages/internal/router"
 	"github.com/ZupIT/horusec-platform/messages/internal/services/mailer"
+	"github.com/ZupIT/horusec-platform/messages/internal/services/renderer"
 )
 
 // Injectors from wire.go:
@@ -31,7 +32,8 @@ func Initialize(string2 string) (router.IRouter, error) {
 	}
 	iService := mailer.NewMailerService()
 	handler := health.NewHealthHandler(iBroker, iService)
-	iController := email.NewEmailController(iService)
+	rendererIService := renderer.NewRendererService()
+	iController := email.NewEmailController(iService, rendererIService)
 	eventHandler := email2.NewEmailEventHandler(iController, iBroker)
 	routerIRouter := router.NewHTTPRouter(iRouter, handler, eventHandler)
 	return routerIRouter, nil
@@ -49,4 +51,4 @@ var handleProviders = wire.NewSet(health.NewHealthHandler)
 
 var eventProviders = wire.NewSet(email2.NewEmailEventHandler)
 
-var serviceProviders = wire.NewSet(mailer.NewMailerService)
+var serviceProviders = wire.NewSet(mailer.NewMailerService, renderer.NewRendererService)
@@ -15,16 +15,12 @@
 package email
 
 import (
-	"bytes"
-	"html/template"
-
 	"gopkg.in/gomail.v2"
 
 	emailEntities "github.com/ZupIT/horusec-devkit/pkg/entities/email"
-	emailEnums "github.com/ZupIT/horusec-devkit/pkg/enums/email"
 
-	"github.com/ZupIT/horusec-platform/messages/internal/enums/templates"
 	"github.com/ZupIT/horusec-platform/messages/internal/services/mailer"
+	"github.com/ZupIT/horusec-platform/messages/internal/services/renderer"
 )
 
 type IController interface {
@@ -32,37 +28,35 @@ type IController interface {
 }
 
 type Controller struct {
-	tpl           *template.Template
-	mailerService mailer.IService
+	mailerService   mailer.IService
+	rendererService renderer.IService
 }
 
-func NewEmailController(mailerService mailer.IService) IController {
-	tpl := template.Must(template.New(emailEnums.AccountConfirmation.ToString()).Parse(templates.EmailConfirmationTpl))
-	tpl = template.Must(tpl.New(emailEnums.ResetPassword.ToString()).Parse(templates.ResetPasswordTpl))
-	tpl = template.Must(tpl.New(emailEnums.OrganizationInvite.ToString()).Parse(templates.OrganizationInviteTpl))
-
+func NewEmailController(mailerService mailer.IService, rendererService renderer.IService) IController {
 	return &Controller{
-		tpl:           tpl,
-		mailerService: mailerService,
+		mailerService:   mailerService,
+		rendererService: rendererService,
 	}
 }
 
 func (c *Controller) SendEmail(data *emailEntities.Message) error {
-	body := new(bytes.Buffer)
-	if err := c.tpl.ExecuteTemplate(body, data.TemplateName.ToString(), data.Data); err != nil {
+	content, err := c.rendererService.Render(data)
+	if err != nil {
 		return err
 	}
 
-	return c.mailerService.SendEmail(c.createMessage(data, body.String()))
+	return c.mailerService.SendEmail(c.createMessage(data, content))
 }
 
-func (c *Controller) createMessage(data *emailEntities.Message, body string) *gomail.Message {
+// createMessage sets the plain text body before the html alternative, so clients that support html prefer it
+func (c *Controller) createMessage(data *emailEntities.Message, content *renderer.Content) *gomail.Message {
 	message := gomail.NewMessage()
 
 	message.SetHeader("From", c.mailerService.GetFromHeader())
-	message.SetHeader("Subject", data.Subject)
+	message.SetHeader("Subject", content.Subject)
 	message.SetHeader("To", data.To)
-	message.SetBody("text/html", body)
+	message.SetBody("text/plain", content.Text)
+	message.AddAlternative("text/html", content.HTML)
 
 	return message
 }
@@ -15,6 +15,7 @@
 package email
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -22,12 +23,14 @@ import (
 	emailEntities "github.com/ZupIT/horusec-devkit/pkg/entities/email"
 	emailEnums "github.com/ZupIT/horusec-devkit/pkg/enums/email"
 
+	"github.com/ZupIT/horusec-platform/messages/internal/enums/templates"
 	"github.com/ZupIT/horusec-platform/messages/internal/services/mailer"
+	"github.com/ZupIT/horusec-platform/messages/internal/services/renderer"
 )
 
 func TestNewController(t *testing.T) {
 	t.Run("should success create a new controller", func(t *testing.T) {
-		assert.NotNil(t, NewEmailController(nil))
+		assert.NotNil(t, NewEmailController(nil, nil))
 	})
 }
 
@@ -37,18 +40,121 @@ func TestSendEmail(t *testing.T) {
 		mailerMock.On("SendEmail").Return(nil)
 		mailerMock.On("GetFromHeader").Return("test")
 
-		controller := NewEmailController(mailerMock)
+		controller := NewEmailController(mailerMock, renderer.NewRendererService())
 
 		message := &emailEntities.Message{TemplateName: emailEnums.AccountConfirmation}
 		assert.NoError(t, controller.SendEmail(message))
 	})
 
+	t.Run("should success send risk acceptance expired email", func(t *testing.T) {
+		mailerMock := &mailer.Mock{}
+		mailerMock.On("SendEmail").Return(nil)
+		mailerMock.On("GetFromHeader").Return("test")
+
+		controller := NewEmailController(mailerMock, renderer.NewRendererService())
+
+		message := &emailEntities.Message{
+			To:           "test@horusec.io",
+			TemplateName: templates.RiskAcceptanceExpired,
+			Data: map[string]interface{}{
+				"Username":       "test",
+				"RepositoryName": "horusec",
+				"VulnHash":       "1234",
+				"File":           "main.go",
+				"Line":           "10",
+				"Severity":       "HIGH",
+				"Approver":       "approver@horusec.io",
+				"ExpiresAt":      "2021-12-30 10:00 UTC",
+			},
+		}
+		assert.NoError(t, controller.SendEmail(message))
+		mailerMock.AssertCalled(t, "SendEmail")
+	})
+
+	t.Run("should success send workspace digest email", func(t *testing.T) {
+		mailerMock := &mailer.Mock{}
+		mailerMock.On("SendEmail").Return(nil)
+		mailerMock.On("GetFromHeader").Return("test")
+
+		controller := NewEmailController(mailerMock, renderer.NewRendererService())
+
+		findings := map[string]interface{}{"Critical": 1, "High": 2, "Medium": 0, "Low": 0, "Total": 3}
+		message := &emailEntities.Message{
+			To:           "test@horusec.io",
+			TemplateName: templates.WorkspaceDigest,
+			Data: map[string]interface{}{
+				"Username":      "test",
+				"WorkspaceName": "horusec",
+				"StartDate":     "2021-12-23",
+				"EndDate":       "2021-12-30",
+				"NewFindings":   findings,
+				"FixedFindings": findings,
+				"TopRepositories": []map[string]interface{}{
+					{"RepositoryName": "horusec", "Critical": 1, "High": 2, "Medium": 0, "Low": 0, "Total": 3},
+				},
+				"RiskAcceptances": []map[string]interface{}{
+					{"RepositoryName": "horusec", "Severity": "HIGH", "VulnHash": "1234", "AccountUsername": "test",
+						"ExpiresAt": "2021-12-31", "IsOverdue": false},
+				},
+			},
+		}
+		assert.NoError(t, controller.SendEmail(message))
+		mailerMock.AssertCalled(t, "SendEmail")
+	})
+
+	t.Run("should success send workspace digest email without repositories and risk acceptances",
+		func(t *testing.T) {
+			mailerMock := &mailer.Mock{}
+			mailerMock.On("SendEmail").Return(nil)
+			mailerMock.On("GetFromHeader").Return("test")
+
+			controller := NewEmailController(mailerMock, renderer.NewRendererService())
+
+			message := &emailEntities.Message{
+				To:           "test@horusec.io",
+				TemplateName: templates.WorkspaceDigest,
+				Data: map[string]interface{}{
+					"Username":      "test",
+					"WorkspaceName": "horusec",
+					"NewFindings":   map[string]interface{}{},
+					"FixedFindings": map[string]interface{}{},
+				},
+			}
+			assert.NoError(t, controller.SendEmail(message))
+		})
+
 	t.Run("should return error when failed to execute template", func(t *testing.T) {
 		mailerMock := &mailer.Mock{}
 
-		controller := NewEmailController(mailerMock)
+		controller := NewEmailController(mailerMock, renderer.NewRendererService())
 
 		message := &emailEntities.Message{}
 		assert.Error(t, controller.SendEmail(message))
 	})
+
+	t.Run("should send the rendered content of the message", func(t *testing.T) {
+		mailerMock := &mailer.Mock{}
+		mailerMock.On("SendEmail").Return(nil)
+		mailerMock.On("GetFromHeader").Return("test")
+
+		rendererMock := &renderer.Mock{}
+		rendererMock.On("Render").Return(&renderer.Content{Subject: "test", HTML: "<p>test</p>", Text: "test"}, nil)
+
+		controller := NewEmailController(mailerMock, rendererMock)
+
+		assert.NoError(t, controller.SendEmail(&emailEntities.Message{To: "test@horusec.io"}))
+		mailerMock.AssertCalled(t, "SendEmail")
+	})
+
+	t.Run("should return error when failed to render the message", func(t *testing.T) {
+		mailerMock := &mailer.Mock{}
+
+		rendererMock := &renderer.Mock{}
+		rendererMock.On("Render").Return(&renderer.Content{}, errors.New("test"))
+
+		controller := NewEmailController(mailerMock, rendererMock)
+
+		assert.Error(t, controller.SendEmail(&emailEntities.Message{}))
+		mailerMock.AssertNotCalled(t, "SendEmail")
+	})
 }
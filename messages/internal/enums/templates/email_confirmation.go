@@ -301,3 +301,9 @@ const EmailConfirmationTpl = `<!doctype html>
 </body>
 </html>
 `
+
+const EmailConfirmationTextTpl = `Hello, {{.Username}}!
+
+To start using Horusec, confirm your email: {{.URL}}
+
+Horusec Team`
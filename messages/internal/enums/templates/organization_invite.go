@@ -298,3 +298,11 @@ const OrganizationInviteTpl = `<!doctype html>
   </table>
 </body>
 </html>`
+
+const OrganizationInviteTextTpl = `Hello, {{.Username}}!
+
+You have been invited to join the workspace {{.WorkspaceName}}.
+
+Accept the invitation: {{.URL}}
+
+Horusec Team`
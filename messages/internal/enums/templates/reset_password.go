@@ -308,3 +308,12 @@ const ResetPasswordTpl = `<!doctype html>
   </table>
 </body>
 </html>`
+
+const ResetPasswordTextTpl = `Hello, {{.Username}}!
+
+You have requested a password reset to access HORUSEC. The code is valid for 10 minutes and should be used 1x.
+
+Code: {{.Code}}
+{{.URL}}
+
+Horusec Team`
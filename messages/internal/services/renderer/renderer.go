@@ -0,0 +1,321 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package renderer
+
+import (
+	"bytes"
+	"fmt"
+	htmlTemplate "html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	textTemplate "text/template"
+
+	emailEntities "github.com/ZupIT/horusec-devkit/pkg/entities/email"
+	emailEnums "github.com/ZupIT/horusec-devkit/pkg/enums/email"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/ZupIT/horusec-platform/messages/internal/enums/templates"
+	"github.com/ZupIT/horusec-platform/messages/internal/services/renderer/enums"
+)
+
+type IService interface {
+	Render(message *emailEntities.Message) (*Content, error)
+}
+
+// Content is the email rendered in the locale of the recipient, with the plain text alternative of the html
+type Content struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+type templateSet struct {
+	html *htmlTemplate.Template
+	text *textTemplate.Template
+}
+
+type Service struct {
+	defaultLocale string
+	builtIn       *templateSet
+	customRoot    *templateSet
+	custom        map[string]*templateSet
+}
+
+// NewRendererService loads the built-in templates and the custom ones of the templates path, panicking when any
+// custom template is invalid so a broken file is noticed at startup instead of when sending the email
+func NewRendererService() IService {
+	service, err := newRendererService(env.GetEnvOrDefault(enums.EnvTemplatesPath, ""),
+		env.GetEnvOrDefault(enums.EnvDefaultLocale, enums.DefaultLocale))
+	if err != nil {
+		logger.LogPanic(enums.MessageInvalidTemplates, err)
+	}
+
+	return service
+}
+
+func newRendererService(path, defaultLocale string) (*Service, error) {
+	service := &Service{
+		defaultLocale: normalizeLocale(defaultLocale),
+		builtIn:       newBuiltInTemplates(),
+		custom:        map[string]*templateSet{},
+	}
+
+	if path == "" {
+		return service, nil
+	}
+
+	return service, service.loadCustomTemplates(path)
+}
+
+func newTemplateSet() *templateSet {
+	return &templateSet{
+		html: htmlTemplate.New(""),
+		text: textTemplate.New(""),
+	}
+}
+
+func newBuiltInTemplates() *templateSet {
+	set := newTemplateSet()
+	set.mustAdd(emailEnums.AccountConfirmation, templates.EmailConfirmationTpl, templates.EmailConfirmationTextTpl)
+	set.mustAdd(emailEnums.ResetPassword, templates.ResetPasswordTpl, templates.ResetPasswordTextTpl)
+	set.mustAdd(emailEnums.OrganizationInvite, templates.OrganizationInviteTpl, templates.OrganizationInviteTextTpl)
+	set.mustAdd(templates.RiskAcceptanceExpired, templates.RiskAcceptanceExpiredTpl,
+		templates.RiskAcceptanceExpiredTextTpl)
+	set.mustAdd(templates.WorkspaceDigest, templates.WorkspaceDigestTpl, templates.WorkspaceDigestTextTpl)
+
+	return set
+}
+
+func (t *templateSet) mustAdd(name emailEnums.Template, html, text string) {
+	htmlTemplate.Must(t.html.New(name.ToString() + enums.ExtensionHTML).Parse(html))
+	textTemplate.Must(t.text.New(name.ToString() + enums.ExtensionText).Parse(text))
+}
+
+// loadCustomTemplates parses the files of the templates path as the custom templates of any locale and the files of
+// each sub directory as the templates of the locale with the name of the directory, like pt-BR
+func (s *Service) loadCustomTemplates(path string) (err error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return err
+	}
+
+	if s.customRoot, err = parseFiles(path, entries); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			if err := s.loadLocaleTemplates(path, entry.Name()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return s.validateTextAlternatives()
+}
+
+func (s *Service) loadLocaleTemplates(path, locale string) error {
+	dir := filepath.Join(path, locale)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	set, err := parseFiles(dir, entries)
+	if err != nil {
+		return err
+	}
+
+	s.custom[normalizeLocale(locale)] = set
+
+	return nil
+}
+
+func parseFiles(dir string, entries []os.DirEntry) (*templateSet, error) {
+	set := newTemplateSet()
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		if err := set.parseFile(filepath.Join(dir, entry.Name())); err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Join(dir, entry.Name()), err)
+		}
+	}
+
+	return set, nil
+}
+
+// parseFile adds the file to the set by its name, html files are the body of the email, txt files the plain text
+// alternative and subject files the subject, files with other extensions are ignored
+func (t *templateSet) parseFile(path string) (err error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	name := filepath.Base(path)
+
+	switch filepath.Ext(name) {
+	case enums.ExtensionHTML:
+		_, err = t.html.New(name).Parse(string(content))
+	case enums.ExtensionText, enums.ExtensionSubject:
+		_, err = t.text.New(name).Parse(string(content))
+	}
+
+	return err
+}
+
+// validateTextAlternatives checks if every custom html template has a plain text alternative in its own locale or
+// in one of the custom fallbacks. The built-in text is never used, since it would not match the custom html
+func (s *Service) validateTextAlternatives() error {
+	if err := validateTextAlternatives(s.customRoot, []*templateSet{s.customRoot}); err != nil {
+		return err
+	}
+
+	for locale, set := range s.custom {
+		if err := validateTextAlternatives(set, s.getCustomTemplateSets(locale)); err != nil {
+			return fmt.Errorf("%s: %w", locale, err)
+		}
+	}
+
+	return nil
+}
+
+func validateTextAlternatives(set *templateSet, fallbacks []*templateSet) error {
+	for _, tpl := range set.html.Templates() {
+		if !strings.HasSuffix(tpl.Name(), enums.ExtensionHTML) {
+			continue
+		}
+
+		name := strings.TrimSuffix(tpl.Name(), enums.ExtensionHTML) + enums.ExtensionText
+		if lookupText(fallbacks, name) == nil {
+			return fmt.Errorf("%w: %s", enums.ErrorMissingTextTemplate, tpl.Name())
+		}
+	}
+
+	return nil
+}
+
+// Render executes the templates of the message in the locale informed in its data, falling back to the default
+// locale, to the custom templates without locale and at last to the built-in ones
+func (s *Service) Render(message *emailEntities.Message) (*Content, error) {
+	sets := s.getTemplateSets(s.getLocale(message.Data))
+	name := message.TemplateName.ToString()
+
+	html, err := executeHTML(sets, name+enums.ExtensionHTML, message.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := executeText(sets, name+enums.ExtensionText, message.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	subject, err := s.renderSubject(sets, name, message)
+
+	return &Content{Subject: subject, HTML: html, Text: text}, err
+}
+
+func (s *Service) renderSubject(sets []*templateSet, name string, message *emailEntities.Message) (string, error) {
+	subject, err := executeText(sets, name+enums.ExtensionSubject, message.Data)
+	if err == enums.ErrorTemplateNotFound {
+		return message.Subject, nil
+	}
+
+	return strings.TrimSpace(subject), err
+}
+
+func (s *Service) getLocale(data interface{}) string {
+	values, ok := data.(map[string]interface{})
+	if !ok {
+		return s.defaultLocale
+	}
+
+	locale, ok := values[enums.DataLocale].(string)
+	if !ok || locale == "" {
+		return s.defaultLocale
+	}
+
+	return normalizeLocale(locale)
+}
+
+// getTemplateSets returns the templates in the order they should be searched, a locale like pt-BR also falls back
+// to the templates of its language, like pt
+func (s *Service) getTemplateSets(locale string) []*templateSet {
+	return append(s.getCustomTemplateSets(locale), s.builtIn)
+}
+
+func (s *Service) getCustomTemplateSets(locale string) (sets []*templateSet) {
+	for _, key := range []string{locale, getLanguage(locale), s.defaultLocale, getLanguage(s.defaultLocale)} {
+		if set, ok := s.custom[key]; ok {
+			sets = append(sets, set)
+		}
+	}
+
+	if s.customRoot != nil {
+		sets = append(sets, s.customRoot)
+	}
+
+	return sets
+}
+
+func executeHTML(sets []*templateSet, name string, data interface{}) (string, error) {
+	for _, set := range sets {
+		if tpl := set.html.Lookup(name); tpl != nil {
+			buffer := new(bytes.Buffer)
+			err := tpl.Execute(buffer, data)
+
+			return buffer.String(), err
+		}
+	}
+
+	return "", enums.ErrorTemplateNotFound
+}
+
+func executeText(sets []*templateSet, name string, data interface{}) (string, error) {
+	tpl := lookupText(sets, name)
+	if tpl == nil {
+		return "", enums.ErrorTemplateNotFound
+	}
+
+	buffer := new(bytes.Buffer)
+	err := tpl.Execute(buffer, data)
+
+	return buffer.String(), err
+}
+
+func lookupText(sets []*templateSet, name string) *textTemplate.Template {
+	for _, set := range sets {
+		if tpl := set.text.Lookup(name); tpl != nil {
+			return tpl
+		}
+	}
+
+	return nil
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+func getLanguage(locale string) string {
+	return strings.Split(locale, "-")[0]
+}
@@ -0,0 +1,225 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package renderer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	emailEntities "github.com/ZupIT/horusec-devkit/pkg/entities/email"
+	emailEnums "github.com/ZupIT/horusec-devkit/pkg/enums/email"
+
+	"github.com/ZupIT/horusec-platform/messages/internal/enums/templates"
+	"github.com/ZupIT/horusec-platform/messages/internal/services/renderer/enums"
+)
+
+func writeTemplates(t *testing.T, files map[string]string) string {
+	path := t.TempDir()
+
+	for name, content := range files {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(path, name)), os.ModePerm))
+		assert.NoError(t, os.WriteFile(filepath.Join(path, name), []byte(content), os.ModePerm))
+	}
+
+	return path
+}
+
+func newMessage(locale string) *emailEntities.Message {
+	return &emailEntities.Message{
+		Subject:      "[Horusec] Account Confirmation Email",
+		TemplateName: emailEnums.AccountConfirmation,
+		Data:         map[string]interface{}{"Username": "test", "URL": "http://localhost", enums.DataLocale: locale},
+	}
+}
+
+func TestNewRendererService(t *testing.T) {
+	t.Run("should success create a new service with the built-in templates", func(t *testing.T) {
+		assert.NotNil(t, NewRendererService())
+	})
+
+	t.Run("should success create a new service with the templates of the path", func(t *testing.T) {
+		t.Setenv(enums.EnvTemplatesPath, writeTemplates(t, map[string]string{
+			"pt-BR/account-confirmation.html": "<p>Olá, {{.Username}}!</p>",
+			"pt-BR/account-confirmation.txt":  "Olá, {{.Username}}!",
+		}))
+
+		assert.NotPanics(t, func() {
+			assert.NotNil(t, NewRendererService())
+		})
+	})
+
+	t.Run("should panic when there is an invalid template in the path", func(t *testing.T) {
+		t.Setenv(enums.EnvTemplatesPath, writeTemplates(t, map[string]string{
+			"account-confirmation.html": "<p>{{.Username</p>",
+		}))
+
+		assert.Panics(t, func() {
+			_ = NewRendererService()
+		})
+	})
+}
+
+func TestLoadCustomTemplates(t *testing.T) {
+	t.Run("should return error when the path does not exist", func(t *testing.T) {
+		_, err := newRendererService(filepath.Join(t.TempDir(), "test"), enums.DefaultLocale)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid html template", func(t *testing.T) {
+		_, err := newRendererService(writeTemplates(t, map[string]string{
+			"account-confirmation.html": "<p>{{.Username</p>",
+		}), enums.DefaultLocale)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid template of a locale", func(t *testing.T) {
+		_, err := newRendererService(writeTemplates(t, map[string]string{
+			"es-ES/account-confirmation.subject": "{{if .Username}}",
+		}), enums.DefaultLocale)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "es-ES")
+	})
+
+	t.Run("should return error when a new template has no plain text alternative", func(t *testing.T) {
+		_, err := newRendererService(writeTemplates(t, map[string]string{
+			"pt-BR/new-template.html": "<p>{{.Username}}</p>",
+		}), enums.DefaultLocale)
+		assert.ErrorIs(t, err, enums.ErrorMissingTextTemplate)
+	})
+
+	t.Run("should return error when a custom html has only the built-in plain text alternative", func(t *testing.T) {
+		_, err := newRendererService(writeTemplates(t, map[string]string{
+			"account-confirmation.html": "<p>{{.Username}}</p>",
+		}), enums.DefaultLocale)
+		assert.ErrorIs(t, err, enums.ErrorMissingTextTemplate)
+
+		_, err = newRendererService(writeTemplates(t, map[string]string{
+			"pt-BR/account-confirmation.html": "<p>{{.Username}}</p>",
+		}), enums.DefaultLocale)
+		assert.ErrorIs(t, err, enums.ErrorMissingTextTemplate)
+	})
+
+	t.Run("should accept a new template with the plain text alternative in the fallback", func(t *testing.T) {
+		_, err := newRendererService(writeTemplates(t, map[string]string{
+			"pt-BR/new-template.html": "<p>{{.Username}}</p>",
+			"new-template.txt":        "{{.Username}}",
+		}), enums.DefaultLocale)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should ignore files with other extensions", func(t *testing.T) {
+		_, err := newRendererService(writeTemplates(t, map[string]string{
+			"README.md": "{{.Username",
+		}), enums.DefaultLocale)
+		assert.NoError(t, err)
+	})
+}
+
+func TestRender(t *testing.T) {
+	path := writeTemplates(t, map[string]string{
+		"account-confirmation.html":          "<p>Hi, {{.Username}}!</p>",
+		"account-confirmation.txt":           "Hi, {{.Username}}!",
+		"pt-BR/account-confirmation.html":    "<p>Olá, {{.Username}}!</p>",
+		"pt-BR/account-confirmation.txt":     "Olá, {{.Username}}!",
+		"pt-BR/account-confirmation.subject": "[Horusec] Confirmação de e-mail de {{.Username}}\n",
+		"es/account-confirmation.html":       "<p>¡Hola, {{.Username}}!</p>",
+	})
+
+	service, err := newRendererService(path, enums.DefaultLocale)
+	assert.NoError(t, err)
+
+	t.Run("should render the templates of the locale of the message", func(t *testing.T) {
+		content, err := service.Render(newMessage("pt-BR"))
+		assert.NoError(t, err)
+		assert.Equal(t, "<p>Olá, test!</p>", content.HTML)
+		assert.Equal(t, "Olá, test!", content.Text)
+		assert.Equal(t, "[Horusec] Confirmação de e-mail de test", content.Subject)
+	})
+
+	t.Run("should render the templates of the locale ignoring case and separator", func(t *testing.T) {
+		content, err := service.Render(newMessage("pt_br"))
+		assert.NoError(t, err)
+		assert.Equal(t, "<p>Olá, test!</p>", content.HTML)
+	})
+
+	t.Run("should render the templates of the language when there is no locale directory", func(t *testing.T) {
+		content, err := service.Render(newMessage("es-AR"))
+		assert.NoError(t, err)
+		assert.Equal(t, "<p>¡Hola, test!</p>", content.HTML)
+	})
+
+	t.Run("should fallback to the custom templates without locale", func(t *testing.T) {
+		content, err := service.Render(newMessage("fr-FR"))
+		assert.NoError(t, err)
+		assert.Equal(t, "<p>Hi, test!</p>", content.HTML)
+		assert.Equal(t, "[Horusec] Account Confirmation Email", content.Subject)
+	})
+
+	t.Run("should fallback to the custom plain text without locale of a custom html", func(t *testing.T) {
+		content, err := service.Render(newMessage("es-ES"))
+		assert.NoError(t, err)
+		assert.Equal(t, "<p>¡Hola, test!</p>", content.HTML)
+		assert.Equal(t, "Hi, test!", content.Text)
+	})
+
+	t.Run("should fallback to the built-in templates when not customized", func(t *testing.T) {
+		message := newMessage("pt-BR")
+		message.TemplateName = emailEnums.ResetPassword
+
+		content, err := service.Render(message)
+		assert.NoError(t, err)
+		assert.Contains(t, content.Text, "Hello, test!")
+	})
+
+	t.Run("should use the default locale when the message has no locale", func(t *testing.T) {
+		service, err := newRendererService(path, "pt-BR")
+		assert.NoError(t, err)
+
+		content, err := service.Render(newMessage(""))
+		assert.NoError(t, err)
+		assert.Equal(t, "<p>Olá, test!</p>", content.HTML)
+	})
+
+	t.Run("should return error when template not found", func(t *testing.T) {
+		_, err := service.Render(&emailEntities.Message{TemplateName: "test"})
+		assert.Equal(t, enums.ErrorTemplateNotFound, err)
+	})
+
+	t.Run("should return error when failed to execute the template", func(t *testing.T) {
+		_, err := service.Render(&emailEntities.Message{TemplateName: templates.WorkspaceDigest, Data: "test"})
+		assert.Error(t, err)
+	})
+}
+
+func TestRenderBuiltIn(t *testing.T) {
+	service, err := newRendererService("", enums.DefaultLocale)
+	assert.NoError(t, err)
+
+	t.Run("should render the html and plain text of every built-in template", func(t *testing.T) {
+		for _, name := range []emailEnums.Template{emailEnums.AccountConfirmation, emailEnums.ResetPassword,
+			emailEnums.OrganizationInvite, templates.RiskAcceptanceExpired, templates.WorkspaceDigest} {
+			content, err := service.Render(&emailEntities.Message{TemplateName: name, Subject: "test",
+				Data: map[string]interface{}{"Username": "test", "NewFindings": map[string]interface{}{},
+					"FixedFindings": map[string]interface{}{}}})
+			assert.NoError(t, err)
+			assert.NotEmpty(t, content.HTML)
+			assert.Contains(t, content.Text, "Hello, test!")
+			assert.Equal(t, "test", content.Subject)
+		}
+	})
+}
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS vulnerabilities_remediation CASCADE;
+
+COMMIT;
@@ -0,0 +1,20 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "vulnerabilities_remediation"
+(
+    "remediation_id" UUID NOT NULL,
+    "workspace_id" UUID NOT NULL,
+    "repository_id" UUID NOT NULL,
+    "vuln_hash" VARCHAR(500) NOT NULL,
+    "severity" VARCHAR(255) NOT NULL,
+    "first_seen_at" TIMESTAMP NOT NULL,
+    "last_seen_at" TIMESTAMP NOT NULL,
+    "resolved_at" TIMESTAMP NULL,
+    PRIMARY KEY (remediation_id),
+    UNIQUE (repository_id, vuln_hash)
+);
+
+CREATE INDEX IF NOT EXISTS vulnerabilities_remediation_workspace_idx
+    ON vulnerabilities_remediation (workspace_id, resolved_at);
+
+COMMIT;
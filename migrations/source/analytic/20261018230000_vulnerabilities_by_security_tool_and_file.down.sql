@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS vulnerabilities_by_security_tool CASCADE;
+DROP TABLE IF EXISTS vulnerabilities_by_file CASCADE;
+
+COMMIT;
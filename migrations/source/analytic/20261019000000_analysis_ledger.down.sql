@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "analysis_ledger";
+
+ALTER TABLE "vulnerabilities_by_author" DROP COLUMN IF EXISTS "analysis_id";
+ALTER TABLE "vulnerabilities_by_repository" DROP COLUMN IF EXISTS "analysis_id";
+ALTER TABLE "vulnerabilities_by_language" DROP COLUMN IF EXISTS "analysis_id";
+ALTER TABLE "vulnerabilities_by_time" DROP COLUMN IF EXISTS "analysis_id";
+ALTER TABLE "vulnerabilities_by_security_tool" DROP COLUMN IF EXISTS "analysis_id";
+ALTER TABLE "vulnerabilities_by_file" DROP COLUMN IF EXISTS "analysis_id";
+
+COMMIT;
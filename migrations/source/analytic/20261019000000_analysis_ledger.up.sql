@@ -0,0 +1,38 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "analysis_ledger"
+(
+    "analysis_id"   UUID         NOT NULL,
+    "projection"    VARCHAR(255) NOT NULL,
+    "repository_id" UUID         NOT NULL,
+    "revision"      INT          NOT NULL,
+    "created_at"    TIMESTAMP    NOT NULL,
+    "updated_at"    TIMESTAMP    NOT NULL,
+    PRIMARY KEY (analysis_id, projection)
+);
+
+ALTER TABLE "vulnerabilities_by_author" ADD COLUMN IF NOT EXISTS "analysis_id" UUID;
+CREATE INDEX IF NOT EXISTS vulnerabilities_by_author_analysis_id_idx
+    ON vulnerabilities_by_author (analysis_id);
+
+ALTER TABLE "vulnerabilities_by_repository" ADD COLUMN IF NOT EXISTS "analysis_id" UUID;
+CREATE INDEX IF NOT EXISTS vulnerabilities_by_repository_analysis_id_idx
+    ON vulnerabilities_by_repository (analysis_id);
+
+ALTER TABLE "vulnerabilities_by_language" ADD COLUMN IF NOT EXISTS "analysis_id" UUID;
+CREATE INDEX IF NOT EXISTS vulnerabilities_by_language_analysis_id_idx
+    ON vulnerabilities_by_language (analysis_id);
+
+ALTER TABLE "vulnerabilities_by_time" ADD COLUMN IF NOT EXISTS "analysis_id" UUID;
+CREATE INDEX IF NOT EXISTS vulnerabilities_by_time_analysis_id_idx
+    ON vulnerabilities_by_time (analysis_id);
+
+ALTER TABLE "vulnerabilities_by_security_tool" ADD COLUMN IF NOT EXISTS "analysis_id" UUID;
+CREATE INDEX IF NOT EXISTS vulnerabilities_by_security_tool_analysis_id_idx
+    ON vulnerabilities_by_security_tool (analysis_id);
+
+ALTER TABLE "vulnerabilities_by_file" ADD COLUMN IF NOT EXISTS "analysis_id" UUID;
+CREATE INDEX IF NOT EXISTS vulnerabilities_by_file_analysis_id_idx
+    ON vulnerabilities_by_file (analysis_id);
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "digest_subscriptions"
+(
+    "account_id"   UUID        NOT NULL,
+    "workspace_id" UUID        NOT NULL,
+    "frequency"    VARCHAR(20) NOT NULL,
+    "last_sent_at" TIMESTAMP,
+    "created_at"   TIMESTAMP   NOT NULL,
+    "updated_at"   TIMESTAMP   NOT NULL,
+    PRIMARY KEY (account_id, workspace_id)
+);
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "vulnerabilities_remediation_checkpoints"
+(
+    "repository_id"       UUID      NOT NULL,
+    "analysis_id"         UUID      NOT NULL,
+    "analysis_created_at" TIMESTAMP NOT NULL,
+    "revision"            INT       NOT NULL,
+    "updated_at"          TIMESTAMP NOT NULL,
+    PRIMARY KEY (repository_id)
+);
+
+COMMIT;
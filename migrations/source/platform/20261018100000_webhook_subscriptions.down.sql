@@ -0,0 +1,17 @@
+BEGIN;
+
+DROP INDEX IF EXISTS webhooks_workspace_id_repository_id_idx;
+
+ALTER TABLE webhooks DROP COLUMN IF EXISTS "events";
+
+DELETE FROM webhooks WHERE repository_id IS NULL;
+
+DELETE FROM webhooks WHERE webhook_id NOT IN (
+    SELECT DISTINCT ON (repository_id) webhook_id FROM webhooks ORDER BY repository_id, created_at
+);
+
+ALTER TABLE webhooks ALTER COLUMN repository_id SET NOT NULL;
+
+ALTER TABLE webhooks ADD CONSTRAINT webhooks_repository_id_key UNIQUE (repository_id);
+
+COMMIT;
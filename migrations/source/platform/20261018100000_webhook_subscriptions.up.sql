@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE webhooks DROP CONSTRAINT IF EXISTS webhooks_repository_id_key;
+
+ALTER TABLE webhooks ALTER COLUMN repository_id DROP NOT NULL;
+
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS "events" JSONB NOT NULL DEFAULT '["new-analysis"]';
+
+CREATE INDEX IF NOT EXISTS webhooks_workspace_id_repository_id_idx ON webhooks (workspace_id, repository_id);
+
+COMMIT;
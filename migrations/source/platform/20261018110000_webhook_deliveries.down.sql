@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TABLE IF EXISTS webhook_delivery_attempts CASCADE;
+
+DROP TABLE IF EXISTS webhook_deliveries CASCADE;
+
+ALTER TABLE webhooks DROP COLUMN IF EXISTS "max_attempts";
+
+COMMIT;
@@ -0,0 +1,43 @@
+BEGIN;
+
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS "max_attempts" INTEGER NOT NULL DEFAULT 5;
+
+CREATE TABLE IF NOT EXISTS "webhook_deliveries"
+(
+    "delivery_id"   UUID         NOT NULL,
+    "webhook_id"    UUID         NOT NULL,
+    "event_type"    VARCHAR(255) NOT NULL,
+    "payload"       JSONB        NOT NULL,
+    "status"        VARCHAR(255) NOT NULL,
+    "attempts"      INTEGER      NOT NULL DEFAULT 0,
+    "next_retry_at" TIMESTAMP,
+    "created_at"    TIMESTAMP    NOT NULL,
+    "updated_at"    TIMESTAMP,
+    PRIMARY KEY (delivery_id),
+    FOREIGN KEY (webhook_id) REFERENCES webhooks (webhook_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_created_at_idx
+    ON webhook_deliveries (webhook_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_status_next_retry_at_idx
+    ON webhook_deliveries (status, next_retry_at);
+
+CREATE TABLE IF NOT EXISTS "webhook_delivery_attempts"
+(
+    "attempt_id"    UUID      NOT NULL,
+    "delivery_id"   UUID      NOT NULL,
+    "number"        INTEGER   NOT NULL,
+    "status_code"   INTEGER,
+    "latency"       BIGINT    NOT NULL,
+    "response_body" TEXT,
+    "error"         TEXT,
+    "next_retry_at" TIMESTAMP,
+    "created_at"    TIMESTAMP NOT NULL,
+    PRIMARY KEY (attempt_id),
+    FOREIGN KEY (delivery_id) REFERENCES webhook_deliveries (delivery_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhook_delivery_attempts_delivery_id_idx ON webhook_delivery_attempts (delivery_id);
+
+COMMIT;
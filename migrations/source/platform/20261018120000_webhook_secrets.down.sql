@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE webhooks DROP COLUMN IF EXISTS "previous_secret_expires_at";
+ALTER TABLE webhooks DROP COLUMN IF EXISTS "previous_secret";
+ALTER TABLE webhooks DROP COLUMN IF EXISTS "secret";
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS "secret" TEXT;
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS "previous_secret" TEXT;
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS "previous_secret_expires_at" TIMESTAMP;
+
+COMMIT;
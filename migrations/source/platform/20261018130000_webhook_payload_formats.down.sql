@@ -0,0 +1,9 @@
+BEGIN;
+
+DELETE FROM webhook_deliveries WHERE "payload" IS NOT NULL AND NOT ("payload" ~ '^\s*[\[{]');
+ALTER TABLE webhook_deliveries ALTER COLUMN "payload" TYPE JSONB USING "payload"::JSONB;
+
+ALTER TABLE webhooks DROP COLUMN IF EXISTS "template";
+ALTER TABLE webhooks DROP COLUMN IF EXISTS "format";
+
+COMMIT;
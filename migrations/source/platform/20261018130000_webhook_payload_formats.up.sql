@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS "format" VARCHAR(255) NOT NULL DEFAULT 'full';
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS "template" TEXT;
+
+ALTER TABLE webhook_deliveries ALTER COLUMN "payload" TYPE TEXT USING "payload"::TEXT;
+
+COMMIT;
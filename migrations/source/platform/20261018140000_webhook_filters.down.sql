@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS analysis_repository_id_created_at_idx;
+
+ALTER TABLE webhooks DROP COLUMN IF EXISTS "filters";
+
+COMMIT;
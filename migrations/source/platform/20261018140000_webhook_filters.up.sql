@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS "filters" JSONB;
+
+CREATE INDEX IF NOT EXISTS analysis_repository_id_created_at_idx ON analysis (repository_id, created_at);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE analysis DROP COLUMN IF EXISTS "policy_verdict";
+
+DROP TABLE IF EXISTS policies;
+
+COMMIT;
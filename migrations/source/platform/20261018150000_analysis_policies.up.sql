@@ -0,0 +1,27 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "policies"
+(
+    "policy_id"     UUID         NOT NULL,
+    "workspace_id"  UUID         NOT NULL,
+    "repository_id" UUID,
+    "name"          VARCHAR(255) NOT NULL,
+    "description"   VARCHAR(255),
+    "rules"         JSONB        NOT NULL,
+    "is_active"     BOOLEAN      NOT NULL DEFAULT FALSE,
+    "created_at"    TIMESTAMP    NOT NULL,
+    "updated_at"    TIMESTAMP    NOT NULL,
+    PRIMARY KEY (policy_id),
+    FOREIGN KEY (workspace_id) REFERENCES workspaces (workspace_id) ON DELETE CASCADE,
+    FOREIGN KEY (repository_id) REFERENCES repositories (repository_id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS policies_active_workspace_idx ON policies (workspace_id)
+    WHERE is_active AND repository_id IS NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS policies_active_repository_idx ON policies (repository_id)
+    WHERE is_active AND repository_id IS NOT NULL;
+
+ALTER TABLE analysis ADD COLUMN IF NOT EXISTS "policy_verdict" JSONB;
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "vulnerability_events"
+(
+    "event_id"         UUID         NOT NULL,
+    "vulnerability_id" UUID         NOT NULL,
+    "analysis_id"      UUID         NOT NULL,
+    "account_id"       UUID         NOT NULL,
+    "account_email"    VARCHAR(255),
+    "account_username" VARCHAR(255),
+    "old_type"         VARCHAR(255),
+    "new_type"         VARCHAR(255),
+    "old_severity"     VARCHAR(255),
+    "new_severity"     VARCHAR(255),
+    "justification"    VARCHAR(500) NOT NULL,
+    "created_at"       TIMESTAMP    NOT NULL,
+    PRIMARY KEY (event_id),
+    FOREIGN KEY (vulnerability_id) REFERENCES vulnerabilities (vulnerability_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS vulnerability_events_vulnerability_idx
+    ON vulnerability_events (vulnerability_id, created_at DESC);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "vulnerability_risk_acceptances";
+
+COMMIT;
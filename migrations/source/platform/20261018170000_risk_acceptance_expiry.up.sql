@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "vulnerability_risk_acceptances"
+(
+    "vulnerability_id" UUID      NOT NULL,
+    "analysis_id"      UUID      NOT NULL,
+    "account_id"       UUID      NOT NULL,
+    "account_email"    VARCHAR(255),
+    "account_username" VARCHAR(255),
+    "approver"         VARCHAR(255),
+    "expires_at"       TIMESTAMP NOT NULL,
+    "created_at"       TIMESTAMP NOT NULL,
+    PRIMARY KEY (vulnerability_id),
+    FOREIGN KEY (vulnerability_id) REFERENCES vulnerabilities (vulnerability_id) ON DELETE CASCADE,
+    FOREIGN KEY (analysis_id) REFERENCES analysis (analysis_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS vulnerability_risk_acceptances_expires_at_idx
+    ON vulnerability_risk_acceptances (expires_at);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "vulnerability_assignments";
+DROP TABLE IF EXISTS "vulnerability_comments";
+
+COMMIT;
@@ -0,0 +1,42 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "vulnerability_comments"
+(
+    "comment_id"       UUID          NOT NULL,
+    "workspace_id"     UUID          NOT NULL,
+    "repository_id"    UUID          NOT NULL,
+    "vuln_hash"        VARCHAR(255)  NOT NULL,
+    "account_id"       UUID          NOT NULL,
+    "account_email"    VARCHAR(255),
+    "account_username" VARCHAR(255),
+    "content"          VARCHAR(2000) NOT NULL,
+    "created_at"       TIMESTAMP     NOT NULL,
+    "updated_at"       TIMESTAMP     NOT NULL,
+    PRIMARY KEY (comment_id),
+    FOREIGN KEY (workspace_id) REFERENCES workspaces (workspace_id) ON DELETE CASCADE,
+    FOREIGN KEY (repository_id) REFERENCES repositories (repository_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS vulnerability_comments_repository_id_vuln_hash_idx
+    ON vulnerability_comments (repository_id, vuln_hash);
+
+CREATE TABLE IF NOT EXISTS "vulnerability_assignments"
+(
+    "workspace_id"  UUID         NOT NULL,
+    "repository_id" UUID         NOT NULL,
+    "vuln_hash"     VARCHAR(255) NOT NULL,
+    "assignee_id"   UUID         NOT NULL,
+    "due_date"      TIMESTAMP,
+    "assigned_by"   UUID         NOT NULL,
+    "created_at"    TIMESTAMP    NOT NULL,
+    "updated_at"    TIMESTAMP    NOT NULL,
+    PRIMARY KEY (repository_id, vuln_hash),
+    FOREIGN KEY (workspace_id) REFERENCES workspaces (workspace_id) ON DELETE CASCADE,
+    FOREIGN KEY (repository_id) REFERENCES repositories (repository_id) ON DELETE CASCADE,
+    FOREIGN KEY (assignee_id) REFERENCES accounts (account_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS vulnerability_assignments_assignee_id_idx
+    ON vulnerability_assignments (assignee_id);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS vulnerabilities_vuln_hash_idx;
+DROP INDEX IF EXISTS vulnerabilities_file_vulnerability_id_idx;
+DROP INDEX IF EXISTS analysis_vulnerabilities_vulnerability_id_idx;
+DROP INDEX IF EXISTS analysis_workspace_repository_created_at_idx;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS analysis_workspace_repository_created_at_idx
+    ON analysis (workspace_id, repository_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS analysis_vulnerabilities_vulnerability_id_idx
+    ON analysis_vulnerabilities (vulnerability_id);
+
+CREATE INDEX IF NOT EXISTS vulnerabilities_file_vulnerability_id_idx
+    ON vulnerabilities (file, vulnerability_id);
+
+CREATE INDEX IF NOT EXISTS vulnerabilities_vuln_hash_idx
+    ON vulnerabilities (vuln_hash);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE "vulnerability_events" DROP COLUMN IF EXISTS "suppression_rule_id";
+
+DROP TABLE IF EXISTS "suppression_rules";
+
+COMMIT;
@@ -0,0 +1,33 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "suppression_rules"
+(
+    "rule_id"          UUID         NOT NULL,
+    "workspace_id"     UUID         NOT NULL,
+    "repository_id"    UUID,
+    "name"             VARCHAR(255) NOT NULL,
+    "file_pattern"     VARCHAR(500),
+    "details"          VARCHAR(500),
+    "security_tool"    VARCHAR(255),
+    "language"         VARCHAR(255),
+    "vuln_hash"        VARCHAR(255),
+    "type"             VARCHAR(255) NOT NULL,
+    "justification"    VARCHAR(500) NOT NULL,
+    "is_active"        BOOLEAN      NOT NULL DEFAULT TRUE,
+    "account_id"       UUID         NOT NULL,
+    "account_email"    VARCHAR(255),
+    "account_username" VARCHAR(255),
+    "created_at"       TIMESTAMP    NOT NULL,
+    "updated_at"       TIMESTAMP    NOT NULL,
+    PRIMARY KEY (rule_id),
+    FOREIGN KEY (workspace_id) REFERENCES workspaces (workspace_id) ON DELETE CASCADE,
+    FOREIGN KEY (repository_id) REFERENCES repositories (repository_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS suppression_rules_active_idx
+    ON suppression_rules (workspace_id, repository_id) WHERE is_active;
+
+ALTER TABLE "vulnerability_events"
+    ADD COLUMN IF NOT EXISTS "suppression_rule_id" UUID REFERENCES suppression_rules (rule_id) ON DELETE SET NULL;
+
+COMMIT;
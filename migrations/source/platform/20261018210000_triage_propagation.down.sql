@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS vulnerability_events_propagated_from_idx;
+
+ALTER TABLE "vulnerability_events" DROP COLUMN IF EXISTS "propagated_from_id";
+
+DROP TABLE IF EXISTS "triage_propagation_settings";
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "triage_propagation_settings"
+(
+    "workspace_id"     UUID         NOT NULL,
+    "is_enabled"       BOOLEAN      NOT NULL DEFAULT FALSE,
+    "match_by"         VARCHAR(255) NOT NULL DEFAULT 'hash',
+    "account_id"       UUID         NOT NULL,
+    "account_email"    VARCHAR(255),
+    "account_username" VARCHAR(255),
+    "updated_at"       TIMESTAMP    NOT NULL,
+    PRIMARY KEY (workspace_id),
+    FOREIGN KEY (workspace_id) REFERENCES workspaces (workspace_id) ON DELETE CASCADE
+);
+
+ALTER TABLE "vulnerability_events"
+    ADD COLUMN IF NOT EXISTS "propagated_from_id" UUID REFERENCES vulnerabilities (vulnerability_id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS vulnerability_events_propagated_from_idx
+    ON vulnerability_events (propagated_from_id) WHERE propagated_from_id IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE "analysis" DROP COLUMN IF EXISTS "revision";
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE "accounts" ADD COLUMN IF NOT EXISTS "language" VARCHAR(20) NOT NULL DEFAULT '';
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE "tokens" DROP COLUMN IF EXISTS "expiring_notified_at";
+
+COMMIT;
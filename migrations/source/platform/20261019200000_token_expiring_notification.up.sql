@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE "tokens" ADD COLUMN IF NOT EXISTS "expiring_notified_at" TIMESTAMP NULL;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS webhook_deliveries_webhook_id_event_key_idx;
+
+ALTER TABLE "webhook_deliveries" DROP COLUMN IF EXISTS "event_key";
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE "webhook_deliveries" ADD COLUMN IF NOT EXISTS "event_key" VARCHAR(255) NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_event_key_idx
+    ON webhook_deliveries (webhook_id, event_key);
+
+COMMIT;
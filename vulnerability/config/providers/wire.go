@@ -30,12 +30,31 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
 
 	"github.com/ZupIT/horusec-platform/vulnerability/config/cors"
+	acceptanceController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/acceptance"
+	collaborationController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/collaboration"
+	exportController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/export"
 	managementController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/management"
+	propagationController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/propagation"
+	suppressionController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/suppression"
+	collaborationHandler "github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/collaboration"
+	exportHandler "github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/export"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/health"
 	managementHandler "github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/management"
+	propagationHandler "github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/propagation"
+	suppressionHandler "github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/suppression"
+	expiryJob "github.com/ZupIT/horusec-platform/vulnerability/internal/jobs/expiry"
+	acceptanceRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/acceptance"
+	collaborationRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/collaboration"
+	exportRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/export"
 	managementRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/management"
+	propagationRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/propagation"
+	suppressionRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/suppression"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/router"
+	collaborationUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/collaboration"
+	exportUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/export"
 	managementUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/management"
+	propagationUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/propagation"
+	suppressionUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/suppression"
 )
 
 var devKitProviders = wire.NewSet(
@@ -56,24 +75,46 @@ var configProviders = wire.NewSet(
 
 var repositoryProviders = wire.NewSet(
 	managementRepository.NewManagementRepository,
+	exportRepository.NewExportRepository,
+	acceptanceRepository.NewAcceptanceRepository,
+	collaborationRepository.NewCollaborationRepository,
+	suppressionRepository.NewSuppressionRepository,
+	propagationRepository.NewPropagationRepository,
 )
 
 var controllerProviders = wire.NewSet(
 	managementController.NewManagementController,
+	exportController.NewExportController,
+	acceptanceController.NewAcceptanceController,
+	collaborationController.NewCollaborationController,
+	suppressionController.NewSuppressionController,
+	propagationController.NewPropagationController,
 )
 
 var handlerProviders = wire.NewSet(
 	health.NewHealthHandler,
 	managementHandler.NewManagementHandler,
+	exportHandler.NewExportHandler,
+	collaborationHandler.NewCollaborationHandler,
+	suppressionHandler.NewSuppressionHandler,
+	propagationHandler.NewPropagationHandler,
+)
+
+var jobProviders = wire.NewSet(
+	expiryJob.NewExpiryJob,
 )
 
 var useCasesProviders = wire.NewSet(
 	managementUseCases.NewManagementUseCases,
+	exportUseCases.NewExportUseCases,
+	collaborationUseCases.NewCollaborationUseCases,
+	suppressionUseCases.NewSuppressionUseCases,
+	propagationUseCases.NewPropagationUseCases,
 )
 
 func Initialize(_ string) (router.IRouter, error) {
 	wire.Build(devKitProviders, configProviders, repositoryProviders, controllerProviders,
-		handlerProviders, useCasesProviders)
+		handlerProviders, useCasesProviders, jobProviders)
 
 	return &router.Router{}, nil
 }
@@ -18,12 +18,31 @@ import (
 	"github.com/google/wire"
 
 	"github.com/ZupIT/horusec-platform/vulnerability/config/cors"
+	acceptance2 "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/acceptance"
+	collaboration2 "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/collaboration"
+	export2 "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/export"
 	management3 "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/management"
+	propagation2 "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/propagation"
+	suppression2 "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/suppression"
+	collaboration4 "github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/collaboration"
+	export3 "github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/export"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/health"
 	management4 "github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/management"
+	propagation4 "github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/propagation"
+	suppression4 "github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/suppression"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/jobs/expiry"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/acceptance"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/collaboration"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/export"
 	management2 "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/management"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/propagation"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/suppression"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/router"
+	collaboration3 "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/collaboration"
+	export4 "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/export"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/management"
+	propagation3 "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/propagation"
+	suppression3 "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/suppression"
 )
 
 // Injectors from wire.go:
@@ -46,9 +65,29 @@ func Initialize(string2 string) (router.IRouter, error) {
 	if err != nil {
 		return nil, err
 	}
-	iController := management3.NewManagementController(iRepository, iBroker, connection, iUseCases)
-	managementHandler := management4.NewManagementHandler(iController, iUseCases)
-	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, managementHandler)
+	propagationIRepository := propagation.NewPropagationRepository(connection)
+	iController := management3.NewManagementController(iRepository, iBroker, connection, iUseCases, propagationIRepository)
+	authServiceClient := proto.NewAuthServiceClient(clientConnInterface)
+	managementHandler := management4.NewManagementHandler(iController, iUseCases, authServiceClient)
+	exportIRepository := export.NewExportRepository(connection)
+	exportIController := export2.NewExportController(exportIRepository)
+	exportIUseCases := export4.NewExportUseCases()
+	exportHandler := export3.NewExportHandler(exportIController, exportIUseCases)
+	acceptanceIRepository := acceptance.NewAcceptanceRepository(connection)
+	acceptanceIController := acceptance2.NewAcceptanceController(acceptanceIRepository, iRepository, iBroker, connection, iUseCases)
+	collaborationIRepository := collaboration.NewCollaborationRepository(connection)
+	collaborationIController := collaboration2.NewCollaborationController(collaborationIRepository, connection)
+	collaborationIUseCases := collaboration3.NewCollaborationUseCases()
+	collaborationHandler := collaboration4.NewCollaborationHandler(collaborationIController, collaborationIUseCases, authServiceClient)
+	suppressionIRepository := suppression.NewSuppressionRepository(connection)
+	suppressionIController := suppression2.NewSuppressionController(suppressionIRepository, connection)
+	suppressionIUseCases := suppression3.NewSuppressionUseCases()
+	suppressionHandler := suppression4.NewSuppressionHandler(suppressionIController, suppressionIUseCases, authServiceClient)
+	propagationIController := propagation2.NewPropagationController(propagationIRepository, connection)
+	propagationIUseCases := propagation3.NewPropagationUseCases()
+	propagationHandler := propagation4.NewPropagationHandler(propagationIController, propagationIUseCases, authServiceClient)
+	iJob := expiry.NewExpiryJob(acceptanceIController)
+	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, managementHandler, exportHandler, collaborationHandler, suppressionHandler, propagationHandler, iJob)
 	return routerIRouter, nil
 }
 
@@ -58,10 +97,12 @@ var devKitProviders = wire.NewSet(config2.NewBrokerConfig, broker.NewBroker, pro
 
 var configProviders = wire.NewSet(cors.NewCorsConfig, router.NewHTTPRouter)
 
-var repositoryProviders = wire.NewSet(management2.NewManagementRepository)
+var repositoryProviders = wire.NewSet(management2.NewManagementRepository, export.NewExportRepository, acceptance.NewAcceptanceRepository, collaboration.NewCollaborationRepository, suppression.NewSuppressionRepository, propagation.NewPropagationRepository)
 
-var controllerProviders = wire.NewSet(management3.NewManagementController)
+var controllerProviders = wire.NewSet(management3.NewManagementController, export2.NewExportController, acceptance2.NewAcceptanceController, collaboration2.NewCollaborationController, suppression2.NewSuppressionController, propagation2.NewPropagationController)
 
-var handlerProviders = wire.NewSet(health.NewHealthHandler, management4.NewManagementHandler)
+var handlerProviders = wire.NewSet(health.NewHealthHandler, management4.NewManagementHandler, export3.NewExportHandler, collaboration4.NewCollaborationHandler, suppression4.NewSuppressionHandler, propagation4.NewPropagationHandler)
 
-var useCasesProviders = wire.NewSet(management.NewManagementUseCases)
+var jobProviders = wire.NewSet(expiry.NewExpiryJob)
+
+var useCasesProviders = wire.NewSet(management.NewManagementUseCases, export4.NewExportUseCases, collaboration3.NewCollaborationUseCases, suppression3.NewSuppressionUseCases, propagation3.NewPropagationUseCases)
@@ -0,0 +1,200 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acceptance
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/queues"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	brokerLib "github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	acceptanceEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/acceptance"
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	acceptanceEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/acceptance"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+	acceptanceRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/acceptance"
+	managementRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/management"
+	managementUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/management"
+)
+
+type IController interface {
+	ExpireRiskAcceptances() error
+}
+
+type Controller struct {
+	repository           acceptanceRepository.IRepository
+	managementRepository managementRepository.IRepository
+	broker               brokerLib.IBroker
+	databaseWrite        database.IDatabaseWrite
+	useCases             managementUseCases.IUseCases
+	emailsDisabled       bool
+}
+
+func NewAcceptanceController(repository acceptanceRepository.IRepository,
+	repositoryManagement managementRepository.IRepository, broker brokerLib.IBroker,
+	databaseConnection *database.Connection, useCases managementUseCases.IUseCases) IController {
+	return &Controller{
+		repository:           repository,
+		managementRepository: repositoryManagement,
+		broker:               broker,
+		databaseWrite:        databaseConnection.Write,
+		useCases:             useCases,
+		emailsDisabled:       env.GetEnvOrDefaultBool(acceptanceEnums.EnvDisableEmails, false),
+	}
+}
+
+// ExpireRiskAcceptances reverts the expired risk acceptances to vulnerability, each one in its own transaction.
+// The latest analysis of each changed repository is published only once to keep analytic consistent
+func (c *Controller) ExpireRiskAcceptances() error {
+	expired, err := c.repository.ListExpiredRiskAcceptances(time.Now())
+	if err != nil {
+		return err
+	}
+
+	analysisIDs := map[uuid.UUID]uuid.UUID{}
+	for _, riskAcceptance := range expired {
+		analysisID, err := c.expireRiskAcceptance(riskAcceptance)
+		if err != nil {
+			c.logExpireError(err)
+			continue
+		}
+
+		analysisIDs[riskAcceptance.RepositoryID] = analysisID
+		c.sendExpiredEmails(riskAcceptance)
+	}
+
+	c.publishAnalysisChanges(analysisIDs)
+	return nil
+}
+
+func (c *Controller) logExpireError(err error) {
+	if errors.Is(err, acceptanceEnums.ErrorRiskAcceptanceAlreadyExpired) {
+		logger.LogInfo(err.Error())
+		return
+	}
+
+	logger.LogError(acceptanceEnums.MessageFailedToExpireRiskAcceptance, err)
+}
+
+// expireRiskAcceptance returns the latest analysis of the repository, which revision is increased in the same
+// transaction that reverts the vulnerability
+func (c *Controller) expireRiskAcceptance(riskAcceptance *acceptanceEntities.Expired) (uuid.UUID, error) {
+	analysisID, err := c.managementRepository.GetLatestAnalysisID(riskAcceptance.RepositoryID, uuid.Nil)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	transaction := c.databaseWrite.StartTransaction()
+
+	if err := c.revertToVulnerability(riskAcceptance, analysisID, transaction); err != nil {
+		logger.LogError(acceptanceEnums.MessageFailedToRollbackExpiry, transaction.RollbackTransaction().GetError())
+		return uuid.Nil, err
+	}
+
+	return analysisID, transaction.CommitTransaction().GetError()
+}
+
+// revertToVulnerability claims the risk acceptance by removing it first, when no row is removed another instance
+// already expired it, so the vulnerability, the event and the emails are left to that instance
+func (c *Controller) revertToVulnerability(riskAcceptance *acceptanceEntities.Expired, analysisID uuid.UUID,
+	transaction database.IDatabaseWrite) error {
+	condition := c.useCases.FilterVulnerabilityByID(riskAcceptance.VulnerabilityID)
+
+	if err := c.claimRiskAcceptance(condition, transaction); err != nil {
+		return err
+	}
+
+	if err := transaction.Update(map[string]interface{}{"type": vulnerabilityEnums.Vulnerability}, condition,
+		managementEnums.VulnerabilitiesTable).GetError(); err != nil {
+		return err
+	}
+
+	if err := transaction.Create(riskAcceptance.ToEvent(), managementEnums.EventsTable).GetError(); err != nil {
+		return err
+	}
+
+	return c.incrementAnalysisRevision(analysisID, transaction)
+}
+
+func (c *Controller) incrementAnalysisRevision(analysisID uuid.UUID, transaction database.IDatabaseWrite) error {
+	if analysisID == uuid.Nil {
+		return nil
+	}
+
+	return transaction.Update(c.useCases.IncrementAnalysisRevision(), c.useCases.FilterAnalysisByID(analysisID),
+		managementEnums.AnalysisTable).GetError()
+}
+
+func (c *Controller) claimRiskAcceptance(condition map[string]interface{}, transaction database.IDatabaseWrite) error {
+	result := transaction.Delete(condition, acceptanceEnums.RiskAcceptancesTable)
+	if err := result.GetError(); err != nil {
+		return err
+	}
+
+	if result.GetRowsAffected() != 1 {
+		return acceptanceEnums.ErrorRiskAcceptanceAlreadyExpired
+	}
+
+	return nil
+}
+
+func (c *Controller) sendExpiredEmails(riskAcceptance *acceptanceEntities.Expired) {
+	if c.emailsDisabled {
+		return
+	}
+
+	for _, message := range riskAcceptance.ToEmailMessages() {
+		if err := c.broker.Publish(queues.HorusecEmail.ToString(), "", "", message); err != nil {
+			logger.LogError(acceptanceEnums.MessageFailedToSendExpiredEmail, err)
+		}
+	}
+}
+
+func (c *Controller) publishAnalysisChanges(analysisIDs map[uuid.UUID]uuid.UUID) {
+	for _, analysisID := range analysisIDs {
+		if analysisID == uuid.Nil {
+			continue
+		}
+
+		if err := c.publishLatestAnalysis(analysisID); err != nil {
+			logger.LogError(acceptanceEnums.MessageFailedToPublishExpiredAnalysis, err)
+		}
+	}
+}
+
+// publishLatestAnalysis reads the revision before the analysis, so the analysis published always has at least the
+// changes of its revision even when another change is committed in between
+func (c *Controller) publishLatestAnalysis(analysisID uuid.UUID) error {
+	revision, err := c.managementRepository.GetAnalysisRevision(analysisID)
+	if err != nil {
+		return err
+	}
+
+	analysis, err := c.managementRepository.GetAnalysis(analysisID)
+	if err != nil {
+		return err
+	}
+
+	return c.broker.Publish("", exchange.NewAnalysis, exchange.Fanout,
+		managementEntities.NewRevisedAnalysis(analysis, revision).ToBytes())
+}
@@ -0,0 +1,313 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acceptance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	acceptanceEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/acceptance"
+	acceptanceEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/acceptance"
+	acceptanceRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/acceptance"
+	managementRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/management"
+	managementUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/management"
+)
+
+func newExpiredRiskAcceptances() []*acceptanceEntities.Expired {
+	repositoryID := uuid.New()
+
+	expired := []*acceptanceEntities.Expired{
+		{RepositoryID: repositoryID},
+		{RepositoryID: repositoryID},
+	}
+
+	for _, riskAcceptance := range expired {
+		riskAcceptance.VulnerabilityID = uuid.New()
+		riskAcceptance.AccountEmail = "test@horusec.io"
+	}
+
+	return expired
+}
+
+func newDatabaseMock() *database.Mock {
+	databaseMock := &database.Mock{}
+	databaseMock.On("StartTransaction").Return(databaseMock)
+	databaseMock.On("Delete").Return(response.NewResponse(1, nil, nil))
+	databaseMock.On("Update").Return(&response.Response{})
+	databaseMock.On("Create").Return(&response.Response{})
+	databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+	return databaseMock
+}
+
+func newManagementRepositoryMock() *managementRepository.Mock {
+	repositoryMock := &managementRepository.Mock{}
+	repositoryMock.On("GetLatestAnalysisID").Return(uuid.New(), nil)
+	repositoryMock.On("GetAnalysis").Return(&analysisEntities.Analysis{}, nil)
+	repositoryMock.On("GetAnalysisRevision").Return(1, nil)
+
+	return repositoryMock
+}
+
+func TestNewAcceptanceController(t *testing.T) {
+	t.Run("should success create a new controller", func(t *testing.T) {
+		assert.NotNil(t, NewAcceptanceController(nil, nil, nil, &database.Connection{}, nil))
+	})
+}
+
+func TestExpireRiskAcceptances(t *testing.T) {
+	t.Run("should revert expired risk acceptances and publish the repository analysis once", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		repositoryMock := &acceptanceRepository.Mock{}
+		repositoryMock.On("ListExpiredRiskAcceptances").Return(newExpiredRiskAcceptances(), nil)
+
+		repositoryManagementMock := newManagementRepositoryMock()
+
+		controller := NewAcceptanceController(repositoryMock, repositoryManagementMock, brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases())
+
+		assert.NoError(t, controller.ExpireRiskAcceptances())
+		databaseMock.AssertNumberOfCalls(t, "Update", 4)
+		databaseMock.AssertNumberOfCalls(t, "Create", 2)
+		databaseMock.AssertNumberOfCalls(t, "Delete", 2)
+		databaseMock.AssertNumberOfCalls(t, "CommitTransaction", 2)
+		repositoryManagementMock.AssertNumberOfCalls(t, "GetAnalysis", 1)
+		brokerMock.AssertNumberOfCalls(t, "Publish", 3)
+	})
+
+	t.Run("should not send emails when emails are disabled", func(t *testing.T) {
+		t.Setenv(acceptanceEnums.EnvDisableEmails, "true")
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		repositoryMock := &acceptanceRepository.Mock{}
+		repositoryMock.On("ListExpiredRiskAcceptances").Return(newExpiredRiskAcceptances(), nil)
+
+		databaseMock := newDatabaseMock()
+
+		controller := NewAcceptanceController(repositoryMock, newManagementRepositoryMock(), brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases())
+
+		assert.NoError(t, controller.ExpireRiskAcceptances())
+		brokerMock.AssertNumberOfCalls(t, "Publish", 1)
+	})
+
+	t.Run("should skip event and emails when risk acceptance was expired by another instance", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Delete").Return(response.NewResponse(0, nil, nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		brokerMock := &broker.Mock{}
+
+		repositoryMock := &acceptanceRepository.Mock{}
+		repositoryMock.On("ListExpiredRiskAcceptances").Return(newExpiredRiskAcceptances(), nil)
+
+		controller := NewAcceptanceController(repositoryMock, newManagementRepositoryMock(), brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases())
+
+		assert.NoError(t, controller.ExpireRiskAcceptances())
+		databaseMock.AssertNotCalled(t, "Update")
+		databaseMock.AssertNotCalled(t, "Create")
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should rollback and skip risk acceptance when failed to revert it", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Delete").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		brokerMock := &broker.Mock{}
+
+		repositoryMock := &acceptanceRepository.Mock{}
+		repositoryMock.On("ListExpiredRiskAcceptances").Return(newExpiredRiskAcceptances(), nil)
+
+		controller := NewAcceptanceController(repositoryMock, newManagementRepositoryMock(), brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases())
+
+		assert.NoError(t, controller.ExpireRiskAcceptances())
+		databaseMock.AssertNumberOfCalls(t, "RollbackTransaction", 2)
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should rollback when failed to save the event", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Delete").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		repositoryMock := &acceptanceRepository.Mock{}
+		repositoryMock.On("ListExpiredRiskAcceptances").Return(newExpiredRiskAcceptances()[:1], nil)
+
+		controller := NewAcceptanceController(repositoryMock, newManagementRepositoryMock(), &broker.Mock{},
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases())
+
+		assert.NoError(t, controller.ExpireRiskAcceptances())
+		databaseMock.AssertNumberOfCalls(t, "RollbackTransaction", 1)
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
+	})
+
+	t.Run("should rollback when failed to increment the revision of the analysis", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Delete").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("Update").Return(&response.Response{}).Once()
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		brokerMock := &broker.Mock{}
+
+		repositoryMock := &acceptanceRepository.Mock{}
+		repositoryMock.On("ListExpiredRiskAcceptances").Return(newExpiredRiskAcceptances()[:1], nil)
+
+		controller := NewAcceptanceController(repositoryMock, newManagementRepositoryMock(), brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases())
+
+		assert.NoError(t, controller.ExpireRiskAcceptances())
+		databaseMock.AssertNumberOfCalls(t, "Update", 2)
+		databaseMock.AssertNumberOfCalls(t, "RollbackTransaction", 1)
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should skip risk acceptance when failed to get the latest analysis", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+
+		brokerMock := &broker.Mock{}
+
+		repositoryMock := &acceptanceRepository.Mock{}
+		repositoryMock.On("ListExpiredRiskAcceptances").Return(newExpiredRiskAcceptances(), nil)
+
+		repositoryManagementMock := &managementRepository.Mock{}
+		repositoryManagementMock.On("GetLatestAnalysisID").Return(uuid.Nil, errors.New("test"))
+
+		controller := NewAcceptanceController(repositoryMock, repositoryManagementMock, brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases())
+
+		assert.NoError(t, controller.ExpireRiskAcceptances())
+		databaseMock.AssertNotCalled(t, "StartTransaction")
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should rollback when failed to remove the risk acceptance", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Delete").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		repositoryMock := &acceptanceRepository.Mock{}
+		repositoryMock.On("ListExpiredRiskAcceptances").Return(newExpiredRiskAcceptances()[:1], nil)
+
+		controller := NewAcceptanceController(repositoryMock, newManagementRepositoryMock(), &broker.Mock{},
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases())
+
+		assert.NoError(t, controller.ExpireRiskAcceptances())
+		databaseMock.AssertNumberOfCalls(t, "RollbackTransaction", 1)
+		databaseMock.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("should not publish analysis when repository has no analysis", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		repositoryMock := &acceptanceRepository.Mock{}
+		repositoryMock.On("ListExpiredRiskAcceptances").Return(newExpiredRiskAcceptances()[:1], nil)
+
+		repositoryManagementMock := &managementRepository.Mock{}
+		repositoryManagementMock.On("GetLatestAnalysisID").Return(uuid.Nil, nil)
+
+		controller := NewAcceptanceController(repositoryMock, repositoryManagementMock, brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases())
+
+		assert.NoError(t, controller.ExpireRiskAcceptances())
+		databaseMock.AssertNumberOfCalls(t, "Update", 1)
+		repositoryManagementMock.AssertNotCalled(t, "GetAnalysis")
+		brokerMock.AssertNumberOfCalls(t, "Publish", 1)
+	})
+
+	t.Run("should not publish analysis when failed to get its revision", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		repositoryMock := &acceptanceRepository.Mock{}
+		repositoryMock.On("ListExpiredRiskAcceptances").Return(newExpiredRiskAcceptances()[:1], nil)
+
+		repositoryManagementMock := &managementRepository.Mock{}
+		repositoryManagementMock.On("GetLatestAnalysisID").Return(uuid.New(), nil)
+		repositoryManagementMock.On("GetAnalysisRevision").Return(0, errors.New("test"))
+
+		controller := NewAcceptanceController(repositoryMock, repositoryManagementMock, brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases())
+
+		assert.NoError(t, controller.ExpireRiskAcceptances())
+		brokerMock.AssertNumberOfCalls(t, "Publish", 1)
+	})
+
+	t.Run("should log errors when failed to get or publish analysis and send emails", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(errors.New("test"))
+
+		repositoryMock := &acceptanceRepository.Mock{}
+		repositoryMock.On("ListExpiredRiskAcceptances").Return(newExpiredRiskAcceptances(), nil)
+
+		repositoryManagementMock := &managementRepository.Mock{}
+		repositoryManagementMock.On("GetLatestAnalysisID").Return(uuid.New(), nil)
+		repositoryManagementMock.On("GetAnalysisRevision").Return(1, nil)
+		repositoryManagementMock.On("GetAnalysis").Return(&analysisEntities.Analysis{}, errors.New("test"))
+
+		controller := NewAcceptanceController(repositoryMock, repositoryManagementMock, brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases())
+
+		assert.NoError(t, controller.ExpireRiskAcceptances())
+		brokerMock.AssertNumberOfCalls(t, "Publish", 2)
+	})
+
+	t.Run("should return error when failed to list expired risk acceptances", func(t *testing.T) {
+		repositoryMock := &acceptanceRepository.Mock{}
+		repositoryMock.On("ListExpiredRiskAcceptances").Return(
+			[]*acceptanceEntities.Expired{}, errors.New("test"))
+
+		controller := NewAcceptanceController(repositoryMock, nil, &broker.Mock{}, &database.Connection{},
+			managementUseCases.NewManagementUseCases())
+
+		assert.Error(t, controller.ExpireRiskAcceptances())
+	})
+}
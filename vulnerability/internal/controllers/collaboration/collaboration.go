@@ -0,0 +1,127 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	collaborationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/collaboration"
+	collaborationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/collaboration"
+	collaborationRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/collaboration"
+)
+
+type IController interface {
+	ListComments(key *collaborationEntities.VulnerabilityKey) ([]collaborationEntities.Comment, error)
+	CreateComment(data *collaborationEntities.CommentData) (*collaborationEntities.Comment, error)
+	UpdateComment(data *collaborationEntities.CommentData) error
+	DeleteComment(data *collaborationEntities.CommentData) error
+	GetAssignment(key *collaborationEntities.VulnerabilityKey) (*collaborationEntities.Assignment, error)
+	SaveAssignment(data *collaborationEntities.AssignmentData) (*collaborationEntities.Assignment, error)
+	DeleteAssignment(key *collaborationEntities.VulnerabilityKey) error
+}
+
+type Controller struct {
+	repository    collaborationRepository.IRepository
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewCollaborationController(repository collaborationRepository.IRepository,
+	databaseConnection *database.Connection) IController {
+	return &Controller{
+		repository:    repository,
+		databaseWrite: databaseConnection.Write,
+	}
+}
+
+func (c *Controller) ListComments(
+	key *collaborationEntities.VulnerabilityKey) ([]collaborationEntities.Comment, error) {
+	return c.repository.ListComments(key)
+}
+
+func (c *Controller) CreateComment(data *collaborationEntities.CommentData) (*collaborationEntities.Comment, error) {
+	comment := data.ToComment()
+
+	return comment, c.databaseWrite.Create(comment, collaborationEnums.CommentsTable).GetError()
+}
+
+// UpdateComment changes the content of the comment, comments from other accounts are handled as not found
+func (c *Controller) UpdateComment(data *collaborationEntities.CommentData) error {
+	result := c.databaseWrite.Update(data.ToUpdateMap(), data.ToAuthorFilter(), collaborationEnums.CommentsTable)
+
+	return c.checkRowsAffected(result.GetRowsAffected(), result.GetError())
+}
+
+// DeleteComment removes the comment, comments from other accounts are handled as not found
+func (c *Controller) DeleteComment(data *collaborationEntities.CommentData) error {
+	result := c.databaseWrite.Delete(data.ToAuthorFilter(), collaborationEnums.CommentsTable)
+
+	return c.checkRowsAffected(result.GetRowsAffected(), result.GetError())
+}
+
+func (c *Controller) checkRowsAffected(rowsAffected int, err error) error {
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return databaseEnums.ErrorNotFoundRecords
+	}
+
+	return nil
+}
+
+func (c *Controller) GetAssignment(
+	key *collaborationEntities.VulnerabilityKey) (*collaborationEntities.Assignment, error) {
+	return c.repository.GetAssignment(key)
+}
+
+// SaveAssignment assigns the vulnerability to a member of the repository, replacing the previous assignee
+func (c *Controller) SaveAssignment(
+	data *collaborationEntities.AssignmentData) (*collaborationEntities.Assignment, error) {
+	isMember, err := c.repository.IsRepositoryMember(data.AssigneeID, data.WorkspaceID, data.RepositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isMember {
+		return nil, collaborationEnums.ErrorAssigneeIsNotMember
+	}
+
+	assignment, err := c.repository.GetAssignment(&data.VulnerabilityKey)
+	if err != nil {
+		return c.createAssignment(data, err)
+	}
+
+	assignment.Update(data)
+	return assignment, c.databaseWrite.Update(assignment.ToUpdateMap(), data.ToFilter(),
+		collaborationEnums.AssignmentsTable).GetError()
+}
+
+func (c *Controller) createAssignment(data *collaborationEntities.AssignmentData,
+	err error) (*collaborationEntities.Assignment, error) {
+	if err != databaseEnums.ErrorNotFoundRecords {
+		return nil, err
+	}
+
+	assignment := data.ToAssignment()
+	return assignment, c.databaseWrite.Create(assignment, collaborationEnums.AssignmentsTable).GetError()
+}
+
+func (c *Controller) DeleteAssignment(key *collaborationEntities.VulnerabilityKey) error {
+	result := c.databaseWrite.Delete(key.ToFilter(), collaborationEnums.AssignmentsTable)
+
+	return c.checkRowsAffected(result.GetRowsAffected(), result.GetError())
+}
@@ -0,0 +1,229 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	collaborationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/collaboration"
+	collaborationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/collaboration"
+	collaborationRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/collaboration"
+)
+
+func newCommentData() *collaborationEntities.CommentData {
+	return &collaborationEntities.CommentData{
+		VulnerabilityKey: collaborationEntities.VulnerabilityKey{VulnHash: "1234"},
+		CommentID:        uuid.New(),
+		AccountID:        uuid.New(),
+		Content:          "test",
+	}
+}
+
+func newAssignmentData() *collaborationEntities.AssignmentData {
+	return &collaborationEntities.AssignmentData{
+		VulnerabilityKey: collaborationEntities.VulnerabilityKey{VulnHash: "1234"},
+		AssigneeID:       uuid.New(),
+		AssignedBy:       uuid.New(),
+	}
+}
+
+func newController(repositoryMock *collaborationRepository.Mock, databaseMock *database.Mock) IController {
+	return NewCollaborationController(repositoryMock, &database.Connection{Read: databaseMock, Write: databaseMock})
+}
+
+func TestNewCollaborationController(t *testing.T) {
+	t.Run("should success create a new controller", func(t *testing.T) {
+		assert.NotNil(t, NewCollaborationController(nil, &database.Connection{}))
+	})
+}
+
+func TestListComments(t *testing.T) {
+	t.Run("should success list comments", func(t *testing.T) {
+		repositoryMock := &collaborationRepository.Mock{}
+		repositoryMock.On("ListComments").Return([]collaborationEntities.Comment{{Content: "test"}}, nil)
+
+		result, err := newController(repositoryMock, &database.Mock{}).ListComments(
+			&collaborationEntities.VulnerabilityKey{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+}
+
+func TestCreateComment(t *testing.T) {
+	t.Run("should success create comment", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		data := newCommentData()
+
+		comment, err := newController(&collaborationRepository.Mock{}, databaseMock).CreateComment(data)
+		assert.NoError(t, err)
+		assert.Equal(t, data.AccountID, comment.AccountID)
+		assert.Equal(t, "test", comment.Content)
+	})
+
+	t.Run("should return error when failed to create comment", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := newController(&collaborationRepository.Mock{}, databaseMock).CreateComment(newCommentData())
+		assert.Error(t, err)
+	})
+}
+
+func TestUpdateComment(t *testing.T) {
+	t.Run("should success update comment", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+
+		assert.NoError(t, newController(&collaborationRepository.Mock{}, databaseMock).UpdateComment(newCommentData()))
+	})
+
+	t.Run("should return not found when comment is from other account", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, nil, nil))
+
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords,
+			newController(&collaborationRepository.Mock{}, databaseMock).UpdateComment(newCommentData()))
+	})
+
+	t.Run("should return error when failed to update comment", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, newController(&collaborationRepository.Mock{}, databaseMock).UpdateComment(newCommentData()))
+	})
+}
+
+func TestDeleteComment(t *testing.T) {
+	t.Run("should success delete comment", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(response.NewResponse(1, nil, nil))
+
+		assert.NoError(t, newController(&collaborationRepository.Mock{}, databaseMock).DeleteComment(newCommentData()))
+	})
+
+	t.Run("should return not found when comment is from other account", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(response.NewResponse(0, nil, nil))
+
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords,
+			newController(&collaborationRepository.Mock{}, databaseMock).DeleteComment(newCommentData()))
+	})
+}
+
+func TestGetAssignment(t *testing.T) {
+	t.Run("should success get assignment", func(t *testing.T) {
+		assignment := &collaborationEntities.Assignment{AssigneeID: uuid.New()}
+
+		repositoryMock := &collaborationRepository.Mock{}
+		repositoryMock.On("GetAssignment").Return(assignment, nil)
+
+		result, err := newController(repositoryMock, &database.Mock{}).GetAssignment(
+			&collaborationEntities.VulnerabilityKey{})
+		assert.NoError(t, err)
+		assert.Equal(t, assignment, result)
+	})
+}
+
+func TestSaveAssignment(t *testing.T) {
+	t.Run("should create assignment when vulnerability is not assigned", func(t *testing.T) {
+		repositoryMock := &collaborationRepository.Mock{}
+		repositoryMock.On("IsRepositoryMember").Return(true, nil)
+		repositoryMock.On("GetAssignment").Return(
+			&collaborationEntities.Assignment{}, databaseEnums.ErrorNotFoundRecords)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		data := newAssignmentData()
+
+		result, err := newController(repositoryMock, databaseMock).SaveAssignment(data)
+		assert.NoError(t, err)
+		assert.Equal(t, data.AssigneeID, result.AssigneeID)
+		databaseMock.AssertCalled(t, "Create")
+		databaseMock.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("should replace assignee when vulnerability is already assigned", func(t *testing.T) {
+		previous := &collaborationEntities.Assignment{AssigneeID: uuid.New()}
+
+		repositoryMock := &collaborationRepository.Mock{}
+		repositoryMock.On("IsRepositoryMember").Return(true, nil)
+		repositoryMock.On("GetAssignment").Return(previous, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		data := newAssignmentData()
+
+		result, err := newController(repositoryMock, databaseMock).SaveAssignment(data)
+		assert.NoError(t, err)
+		assert.Equal(t, data.AssigneeID, result.AssigneeID)
+		databaseMock.AssertCalled(t, "Update")
+		databaseMock.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("should return error when assignee is not member of the repository", func(t *testing.T) {
+		repositoryMock := &collaborationRepository.Mock{}
+		repositoryMock.On("IsRepositoryMember").Return(false, nil)
+
+		_, err := newController(repositoryMock, &database.Mock{}).SaveAssignment(newAssignmentData())
+		assert.Equal(t, collaborationEnums.ErrorAssigneeIsNotMember, err)
+	})
+
+	t.Run("should return error when failed to check membership", func(t *testing.T) {
+		repositoryMock := &collaborationRepository.Mock{}
+		repositoryMock.On("IsRepositoryMember").Return(false, errors.New("test"))
+
+		_, err := newController(repositoryMock, &database.Mock{}).SaveAssignment(newAssignmentData())
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to get assignment", func(t *testing.T) {
+		repositoryMock := &collaborationRepository.Mock{}
+		repositoryMock.On("IsRepositoryMember").Return(true, nil)
+		repositoryMock.On("GetAssignment").Return(&collaborationEntities.Assignment{}, errors.New("test"))
+
+		_, err := newController(repositoryMock, &database.Mock{}).SaveAssignment(newAssignmentData())
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteAssignment(t *testing.T) {
+	t.Run("should success delete assignment", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(response.NewResponse(1, nil, nil))
+
+		assert.NoError(t, newController(&collaborationRepository.Mock{}, databaseMock).DeleteAssignment(
+			&collaborationEntities.VulnerabilityKey{}))
+	})
+
+	t.Run("should return not found when vulnerability is not assigned", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(response.NewResponse(0, nil, nil))
+
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, newController(&collaborationRepository.Mock{},
+			databaseMock).DeleteAssignment(&collaborationEntities.VulnerabilityKey{}))
+	})
+}
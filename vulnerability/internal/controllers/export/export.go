@@ -0,0 +1,89 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	exportEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/export"
+	exportEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/export"
+	exportRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/export"
+)
+
+type IController interface {
+	Export(filter *exportEntities.Filter, output io.Writer) error
+}
+
+type Controller struct {
+	repository exportRepository.IRepository
+}
+
+func NewExportController(repository exportRepository.IRepository) IController {
+	return &Controller{
+		repository: repository,
+	}
+}
+
+// Export streams the vulnerabilities in batches, flushing the output after each one. The first batch is searched
+// before anything is written, so a database failure can still be answered with a proper error response
+func (c *Controller) Export(filter *exportEntities.Filter, output io.Writer) error {
+	vulnerabilities, err := c.repository.ListVulnerabilitiesBatch(filter, uuid.Nil)
+	if err != nil {
+		return err
+	}
+
+	writer := exportEntities.NewWriter(filter.Format, output)
+	if err := writer.Begin(); err != nil {
+		return err
+	}
+
+	for {
+		if err := c.writeBatch(writer, output, vulnerabilities); err != nil {
+			return err
+		}
+
+		if len(vulnerabilities) < exportEnums.BatchSize {
+			return writer.End()
+		}
+
+		vulnerabilities, err = c.repository.ListVulnerabilitiesBatch(filter,
+			vulnerabilities[len(vulnerabilities)-1].VulnerabilityID)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Controller) writeBatch(writer exportEntities.IWriter, output io.Writer,
+	vulnerabilities []exportEntities.Vulnerability) error {
+	for index := range vulnerabilities {
+		if err := writer.Write(&vulnerabilities[index]); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	if flusher, ok := output.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return nil
+}
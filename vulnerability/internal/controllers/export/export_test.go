@@ -0,0 +1,111 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bufio"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+
+	exportEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/export"
+	exportEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/export"
+	exportRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/export"
+)
+
+func newVulnerabilitiesMock(length int) []exportEntities.Vulnerability {
+	vulnerabilities := make([]exportEntities.Vulnerability, length)
+	for index := range vulnerabilities {
+		vulnerabilities[index] = exportEntities.Vulnerability{
+			Vulnerability: vulnerabilityEntities.Vulnerability{VulnerabilityID: uuid.New()},
+		}
+	}
+
+	return vulnerabilities
+}
+
+func countLines(t *testing.T, recorder *httptest.ResponseRecorder) int {
+	lines := 0
+	scanner := bufio.NewScanner(recorder.Body)
+	for scanner.Scan() {
+		lines++
+	}
+
+	assert.NoError(t, scanner.Err())
+	return lines
+}
+
+func TestNewExportController(t *testing.T) {
+	t.Run("Should success create a new controller", func(t *testing.T) {
+		assert.NotNil(t, NewExportController(nil))
+	})
+}
+
+func TestExport(t *testing.T) {
+	t.Run("Should export all batches until a batch smaller than the batch size", func(t *testing.T) {
+		repositoryMock := &exportRepository.Mock{}
+		repositoryMock.On("ListVulnerabilitiesBatch").Once().Return(
+			newVulnerabilitiesMock(exportEnums.BatchSize), nil)
+		repositoryMock.On("ListVulnerabilitiesBatch").Once().Return(newVulnerabilitiesMock(2), nil)
+
+		recorder := httptest.NewRecorder()
+		controller := NewExportController(repositoryMock)
+
+		assert.NoError(t, controller.Export(&exportEntities.Filter{Format: exportEnums.NDJSON}, recorder))
+		assert.Equal(t, exportEnums.BatchSize+2, countLines(t, recorder))
+		assert.True(t, recorder.Flushed)
+		repositoryMock.AssertNumberOfCalls(t, "ListVulnerabilitiesBatch", 2)
+	})
+
+	t.Run("Should export an empty result", func(t *testing.T) {
+		repositoryMock := &exportRepository.Mock{}
+		repositoryMock.On("ListVulnerabilitiesBatch").Return([]exportEntities.Vulnerability{}, nil)
+
+		recorder := httptest.NewRecorder()
+		controller := NewExportController(repositoryMock)
+
+		assert.NoError(t, controller.Export(&exportEntities.Filter{Format: exportEnums.CSV}, recorder))
+		assert.Equal(t, 1, countLines(t, recorder))
+	})
+
+	t.Run("Should return error without writing when failed to get first batch", func(t *testing.T) {
+		repositoryMock := &exportRepository.Mock{}
+		repositoryMock.On("ListVulnerabilitiesBatch").Return([]exportEntities.Vulnerability{}, errors.New("test"))
+
+		recorder := httptest.NewRecorder()
+		controller := NewExportController(repositoryMock)
+
+		assert.Error(t, controller.Export(&exportEntities.Filter{Format: exportEnums.SARIF}, recorder))
+		assert.Empty(t, recorder.Body.String())
+	})
+
+	t.Run("Should return error when failed to get next batch", func(t *testing.T) {
+		repositoryMock := &exportRepository.Mock{}
+		repositoryMock.On("ListVulnerabilitiesBatch").Once().Return(
+			newVulnerabilitiesMock(exportEnums.BatchSize), nil)
+		repositoryMock.On("ListVulnerabilitiesBatch").Return([]exportEntities.Vulnerability{}, errors.New("test"))
+
+		recorder := httptest.NewRecorder()
+		controller := NewExportController(repositoryMock)
+
+		assert.Error(t, controller.Export(&exportEntities.Filter{Format: exportEnums.NDJSON}, recorder))
+		assert.Equal(t, exportEnums.BatchSize, countLines(t, recorder))
+	})
+}
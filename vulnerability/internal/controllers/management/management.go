@@ -15,21 +15,27 @@
 package management
 
 import (
-	"time"
-
 	"github.com/go-enry/go-enry/v2"
-
 	"github.com/google/uuid"
+
 	"github.com/pkg/errors"
 
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
 	brokerLib "github.com/ZupIT/horusec-devkit/pkg/services/broker"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 
+	acceptanceEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/acceptance"
 	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	propagationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/propagation"
+	acceptanceEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/acceptance"
 	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
 	managementRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/management"
+	propagationRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/propagation"
 	managementUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/management"
 )
 
@@ -37,22 +43,30 @@ type IController interface {
 	ListVulnerabilitiesByFile(filter *managementEntities.Filter) (*managementEntities.ResponseVulnerabilitiesByFile, error)
 	ListVulnerableFiles(filter *managementEntities.Filter) (*managementEntities.ResponseFilesVulnerable, error)
 	UpdateVulnerabilities(data *managementEntities.UpdateData) error
+	GetAnalysesDiff(filter *managementEntities.DiffFilter) (*managementEntities.Diff, error)
+	ListVulnerabilityHistory(filter *managementEntities.HistoryFilter) ([]managementEntities.Event, error)
+	BulkUpdateVulnerabilities(data *managementEntities.BulkUpdateData) (*managementEntities.BulkUpdateResult, error)
+	ListPropagations(filter *managementEntities.HistoryFilter) ([]propagationEntities.PropagatedVulnerability, error)
+	UndoPropagation(data *propagationEntities.UndoData) (*managementEntities.BulkUpdateResult, error)
 }
 
 type Controller struct {
-	repository    managementRepository.IRepository
-	broker        brokerLib.IBroker
-	databaseWrite database.IDatabaseWrite
-	useCases      managementUseCases.IUseCases
+	repository            managementRepository.IRepository
+	broker                brokerLib.IBroker
+	databaseWrite         database.IDatabaseWrite
+	useCases              managementUseCases.IUseCases
+	propagationRepository propagationRepository.IRepository
 }
 
 func NewManagementController(repository managementRepository.IRepository, broker brokerLib.IBroker,
-	databaseConnection *database.Connection, useCases managementUseCases.IUseCases) IController {
+	databaseConnection *database.Connection, useCases managementUseCases.IUseCases,
+	propagationRepository propagationRepository.IRepository) IController {
 	return &Controller{
-		repository:    repository,
-		broker:        broker,
-		databaseWrite: databaseConnection.Write,
-		useCases:      useCases,
+		repository:            repository,
+		broker:                broker,
+		databaseWrite:         databaseConnection.Write,
+		useCases:              useCases,
+		propagationRepository: propagationRepository,
 	}
 }
 
@@ -73,42 +87,366 @@ func (c *Controller) ListVulnerableFiles(
 	return response, nil
 }
 
+// UpdateVulnerabilities changes the vulnerabilities of the analysis and, when the workspace opted in, propagates
+// their triage to the same vulnerabilities of its other repositories
 func (c *Controller) UpdateVulnerabilities(data *managementEntities.UpdateData) error {
+	if err := c.saveAnalysisChanges(data); err != nil {
+		return err
+	}
+
+	if err := c.publishAnalysisChanges(data); err != nil {
+		return err
+	}
+
+	c.propagateTriage(data)
+	return nil
+}
+
+func (c *Controller) saveAnalysisChanges(data *managementEntities.UpdateData) error {
 	transaction := c.databaseWrite.StartTransaction()
 
-	for _, vulnerability := range data.Vulnerabilities {
-		if err := c.updateVulnerability(vulnerability, transaction); err != nil {
-			logger.LogError(managementEnums.MessageFailedToRollbackUpdate, transaction.RollbackTransaction().GetError())
-			return err
-		}
+	if err := c.updateAnalysisVulnerabilities(data, transaction); err != nil {
+		logger.LogError(managementEnums.MessageFailedToRollbackUpdate, transaction.RollbackTransaction().GetError())
+		return err
 	}
 
 	if err := transaction.CommitTransaction().GetError(); err != nil {
 		return errors.Wrap(err, managementEnums.MessageFailedToCommitUpdateTransaction)
 	}
 
-	return c.publishAnalysisChanges(data.AnalysisID)
+	return nil
+}
+
+func (c *Controller) updateAnalysisVulnerabilities(data *managementEntities.UpdateData,
+	transaction database.IDatabaseWrite) error {
+	for _, vulnerability := range data.Vulnerabilities {
+		if err := c.updateVulnerability(data, vulnerability, transaction); err != nil {
+			return err
+		}
+	}
+
+	return c.incrementAnalysisRevision(data.AnalysisID, transaction)
+}
+
+func (c *Controller) incrementAnalysisRevision(analysisID uuid.UUID, transaction database.IDatabaseWrite) error {
+	return transaction.Update(c.useCases.IncrementAnalysisRevision(), c.useCases.FilterAnalysisByID(analysisID),
+		managementEnums.AnalysisTable).GetError()
 }
 
-func (c *Controller) updateVulnerability(
+func (c *Controller) updateVulnerability(updateData *managementEntities.UpdateData,
 	data *managementEntities.VulnerabilityData, transaction database.IDatabaseWrite) error {
 	vulnerability, err := c.repository.GetVulnerability(data.VulnerabilityID)
 	if err != nil {
 		return err
 	}
 
+	return c.applyVulnerabilityData(updateData, data, vulnerability, transaction)
+}
+
+func (c *Controller) applyVulnerabilityData(updateData *managementEntities.UpdateData,
+	data *managementEntities.VulnerabilityData, vulnerability *vulnerabilityEntities.Vulnerability,
+	transaction database.IDatabaseWrite) error {
+	event := managementEntities.NewEvent(vulnerability, data, updateData)
 	vulnerability.SetType(data.Type)
 	vulnerability.SetSeverity(data.Severity)
-	return transaction.Update(vulnerability, c.useCases.FilterVulnerabilityByID(vulnerability.VulnerabilityID),
-		managementEnums.VulnerabilitiesTable).GetError()
+	event.SetNewValues(vulnerability)
+
+	if err := transaction.Update(vulnerability, c.useCases.FilterVulnerabilityByID(vulnerability.VulnerabilityID),
+		managementEnums.VulnerabilitiesTable).GetError(); err != nil {
+		return err
+	}
+
+	if err := transaction.Create(event, managementEnums.EventsTable).GetError(); err != nil {
+		return err
+	}
+
+	return c.saveRiskAcceptance(updateData, data, transaction)
+}
+
+// saveRiskAcceptance replaces the expiry of the vulnerability, it is removed when the vulnerability is no longer
+// accepted as risk or when the acceptance has no expiry
+func (c *Controller) saveRiskAcceptance(updateData *managementEntities.UpdateData,
+	data *managementEntities.VulnerabilityData, transaction database.IDatabaseWrite) error {
+	if err := transaction.Delete(c.useCases.FilterVulnerabilityByID(data.VulnerabilityID),
+		acceptanceEnums.RiskAcceptancesTable).GetError(); err != nil {
+		return err
+	}
+
+	if !data.IsTimeBoxedRiskAcceptance() {
+		return nil
+	}
+
+	return transaction.Create(acceptanceEntities.NewRiskAcceptance(data, updateData),
+		acceptanceEnums.RiskAcceptancesTable).GetError()
 }
 
-func (c *Controller) publishAnalysisChanges(analysisID uuid.UUID) error {
+// publishAnalysisChanges reads the revision before the analysis, so the analysis published always has at least the
+// changes of its revision even when another change is committed in between
+func (c *Controller) publishAnalysisChanges(data *managementEntities.UpdateData) error {
+	revision, err := c.repository.GetAnalysisRevision(data.AnalysisID)
+	if err != nil {
+		return err
+	}
+
+	analysis, err := c.repository.GetAnalysis(data.AnalysisID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.broker.Publish("", exchange.NewAnalysis, exchange.Fanout,
+		managementEntities.NewRevisedAnalysis(analysis, revision).ToBytes()); err != nil {
+		return err
+	}
+
+	return c.broker.Publish(managementEnums.WebhookEventsQueue, "", "", data.ToWebhookEvent(analysis).ToBytes())
+}
+
+// GetAnalysesDiff compares the analysis of the filter with the base analysis informed, or with the latest other
+// analysis of the repository. Analyses from other workspaces or repositories are handled as not found
+func (c *Controller) GetAnalysesDiff(filter *managementEntities.DiffFilter) (*managementEntities.Diff, error) {
+	head, err := c.getRepositoryAnalysis(filter, filter.AnalysisID)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := c.getBaseAnalysis(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return managementEntities.NewDiff(base, head), nil
+}
+
+func (c *Controller) getBaseAnalysis(filter *managementEntities.DiffFilter) (*analysisEntities.Analysis, error) {
+	if !filter.IsLatestBase() {
+		return c.getRepositoryAnalysis(filter, filter.BaseAnalysisID)
+	}
+
+	analysisID, err := c.repository.GetLatestAnalysisID(filter.RepositoryID, filter.AnalysisID)
+	if err != nil || analysisID == uuid.Nil {
+		return nil, err
+	}
+
+	return c.getRepositoryAnalysis(filter, analysisID)
+}
+
+func (c *Controller) getRepositoryAnalysis(filter *managementEntities.DiffFilter,
+	analysisID uuid.UUID) (*analysisEntities.Analysis, error) {
 	analysis, err := c.repository.GetAnalysis(analysisID)
 	if err != nil {
+		return nil, err
+	}
+
+	if !filter.IsFromRepository(analysis) {
+		return nil, databaseEnums.ErrorNotFoundRecords
+	}
+
+	return analysis, nil
+}
+
+func (c *Controller) ListVulnerabilityHistory(
+	filter *managementEntities.HistoryFilter) ([]managementEntities.Event, error) {
+	return c.repository.ListVulnerabilityHistory(filter)
+}
+
+// BulkUpdateVulnerabilities counts the vulnerabilities matched by the filter and, when it is not a dry run, updates
+// them in batches with a transaction by batch. Each affected repository receives a single analysis update with all
+// its changed vulnerabilities, including the ones of the batches committed before a failure
+// Bulk updates are not propagated to other repositories, the filter by workspace already reaches all of them
+func (c *Controller) BulkUpdateVulnerabilities(
+	data *managementEntities.BulkUpdateData) (*managementEntities.BulkUpdateResult, error) {
+	result, err := c.repository.CountBulkVulnerabilities(data.Filter)
+	if err != nil || data.DryRun || result.TotalVulnerabilities == 0 {
+		return c.setBulkDryRun(result, data), err
+	}
+
+	updates, err := c.applyBulkUpdate(data)
+	if publishErr := c.publishBulkAnalysisChanges(updates); err == nil {
+		err = publishErr
+	}
+
+	return result, err
+}
+
+func (c *Controller) setBulkDryRun(result *managementEntities.BulkUpdateResult,
+	data *managementEntities.BulkUpdateData) *managementEntities.BulkUpdateResult {
+	if result != nil {
+		result.DryRun = data.DryRun
+	}
+
+	return result
+}
+
+// applyBulkUpdate returns the committed updates grouped by repository
+func (c *Controller) applyBulkUpdate(
+	data *managementEntities.BulkUpdateData) (map[uuid.UUID]*managementEntities.UpdateData, error) {
+	updates := map[uuid.UUID]*managementEntities.UpdateData{}
+	lastVulnerabilityID := uuid.Nil
+
+	for {
+		vulnerabilities, err := c.repository.ListBulkVulnerabilities(data.Filter, lastVulnerabilityID)
+		if err != nil || len(vulnerabilities) == 0 {
+			return updates, err
+		}
+
+		if err := c.applyBulkUpdateBatch(data, vulnerabilities, updates); err != nil {
+			return updates, err
+		}
+
+		if len(vulnerabilities) < managementEnums.BulkBatchSize {
+			return updates, nil
+		}
+
+		lastVulnerabilityID = vulnerabilities[len(vulnerabilities)-1].VulnerabilityID
+	}
+}
+
+func (c *Controller) applyBulkUpdateBatch(data *managementEntities.BulkUpdateData,
+	vulnerabilities []managementEntities.BulkVulnerability, updates map[uuid.UUID]*managementEntities.UpdateData) error {
+	batchUpdates := map[uuid.UUID]*managementEntities.UpdateData{}
+	transaction := c.databaseWrite.StartTransaction()
+
+	for index := range vulnerabilities {
+		vulnerability := &vulnerabilities[index]
+
+		updateData := c.getRepositoryUpdateData(data, vulnerability, batchUpdates)
+		vulnerabilityData := data.ToVulnerabilityData(&vulnerability.Vulnerability)
+		updateData.Vulnerabilities = append(updateData.Vulnerabilities, vulnerabilityData)
+
+		if err := c.applyVulnerabilityData(updateData, vulnerabilityData, &vulnerability.Vulnerability,
+			transaction); err != nil {
+			logger.LogError(managementEnums.MessageFailedToRollbackUpdate, transaction.RollbackTransaction().GetError())
+			return err
+		}
+	}
+
+	if err := c.incrementAnalysisRevisions(batchUpdates, transaction); err != nil {
+		logger.LogError(managementEnums.MessageFailedToRollbackUpdate, transaction.RollbackTransaction().GetError())
 		return err
 	}
 
-	analysis.CreatedAt = time.Now() // send an updated analysis to analytic.
-	return c.broker.Publish("", exchange.NewAnalysis, exchange.Fanout, analysis.ToBytes())
+	if err := transaction.CommitTransaction().GetError(); err != nil {
+		return errors.Wrap(err, managementEnums.MessageFailedToCommitUpdateTransaction)
+	}
+
+	c.mergeBulkUpdates(data, updates, batchUpdates)
+	return nil
+}
+
+func (c *Controller) incrementAnalysisRevisions(batchUpdates map[uuid.UUID]*managementEntities.UpdateData,
+	transaction database.IDatabaseWrite) error {
+	for _, batchUpdate := range batchUpdates {
+		if err := c.incrementAnalysisRevision(batchUpdate.AnalysisID, transaction); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Controller) getRepositoryUpdateData(data *managementEntities.BulkUpdateData,
+	vulnerability *managementEntities.BulkVulnerability,
+	updates map[uuid.UUID]*managementEntities.UpdateData) *managementEntities.UpdateData {
+	if _, ok := updates[vulnerability.RepositoryID]; !ok {
+		updates[vulnerability.RepositoryID] = data.ToUpdateData(vulnerability.AnalysisID)
+	}
+
+	return updates[vulnerability.RepositoryID]
+}
+
+func (c *Controller) mergeBulkUpdates(data *managementEntities.BulkUpdateData,
+	updates, batchUpdates map[uuid.UUID]*managementEntities.UpdateData) {
+	for repositoryID, batchUpdate := range batchUpdates {
+		if _, ok := updates[repositoryID]; !ok {
+			updates[repositoryID] = data.ToUpdateData(batchUpdate.AnalysisID)
+		}
+
+		updates[repositoryID].Vulnerabilities = append(updates[repositoryID].Vulnerabilities,
+			batchUpdate.Vulnerabilities...)
+	}
+}
+
+func (c *Controller) publishBulkAnalysisChanges(updates map[uuid.UUID]*managementEntities.UpdateData) error {
+	for _, updateData := range updates {
+		if err := c.publishAnalysisChanges(updateData); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// propagateTriage is only logged when it fails, since the triage of the repository was already saved. The
+// propagations committed before a failure are still published to their repositories
+func (c *Controller) propagateTriage(data *managementEntities.UpdateData) {
+	updates, err := c.applyPropagation(data)
+	if publishErr := c.publishBulkAnalysisChanges(updates); err == nil {
+		err = publishErr
+	}
+
+	if err != nil {
+		logger.LogError(propagationEnums.MessageFailedToPropagateTriage, err)
+	}
+}
+
+// applyPropagation returns the committed propagations grouped by repository, each triaged vulnerability is carried
+// to its matches with a transaction by vulnerability
+func (c *Controller) applyPropagation(
+	data *managementEntities.UpdateData) (map[uuid.UUID]*managementEntities.UpdateData, error) {
+	updates := map[uuid.UUID]*managementEntities.UpdateData{}
+
+	source, err := c.propagationRepository.GetSource(data.AnalysisID)
+	if err != nil || !source.IsEnabled {
+		return updates, err
+	}
+
+	for _, vulnerabilityData := range data.Vulnerabilities {
+		if !vulnerabilityData.IsTriaged() {
+			continue
+		}
+
+		targets, err := c.propagationRepository.ListTargets(source, vulnerabilityData.VulnerabilityID)
+		if err != nil {
+			return updates, err
+		}
+
+		if err := c.applyBulkUpdateBatch(data.ToPropagationData(vulnerabilityData), targets, updates); err != nil {
+			return updates, err
+		}
+	}
+
+	return updates, nil
+}
+
+// ListPropagations returns the vulnerabilities of the workspace that still have the triage propagated from the
+// vulnerability of the filter
+func (c *Controller) ListPropagations(
+	filter *managementEntities.HistoryFilter) ([]propagationEntities.PropagatedVulnerability, error) {
+	return c.propagationRepository.ListPropagated(filter)
+}
+
+// UndoPropagation restores the vulnerabilities that still have the propagated triage to the type they had before
+// it, recording the undo in their history. The vulnerabilities changed after the propagation are kept as they are
+func (c *Controller) UndoPropagation(
+	data *propagationEntities.UndoData) (*managementEntities.BulkUpdateResult, error) {
+	vulnerabilities, err := c.propagationRepository.ListPropagated(&data.HistoryFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[uuid.UUID]*managementEntities.UpdateData{}
+	for oldType, targets := range propagationEntities.GroupByOldType(vulnerabilities) {
+		if err = c.applyBulkUpdateBatch(data.ToBulkUpdateData(oldType), targets, updates); err != nil {
+			break
+		}
+	}
+
+	if publishErr := c.publishBulkAnalysisChanges(updates); err == nil {
+		err = publishErr
+	}
+
+	return &managementEntities.BulkUpdateResult{
+		TotalVulnerabilities: len(vulnerabilities),
+		TotalRepositories:    len(updates),
+	}, err
 }
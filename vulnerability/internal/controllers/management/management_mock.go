@@ -20,6 +20,7 @@ import (
 	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
 
 	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	propagationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/propagation"
 )
 
 type Mock struct {
@@ -43,3 +44,36 @@ func (m *Mock) UpdateVulnerabilities(_ *managementEntities.UpdateData) error {
 
 	return utilsMock.ReturnNilOrError(args, 0)
 }
+
+func (m *Mock) GetAnalysesDiff(_ *managementEntities.DiffFilter) (*managementEntities.Diff, error) {
+	args := m.MethodCalled("GetAnalysesDiff")
+
+	return args.Get(0).(*managementEntities.Diff), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListVulnerabilityHistory(_ *managementEntities.HistoryFilter) ([]managementEntities.Event, error) {
+	args := m.MethodCalled("ListVulnerabilityHistory")
+
+	return args.Get(0).([]managementEntities.Event), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) BulkUpdateVulnerabilities(
+	_ *managementEntities.BulkUpdateData) (*managementEntities.BulkUpdateResult, error) {
+	args := m.MethodCalled("BulkUpdateVulnerabilities")
+
+	return args.Get(0).(*managementEntities.BulkUpdateResult), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListPropagations(
+	_ *managementEntities.HistoryFilter) ([]propagationEntities.PropagatedVulnerability, error) {
+	args := m.MethodCalled("ListPropagations")
+
+	return args.Get(0).([]propagationEntities.PropagatedVulnerability), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) UndoPropagation(
+	_ *propagationEntities.UndoData) (*managementEntities.BulkUpdateResult, error) {
+	args := m.MethodCalled("UndoPropagation")
+
+	return args.Get(0).(*managementEntities.BulkUpdateResult), utilsMock.ReturnNilOrError(args, 1)
+}
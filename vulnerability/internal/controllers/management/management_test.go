@@ -17,6 +17,7 @@ package management
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -27,16 +28,21 @@ import (
 	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
 	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
 
 	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	propagationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/propagation"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
 	managementRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/management"
+	propagationRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/propagation"
 	managementUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/management"
 )
 
 func TestNewManagementController(t *testing.T) {
 	t.Run("should success create a new controller", func(t *testing.T) {
-		assert.NotNil(t, NewManagementController(nil, nil, &database.Connection{}, nil))
+		assert.NotNil(t, NewManagementController(nil, nil, &database.Connection{}, nil, nil))
 	})
 }
 
@@ -50,7 +56,7 @@ func TestGetAllVulnerabilitiesOfTheFile(t *testing.T) {
 		repositoryMock.On("ListVulnerabilitiesByFile").Return(&managementEntities.ResponseVulnerabilitiesByFile{}, nil)
 
 		controller := NewManagementController(repositoryMock, brokerMock,
-			databaseConnection, managementUseCases.NewManagementUseCases())
+			databaseConnection, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
 
 		result, err := controller.ListVulnerabilitiesByFile(&managementEntities.Filter{})
 		assert.NoError(t, err)
@@ -84,7 +90,7 @@ func TestGetAllFilesVulnerable(t *testing.T) {
 		}, nil)
 
 		controller := NewManagementController(repositoryMock, brokerMock,
-			databaseConnection, managementUseCases.NewManagementUseCases())
+			databaseConnection, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
 
 		result, err := controller.ListVulnerableFiles(&managementEntities.Filter{})
 		assert.NoError(t, err)
@@ -105,7 +111,7 @@ func TestGetAllFilesVulnerable(t *testing.T) {
 		repositoryMock.On("ListVulnerableFiles").Return(&managementEntities.ResponseFilesVulnerable{}, errors.New("unexpected error"))
 
 		controller := NewManagementController(repositoryMock, brokerMock,
-			databaseConnection, managementUseCases.NewManagementUseCases())
+			databaseConnection, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
 
 		_, err := controller.ListVulnerableFiles(&managementEntities.Filter{})
 		assert.Error(t, err)
@@ -119,6 +125,7 @@ func TestUpdateVulnerability(t *testing.T) {
 				VulnerabilityID: uuid.New(),
 				Severity:        severities.Critical,
 				Type:            vulnerabilityEnums.Vulnerability,
+				Justification:   "test",
 			},
 		},
 		AnalysisID: uuid.New(),
@@ -128,6 +135,8 @@ func TestUpdateVulnerability(t *testing.T) {
 		databaseMock := &database.Mock{}
 		databaseMock.On("StartTransaction").Return(databaseMock)
 		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
 		databaseMock.On("CommitTransaction").Return(&response.Response{})
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
@@ -138,11 +147,38 @@ func TestUpdateVulnerability(t *testing.T) {
 		repositoryMock := &managementRepository.Mock{}
 		repositoryMock.On("GetVulnerability").Return(&vulnerabilityEntities.Vulnerability{}, nil)
 		repositoryMock.On("GetAnalysis").Return(&analysisEntities.Analysis{}, nil)
+		repositoryMock.On("GetAnalysisRevision").Return(1, nil)
 
 		controller := NewManagementController(repositoryMock, brokerMock,
-			databaseConnection, managementUseCases.NewManagementUseCases())
+			databaseConnection, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
 
 		assert.NoError(t, controller.UpdateVulnerabilities(updateData))
+		brokerMock.AssertNumberOfCalls(t, "Publish", 2)
+	})
+
+	t.Run("should return error when publishing analysis changes", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(errors.New("test"))
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetVulnerability").Return(&vulnerabilityEntities.Vulnerability{}, nil)
+		repositoryMock.On("GetAnalysis").Return(&analysisEntities.Analysis{}, nil)
+		repositoryMock.On("GetAnalysisRevision").Return(1, nil)
+
+		controller := NewManagementController(repositoryMock, brokerMock,
+			databaseConnection, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
+
+		assert.Error(t, controller.UpdateVulnerabilities(updateData))
+		brokerMock.AssertNumberOfCalls(t, "Publish", 1)
 	})
 
 	t.Run("should return error when getting analysis", func(t *testing.T) {
@@ -151,18 +187,70 @@ func TestUpdateVulnerability(t *testing.T) {
 		databaseMock := &database.Mock{}
 		databaseMock.On("StartTransaction").Return(databaseMock)
 		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
 		databaseMock.On("CommitTransaction").Return(&response.Response{})
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 
 		repositoryMock := &managementRepository.Mock{}
 		repositoryMock.On("GetVulnerability").Return(&vulnerabilityEntities.Vulnerability{}, nil)
+		repositoryMock.On("GetAnalysisRevision").Return(1, nil)
 		repositoryMock.On("GetAnalysis").Return(&analysisEntities.Analysis{}, errors.New("test"))
 
 		controller := NewManagementController(repositoryMock, brokerMock,
-			databaseConnection, managementUseCases.NewManagementUseCases())
+			databaseConnection, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
+
+		assert.Error(t, controller.UpdateVulnerabilities(updateData))
+	})
+
+	t.Run("should return error when getting the analysis revision", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetVulnerability").Return(&vulnerabilityEntities.Vulnerability{}, nil)
+		repositoryMock.On("GetAnalysisRevision").Return(0, errors.New("test"))
+
+		controller := NewManagementController(repositoryMock, brokerMock,
+			databaseConnection, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
+
+		assert.Error(t, controller.UpdateVulnerabilities(updateData))
+		repositoryMock.AssertNotCalled(t, "GetAnalysis")
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should rollback when failed to increment the analysis revision", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{}).Times(len(updateData.Vulnerabilities))
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetVulnerability").Return(&vulnerabilityEntities.Vulnerability{}, nil)
+
+		controller := NewManagementController(repositoryMock, brokerMock,
+			databaseConnection, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
 
 		assert.Error(t, controller.UpdateVulnerabilities(updateData))
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
+		brokerMock.AssertNotCalled(t, "Publish")
 	})
 
 	t.Run("should return error when updating vulnerabilities", func(t *testing.T) {
@@ -180,9 +268,91 @@ func TestUpdateVulnerability(t *testing.T) {
 		repositoryMock.On("GetVulnerability").Return(&vulnerabilityEntities.Vulnerability{}, nil)
 
 		controller := NewManagementController(repositoryMock, brokerMock,
-			databaseConnection, managementUseCases.NewManagementUseCases())
+			databaseConnection, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
+
+		assert.Error(t, controller.UpdateVulnerabilities(updateData))
+	})
+
+	t.Run("should success update vulnerabilities with time-boxed risk acceptance", func(t *testing.T) {
+		expiresAt := time.Now().AddDate(0, 1, 0)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetVulnerability").Return(&vulnerabilityEntities.Vulnerability{}, nil)
+		repositoryMock.On("GetAnalysis").Return(&analysisEntities.Analysis{}, nil)
+		repositoryMock.On("GetAnalysisRevision").Return(1, nil)
+
+		controller := NewManagementController(repositoryMock, brokerMock,
+			databaseConnection, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
+
+		assert.NoError(t, controller.UpdateVulnerabilities(&managementEntities.UpdateData{
+			AnalysisID: uuid.New(),
+			Vulnerabilities: []*managementEntities.VulnerabilityData{
+				{
+					VulnerabilityID: uuid.New(),
+					Severity:        severities.Critical,
+					Type:            vulnerabilityEnums.RiskAccepted,
+					Justification:   "test",
+					ExpiresAt:       &expiresAt,
+				},
+			},
+		}))
+		databaseMock.AssertNumberOfCalls(t, "Delete", 1)
+		databaseMock.AssertNumberOfCalls(t, "Create", 2)
+	})
+
+	t.Run("should return error when removing previous risk acceptance", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Delete").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetVulnerability").Return(&vulnerabilityEntities.Vulnerability{}, nil)
+
+		controller := NewManagementController(repositoryMock, brokerMock,
+			databaseConnection, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
+
+		assert.Error(t, controller.UpdateVulnerabilities(updateData))
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
+	})
+
+	t.Run("should return error when saving vulnerability event", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetVulnerability").Return(&vulnerabilityEntities.Vulnerability{}, nil)
+
+		controller := NewManagementController(repositoryMock, brokerMock,
+			databaseConnection, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
 
 		assert.Error(t, controller.UpdateVulnerabilities(updateData))
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
 	})
 
 	t.Run("should return error when getting vulnerability", func(t *testing.T) {
@@ -199,7 +369,7 @@ func TestUpdateVulnerability(t *testing.T) {
 			&vulnerabilityEntities.Vulnerability{}, errors.New("test"))
 
 		controller := NewManagementController(repositoryMock, brokerMock,
-			databaseConnection, managementUseCases.NewManagementUseCases())
+			databaseConnection, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
 
 		assert.Error(t, controller.UpdateVulnerabilities(updateData))
 	})
@@ -210,6 +380,8 @@ func TestUpdateVulnerability(t *testing.T) {
 		databaseMock := &database.Mock{}
 		databaseMock.On("StartTransaction").Return(databaseMock)
 		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
 		databaseMock.On("CommitTransaction").Return(
 			response.NewResponse(0, errors.New("test"), nil))
 
@@ -218,10 +390,642 @@ func TestUpdateVulnerability(t *testing.T) {
 		repositoryMock := &managementRepository.Mock{}
 		repositoryMock.On("GetVulnerability").Return(&vulnerabilityEntities.Vulnerability{}, nil)
 		repositoryMock.On("GetAnalysis").Return(&analysisEntities.Analysis{}, nil)
+		repositoryMock.On("GetAnalysisRevision").Return(1, nil)
 
 		controller := NewManagementController(repositoryMock, brokerMock,
-			databaseConnection, managementUseCases.NewManagementUseCases())
+			databaseConnection, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
 
 		assert.Error(t, controller.UpdateVulnerabilities(updateData))
 	})
 }
+
+func TestGetAnalysesDiff(t *testing.T) {
+	filter := &managementEntities.DiffFilter{WorkspaceID: uuid.New(), RepositoryID: uuid.New(), AnalysisID: uuid.New()}
+
+	newAnalysis := func(hashes ...string) *analysisEntities.Analysis {
+		analysis := &analysisEntities.Analysis{ID: uuid.New(), WorkspaceID: filter.WorkspaceID,
+			RepositoryID: filter.RepositoryID}
+		for _, hash := range hashes {
+			analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities,
+				analysisEntities.AnalysisVulnerabilities{Vulnerability: vulnerabilityEntities.Vulnerability{
+					VulnHash: hash, Severity: severities.High}})
+		}
+
+		return analysis
+	}
+
+	t.Run("should success compare with latest analysis of the repository", func(t *testing.T) {
+		base := newAnalysis("fixed", "unchanged")
+		head := newAnalysis("unchanged", "new")
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetAnalysis").Once().Return(head, nil)
+		repositoryMock.On("GetLatestAnalysisID").Return(base.ID, nil)
+		repositoryMock.On("GetAnalysis").Once().Return(base, nil)
+
+		controller := NewManagementController(repositoryMock, nil, &database.Connection{}, nil, nil)
+
+		result, err := controller.GetAnalysesDiff(filter)
+		assert.NoError(t, err)
+		assert.Equal(t, base.ID, *result.BaseAnalysisID)
+		assert.Equal(t, 1, result.New.Severities[severities.High.ToString()])
+		assert.Equal(t, 1, result.Fixed.Total)
+		assert.Equal(t, 1, result.Unchanged.Total)
+	})
+
+	t.Run("should success compare with the base analysis informed", func(t *testing.T) {
+		base := newAnalysis("fixed")
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetAnalysis").Once().Return(newAnalysis("new"), nil)
+		repositoryMock.On("GetAnalysis").Once().Return(base, nil)
+
+		controller := NewManagementController(repositoryMock, nil, &database.Connection{}, nil, nil)
+
+		result, err := controller.GetAnalysesDiff(&managementEntities.DiffFilter{WorkspaceID: filter.WorkspaceID,
+			RepositoryID: filter.RepositoryID, AnalysisID: uuid.New(), BaseAnalysisID: base.ID})
+		assert.NoError(t, err)
+		assert.Equal(t, base.ID, *result.BaseAnalysisID)
+		repositoryMock.AssertNotCalled(t, "GetLatestAnalysisID")
+	})
+
+	t.Run("should return all as new when repository has no other analysis", func(t *testing.T) {
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetAnalysis").Return(newAnalysis("new"), nil)
+		repositoryMock.On("GetLatestAnalysisID").Return(uuid.Nil, nil)
+
+		controller := NewManagementController(repositoryMock, nil, &database.Connection{}, nil, nil)
+
+		result, err := controller.GetAnalysesDiff(filter)
+		assert.NoError(t, err)
+		assert.Nil(t, result.BaseAnalysisID)
+		assert.Equal(t, 1, result.New.Total)
+		repositoryMock.AssertNumberOfCalls(t, "GetAnalysis", 1)
+	})
+
+	t.Run("should return not found when analysis is from another repository", func(t *testing.T) {
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetAnalysis").Return(&analysisEntities.Analysis{ID: uuid.New(),
+			WorkspaceID: filter.WorkspaceID, RepositoryID: uuid.New()}, nil)
+
+		controller := NewManagementController(repositoryMock, nil, &database.Connection{}, nil, nil)
+
+		_, err := controller.GetAnalysesDiff(filter)
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+
+	t.Run("should return error when failed to get analysis", func(t *testing.T) {
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetAnalysis").Return(&analysisEntities.Analysis{}, errors.New("test"))
+
+		controller := NewManagementController(repositoryMock, nil, &database.Connection{}, nil, nil)
+
+		_, err := controller.GetAnalysesDiff(filter)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to get latest analysis id", func(t *testing.T) {
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetAnalysis").Return(newAnalysis(), nil)
+		repositoryMock.On("GetLatestAnalysisID").Return(uuid.Nil, errors.New("test"))
+
+		controller := NewManagementController(repositoryMock, nil, &database.Connection{}, nil, nil)
+
+		_, err := controller.GetAnalysesDiff(filter)
+		assert.Error(t, err)
+	})
+}
+
+func TestListVulnerabilityHistory(t *testing.T) {
+	t.Run("should success list vulnerability history", func(t *testing.T) {
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("ListVulnerabilityHistory").Return(
+			[]managementEntities.Event{{EventID: uuid.New()}}, nil)
+
+		controller := NewManagementController(repositoryMock, &broker.Mock{},
+			&database.Connection{}, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
+
+		result, err := controller.ListVulnerabilityHistory(&managementEntities.HistoryFilter{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("should return error when failed to list vulnerability history", func(t *testing.T) {
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("ListVulnerabilityHistory").Return([]managementEntities.Event{}, errors.New("test"))
+
+		controller := NewManagementController(repositoryMock, &broker.Mock{},
+			&database.Connection{}, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
+
+		_, err := controller.ListVulnerabilityHistory(&managementEntities.HistoryFilter{})
+		assert.Error(t, err)
+	})
+}
+
+func TestBulkUpdateVulnerabilities(t *testing.T) {
+	newBulkUpdateData := func(dryRun bool) *managementEntities.BulkUpdateData {
+		return &managementEntities.BulkUpdateData{
+			Type:          vulnerabilityEnums.FalsePositive,
+			Justification: "test",
+			Filter:        &managementEntities.Filter{WorkspaceID: uuid.New()},
+			DryRun:        dryRun,
+		}
+	}
+
+	newBulkVulnerabilities := func(total int, repositoryIDs ...uuid.UUID) []managementEntities.BulkVulnerability {
+		vulnerabilities := make([]managementEntities.BulkVulnerability, total)
+		for index := range vulnerabilities {
+			vulnerabilities[index].VulnerabilityID = uuid.New()
+			vulnerabilities[index].RepositoryID = repositoryIDs[index%len(repositoryIDs)]
+		}
+
+		return vulnerabilities
+	}
+
+	newDatabaseMock := func() *database.Mock {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		return databaseMock
+	}
+
+	t.Run("should only count the vulnerabilities when dry run", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+		brokerMock := &broker.Mock{}
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("CountBulkVulnerabilities").Return(
+			&managementEntities.BulkUpdateResult{TotalVulnerabilities: 10, TotalRepositories: 2}, nil)
+
+		controller := NewManagementController(repositoryMock, brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			newPropagationRepositoryMock())
+
+		result, err := controller.BulkUpdateVulnerabilities(newBulkUpdateData(true))
+		assert.NoError(t, err)
+		assert.True(t, result.DryRun)
+		assert.Equal(t, 10, result.TotalVulnerabilities)
+		assert.Equal(t, 2, result.TotalRepositories)
+		repositoryMock.AssertNotCalled(t, "ListBulkVulnerabilities")
+		databaseMock.AssertNotCalled(t, "StartTransaction")
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should not update when no vulnerabilities match the filter", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("CountBulkVulnerabilities").Return(&managementEntities.BulkUpdateResult{}, nil)
+
+		controller := NewManagementController(repositoryMock, &broker.Mock{},
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			newPropagationRepositoryMock())
+
+		result, err := controller.BulkUpdateVulnerabilities(newBulkUpdateData(false))
+		assert.NoError(t, err)
+		assert.False(t, result.DryRun)
+		assert.Equal(t, 0, result.TotalVulnerabilities)
+		repositoryMock.AssertNotCalled(t, "ListBulkVulnerabilities")
+	})
+
+	t.Run("should update in batches and publish once by repository", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("CountBulkVulnerabilities").Return(
+			&managementEntities.BulkUpdateResult{TotalVulnerabilities: 502, TotalRepositories: 2}, nil)
+		repositoryMock.On("ListBulkVulnerabilities").Once().Return(
+			newBulkVulnerabilities(managementEnums.BulkBatchSize, uuid.New(), uuid.New()), nil)
+		repositoryMock.On("ListBulkVulnerabilities").Once().Return(newBulkVulnerabilities(2, uuid.New()), nil)
+		repositoryMock.On("GetAnalysis").Return(&analysisEntities.Analysis{}, nil)
+		repositoryMock.On("GetAnalysisRevision").Return(1, nil)
+
+		controller := NewManagementController(repositoryMock, brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			newPropagationRepositoryMock())
+
+		result, err := controller.BulkUpdateVulnerabilities(newBulkUpdateData(false))
+		assert.NoError(t, err)
+		assert.Equal(t, 502, result.TotalVulnerabilities)
+		repositoryMock.AssertNumberOfCalls(t, "ListBulkVulnerabilities", 2)
+		databaseMock.AssertNumberOfCalls(t, "StartTransaction", 2)
+		databaseMock.AssertNumberOfCalls(t, "CommitTransaction", 2)
+		databaseMock.AssertNumberOfCalls(t, "Update", 502+3)
+		repositoryMock.AssertNumberOfCalls(t, "GetAnalysis", 3)
+		brokerMock.AssertNumberOfCalls(t, "Publish", 6)
+	})
+
+	t.Run("should stop listing when the last batch is empty", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("CountBulkVulnerabilities").Return(
+			&managementEntities.BulkUpdateResult{TotalVulnerabilities: 500, TotalRepositories: 1}, nil)
+		repositoryMock.On("ListBulkVulnerabilities").Once().Return(
+			newBulkVulnerabilities(managementEnums.BulkBatchSize, uuid.New()), nil)
+		repositoryMock.On("ListBulkVulnerabilities").Once().Return(
+			[]managementEntities.BulkVulnerability{}, nil)
+		repositoryMock.On("GetAnalysis").Return(&analysisEntities.Analysis{}, nil)
+		repositoryMock.On("GetAnalysisRevision").Return(1, nil)
+
+		controller := NewManagementController(repositoryMock, brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			newPropagationRepositoryMock())
+
+		_, err := controller.BulkUpdateVulnerabilities(newBulkUpdateData(false))
+		assert.NoError(t, err)
+		databaseMock.AssertNumberOfCalls(t, "StartTransaction", 1)
+		brokerMock.AssertNumberOfCalls(t, "Publish", 2)
+	})
+
+	t.Run("should publish the committed batches when a batch fails", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Once().Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("CountBulkVulnerabilities").Return(
+			&managementEntities.BulkUpdateResult{TotalVulnerabilities: 501, TotalRepositories: 2}, nil)
+		repositoryMock.On("ListBulkVulnerabilities").Once().Return(
+			newBulkVulnerabilities(managementEnums.BulkBatchSize, uuid.New()), nil)
+		repositoryMock.On("ListBulkVulnerabilities").Once().Return(newBulkVulnerabilities(1, uuid.New()), nil)
+		repositoryMock.On("GetAnalysis").Return(&analysisEntities.Analysis{}, nil)
+		repositoryMock.On("GetAnalysisRevision").Return(1, nil)
+
+		controller := NewManagementController(repositoryMock, brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			newPropagationRepositoryMock())
+
+		_, err := controller.BulkUpdateVulnerabilities(newBulkUpdateData(false))
+		assert.Error(t, err)
+		repositoryMock.AssertNumberOfCalls(t, "GetAnalysis", 1)
+		brokerMock.AssertNumberOfCalls(t, "Publish", 2)
+	})
+
+	t.Run("should rollback and return error when updating a vulnerability", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		brokerMock := &broker.Mock{}
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("CountBulkVulnerabilities").Return(
+			&managementEntities.BulkUpdateResult{TotalVulnerabilities: 1, TotalRepositories: 1}, nil)
+		repositoryMock.On("ListBulkVulnerabilities").Return(newBulkVulnerabilities(1, uuid.New()), nil)
+
+		controller := NewManagementController(repositoryMock, brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			newPropagationRepositoryMock())
+
+		_, err := controller.BulkUpdateVulnerabilities(newBulkUpdateData(false))
+		assert.Error(t, err)
+		databaseMock.AssertNumberOfCalls(t, "RollbackTransaction", 1)
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should return error when listing vulnerabilities", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("CountBulkVulnerabilities").Return(
+			&managementEntities.BulkUpdateResult{TotalVulnerabilities: 1, TotalRepositories: 1}, nil)
+		repositoryMock.On("ListBulkVulnerabilities").Return(
+			[]managementEntities.BulkVulnerability{}, errors.New("test"))
+
+		controller := NewManagementController(repositoryMock, &broker.Mock{},
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			newPropagationRepositoryMock())
+
+		_, err := controller.BulkUpdateVulnerabilities(newBulkUpdateData(false))
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when publishing analysis changes", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("CountBulkVulnerabilities").Return(
+			&managementEntities.BulkUpdateResult{TotalVulnerabilities: 1, TotalRepositories: 1}, nil)
+		repositoryMock.On("ListBulkVulnerabilities").Return(newBulkVulnerabilities(1, uuid.New()), nil)
+		repositoryMock.On("GetAnalysisRevision").Return(1, nil)
+		repositoryMock.On("GetAnalysis").Return(&analysisEntities.Analysis{}, errors.New("test"))
+
+		controller := NewManagementController(repositoryMock, &broker.Mock{},
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			newPropagationRepositoryMock())
+
+		_, err := controller.BulkUpdateVulnerabilities(newBulkUpdateData(false))
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when counting vulnerabilities", func(t *testing.T) {
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("CountBulkVulnerabilities").Return(
+			&managementEntities.BulkUpdateResult{}, errors.New("test"))
+
+		controller := NewManagementController(repositoryMock, &broker.Mock{},
+			&database.Connection{}, managementUseCases.NewManagementUseCases(), newPropagationRepositoryMock())
+
+		_, err := controller.BulkUpdateVulnerabilities(newBulkUpdateData(false))
+		assert.Error(t, err)
+		repositoryMock.AssertNotCalled(t, "ListBulkVulnerabilities")
+	})
+}
+
+func newPropagationRepositoryMock() *propagationRepository.Mock {
+	repositoryMock := &propagationRepository.Mock{}
+	repositoryMock.On("GetSource").Return(&propagationEntities.Source{}, nil)
+
+	return repositoryMock
+}
+
+func TestUpdateVulnerabilityPropagation(t *testing.T) {
+	newUpdateData := func(vulnType vulnerabilityEnums.Type) *managementEntities.UpdateData {
+		return &managementEntities.UpdateData{
+			AnalysisID: uuid.New(),
+			Vulnerabilities: []*managementEntities.VulnerabilityData{
+				{
+					VulnerabilityID: uuid.New(),
+					Severity:        severities.High,
+					Type:            vulnType,
+					Justification:   "test",
+				},
+			},
+		}
+	}
+
+	newDatabaseMock := func() *database.Mock {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		return databaseMock
+	}
+
+	newRepositoryMock := func() *managementRepository.Mock {
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetVulnerability").Return(&vulnerabilityEntities.Vulnerability{}, nil)
+		repositoryMock.On("GetAnalysis").Return(&analysisEntities.Analysis{}, nil)
+		repositoryMock.On("GetAnalysisRevision").Return(1, nil)
+
+		return repositoryMock
+	}
+
+	enabledSource := &propagationEntities.Source{IsEnabled: true, MatchBy: propagationEnums.MatchByHash}
+
+	t.Run("should propagate the triage to the vulnerabilities of the other repositories", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		propagationMock := &propagationRepository.Mock{}
+		propagationMock.On("GetSource").Return(enabledSource, nil)
+		propagationMock.On("ListTargets").Return([]managementEntities.BulkVulnerability{
+			{RepositoryID: uuid.New()}, {RepositoryID: uuid.New()}}, nil)
+
+		controller := NewManagementController(newRepositoryMock(), brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			propagationMock)
+
+		assert.NoError(t, controller.UpdateVulnerabilities(newUpdateData(vulnerabilityEnums.FalsePositive)))
+		databaseMock.AssertNumberOfCalls(t, "StartTransaction", 2)
+		databaseMock.AssertNumberOfCalls(t, "Update", 3+3)
+		brokerMock.AssertNumberOfCalls(t, "Publish", 6)
+	})
+
+	t.Run("should not propagate when the workspace has the propagation disabled", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		propagationMock := newPropagationRepositoryMock()
+
+		controller := NewManagementController(newRepositoryMock(), brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			propagationMock)
+
+		assert.NoError(t, controller.UpdateVulnerabilities(newUpdateData(vulnerabilityEnums.FalsePositive)))
+		propagationMock.AssertNotCalled(t, "ListTargets")
+		brokerMock.AssertNumberOfCalls(t, "Publish", 2)
+	})
+
+	t.Run("should not propagate vulnerabilities changed back to unclassified", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		propagationMock := &propagationRepository.Mock{}
+		propagationMock.On("GetSource").Return(enabledSource, nil)
+
+		controller := NewManagementController(newRepositoryMock(), brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			propagationMock)
+
+		assert.NoError(t, controller.UpdateVulnerabilities(newUpdateData(vulnerabilityEnums.Vulnerability)))
+		propagationMock.AssertNotCalled(t, "ListTargets")
+		databaseMock.AssertNumberOfCalls(t, "StartTransaction", 1)
+	})
+
+	t.Run("should keep the update when failing to get the propagation source", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		propagationMock := &propagationRepository.Mock{}
+		propagationMock.On("GetSource").Return(&propagationEntities.Source{}, errors.New("test"))
+
+		controller := NewManagementController(newRepositoryMock(), brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			propagationMock)
+
+		assert.NoError(t, controller.UpdateVulnerabilities(newUpdateData(vulnerabilityEnums.FalsePositive)))
+		brokerMock.AssertNumberOfCalls(t, "Publish", 2)
+	})
+
+	t.Run("should keep the update when failing to list the propagation targets", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		propagationMock := &propagationRepository.Mock{}
+		propagationMock.On("GetSource").Return(enabledSource, nil)
+		propagationMock.On("ListTargets").Return([]managementEntities.BulkVulnerability{}, errors.New("test"))
+
+		controller := NewManagementController(newRepositoryMock(), brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			propagationMock)
+
+		assert.NoError(t, controller.UpdateVulnerabilities(newUpdateData(vulnerabilityEnums.FalsePositive)))
+		databaseMock.AssertNumberOfCalls(t, "StartTransaction", 1)
+		brokerMock.AssertNumberOfCalls(t, "Publish", 2)
+	})
+
+	t.Run("should rollback the propagation when failing to update the targets", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Twice().Return(&response.Response{})
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		propagationMock := &propagationRepository.Mock{}
+		propagationMock.On("GetSource").Return(enabledSource, nil)
+		propagationMock.On("ListTargets").Return([]managementEntities.BulkVulnerability{
+			{RepositoryID: uuid.New()}}, nil)
+
+		controller := NewManagementController(newRepositoryMock(), brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			propagationMock)
+
+		assert.NoError(t, controller.UpdateVulnerabilities(newUpdateData(vulnerabilityEnums.FalsePositive)))
+		databaseMock.AssertNumberOfCalls(t, "RollbackTransaction", 1)
+		brokerMock.AssertNumberOfCalls(t, "Publish", 2)
+	})
+}
+
+func TestListPropagations(t *testing.T) {
+	t.Run("should success list the propagations of the vulnerability", func(t *testing.T) {
+		propagationMock := &propagationRepository.Mock{}
+		propagationMock.On("ListPropagated").Return([]propagationEntities.PropagatedVulnerability{{}}, nil)
+
+		controller := NewManagementController(nil, nil, &database.Connection{}, nil, propagationMock)
+
+		result, err := controller.ListPropagations(&managementEntities.HistoryFilter{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+}
+
+func TestUndoPropagation(t *testing.T) {
+	newPropagated := func(oldType vulnerabilityEnums.Type,
+		repositoryID uuid.UUID) propagationEntities.PropagatedVulnerability {
+		propagated := propagationEntities.PropagatedVulnerability{OldType: oldType}
+		propagated.VulnerabilityID = uuid.New()
+		propagated.RepositoryID = repositoryID
+
+		return propagated
+	}
+
+	newDatabaseMock := func() *database.Mock {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		return databaseMock
+	}
+
+	t.Run("should restore the propagated vulnerabilities grouped by their old type", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetAnalysis").Return(&analysisEntities.Analysis{}, nil)
+		repositoryMock.On("GetAnalysisRevision").Return(1, nil)
+
+		repositoryID := uuid.New()
+		propagationMock := &propagationRepository.Mock{}
+		propagationMock.On("ListPropagated").Return([]propagationEntities.PropagatedVulnerability{
+			newPropagated(vulnerabilityEnums.Vulnerability, repositoryID),
+			newPropagated(vulnerabilityEnums.Vulnerability, uuid.New()),
+			newPropagated(vulnerabilityEnums.Corrected, repositoryID),
+		}, nil)
+
+		controller := NewManagementController(repositoryMock, brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			propagationMock)
+
+		result, err := controller.UndoPropagation(&propagationEntities.UndoData{})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, result.TotalVulnerabilities)
+		assert.Equal(t, 2, result.TotalRepositories)
+		databaseMock.AssertNumberOfCalls(t, "StartTransaction", 2)
+		databaseMock.AssertNumberOfCalls(t, "Update", 3+3)
+		brokerMock.AssertNumberOfCalls(t, "Publish", 4)
+	})
+
+	t.Run("should do nothing when there are no propagated vulnerabilities", func(t *testing.T) {
+		databaseMock := newDatabaseMock()
+		brokerMock := &broker.Mock{}
+
+		propagationMock := &propagationRepository.Mock{}
+		propagationMock.On("ListPropagated").Return([]propagationEntities.PropagatedVulnerability{}, nil)
+
+		controller := NewManagementController(&managementRepository.Mock{}, brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			propagationMock)
+
+		result, err := controller.UndoPropagation(&propagationEntities.UndoData{})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result.TotalVulnerabilities)
+		databaseMock.AssertNotCalled(t, "StartTransaction")
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should return error when failing to list the propagated vulnerabilities", func(t *testing.T) {
+		propagationMock := &propagationRepository.Mock{}
+		propagationMock.On("ListPropagated").Return(
+			[]propagationEntities.PropagatedVulnerability{}, errors.New("test"))
+
+		controller := NewManagementController(nil, nil, &database.Connection{}, nil, propagationMock)
+
+		result, err := controller.UndoPropagation(&propagationEntities.UndoData{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when failing to restore the vulnerabilities", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		brokerMock := &broker.Mock{}
+
+		propagationMock := &propagationRepository.Mock{}
+		propagationMock.On("ListPropagated").Return([]propagationEntities.PropagatedVulnerability{
+			newPropagated(vulnerabilityEnums.Vulnerability, uuid.New())}, nil)
+
+		controller := NewManagementController(&managementRepository.Mock{}, brokerMock,
+			&database.Connection{Read: databaseMock, Write: databaseMock}, managementUseCases.NewManagementUseCases(),
+			propagationMock)
+
+		result, err := controller.UndoPropagation(&propagationEntities.UndoData{})
+		assert.Error(t, err)
+		assert.Equal(t, 0, result.TotalRepositories)
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	propagationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/propagation"
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
+	propagationRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/propagation"
+)
+
+type IController interface {
+	GetSettings(workspaceID uuid.UUID) (*propagationEntities.Settings, error)
+	SaveSettings(data *propagationEntities.SettingsData) (*propagationEntities.Settings, error)
+}
+
+type Controller struct {
+	repository    propagationRepository.IRepository
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewPropagationController(repository propagationRepository.IRepository,
+	databaseConnection *database.Connection) IController {
+	return &Controller{
+		repository:    repository,
+		databaseWrite: databaseConnection.Write,
+	}
+}
+
+func (c *Controller) GetSettings(workspaceID uuid.UUID) (*propagationEntities.Settings, error) {
+	return c.repository.GetSettings(workspaceID)
+}
+
+// SaveSettings replaces the settings of the workspace, creating them on its first change. Only the next triages
+// are propagated, the vulnerabilities triaged before are kept as they are
+func (c *Controller) SaveSettings(data *propagationEntities.SettingsData) (*propagationEntities.Settings, error) {
+	settings := data.ToSettings()
+
+	result := c.databaseWrite.Update(settings.ToUpdateMap(), settings.ToFilter(), propagationEnums.SettingsTable)
+	if result.GetError() != nil || result.GetRowsAffected() > 0 {
+		return settings, result.GetError()
+	}
+
+	return settings, c.databaseWrite.Create(settings, propagationEnums.SettingsTable).GetError()
+}
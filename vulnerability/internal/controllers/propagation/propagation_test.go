@@ -0,0 +1,108 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	propagationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/propagation"
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
+	propagationRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/propagation"
+)
+
+func newSettingsData() *propagationEntities.SettingsData {
+	return &propagationEntities.SettingsData{
+		WorkspaceID: uuid.New(),
+		IsEnabled:   true,
+		MatchBy:     propagationEnums.MatchByHash,
+	}
+}
+
+func TestNewPropagationController(t *testing.T) {
+	t.Run("should success create a new controller", func(t *testing.T) {
+		assert.NotNil(t, NewPropagationController(nil, &database.Connection{}))
+	})
+}
+
+func TestGetSettings(t *testing.T) {
+	t.Run("should success get the settings", func(t *testing.T) {
+		repositoryMock := &propagationRepository.Mock{}
+		repositoryMock.On("GetSettings").Return(&propagationEntities.Settings{IsEnabled: true}, nil)
+
+		controller := NewPropagationController(repositoryMock, &database.Connection{})
+
+		result, err := controller.GetSettings(uuid.New())
+		assert.NoError(t, err)
+		assert.True(t, result.IsEnabled)
+	})
+}
+
+func TestSaveSettings(t *testing.T) {
+	t.Run("should update the settings when they already exist", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+
+		controller := NewPropagationController(nil, &database.Connection{Read: databaseMock, Write: databaseMock})
+
+		data := newSettingsData()
+		result, err := controller.SaveSettings(data)
+		assert.NoError(t, err)
+		assert.Equal(t, data.WorkspaceID, result.WorkspaceID)
+		assert.True(t, result.IsEnabled)
+		databaseMock.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("should create the settings on their first change", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, nil, nil))
+		databaseMock.On("Create").Return(response.NewResponse(1, nil, nil))
+
+		controller := NewPropagationController(nil, &database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := controller.SaveSettings(newSettingsData())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		databaseMock.AssertNumberOfCalls(t, "Create", 1)
+	})
+
+	t.Run("should return error when failed to update the settings", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		controller := NewPropagationController(nil, &database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := controller.SaveSettings(newSettingsData())
+		assert.Error(t, err)
+		databaseMock.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("should return error when failed to create the settings", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, nil, nil))
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		controller := NewPropagationController(nil, &database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := controller.SaveSettings(newSettingsData())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	suppressionEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/suppression"
+	suppressionEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/suppression"
+	suppressionRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/suppression"
+)
+
+type IController interface {
+	ListRules(scope *suppressionEntities.Scope) ([]suppressionEntities.Rule, error)
+	GetRule(scope *suppressionEntities.Scope) (*suppressionEntities.Rule, error)
+	CreateRule(data *suppressionEntities.RuleData) (*suppressionEntities.Rule, error)
+	UpdateRule(data *suppressionEntities.RuleData) (*suppressionEntities.Rule, error)
+	DeleteRule(scope *suppressionEntities.Scope) error
+	PreviewMatches(data *suppressionEntities.PreviewData) (*suppressionEntities.PreviewResponse, error)
+}
+
+type Controller struct {
+	repository    suppressionRepository.IRepository
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewSuppressionController(repository suppressionRepository.IRepository,
+	databaseConnection *database.Connection) IController {
+	return &Controller{
+		repository:    repository,
+		databaseWrite: databaseConnection.Write,
+	}
+}
+
+func (c *Controller) ListRules(scope *suppressionEntities.Scope) ([]suppressionEntities.Rule, error) {
+	return c.repository.ListRules(scope)
+}
+
+func (c *Controller) GetRule(scope *suppressionEntities.Scope) (*suppressionEntities.Rule, error) {
+	return c.repository.GetRule(scope)
+}
+
+func (c *Controller) CreateRule(data *suppressionEntities.RuleData) (*suppressionEntities.Rule, error) {
+	rule := data.ToRule()
+
+	return rule, c.databaseWrite.Create(rule, suppressionEnums.RulesTable).GetError()
+}
+
+// UpdateRule replaces the rule, only the vulnerabilities of the next analyses are classified with the new data
+func (c *Controller) UpdateRule(data *suppressionEntities.RuleData) (*suppressionEntities.Rule, error) {
+	rule, err := c.repository.GetRule(&data.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Update(data)
+	return rule, c.databaseWrite.Update(rule.ToUpdateMap(), data.ToFilter(), suppressionEnums.RulesTable).GetError()
+}
+
+// DeleteRule removes the rule, the vulnerabilities already classified by it are kept as they are
+func (c *Controller) DeleteRule(scope *suppressionEntities.Scope) error {
+	result := c.databaseWrite.Delete(scope.ToFilter(), suppressionEnums.RulesTable)
+	if result.GetError() != nil {
+		return result.GetError()
+	}
+
+	if result.GetRowsAffected() == 0 {
+		return databaseEnums.ErrorNotFoundRecords
+	}
+
+	return nil
+}
+
+func (c *Controller) PreviewMatches(
+	data *suppressionEntities.PreviewData) (*suppressionEntities.PreviewResponse, error) {
+	return c.repository.PreviewMatches(data)
+}
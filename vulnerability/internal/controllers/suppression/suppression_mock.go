@@ -0,0 +1,64 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	suppressionEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/suppression"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) ListRules(_ *suppressionEntities.Scope) ([]suppressionEntities.Rule, error) {
+	args := m.MethodCalled("ListRules")
+
+	return args.Get(0).([]suppressionEntities.Rule), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetRule(_ *suppressionEntities.Scope) (*suppressionEntities.Rule, error) {
+	args := m.MethodCalled("GetRule")
+
+	return args.Get(0).(*suppressionEntities.Rule), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) CreateRule(_ *suppressionEntities.RuleData) (*suppressionEntities.Rule, error) {
+	args := m.MethodCalled("CreateRule")
+
+	return args.Get(0).(*suppressionEntities.Rule), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) UpdateRule(_ *suppressionEntities.RuleData) (*suppressionEntities.Rule, error) {
+	args := m.MethodCalled("UpdateRule")
+
+	return args.Get(0).(*suppressionEntities.Rule), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) DeleteRule(_ *suppressionEntities.Scope) error {
+	args := m.MethodCalled("DeleteRule")
+
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) PreviewMatches(
+	_ *suppressionEntities.PreviewData) (*suppressionEntities.PreviewResponse, error) {
+	args := m.MethodCalled("PreviewMatches")
+
+	return args.Get(0).(*suppressionEntities.PreviewResponse), utilsMock.ReturnNilOrError(args, 1)
+}
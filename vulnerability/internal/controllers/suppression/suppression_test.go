@@ -0,0 +1,168 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	suppressionEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/suppression"
+	suppressionRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/suppression"
+)
+
+func newRuleData() *suppressionEntities.RuleData {
+	return &suppressionEntities.RuleData{
+		Scope:       suppressionEntities.Scope{WorkspaceID: uuid.New(), RuleID: uuid.New()},
+		Name:        "test",
+		FilePattern: "test/**",
+		AccountID:   uuid.New(),
+	}
+}
+
+func newController(repositoryMock *suppressionRepository.Mock, databaseMock *database.Mock) IController {
+	return NewSuppressionController(repositoryMock, &database.Connection{Read: databaseMock, Write: databaseMock})
+}
+
+func TestNewSuppressionController(t *testing.T) {
+	t.Run("should success create a new controller", func(t *testing.T) {
+		assert.NotNil(t, NewSuppressionController(nil, &database.Connection{}))
+	})
+}
+
+func TestListRules(t *testing.T) {
+	t.Run("should success list rules", func(t *testing.T) {
+		repositoryMock := &suppressionRepository.Mock{}
+		repositoryMock.On("ListRules").Return([]suppressionEntities.Rule{{Name: "test"}}, nil)
+
+		result, err := newController(repositoryMock, &database.Mock{}).ListRules(&suppressionEntities.Scope{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+}
+
+func TestGetRule(t *testing.T) {
+	t.Run("should success get rule", func(t *testing.T) {
+		repositoryMock := &suppressionRepository.Mock{}
+		repositoryMock.On("GetRule").Return(&suppressionEntities.Rule{Name: "test"}, nil)
+
+		result, err := newController(repositoryMock, &database.Mock{}).GetRule(&suppressionEntities.Scope{})
+		assert.NoError(t, err)
+		assert.Equal(t, "test", result.Name)
+	})
+}
+
+func TestCreateRule(t *testing.T) {
+	t.Run("should success create rule", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		data := newRuleData()
+
+		rule, err := newController(&suppressionRepository.Mock{}, databaseMock).CreateRule(data)
+		assert.NoError(t, err)
+		assert.Equal(t, data.WorkspaceID, rule.WorkspaceID)
+		assert.Equal(t, data.AccountID, rule.AccountID)
+		assert.NotEqual(t, uuid.Nil, rule.RuleID)
+	})
+
+	t.Run("should return error when failed to create rule", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := newController(&suppressionRepository.Mock{}, databaseMock).CreateRule(newRuleData())
+		assert.Error(t, err)
+	})
+}
+
+func TestUpdateRule(t *testing.T) {
+	t.Run("should success update rule", func(t *testing.T) {
+		ruleID := uuid.New()
+
+		repositoryMock := &suppressionRepository.Mock{}
+		repositoryMock.On("GetRule").Return(&suppressionEntities.Rule{RuleID: ruleID, Name: "old"}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+
+		rule, err := newController(repositoryMock, databaseMock).UpdateRule(newRuleData())
+		assert.NoError(t, err)
+		assert.Equal(t, ruleID, rule.RuleID)
+		assert.Equal(t, "test", rule.Name)
+	})
+
+	t.Run("should return not found when rule does not exist", func(t *testing.T) {
+		repositoryMock := &suppressionRepository.Mock{}
+		repositoryMock.On("GetRule").Return(&suppressionEntities.Rule{}, databaseEnums.ErrorNotFoundRecords)
+
+		_, err := newController(repositoryMock, &database.Mock{}).UpdateRule(newRuleData())
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+
+	t.Run("should return error when failed to update rule", func(t *testing.T) {
+		repositoryMock := &suppressionRepository.Mock{}
+		repositoryMock.On("GetRule").Return(&suppressionEntities.Rule{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := newController(repositoryMock, databaseMock).UpdateRule(newRuleData())
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteRule(t *testing.T) {
+	t.Run("should success delete rule", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(response.NewResponse(1, nil, nil))
+
+		assert.NoError(t, newController(&suppressionRepository.Mock{}, databaseMock).DeleteRule(
+			&suppressionEntities.Scope{}))
+	})
+
+	t.Run("should return not found when no rule was deleted", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(response.NewResponse(0, nil, nil))
+
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, newController(&suppressionRepository.Mock{},
+			databaseMock).DeleteRule(&suppressionEntities.Scope{}))
+	})
+
+	t.Run("should return error when failed to delete rule", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, newController(&suppressionRepository.Mock{}, databaseMock).DeleteRule(
+			&suppressionEntities.Scope{}))
+	})
+}
+
+func TestPreviewMatches(t *testing.T) {
+	t.Run("should success preview matches", func(t *testing.T) {
+		repositoryMock := &suppressionRepository.Mock{}
+		repositoryMock.On("PreviewMatches").Return(&suppressionEntities.PreviewResponse{TotalItems: 1}, nil)
+
+		result, err := newController(repositoryMock, &database.Mock{}).PreviewMatches(
+			&suppressionEntities.PreviewData{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.TotalItems)
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acceptance
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	emailEntities "github.com/ZupIT/horusec-devkit/pkg/entities/email"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	acceptanceEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/acceptance"
+)
+
+// Expired is a risk acceptance that reached its expiry date, with the vulnerability and repository data needed to
+// notify the accounts involved
+type Expired struct {
+	RiskAcceptance
+	VulnHash         string              `json:"vulnHash" gorm:"Column:vuln_hash"`
+	File             string              `json:"file" gorm:"Column:file"`
+	Line             string              `json:"line" gorm:"Column:line"`
+	Severity         severities.Severity `json:"severity" gorm:"Column:severity"`
+	WorkspaceID      uuid.UUID           `json:"workspaceID" gorm:"Column:workspace_id"`
+	RepositoryID     uuid.UUID           `json:"repositoryID" gorm:"Column:repository_id"`
+	RepositoryName   string              `json:"repositoryName" gorm:"Column:repository_name"`
+	AccountLanguage  string              `json:"accountLanguage" gorm:"Column:account_language"`
+	ApproverLanguage string              `json:"approverLanguage" gorm:"Column:approver_language"`
+}
+
+// ToEvent creates the history record of the expiry, the change is made by the platform so there is no account
+func (e *Expired) ToEvent() *managementEntities.Event {
+	return &managementEntities.Event{
+		EventID:         uuid.New(),
+		VulnerabilityID: e.VulnerabilityID,
+		AnalysisID:      e.AnalysisID,
+		AccountUsername: acceptanceEnums.SystemUsername,
+		OldType:         vulnerabilityEnums.RiskAccepted,
+		NewType:         vulnerabilityEnums.Vulnerability,
+		OldSeverity:     e.Severity,
+		NewSeverity:     e.Severity,
+		Justification:   acceptanceEnums.ExpiredJustification,
+		CreatedAt:       time.Now(),
+	}
+}
+
+// ToEmailMessages returns one message for the account that accepted the risk and other for the approver, each one
+// in the language of its recipient
+func (e *Expired) ToEmailMessages() (messages [][]byte) {
+	for _, email := range e.getRecipients() {
+		message := &emailEntities.Message{
+			To:           email,
+			TemplateName: acceptanceEnums.ExpiredEmailTemplate,
+			Subject:      acceptanceEnums.ExpiredEmailSubject,
+			Data: map[string]interface{}{
+				"Username":       e.AccountUsername,
+				"RepositoryName": e.RepositoryName,
+				"VulnHash":       e.VulnHash,
+				"File":           e.File,
+				"Line":           e.Line,
+				"Severity":       e.Severity,
+				"Approver":       e.Approver,
+				"ExpiresAt":      e.ExpiresAt.Format(acceptanceEnums.ExpiresAtLayout),
+				"Locale":         e.getLanguage(email),
+			},
+		}
+
+		messages = append(messages, message.ToBytes())
+	}
+
+	return messages
+}
+
+func (e *Expired) getRecipients() (recipients []string) {
+	if e.AccountEmail != "" {
+		recipients = append(recipients, e.AccountEmail)
+	}
+
+	if e.Approver != "" && e.Approver != e.AccountEmail {
+		recipients = append(recipients, e.Approver)
+	}
+
+	return recipients
+}
+
+func (e *Expired) getLanguage(email string) string {
+	if email == e.AccountEmail {
+		return e.AccountLanguage
+	}
+
+	return e.ApproverLanguage
+}
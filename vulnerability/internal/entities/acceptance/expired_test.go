@@ -0,0 +1,104 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acceptance
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	emailEntities "github.com/ZupIT/horusec-devkit/pkg/entities/email"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	acceptanceEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/acceptance"
+)
+
+func newExpired(accountEmail, approver string) *Expired {
+	return &Expired{
+		RiskAcceptance: RiskAcceptance{
+			VulnerabilityID: uuid.New(),
+			AnalysisID:      uuid.New(),
+			AccountEmail:    accountEmail,
+			AccountUsername: "test",
+			Approver:        approver,
+			ExpiresAt:       time.Date(2021, 12, 30, 10, 0, 0, 0, time.UTC),
+		},
+		VulnHash:         "1234",
+		File:             "main.go",
+		Line:             "10",
+		Severity:         severities.High,
+		RepositoryName:   "horusec",
+		AccountLanguage:  "pt-BR",
+		ApproverLanguage: "es",
+	}
+}
+
+func TestToEvent(t *testing.T) {
+	t.Run("should create an event reverting risk accepted to vulnerability", func(t *testing.T) {
+		expired := newExpired("test@horusec.io", "")
+
+		event := expired.ToEvent()
+		assert.NotEqual(t, uuid.Nil, event.EventID)
+		assert.Equal(t, expired.VulnerabilityID, event.VulnerabilityID)
+		assert.Equal(t, expired.AnalysisID, event.AnalysisID)
+		assert.Equal(t, uuid.Nil, event.AccountID)
+		assert.Equal(t, acceptanceEnums.SystemUsername, event.AccountUsername)
+		assert.Equal(t, vulnerabilityEnums.RiskAccepted, event.OldType)
+		assert.Equal(t, vulnerabilityEnums.Vulnerability, event.NewType)
+		assert.Equal(t, severities.High, event.OldSeverity)
+		assert.Equal(t, severities.High, event.NewSeverity)
+		assert.Equal(t, acceptanceEnums.ExpiredJustification, event.Justification)
+	})
+}
+
+func TestToEmailMessages(t *testing.T) {
+	t.Run("should create messages to the account and the approver", func(t *testing.T) {
+		messages := newExpired("test@horusec.io", "approver@horusec.io").ToEmailMessages()
+		assert.Len(t, messages, 2)
+
+		message := &emailEntities.Message{}
+		assert.NoError(t, json.Unmarshal(messages[1], message))
+		assert.Equal(t, "approver@horusec.io", message.To)
+		assert.Equal(t, acceptanceEnums.ExpiredEmailTemplate, message.TemplateName)
+		assert.Equal(t, acceptanceEnums.ExpiredEmailSubject, message.Subject)
+
+		data := message.Data.(map[string]interface{})
+		assert.Equal(t, "horusec", data["RepositoryName"])
+		assert.Equal(t, "1234", data["VulnHash"])
+		assert.Equal(t, "2021-12-30 10:00 UTC", data["ExpiresAt"])
+		assert.Equal(t, "es", data["Locale"])
+	})
+
+	t.Run("should send the message to the account in its language", func(t *testing.T) {
+		messages := newExpired("test@horusec.io", "approver@horusec.io").ToEmailMessages()
+
+		message := &emailEntities.Message{}
+		assert.NoError(t, json.Unmarshal(messages[0], message))
+		assert.Equal(t, "test@horusec.io", message.To)
+		assert.Equal(t, "pt-BR", message.Data.(map[string]interface{})["Locale"])
+	})
+
+	t.Run("should create only one message when approver is the same account", func(t *testing.T) {
+		assert.Len(t, newExpired("test@horusec.io", "test@horusec.io").ToEmailMessages(), 1)
+	})
+
+	t.Run("should create no messages when there is no email", func(t *testing.T) {
+		assert.Empty(t, newExpired("", "").ToEmailMessages())
+	})
+}
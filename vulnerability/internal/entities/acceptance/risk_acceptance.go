@@ -0,0 +1,49 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acceptance
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+)
+
+// RiskAcceptance keeps the expiry of a vulnerability accepted as risk for a limited time
+type RiskAcceptance struct {
+	VulnerabilityID uuid.UUID `json:"vulnerabilityID" gorm:"Column:vulnerability_id"`
+	AnalysisID      uuid.UUID `json:"analysisID" gorm:"Column:analysis_id"`
+	AccountID       uuid.UUID `json:"accountID" gorm:"Column:account_id"`
+	AccountEmail    string    `json:"accountEmail" gorm:"Column:account_email"`
+	AccountUsername string    `json:"accountUsername" gorm:"Column:account_username"`
+	Approver        string    `json:"approver" gorm:"Column:approver"`
+	ExpiresAt       time.Time `json:"expiresAt" gorm:"Column:expires_at"`
+	CreatedAt       time.Time `json:"createdAt" gorm:"Column:created_at"`
+}
+
+func NewRiskAcceptance(data *managementEntities.VulnerabilityData,
+	updateData *managementEntities.UpdateData) *RiskAcceptance {
+	return &RiskAcceptance{
+		VulnerabilityID: data.VulnerabilityID,
+		AnalysisID:      updateData.AnalysisID,
+		AccountID:       updateData.AccountID,
+		AccountEmail:    updateData.AccountEmail,
+		AccountUsername: updateData.AccountUsername,
+		Approver:        data.Approver,
+		ExpiresAt:       *data.ExpiresAt,
+		CreatedAt:       time.Now(),
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acceptance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+)
+
+func TestNewRiskAcceptance(t *testing.T) {
+	t.Run("should create risk acceptance with the expiry, approver and account", func(t *testing.T) {
+		expiresAt := time.Now().AddDate(0, 1, 0)
+		data := &managementEntities.VulnerabilityData{
+			VulnerabilityID: uuid.New(),
+			Type:            vulnerabilityEnums.RiskAccepted,
+			ExpiresAt:       &expiresAt,
+			Approver:        "approver@horusec.io",
+		}
+		updateData := &managementEntities.UpdateData{AnalysisID: uuid.New()}
+		updateData.SetAccountData(uuid.New(), "test@horusec.io", "test")
+
+		riskAcceptance := NewRiskAcceptance(data, updateData)
+		assert.Equal(t, data.VulnerabilityID, riskAcceptance.VulnerabilityID)
+		assert.Equal(t, updateData.AnalysisID, riskAcceptance.AnalysisID)
+		assert.Equal(t, updateData.AccountID, riskAcceptance.AccountID)
+		assert.Equal(t, "test@horusec.io", riskAcceptance.AccountEmail)
+		assert.Equal(t, "test", riskAcceptance.AccountUsername)
+		assert.Equal(t, "approver@horusec.io", riskAcceptance.Approver)
+		assert.Equal(t, expiresAt, riskAcceptance.ExpiresAt)
+		assert.False(t, riskAcceptance.CreatedAt.IsZero())
+	})
+}
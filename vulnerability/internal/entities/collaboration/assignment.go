@@ -0,0 +1,83 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+type Assignment struct {
+	WorkspaceID  uuid.UUID  `json:"workspaceID" gorm:"Column:workspace_id"`
+	RepositoryID uuid.UUID  `json:"repositoryID" gorm:"Column:repository_id"`
+	VulnHash     string     `json:"vulnHash" gorm:"Column:vuln_hash"`
+	AssigneeID   uuid.UUID  `json:"assigneeID" gorm:"Column:assignee_id"`
+	DueDate      *time.Time `json:"dueDate" gorm:"Column:due_date"`
+	AssignedBy   uuid.UUID  `json:"assignedBy" gorm:"Column:assigned_by"`
+	CreatedAt    time.Time  `json:"createdAt" gorm:"Column:created_at"`
+	UpdatedAt    time.Time  `json:"updatedAt" gorm:"Column:updated_at"`
+}
+
+type AssignmentData struct {
+	VulnerabilityKey `swaggerignore:"true"`
+	AssignedBy       uuid.UUID  `json:"-"`
+	AssigneeID       uuid.UUID  `json:"assigneeID" example:"00000000-0000-0000-0000-000000000000"`
+	DueDate          *time.Time `json:"dueDate" example:"2021-12-30T00:00:00Z"`
+}
+
+func (a *AssignmentData) Validate() error {
+	return validation.ValidateStruct(a,
+		validation.Field(&a.AssigneeID, validation.Required, validation.NotIn(uuid.Nil.String())),
+		validation.Field(&a.DueDate, validation.Min(time.Now())),
+	)
+}
+
+func (a *AssignmentData) SetAssignedBy(accountID uuid.UUID) {
+	a.AssignedBy = accountID
+}
+
+func (a *AssignmentData) ToAssignment() *Assignment {
+	return &Assignment{
+		WorkspaceID:  a.WorkspaceID,
+		RepositoryID: a.RepositoryID,
+		VulnHash:     a.VulnHash,
+		AssigneeID:   a.AssigneeID,
+		DueDate:      a.DueDate,
+		AssignedBy:   a.AssignedBy,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// Update replaces the assignee of an existing assignment, keeping the date it was first created
+func (a *Assignment) Update(data *AssignmentData) *Assignment {
+	a.AssigneeID = data.AssigneeID
+	a.DueDate = data.DueDate
+	a.AssignedBy = data.AssignedBy
+	a.UpdatedAt = time.Now()
+
+	return a
+}
+
+func (a *Assignment) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"assignee_id": a.AssigneeID,
+		"due_date":    a.DueDate,
+		"assigned_by": a.AssignedBy,
+		"updated_at":  a.UpdatedAt,
+	}
+}
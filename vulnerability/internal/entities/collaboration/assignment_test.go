@@ -0,0 +1,108 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAssignmentData() *AssignmentData {
+	dueDate := time.Now().Add(24 * time.Hour)
+
+	return &AssignmentData{
+		VulnerabilityKey: VulnerabilityKey{WorkspaceID: uuid.New(), RepositoryID: uuid.New(), VulnHash: "1234"},
+		AssigneeID:       uuid.New(),
+		DueDate:          &dueDate,
+	}
+}
+
+func TestValidateAssignmentData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		assert.NoError(t, newAssignmentData().Validate())
+	})
+
+	t.Run("should return no error when due date is empty", func(t *testing.T) {
+		data := newAssignmentData()
+		data.DueDate = nil
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when empty assignee", func(t *testing.T) {
+		data := newAssignmentData()
+		data.AssigneeID = uuid.Nil
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when due date is in the past", func(t *testing.T) {
+		dueDate := time.Now().Add(-time.Hour)
+
+		data := newAssignmentData()
+		data.DueDate = &dueDate
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestToAssignment(t *testing.T) {
+	t.Run("should success parse data to assignment", func(t *testing.T) {
+		assignedBy := uuid.New()
+
+		data := newAssignmentData()
+		data.SetAssignedBy(assignedBy)
+
+		assignment := data.ToAssignment()
+		assert.Equal(t, data.WorkspaceID, assignment.WorkspaceID)
+		assert.Equal(t, data.RepositoryID, assignment.RepositoryID)
+		assert.Equal(t, "1234", assignment.VulnHash)
+		assert.Equal(t, data.AssigneeID, assignment.AssigneeID)
+		assert.Equal(t, data.DueDate, assignment.DueDate)
+		assert.Equal(t, assignedBy, assignment.AssignedBy)
+		assert.False(t, assignment.CreatedAt.IsZero())
+	})
+}
+
+func TestUpdateAssignment(t *testing.T) {
+	t.Run("should replace assignee and keep creation date", func(t *testing.T) {
+		createdAt := time.Now().Add(-time.Hour)
+		assignment := &Assignment{AssigneeID: uuid.New(), CreatedAt: createdAt}
+
+		data := newAssignmentData()
+		data.SetAssignedBy(uuid.New())
+
+		assignment.Update(data)
+		assert.Equal(t, data.AssigneeID, assignment.AssigneeID)
+		assert.Equal(t, data.DueDate, assignment.DueDate)
+		assert.Equal(t, data.AssignedBy, assignment.AssignedBy)
+		assert.Equal(t, createdAt, assignment.CreatedAt)
+		assert.True(t, assignment.UpdatedAt.After(createdAt))
+	})
+}
+
+func TestToUpdateMapAssignment(t *testing.T) {
+	t.Run("should return assignee, due date, assigned by and update date", func(t *testing.T) {
+		assignment := newAssignmentData().ToAssignment()
+
+		updateMap := assignment.ToUpdateMap()
+		assert.Len(t, updateMap, 4)
+		assert.Equal(t, assignment.AssigneeID, updateMap["assignee_id"])
+		assert.Equal(t, assignment.DueDate, updateMap["due_date"])
+	})
+}
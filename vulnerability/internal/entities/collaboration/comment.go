@@ -0,0 +1,90 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+
+	collaborationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/collaboration"
+)
+
+type Comment struct {
+	CommentID       uuid.UUID `json:"commentID" gorm:"Column:comment_id"`
+	WorkspaceID     uuid.UUID `json:"workspaceID" gorm:"Column:workspace_id"`
+	RepositoryID    uuid.UUID `json:"repositoryID" gorm:"Column:repository_id"`
+	VulnHash        string    `json:"vulnHash" gorm:"Column:vuln_hash"`
+	AccountID       uuid.UUID `json:"accountID" gorm:"Column:account_id"`
+	AccountEmail    string    `json:"accountEmail" gorm:"Column:account_email"`
+	AccountUsername string    `json:"accountUsername" gorm:"Column:account_username"`
+	Content         string    `json:"content" gorm:"Column:content"`
+	CreatedAt       time.Time `json:"createdAt" gorm:"Column:created_at"`
+	UpdatedAt       time.Time `json:"updatedAt" gorm:"Column:updated_at"`
+}
+
+type CommentData struct {
+	VulnerabilityKey `swaggerignore:"true"`
+	CommentID        uuid.UUID `json:"-"`
+	AccountID        uuid.UUID `json:"-"`
+	AccountEmail     string    `json:"-"`
+	AccountUsername  string    `json:"-"`
+	Content          string    `json:"content" example:"the input is sanitized by the framework"`
+}
+
+func (c *CommentData) Validate() error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.Content, validation.Required,
+			validation.Length(1, collaborationEnums.MaxCommentLength)),
+	)
+}
+
+func (c *CommentData) SetAccountData(accountID uuid.UUID, email, username string) {
+	c.AccountID = accountID
+	c.AccountEmail = email
+	c.AccountUsername = username
+}
+
+func (c *CommentData) ToComment() *Comment {
+	return &Comment{
+		CommentID:       uuid.New(),
+		WorkspaceID:     c.WorkspaceID,
+		RepositoryID:    c.RepositoryID,
+		VulnHash:        c.VulnHash,
+		AccountID:       c.AccountID,
+		AccountEmail:    c.AccountEmail,
+		AccountUsername: c.AccountUsername,
+		Content:         c.Content,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+}
+
+func (c *CommentData) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"content":    c.Content,
+		"updated_at": time.Now(),
+	}
+}
+
+// ToAuthorFilter returns a condition matching the comment only when it was written by the account of the request
+func (c *CommentData) ToAuthorFilter() map[string]interface{} {
+	filter := c.ToFilter()
+	filter["comment_id"] = c.CommentID
+	filter["account_id"] = c.AccountID
+
+	return filter
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	collaborationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/collaboration"
+)
+
+func newCommentData() *CommentData {
+	return &CommentData{
+		VulnerabilityKey: VulnerabilityKey{WorkspaceID: uuid.New(), RepositoryID: uuid.New(), VulnHash: "1234"},
+		CommentID:        uuid.New(),
+		Content:          "test",
+	}
+}
+
+func TestValidateCommentData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		assert.NoError(t, newCommentData().Validate())
+	})
+
+	t.Run("should return error when empty content", func(t *testing.T) {
+		data := newCommentData()
+		data.Content = ""
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when content is too long", func(t *testing.T) {
+		data := newCommentData()
+		data.Content = strings.Repeat("a", collaborationEnums.MaxCommentLength+1)
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestToComment(t *testing.T) {
+	t.Run("should success parse data to comment", func(t *testing.T) {
+		accountID := uuid.New()
+
+		data := newCommentData()
+		data.SetAccountData(accountID, "test@horusec.io", "test")
+
+		comment := data.ToComment()
+		assert.NotEqual(t, uuid.Nil, comment.CommentID)
+		assert.Equal(t, data.WorkspaceID, comment.WorkspaceID)
+		assert.Equal(t, data.RepositoryID, comment.RepositoryID)
+		assert.Equal(t, "1234", comment.VulnHash)
+		assert.Equal(t, accountID, comment.AccountID)
+		assert.Equal(t, "test@horusec.io", comment.AccountEmail)
+		assert.Equal(t, "test", comment.AccountUsername)
+		assert.Equal(t, "test", comment.Content)
+		assert.False(t, comment.CreatedAt.IsZero())
+	})
+}
+
+func TestToUpdateMapComment(t *testing.T) {
+	t.Run("should return content and update date", func(t *testing.T) {
+		updateMap := newCommentData().ToUpdateMap()
+
+		assert.Len(t, updateMap, 2)
+		assert.Equal(t, "test", updateMap["content"])
+		assert.NotNil(t, updateMap["updated_at"])
+	})
+}
+
+func TestToAuthorFilter(t *testing.T) {
+	t.Run("should return filter by vulnerability key, comment and account", func(t *testing.T) {
+		accountID := uuid.New()
+
+		data := newCommentData()
+		data.SetAccountData(accountID, "test@horusec.io", "test")
+
+		filter := data.ToAuthorFilter()
+		assert.Len(t, filter, 5)
+		assert.Equal(t, data.CommentID, filter["comment_id"])
+		assert.Equal(t, accountID, filter["account_id"])
+		assert.Equal(t, "1234", filter["vuln_hash"])
+	})
+}
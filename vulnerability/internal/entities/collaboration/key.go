@@ -0,0 +1,57 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	collaborationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/collaboration"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+)
+
+// VulnerabilityKey identifies a vulnerability by its hash and repository, so comments and assignments are kept
+// between the analyses of the repository
+type VulnerabilityKey struct {
+	WorkspaceID  uuid.UUID `json:"workspaceID"`
+	RepositoryID uuid.UUID `json:"repositoryID"`
+	VulnHash     string    `json:"vulnHash"`
+}
+
+func (v *VulnerabilityKey) SetDataFromRequest(r *http.Request) (err error) {
+	if v.WorkspaceID, err = uuid.Parse(chi.URLParam(r, managementEnums.WorkspaceID)); err != nil {
+		return managementEnums.ErrorInvalidWorkspaceID
+	}
+
+	if v.RepositoryID, err = uuid.Parse(chi.URLParam(r, managementEnums.RepositoryID)); err != nil {
+		return managementEnums.ErrorInvalidRepositoryID
+	}
+
+	if v.VulnHash = chi.URLParam(r, collaborationEnums.VulnHash); v.VulnHash == "" {
+		return collaborationEnums.ErrorInvalidVulnHash
+	}
+
+	return nil
+}
+
+func (v *VulnerabilityKey) ToFilter() map[string]interface{} {
+	return map[string]interface{}{
+		"workspace_id":  v.WorkspaceID,
+		"repository_id": v.RepositoryID,
+		"vuln_hash":     v.VulnHash,
+	}
+}
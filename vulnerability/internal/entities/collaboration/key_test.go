@@ -0,0 +1,86 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	collaborationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/collaboration"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+)
+
+func newRequestWithParams(workspaceID, repositoryID, vulnHash string) *http.Request {
+	r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("workspaceID", workspaceID)
+	ctx.URLParams.Add("repositoryID", repositoryID)
+	ctx.URLParams.Add("vulnHash", vulnHash)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func TestSetDataFromRequest(t *testing.T) {
+	t.Run("should success set key data from request", func(t *testing.T) {
+		workspaceID := uuid.New()
+		repositoryID := uuid.New()
+
+		key := &VulnerabilityKey{}
+		assert.NoError(t, key.SetDataFromRequest(newRequestWithParams(workspaceID.String(),
+			repositoryID.String(), "1234")))
+		assert.Equal(t, workspaceID, key.WorkspaceID)
+		assert.Equal(t, repositoryID, key.RepositoryID)
+		assert.Equal(t, "1234", key.VulnHash)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		key := &VulnerabilityKey{}
+
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID,
+			key.SetDataFromRequest(newRequestWithParams("test", uuid.NewString(), "1234")))
+	})
+
+	t.Run("should return error when invalid repository id", func(t *testing.T) {
+		key := &VulnerabilityKey{}
+
+		assert.Equal(t, managementEnums.ErrorInvalidRepositoryID,
+			key.SetDataFromRequest(newRequestWithParams(uuid.NewString(), "test", "1234")))
+	})
+
+	t.Run("should return error when empty vulnerability hash", func(t *testing.T) {
+		key := &VulnerabilityKey{}
+
+		assert.Equal(t, collaborationEnums.ErrorInvalidVulnHash,
+			key.SetDataFromRequest(newRequestWithParams(uuid.NewString(), uuid.NewString(), "")))
+	})
+}
+
+func TestToFilter(t *testing.T) {
+	t.Run("should return filter by workspace, repository and vulnerability hash", func(t *testing.T) {
+		key := &VulnerabilityKey{WorkspaceID: uuid.New(), RepositoryID: uuid.New(), VulnHash: "1234"}
+
+		filter := key.ToFilter()
+		assert.Len(t, filter, 3)
+		assert.Equal(t, key.WorkspaceID, filter["workspace_id"])
+		assert.Equal(t, key.RepositoryID, filter["repository_id"])
+		assert.Equal(t, "1234", filter["vuln_hash"])
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func newCSVWriter(output io.Writer) IWriter {
+	return &csvWriter{writer: csv.NewWriter(output)}
+}
+
+func (c *csvWriter) Begin() error {
+	return c.writer.Write([]string{"repositoryName", "repositoryID", "analysisID", "vulnerabilityID", "vulnHash",
+		"severity", "type", "confidence", "securityTool", "language", "file", "line", "column", "code", "details",
+		"commitAuthor", "commitEmail", "commitHash", "commitMessage", "commitDate"})
+}
+
+func (c *csvWriter) Write(vulnerability *Vulnerability) error {
+	return c.writer.Write([]string{vulnerability.RepositoryName, vulnerability.RepositoryID.String(),
+		vulnerability.AnalysisID.String(), vulnerability.VulnerabilityID.String(), vulnerability.VulnHash,
+		vulnerability.Severity.ToString(), vulnerability.Type.ToString(), vulnerability.Confidence.ToString(),
+		vulnerability.SecurityTool.ToString(), vulnerability.Language.ToString(), vulnerability.File,
+		vulnerability.Line, vulnerability.Column, vulnerability.Code, vulnerability.Details,
+		vulnerability.CommitAuthor, vulnerability.CommitEmail, vulnerability.CommitHash,
+		vulnerability.CommitMessage, vulnerability.CommitDate})
+}
+
+func (c *csvWriter) Flush() error {
+	c.writer.Flush()
+
+	return c.writer.Error()
+}
+
+func (c *csvWriter) End() error {
+	return c.Flush()
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+
+	exportEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/export"
+)
+
+func TestCSVWriter(t *testing.T) {
+	t.Run("Should write header and one row per vulnerability", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		vulnerability := newVulnerabilityMock(severities.High)
+
+		writer := NewWriter(exportEnums.CSV, output)
+		assert.NoError(t, writer.Begin())
+		assert.NoError(t, writer.Write(vulnerability))
+		assert.NoError(t, writer.End())
+
+		records, err := csv.NewReader(output).ReadAll()
+		assert.NoError(t, err)
+		assert.Len(t, records, 2)
+		assert.Equal(t, "repositoryName", records[0][0])
+		assert.Len(t, records[1], len(records[0]))
+		assert.Equal(t, "horusec", records[1][0])
+		assert.Equal(t, vulnerability.VulnerabilityID.String(), records[1][3])
+		assert.Equal(t, severities.High.ToString(), records[1][5])
+		assert.Equal(t, vulnerability.CommitMessage, records[1][18])
+	})
+
+	t.Run("Should write rows to output only when flushed", func(t *testing.T) {
+		output := &bytes.Buffer{}
+
+		writer := NewWriter(exportEnums.CSV, output)
+		assert.NoError(t, writer.Begin())
+		assert.NoError(t, writer.Write(newVulnerabilityMock(severities.High)))
+		assert.Empty(t, output.String())
+
+		assert.NoError(t, writer.Flush())
+		assert.NotEmpty(t, output.String())
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	exportEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/export"
+)
+
+type Filter struct {
+	managementEntities.Filter
+	Format exportEnums.Format `json:"format"`
+}
+
+func (f *Filter) SetFilterDataFromRequest(r *http.Request) error {
+	f.Format = exportEnums.Format(r.URL.Query().Get(exportEnums.FormatQuery))
+
+	return f.SetUnpaginatedFilterDataFromRequest(r)
+}
+
+func (f *Filter) Validate() error {
+	if err := validation.ValidateStruct(f,
+		validation.Field(&f.Format, validation.Required, validation.In(exportEnums.Values()...)),
+	); err != nil {
+		return err
+	}
+
+	return f.ValidateUnpaginated()
+}
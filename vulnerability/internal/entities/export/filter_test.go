@@ -0,0 +1,78 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	exportEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/export"
+)
+
+func TestSetFilterDataFromRequest(t *testing.T) {
+	t.Run("Should parse format and management filters from request", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		r, _ := http.NewRequest(http.MethodGet, "/test?format=sarif&vulnSeverity=HIGH", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", workspaceID.String())
+
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter := &Filter{}
+		assert.NoError(t, filter.SetFilterDataFromRequest(r))
+		assert.Equal(t, exportEnums.SARIF, filter.Format)
+		assert.Equal(t, workspaceID, filter.WorkspaceID)
+		assert.Equal(t, "HIGH", filter.VulnSeverity)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("Should return no error when valid filter", func(t *testing.T) {
+		filter := &Filter{Format: exportEnums.CSV, Filter: managementEntities.Filter{WorkspaceID: uuid.New()}}
+
+		assert.NoError(t, filter.Validate())
+	})
+
+	t.Run("Should return error when format is empty", func(t *testing.T) {
+		filter := &Filter{Filter: managementEntities.Filter{WorkspaceID: uuid.New()}}
+
+		err := filter.Validate()
+		assert.Error(t, err)
+		assert.Equal(t, "format: cannot be blank.", err.Error())
+	})
+
+	t.Run("Should return error when format is invalid", func(t *testing.T) {
+		filter := &Filter{Format: "xml", Filter: managementEntities.Filter{WorkspaceID: uuid.New()}}
+
+		err := filter.Validate()
+		assert.Error(t, err)
+		assert.Equal(t, "format: must be a valid value.", err.Error())
+	})
+
+	t.Run("Should return error when invalid management filter", func(t *testing.T) {
+		filter := &Filter{Format: exportEnums.CSV,
+			Filter: managementEntities.Filter{WorkspaceID: uuid.New(), VulnSeverity: "test"}}
+
+		assert.Error(t, filter.Validate())
+	})
+}
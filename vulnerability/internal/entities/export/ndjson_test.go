@@ -0,0 +1,64 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+
+	exportEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/export"
+)
+
+func TestNDJSONWriter(t *testing.T) {
+	t.Run("Should write one json object per line", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		first := newVulnerabilityMock(severities.Critical)
+		second := newVulnerabilityMock(severities.Low)
+
+		writer := NewWriter(exportEnums.NDJSON, output)
+		assert.NoError(t, writer.Begin())
+		assert.NoError(t, writer.Write(first))
+		assert.NoError(t, writer.Write(second))
+		assert.NoError(t, writer.Flush())
+		assert.NoError(t, writer.End())
+
+		var lines []*Vulnerability
+		scanner := bufio.NewScanner(output)
+		for scanner.Scan() {
+			line := &Vulnerability{}
+			assert.NoError(t, json.Unmarshal(scanner.Bytes(), line))
+			lines = append(lines, line)
+		}
+
+		assert.Len(t, lines, 2)
+		assert.Equal(t, first, lines[0])
+		assert.Equal(t, second, lines[1])
+	})
+
+	t.Run("Should write nothing when there are no vulnerabilities", func(t *testing.T) {
+		output := &bytes.Buffer{}
+
+		writer := NewWriter(exportEnums.NDJSON, output)
+		assert.NoError(t, writer.Begin())
+		assert.NoError(t, writer.End())
+		assert.Empty(t, output.String())
+	})
+}
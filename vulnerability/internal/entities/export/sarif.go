@@ -0,0 +1,213 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+
+	exportEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/export"
+)
+
+type sarifReport struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []interface{} `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string `json:"name"`
+	InformationURI string `json:"informationUri"`
+}
+
+type sarifResult struct {
+	RuleID              string                 `json:"ruleId"`
+	Level               string                 `json:"level"`
+	Message             sarifMessage           `json:"message"`
+	Locations           []sarifLocation        `json:"locations"`
+	PartialFingerprints map[string]string      `json:"partialFingerprints"`
+	Properties          map[string]interface{} `json:"properties"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int           `json:"startLine"`
+	StartColumn int           `json:"startColumn,omitempty"`
+	Snippet     *sarifMessage `json:"snippet,omitempty"`
+}
+
+// sarifWriter streams a single run, results are written between the header and footer of the report
+type sarifWriter struct {
+	output        io.Writer
+	resultsLength int
+}
+
+func newSARIFWriter(output io.Writer) IWriter {
+	return &sarifWriter{output: output}
+}
+
+func (s *sarifWriter) Begin() error {
+	header, err := json.Marshal(s.newEmptyReport())
+	if err != nil {
+		return err
+	}
+
+	// removes the closing of the empty results array, run, runs and report: "]}]}"
+	_, err = s.output.Write(header[:len(header)-4])
+	return err
+}
+
+func (s *sarifWriter) newEmptyReport() *sarifReport {
+	return &sarifReport{
+		Version: exportEnums.SARIFVersion,
+		Schema:  exportEnums.SARIFSchema,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{Name: exportEnums.ToolName, InformationURI: exportEnums.ToolInformationURI},
+				},
+				Results: []interface{}{},
+			},
+		},
+	}
+}
+
+func (s *sarifWriter) Write(vulnerability *Vulnerability) error {
+	result, err := json.Marshal(s.newResult(vulnerability))
+	if err != nil {
+		return err
+	}
+
+	if s.resultsLength > 0 {
+		result = append([]byte(","), result...)
+	}
+
+	s.resultsLength++
+	_, err = s.output.Write(result)
+	return err
+}
+
+func (s *sarifWriter) newResult(vulnerability *Vulnerability) *sarifResult {
+	return &sarifResult{
+		RuleID:  vulnerability.SecurityTool.ToString(),
+		Level:   s.getLevel(vulnerability.Severity),
+		Message: sarifMessage{Text: vulnerability.Details},
+		Locations: []sarifLocation{
+			{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: vulnerability.File},
+					Region:           s.newRegion(vulnerability),
+				},
+			},
+		},
+		PartialFingerprints: map[string]string{exportEnums.VulnHashFingerprint: vulnerability.VulnHash},
+		Properties:          s.newProperties(vulnerability),
+	}
+}
+
+func (s *sarifWriter) getLevel(severity severities.Severity) string {
+	switch severity {
+	case severities.Critical, severities.High:
+		return "error"
+	case severities.Medium:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+func (s *sarifWriter) newRegion(vulnerability *Vulnerability) *sarifRegion {
+	line, err := strconv.Atoi(vulnerability.Line)
+	if err != nil || line < 1 {
+		return nil
+	}
+
+	column, _ := strconv.Atoi(vulnerability.Column)
+	if column < 1 {
+		column = 0
+	}
+
+	region := &sarifRegion{StartLine: line, StartColumn: column}
+	if vulnerability.Code != "" {
+		region.Snippet = &sarifMessage{Text: vulnerability.Code}
+	}
+
+	return region
+}
+
+func (s *sarifWriter) newProperties(vulnerability *Vulnerability) map[string]interface{} {
+	return map[string]interface{}{
+		"security-severity": s.getSecuritySeverity(vulnerability.Severity),
+		"severity":          vulnerability.Severity.ToString(),
+		"type":              vulnerability.Type.ToString(),
+		"confidence":        vulnerability.Confidence.ToString(),
+		"language":          vulnerability.Language.ToString(),
+		"vulnerabilityID":   vulnerability.VulnerabilityID.String(),
+		"analysisID":        vulnerability.AnalysisID.String(),
+		"repositoryID":      vulnerability.RepositoryID.String(),
+		"repositoryName":    vulnerability.RepositoryName,
+		"commitHash":        vulnerability.CommitHash,
+	}
+}
+
+// getSecuritySeverity returns a cvss score inside the band of the severity, as expected by code scanning tools
+func (s *sarifWriter) getSecuritySeverity(severity severities.Severity) string {
+	scores := map[severities.Severity]float64{
+		severities.Critical: 9.5,
+		severities.High:     8.0,
+		severities.Medium:   5.5,
+		severities.Low:      2.0,
+	}
+
+	return fmt.Sprintf("%.1f", scores[severity])
+}
+
+func (s *sarifWriter) Flush() error {
+	return nil
+}
+
+func (s *sarifWriter) End() error {
+	_, err := s.output.Write([]byte("]}]}\n"))
+	return err
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+
+	exportEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/export"
+)
+
+func TestSARIFWriter(t *testing.T) {
+	t.Run("Should write a valid sarif report with one result per vulnerability", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		critical := newVulnerabilityMock(severities.Critical)
+		medium := newVulnerabilityMock(severities.Medium)
+		medium.Line = ""
+
+		writer := NewWriter(exportEnums.SARIF, output)
+		assert.NoError(t, writer.Begin())
+		assert.NoError(t, writer.Write(critical))
+		assert.NoError(t, writer.Write(medium))
+		assert.NoError(t, writer.End())
+
+		report := &sarifReport{}
+		assert.NoError(t, json.Unmarshal(output.Bytes(), report))
+		assert.Equal(t, exportEnums.SARIFVersion, report.Version)
+		assert.Equal(t, exportEnums.SARIFSchema, report.Schema)
+		assert.Len(t, report.Runs, 1)
+		assert.Equal(t, exportEnums.ToolName, report.Runs[0].Tool.Driver.Name)
+		assert.Len(t, report.Runs[0].Results, 2)
+
+		first := report.Runs[0].Results[0].(map[string]interface{})
+		assert.Equal(t, "error", first["level"])
+		assert.Equal(t, critical.SecurityTool.ToString(), first["ruleId"])
+		assert.Equal(t, critical.VulnHash,
+			first["partialFingerprints"].(map[string]interface{})[exportEnums.VulnHashFingerprint])
+		assert.Equal(t, "9.5", first["properties"].(map[string]interface{})["security-severity"])
+
+		location := first["locations"].([]interface{})[0].(map[string]interface{})
+		region := location["physicalLocation"].(map[string]interface{})["region"].(map[string]interface{})
+		assert.Equal(t, float64(10), region["startLine"])
+		assert.Equal(t, float64(2), region["startColumn"])
+
+		second := report.Runs[0].Results[1].(map[string]interface{})
+		assert.Equal(t, "warning", second["level"])
+		assert.NotContains(t, second["locations"].([]interface{})[0].(map[string]interface{})["physicalLocation"], "region")
+	})
+
+	t.Run("Should write a valid sarif report without results", func(t *testing.T) {
+		output := &bytes.Buffer{}
+
+		writer := NewWriter(exportEnums.SARIF, output)
+		assert.NoError(t, writer.Begin())
+		assert.NoError(t, writer.Flush())
+		assert.NoError(t, writer.End())
+
+		report := &sarifReport{}
+		assert.NoError(t, json.Unmarshal(output.Bytes(), report))
+		assert.Len(t, report.Runs, 1)
+		assert.Empty(t, report.Runs[0].Results)
+	})
+
+	t.Run("Should map severities to sarif levels and scores", func(t *testing.T) {
+		writer := &sarifWriter{}
+
+		assert.Equal(t, "error", writer.getLevel(severities.High))
+		assert.Equal(t, "note", writer.getLevel(severities.Low))
+		assert.Equal(t, "note", writer.getLevel(severities.Info))
+		assert.Equal(t, "8.0", writer.getSecuritySeverity(severities.High))
+		assert.Equal(t, "0.0", writer.getSecuritySeverity(severities.Unknown))
+	})
+}
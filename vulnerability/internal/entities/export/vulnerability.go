@@ -0,0 +1,29 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"github.com/google/uuid"
+
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+)
+
+//nolint:lll // notations need more than 130 characters
+type Vulnerability struct {
+	vulnerabilityEntities.Vulnerability
+	AnalysisID     uuid.UUID `json:"analysisID" gorm:"Column:analysis_id" example:"00000000-0000-0000-0000-000000000000"`
+	RepositoryID   uuid.UUID `json:"repositoryID" gorm:"Column:repository_id" example:"00000000-0000-0000-0000-000000000000"`
+	RepositoryName string    `json:"repositoryName" gorm:"Column:repository_name" example:"horusec"`
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"io"
+
+	exportEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/export"
+)
+
+// IWriter renders vulnerabilities one by one, so the whole export never needs to be kept in memory
+type IWriter interface {
+	Begin() error
+	Write(vulnerability *Vulnerability) error
+	Flush() error
+	End() error
+}
+
+func NewWriter(format exportEnums.Format, output io.Writer) IWriter {
+	switch format {
+	case exportEnums.SARIF:
+		return newSARIFWriter(output)
+	case exportEnums.CSV:
+		return newCSVWriter(output)
+	default:
+		return newNDJSONWriter(output)
+	}
+}
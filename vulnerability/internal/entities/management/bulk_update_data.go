@@ -0,0 +1,125 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+)
+
+// BulkUpdateData is the change applied to all vulnerabilities matched by the filter, an empty severity or type
+// keeps the current value of each vulnerability
+type BulkUpdateData struct {
+	Severity        severities.Severity     `json:"severity" example:"INFO" enums:"CRITICAL, HIGH, MEDIUM, LOW, INFO"`
+	Type            vulnerabilityEnums.Type `json:"type" example:"False Positive" enums:"Vulnerability, Risk Accepted, False Positive, Corrected"` //nolint:lll // notations
+	Justification   string                  `json:"justification" example:"test files, not used in production"`
+	ExpiresAt       *time.Time              `json:"expiresAt,omitempty" example:"2021-12-30T00:00:00Z"`
+	Approver        string                  `json:"approver,omitempty" example:"security@horusec.io"`
+	Filter          *Filter                 `json:"-"`
+	DryRun          bool                    `json:"-"`
+	AccountID       uuid.UUID               `json:"-"`
+	AccountEmail    string                  `json:"-"`
+	AccountUsername string                  `json:"-"`
+
+	// PropagatedFromID references the triaged vulnerability of another repository when the change is a propagation
+	PropagatedFromID *uuid.UUID `json:"-"`
+}
+
+// Validate checks the change of the vulnerabilities, the filter is skipped since it's validated without pagination
+// when parsed from the request
+func (b *BulkUpdateData) Validate() error {
+	return validation.ValidateStruct(b,
+		validation.Field(&b.Severity, validation.In(severities.Critical, severities.Unknown,
+			severities.High, severities.Medium, severities.Low, severities.Info)),
+		validation.Field(&b.Type, validation.When(b.Severity == "", validation.Required),
+			validation.In(vulnerabilityEnums.Vulnerability, vulnerabilityEnums.RiskAccepted,
+				vulnerabilityEnums.FalsePositive, vulnerabilityEnums.Corrected)),
+		validation.Field(&b.Justification, validation.Required,
+			validation.Length(1, managementEnums.MaxJustificationLength)),
+		validation.Field(&b.ExpiresAt, validation.When(b.Type != vulnerabilityEnums.RiskAccepted, validation.Nil),
+			validation.Min(time.Now())),
+		validation.Field(&b.Approver, validation.When(b.Type != vulnerabilityEnums.RiskAccepted, validation.Empty),
+			is.EmailFormat),
+		validation.Field(&b.Filter, validation.NotNil, validation.Skip),
+	)
+}
+
+// SetFilterDataFromRequest sets the filter of the vulnerabilities to update and if the update is only a dry run,
+// that counts the vulnerabilities and repositories that would be updated without changing them
+func (b *BulkUpdateData) SetFilterDataFromRequest(r *http.Request) error {
+	b.Filter = &Filter{}
+	if err := b.Filter.SetUnpaginatedFilterDataFromRequest(r); err != nil {
+		return err
+	}
+
+	b.DryRun, _ = strconv.ParseBool(r.URL.Query().Get(managementEnums.DryRunQuery))
+
+	return b.Filter.ValidateUnpaginated()
+}
+
+// SetAccountData sets the account that is updating the vulnerabilities, used to keep the history of the changes
+func (b *BulkUpdateData) SetAccountData(accountID uuid.UUID, email, username string) {
+	b.AccountID = accountID
+	b.AccountEmail = email
+	b.AccountUsername = username
+}
+
+// ToVulnerabilityData returns the change of a single vulnerability, keeping its values not informed in the change
+func (b *BulkUpdateData) ToVulnerabilityData(
+	vulnerability *vulnerabilityEntities.Vulnerability) *VulnerabilityData {
+	data := &VulnerabilityData{
+		VulnerabilityID: vulnerability.VulnerabilityID,
+		Severity:        b.Severity,
+		Type:            b.Type,
+		Justification:   b.Justification,
+		ExpiresAt:       b.ExpiresAt,
+		Approver:        b.Approver,
+	}
+
+	data.PropagatedFromID = b.PropagatedFromID
+
+	if data.Severity == "" {
+		data.Severity = vulnerability.Severity
+	}
+
+	if data.Type == "" {
+		data.Type = vulnerability.Type
+	}
+
+	return data
+}
+
+// ToUpdateData returns the update of the vulnerabilities of an analysis, used to keep the same history, risk
+// acceptance and notifications of the updates sent vulnerability by vulnerability
+func (b *BulkUpdateData) ToUpdateData(analysisID uuid.UUID) *UpdateData {
+	return &UpdateData{
+		AnalysisID:      analysisID,
+		Vulnerabilities: []*VulnerabilityData{},
+		AccountID:       b.AccountID,
+		AccountEmail:    b.AccountEmail,
+		AccountUsername: b.AccountUsername,
+	}
+}
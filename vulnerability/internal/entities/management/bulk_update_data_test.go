@@ -0,0 +1,173 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+)
+
+func TestValidateBulkUpdateData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		data := &BulkUpdateData{Type: vulnerabilityEnums.FalsePositive, Justification: "test", Filter: &Filter{}}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return no error when only severity is changed", func(t *testing.T) {
+		data := &BulkUpdateData{Severity: severities.Low, Justification: "test", Filter: &Filter{}}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when neither type or severity are changed", func(t *testing.T) {
+		data := &BulkUpdateData{Justification: "test", Filter: &Filter{}}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when without justification", func(t *testing.T) {
+		data := &BulkUpdateData{Type: vulnerabilityEnums.FalsePositive, Filter: &Filter{}}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when expiry is sent without risk accepted type", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour)
+		data := &BulkUpdateData{Type: vulnerabilityEnums.FalsePositive, Justification: "test",
+			ExpiresAt: &expiresAt, Filter: &Filter{}}
+
+		assert.Error(t, data.Validate())
+
+		data.Type = vulnerabilityEnums.RiskAccepted
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when without filter", func(t *testing.T) {
+		data := &BulkUpdateData{Type: vulnerabilityEnums.FalsePositive, Justification: "test"}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestBulkUpdateDataSetFilterDataFromRequest(t *testing.T) {
+	t.Run("should set filter without pagination and dry run", func(t *testing.T) {
+		workspaceID := uuid.New()
+		r, _ := http.NewRequest(http.MethodPost, "/test?dryRun=true&vulnSeverity=INFO&filePattern=test/**", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", workspaceID.String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		data := &BulkUpdateData{}
+		assert.NoError(t, data.SetFilterDataFromRequest(r))
+		assert.True(t, data.DryRun)
+		assert.Equal(t, workspaceID, data.Filter.WorkspaceID)
+		assert.Equal(t, "INFO", data.Filter.VulnSeverity)
+		assert.Equal(t, "test/**", data.Filter.FilePattern)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, chi.NewRouteContext()))
+
+		data := &BulkUpdateData{}
+		assert.Error(t, data.SetFilterDataFromRequest(r))
+	})
+
+	t.Run("should return error when invalid filter", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test?vulnSeverity=test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		data := &BulkUpdateData{}
+		assert.Error(t, data.SetFilterDataFromRequest(r))
+	})
+}
+
+func TestBulkUpdateDataSetAccountData(t *testing.T) {
+	t.Run("should set account data and not expose it on json", func(t *testing.T) {
+		data := &BulkUpdateData{}
+		accountID := uuid.New()
+
+		data.SetAccountData(accountID, "test@horusec.io", "test")
+		assert.Equal(t, accountID, data.AccountID)
+		assert.Equal(t, "test@horusec.io", data.AccountEmail)
+		assert.Equal(t, "test", data.AccountUsername)
+
+		bytes, err := json.Marshal(data)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(bytes), "test@horusec.io")
+	})
+}
+
+func TestToVulnerabilityData(t *testing.T) {
+	vulnerability := &vulnerabilityEntities.Vulnerability{
+		VulnerabilityID: uuid.New(),
+		Severity:        severities.High,
+		Type:            vulnerabilityEnums.Vulnerability,
+	}
+
+	t.Run("should return the change of the vulnerability", func(t *testing.T) {
+		data := &BulkUpdateData{Severity: severities.Info, Type: vulnerabilityEnums.FalsePositive,
+			Justification: "test"}
+
+		result := data.ToVulnerabilityData(vulnerability)
+		assert.Equal(t, vulnerability.VulnerabilityID, result.VulnerabilityID)
+		assert.Equal(t, severities.Info, result.Severity)
+		assert.Equal(t, vulnerabilityEnums.FalsePositive, result.Type)
+		assert.Equal(t, "test", result.Justification)
+	})
+
+	t.Run("should keep the values of the vulnerability not changed", func(t *testing.T) {
+		result := (&BulkUpdateData{Justification: "test"}).ToVulnerabilityData(vulnerability)
+		assert.Equal(t, severities.High, result.Severity)
+		assert.Equal(t, vulnerabilityEnums.Vulnerability, result.Type)
+	})
+
+	t.Run("should keep the vulnerability of the propagated triage", func(t *testing.T) {
+		propagatedFromID := uuid.New()
+		data := &BulkUpdateData{Type: vulnerabilityEnums.FalsePositive, PropagatedFromID: &propagatedFromID}
+
+		assert.Equal(t, &propagatedFromID, data.ToVulnerabilityData(vulnerability).PropagatedFromID)
+	})
+}
+
+func TestToUpdateData(t *testing.T) {
+	t.Run("should return an empty update of the analysis with the account data", func(t *testing.T) {
+		analysisID := uuid.New()
+		data := &BulkUpdateData{AccountID: uuid.New(), AccountEmail: "test@horusec.io", AccountUsername: "test"}
+
+		result := data.ToUpdateData(analysisID)
+		assert.Equal(t, analysisID, result.AnalysisID)
+		assert.Empty(t, result.Vulnerabilities)
+		assert.Equal(t, data.AccountID, result.AccountID)
+		assert.Equal(t, data.AccountEmail, result.AccountEmail)
+		assert.Equal(t, data.AccountUsername, result.AccountUsername)
+	})
+}
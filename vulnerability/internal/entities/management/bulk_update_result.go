@@ -0,0 +1,35 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"github.com/google/uuid"
+
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+)
+
+type BulkUpdateResult struct {
+	DryRun               bool `json:"dryRun"`
+	TotalVulnerabilities int  `json:"totalVulnerabilities" gorm:"Column:total_vulnerabilities"`
+	TotalRepositories    int  `json:"totalRepositories" gorm:"Column:total_repositories"`
+}
+
+// BulkVulnerability is a vulnerability matched by the filter of a bulk update with the latest analysis of its
+// repository, used to publish a single analysis update by repository
+type BulkVulnerability struct {
+	vulnerabilityEntities.Vulnerability
+	AnalysisID   uuid.UUID `json:"analysisID" gorm:"Column:analysis_id"`
+	RepositoryID uuid.UUID `json:"repositoryID" gorm:"Column:repository_id"`
+}
@@ -0,0 +1,68 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+)
+
+// Cursor is the position of the last item of a page, the clients receive it as an opaque string and send it back
+// to get the next page. The sorting is kept to refuse cursors of listings with a different order
+type Cursor struct {
+	SortBy    string    `json:"sortBy"`
+	SortOrder string    `json:"sortOrder"`
+	Value     string    `json:"value"`
+	File      string    `json:"file,omitempty"`
+	ID        uuid.UUID `json:"id"`
+}
+
+func NewCursor(filter *Filter, value, file string, id uuid.UUID) *Cursor {
+	return &Cursor{
+		SortBy:    filter.SortBy,
+		SortOrder: filter.SortOrder,
+		Value:     value,
+		File:      file,
+		ID:        id,
+	}
+}
+
+func (c *Cursor) Encode() string {
+	bytes, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(bytes)
+}
+
+func (c *Cursor) Decode(value string) error {
+	bytes, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return managementEnums.ErrorInvalidCursor
+	}
+
+	if err := json.Unmarshal(bytes, c); err != nil {
+		return managementEnums.ErrorInvalidCursor
+	}
+
+	return nil
+}
+
+// IsSameSorting checks if the cursor was created by a listing with the sorting of the filter
+func (c *Cursor) IsSameSorting(filter *Filter) bool {
+	return c.SortBy == filter.SortBy && c.SortOrder == filter.SortOrder
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+)
+
+func TestNewCursor(t *testing.T) {
+	t.Run("should create a cursor with the sorting of the filter", func(t *testing.T) {
+		id := uuid.New()
+		filter := &Filter{SortBy: managementEnums.SortByFile, SortOrder: managementEnums.SortOrderDesc}
+
+		cursor := NewCursor(filter, "value", "main.go", id)
+		assert.Equal(t, managementEnums.SortByFile, cursor.SortBy)
+		assert.Equal(t, managementEnums.SortOrderDesc, cursor.SortOrder)
+		assert.Equal(t, "value", cursor.Value)
+		assert.Equal(t, "main.go", cursor.File)
+		assert.Equal(t, id, cursor.ID)
+	})
+}
+
+func TestEncodeAndDecodeCursor(t *testing.T) {
+	t.Run("should decode a cursor previously encoded", func(t *testing.T) {
+		cursor := NewCursor(&Filter{SortBy: managementEnums.SortByAuthor}, "horusec", "", uuid.New())
+
+		decoded := &Cursor{}
+		assert.NoError(t, decoded.Decode(cursor.Encode()))
+		assert.Equal(t, cursor, decoded)
+	})
+
+	t.Run("should return error when cursor is not base64", func(t *testing.T) {
+		cursor := &Cursor{}
+
+		assert.Equal(t, managementEnums.ErrorInvalidCursor, cursor.Decode("!@#$"))
+	})
+
+	t.Run("should return error when cursor is not a valid json", func(t *testing.T) {
+		cursor := &Cursor{}
+
+		assert.Equal(t, managementEnums.ErrorInvalidCursor, cursor.Decode("dGVzdA"))
+	})
+}
+
+func TestIsSameSorting(t *testing.T) {
+	t.Run("should return true when sorting is the same of the filter", func(t *testing.T) {
+		cursor := &Cursor{SortBy: managementEnums.SortByFile, SortOrder: managementEnums.SortOrderAsc}
+
+		assert.True(t, cursor.IsSameSorting(&Filter{SortBy: managementEnums.SortByFile,
+			SortOrder: managementEnums.SortOrderAsc}))
+	})
+
+	t.Run("should return false when sorting is different of the filter", func(t *testing.T) {
+		cursor := &Cursor{SortBy: managementEnums.SortByFile, SortOrder: managementEnums.SortOrderAsc}
+
+		assert.False(t, cursor.IsSameSorting(&Filter{SortBy: managementEnums.SortByFile}))
+		assert.False(t, cursor.IsSameSorting(&Filter{SortBy: managementEnums.SortByAuthor,
+			SortOrder: managementEnums.SortOrderAsc}))
+	})
+}
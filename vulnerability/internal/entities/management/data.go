@@ -15,17 +15,28 @@
 package management
 
 import (
+	"time"
+
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"github.com/google/uuid"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
 	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
 )
 
 type VulnerabilityData struct {
 	VulnerabilityID uuid.UUID               `json:"vulnerabilityID"`
 	Severity        severities.Severity     `json:"severity" example:"CRITICAL" enums:"CRITICAL, HIGH, MEDIUM, LOW, INFO"`
 	Type            vulnerabilityEnums.Type `json:"type" example:"Vulnerability" enums:"Vulnerability, Risk Accepted, False Positive, Corrected"` //nolint:lll // notations
+	Justification   string                  `json:"justification" example:"test file, not used in production"`
+	ExpiresAt       *time.Time              `json:"expiresAt,omitempty" example:"2021-12-30T00:00:00Z"`
+	Approver        string                  `json:"approver,omitempty" example:"security@horusec.io"`
+
+	// PropagatedFromID references the triaged vulnerability of another repository when the change is a propagation
+	PropagatedFromID *uuid.UUID `json:"-"`
 }
 
 func (v *VulnerabilityData) Validate() error {
@@ -34,7 +45,27 @@ func (v *VulnerabilityData) Validate() error {
 		validation.Field(&v.Severity, validation.In(severities.Critical, severities.Unknown,
 			severities.High, severities.Medium, severities.Low, severities.Info)),
 		validation.Field(&v.Type, validation.In(vulnerabilityEnums.Vulnerability, vulnerabilityEnums.RiskAccepted,
-			vulnerabilityEnums.FalsePositive, vulnerabilityEnums.Corrected)))
+			vulnerabilityEnums.FalsePositive, vulnerabilityEnums.Corrected)),
+		validation.Field(&v.Justification, validation.Required,
+			validation.Length(1, managementEnums.MaxJustificationLength)),
+		validation.Field(&v.ExpiresAt, validation.When(!v.IsRiskAccepted(), validation.Nil),
+			validation.Min(time.Now())),
+		validation.Field(&v.Approver, validation.When(!v.IsRiskAccepted(), validation.Empty), is.EmailFormat))
+}
+
+func (v *VulnerabilityData) IsRiskAccepted() bool {
+	return v.Type == vulnerabilityEnums.RiskAccepted
+}
+
+// IsTriaged checks if the vulnerability was classified by the update, which is the decision carried to the same
+// vulnerability of the other repositories when the workspace propagates its triage
+func (v *VulnerabilityData) IsTriaged() bool {
+	return v.Type != "" && v.Type != vulnerabilityEnums.Vulnerability
+}
+
+// IsTimeBoxedRiskAcceptance checks if the risk acceptance should expire, reverting the vulnerability to be reviewed
+func (v *VulnerabilityData) IsTimeBoxedRiskAcceptance() bool {
+	return v.IsRiskAccepted() && v.ExpiresAt != nil
 }
 
 func (v *VulnerabilityData) SetVulnerabilityID(vulnerabilityID uuid.UUID) {
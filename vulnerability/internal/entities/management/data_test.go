@@ -15,7 +15,9 @@
 package management
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -30,10 +32,72 @@ func TestValidateData(t *testing.T) {
 			VulnerabilityID: uuid.New(),
 			Severity:        severities.Critical,
 			Type:            vulnerabilityEnums.Vulnerability,
+			Justification:   "test",
 		}
 
 		assert.NoError(t, data.Validate())
 	})
+
+	t.Run("should return error when justification is empty or too long", func(t *testing.T) {
+		data := &VulnerabilityData{
+			VulnerabilityID: uuid.New(),
+			Severity:        severities.Critical,
+			Type:            vulnerabilityEnums.FalsePositive,
+		}
+
+		assert.Error(t, data.Validate())
+
+		data.Justification = strings.Repeat("a", 501)
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestValidateRiskAcceptanceData(t *testing.T) {
+	newData := func(vulnType vulnerabilityEnums.Type, expiresAt time.Time, approver string) *VulnerabilityData {
+		return &VulnerabilityData{
+			VulnerabilityID: uuid.New(),
+			Severity:        severities.High,
+			Type:            vulnType,
+			Justification:   "test",
+			ExpiresAt:       &expiresAt,
+			Approver:        approver,
+		}
+	}
+
+	t.Run("should return no error when risk accepted with expiry and approver", func(t *testing.T) {
+		data := newData(vulnerabilityEnums.RiskAccepted, time.Now().AddDate(0, 1, 0), "test@horusec.io")
+
+		assert.NoError(t, data.Validate())
+		assert.True(t, data.IsTimeBoxedRiskAcceptance())
+	})
+
+	t.Run("should return no error when risk accepted without expiry", func(t *testing.T) {
+		data := newData(vulnerabilityEnums.RiskAccepted, time.Now(), "")
+		data.ExpiresAt = nil
+
+		assert.NoError(t, data.Validate())
+		assert.False(t, data.IsTimeBoxedRiskAcceptance())
+	})
+
+	t.Run("should return error when expiry is in the past", func(t *testing.T) {
+		data := newData(vulnerabilityEnums.RiskAccepted, time.Now().AddDate(0, 0, -1), "")
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when approver is not an email", func(t *testing.T) {
+		data := newData(vulnerabilityEnums.RiskAccepted, time.Now().AddDate(0, 1, 0), "test")
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when expiry or approver is sent without risk accepted", func(t *testing.T) {
+		assert.Error(t, newData(vulnerabilityEnums.FalsePositive, time.Now().AddDate(0, 1, 0), "").Validate())
+
+		data := newData(vulnerabilityEnums.FalsePositive, time.Now(), "test@horusec.io")
+		data.ExpiresAt = nil
+		assert.Error(t, data.Validate())
+	})
 }
 
 func TestSetVulnerabilityID(t *testing.T) {
@@ -46,3 +110,16 @@ func TestSetVulnerabilityID(t *testing.T) {
 		assert.Equal(t, id, data.VulnerabilityID)
 	})
 }
+
+func TestIsTriaged(t *testing.T) {
+	t.Run("should be triaged when classified", func(t *testing.T) {
+		assert.True(t, (&VulnerabilityData{Type: vulnerabilityEnums.FalsePositive}).IsTriaged())
+		assert.True(t, (&VulnerabilityData{Type: vulnerabilityEnums.RiskAccepted}).IsTriaged())
+		assert.True(t, (&VulnerabilityData{Type: vulnerabilityEnums.Corrected}).IsTriaged())
+	})
+
+	t.Run("should not be triaged when unclassified or without type", func(t *testing.T) {
+		assert.False(t, (&VulnerabilityData{Type: vulnerabilityEnums.Vulnerability}).IsTriaged())
+		assert.False(t, (&VulnerabilityData{}).IsTriaged())
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+)
+
+type DiffGroup struct {
+	Total           int                                   `json:"total" example:"3"`
+	Severities      map[string]int                        `json:"severities"`
+	Vulnerabilities []vulnerabilityEntities.Vulnerability `json:"vulnerabilities"`
+}
+
+type Diff struct {
+	BaseAnalysisID *uuid.UUID `json:"baseAnalysisID" example:"00000000-0000-0000-0000-000000000000"`
+	HeadAnalysisID uuid.UUID  `json:"headAnalysisID" example:"00000000-0000-0000-0000-000000000000"`
+	New            *DiffGroup `json:"new"`
+	Fixed          *DiffGroup `json:"fixed"`
+	Unchanged      *DiffGroup `json:"unchanged"`
+}
+
+// NewDiff compares the vulnerabilities of both analyses by vulnerability hash. New ones are only found on the head,
+// fixed ones only on the base and unchanged ones on both. The base can be nil when there is nothing to compare with
+func NewDiff(base, head *analysisEntities.Analysis) *Diff {
+	diff := &Diff{HeadAnalysisID: head.ID, New: newDiffGroup(), Fixed: newDiffGroup(), Unchanged: newDiffGroup()}
+	baseVulnerabilities := map[string]vulnerabilityEntities.Vulnerability{}
+	if base != nil {
+		diff.BaseAnalysisID = &base.ID
+		baseVulnerabilities = mapVulnerabilitiesByHash(base)
+	}
+
+	headVulnerabilities := mapVulnerabilitiesByHash(head)
+	for hash := range headVulnerabilities {
+		if _, ok := baseVulnerabilities[hash]; ok {
+			diff.Unchanged.add(headVulnerabilities[hash])
+			continue
+		}
+
+		diff.New.add(headVulnerabilities[hash])
+	}
+
+	for hash := range baseVulnerabilities {
+		if _, ok := headVulnerabilities[hash]; !ok {
+			diff.Fixed.add(baseVulnerabilities[hash])
+		}
+	}
+
+	return diff.sort()
+}
+
+func newDiffGroup() *DiffGroup {
+	group := &DiffGroup{Severities: map[string]int{}, Vulnerabilities: []vulnerabilityEntities.Vulnerability{}}
+	for _, severity := range severities.Values() {
+		group.Severities[severity.ToString()] = 0
+	}
+
+	return group
+}
+
+func mapVulnerabilitiesByHash(
+	analysis *analysisEntities.Analysis) map[string]vulnerabilityEntities.Vulnerability {
+	vulnerabilities := map[string]vulnerabilityEntities.Vulnerability{}
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := analysis.AnalysisVulnerabilities[index].Vulnerability
+		if _, ok := vulnerabilities[vulnerability.VulnHash]; !ok {
+			vulnerabilities[vulnerability.VulnHash] = vulnerability
+		}
+	}
+
+	return vulnerabilities
+}
+
+func (g *DiffGroup) add(vulnerability vulnerabilityEntities.Vulnerability) {
+	g.Total++
+	g.Severities[vulnerability.Severity.ToString()]++
+	g.Vulnerabilities = append(g.Vulnerabilities, vulnerability)
+}
+
+func (d *Diff) sort() *Diff {
+	for _, group := range []*DiffGroup{d.New, d.Fixed, d.Unchanged} {
+		group.sort()
+	}
+
+	return d
+}
+
+// sort orders the vulnerabilities from the most to the least severe, then by file and hash
+func (g *DiffGroup) sort() {
+	ranks := map[severities.Severity]int{}
+	for rank, severity := range severities.Values() {
+		ranks[severity] = rank
+	}
+
+	sort.Slice(g.Vulnerabilities, func(i, j int) bool {
+		first, second := g.Vulnerabilities[i], g.Vulnerabilities[j]
+		if ranks[first.Severity] != ranks[second.Severity] {
+			return ranks[first.Severity] < ranks[second.Severity]
+		}
+
+		if first.File != second.File {
+			return first.File < second.File
+		}
+
+		return first.VulnHash < second.VulnHash
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+)
+
+type DiffFilter struct {
+	WorkspaceID    uuid.UUID `json:"workspaceID"`
+	RepositoryID   uuid.UUID `json:"repositoryID"`
+	AnalysisID     uuid.UUID `json:"analysisID"`
+	BaseAnalysisID uuid.UUID `json:"baseAnalysisID"`
+}
+
+func (d *DiffFilter) SetDataFromRequest(r *http.Request) (err error) {
+	if d.WorkspaceID, err = uuid.Parse(chi.URLParam(r, managementEnums.WorkspaceID)); err != nil {
+		return managementEnums.ErrorInvalidWorkspaceID
+	}
+
+	if d.RepositoryID, err = uuid.Parse(chi.URLParam(r, managementEnums.RepositoryID)); err != nil {
+		return managementEnums.ErrorInvalidRepositoryID
+	}
+
+	if d.AnalysisID, err = uuid.Parse(chi.URLParam(r, managementEnums.AnalysisID)); err != nil {
+		return managementEnums.ErrorInvalidAnalysisID
+	}
+
+	return d.setBaseAnalysisIDFromRequest(r)
+}
+
+// setBaseAnalysisIDFromRequest keeps the base analysis id empty when not informed or when the latest is requested
+func (d *DiffFilter) setBaseAnalysisIDFromRequest(r *http.Request) (err error) {
+	baseAnalysisID := r.URL.Query().Get(managementEnums.BaseAnalysisIDQuery)
+	if baseAnalysisID == "" || baseAnalysisID == managementEnums.LatestAnalysis {
+		return nil
+	}
+
+	if d.BaseAnalysisID, err = uuid.Parse(baseAnalysisID); err != nil {
+		return managementEnums.ErrorInvalidBaseAnalysisID
+	}
+
+	return nil
+}
+
+func (d *DiffFilter) IsLatestBase() bool {
+	return d.BaseAnalysisID == uuid.Nil
+}
+
+// IsFromRepository checks if the analysis found belongs to the workspace and repository of the request
+func (d *DiffFilter) IsFromRepository(analysis *analysisEntities.Analysis) bool {
+	return analysis.WorkspaceID == d.WorkspaceID && analysis.RepositoryID == d.RepositoryID
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+)
+
+func newDiffRequest(workspaceID, repositoryID, analysisID, baseAnalysisID string) *http.Request {
+	r, _ := http.NewRequest(http.MethodGet, "/test?baseAnalysisID="+baseAnalysisID, nil)
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("workspaceID", workspaceID)
+	ctx.URLParams.Add("repositoryID", repositoryID)
+	ctx.URLParams.Add("analysisID", analysisID)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func TestDiffFilterSetDataFromRequest(t *testing.T) {
+	t.Run("should parse request with base analysis id", func(t *testing.T) {
+		workspaceID, repositoryID, analysisID, baseAnalysisID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+		filter := &DiffFilter{}
+		assert.NoError(t, filter.SetDataFromRequest(newDiffRequest(workspaceID.String(), repositoryID.String(),
+			analysisID.String(), baseAnalysisID.String())))
+
+		assert.Equal(t, workspaceID, filter.WorkspaceID)
+		assert.Equal(t, repositoryID, filter.RepositoryID)
+		assert.Equal(t, analysisID, filter.AnalysisID)
+		assert.Equal(t, baseAnalysisID, filter.BaseAnalysisID)
+		assert.False(t, filter.IsLatestBase())
+	})
+
+	t.Run("should use latest analysis when base is empty or latest", func(t *testing.T) {
+		for _, baseAnalysisID := range []string{"", managementEnums.LatestAnalysis} {
+			filter := &DiffFilter{}
+			assert.NoError(t, filter.SetDataFromRequest(newDiffRequest(uuid.NewString(), uuid.NewString(),
+				uuid.NewString(), baseAnalysisID)))
+
+			assert.True(t, filter.IsLatestBase())
+		}
+	})
+
+	t.Run("should return error when invalid ids", func(t *testing.T) {
+		filter := &DiffFilter{}
+
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID, filter.SetDataFromRequest(
+			newDiffRequest("test", uuid.NewString(), uuid.NewString(), "")))
+		assert.Equal(t, managementEnums.ErrorInvalidRepositoryID, filter.SetDataFromRequest(
+			newDiffRequest(uuid.NewString(), "test", uuid.NewString(), "")))
+		assert.Equal(t, managementEnums.ErrorInvalidAnalysisID, filter.SetDataFromRequest(
+			newDiffRequest(uuid.NewString(), uuid.NewString(), "test", "")))
+		assert.Equal(t, managementEnums.ErrorInvalidBaseAnalysisID, filter.SetDataFromRequest(
+			newDiffRequest(uuid.NewString(), uuid.NewString(), uuid.NewString(), "test")))
+	})
+}
+
+func TestDiffFilterIsFromRepository(t *testing.T) {
+	t.Run("should check workspace and repository of the analysis", func(t *testing.T) {
+		filter := &DiffFilter{WorkspaceID: uuid.New(), RepositoryID: uuid.New()}
+
+		assert.True(t, filter.IsFromRepository(&analysisEntities.Analysis{
+			WorkspaceID: filter.WorkspaceID, RepositoryID: filter.RepositoryID}))
+		assert.False(t, filter.IsFromRepository(&analysisEntities.Analysis{
+			WorkspaceID: filter.WorkspaceID, RepositoryID: uuid.New()}))
+		assert.False(t, filter.IsFromRepository(&analysisEntities.Analysis{
+			WorkspaceID: uuid.New(), RepositoryID: filter.RepositoryID}))
+	})
+}
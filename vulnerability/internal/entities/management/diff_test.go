@@ -0,0 +1,105 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+)
+
+func newAnalysisWithVulnerabilities(vulnerabilities ...vulnerabilityEntities.Vulnerability) *analysisEntities.Analysis {
+	analysis := &analysisEntities.Analysis{ID: uuid.New()}
+	for index := range vulnerabilities {
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities,
+			analysisEntities.AnalysisVulnerabilities{Vulnerability: vulnerabilities[index]})
+	}
+
+	return analysis
+}
+
+func newDiffVulnerability(hash string, severity severities.Severity) vulnerabilityEntities.Vulnerability {
+	return vulnerabilityEntities.Vulnerability{VulnerabilityID: uuid.New(), VulnHash: hash, Severity: severity}
+}
+
+func TestNewDiff(t *testing.T) {
+	t.Run("should split vulnerabilities in new, fixed and unchanged by hash", func(t *testing.T) {
+		base := newAnalysisWithVulnerabilities(
+			newDiffVulnerability("fixed", severities.Medium),
+			newDiffVulnerability("unchanged", severities.Low),
+		)
+		head := newAnalysisWithVulnerabilities(
+			newDiffVulnerability("unchanged", severities.Low),
+			newDiffVulnerability("new-low", severities.Low),
+			newDiffVulnerability("new-high", severities.High),
+			newDiffVulnerability("new-high", severities.High),
+		)
+
+		diff := NewDiff(base, head)
+
+		assert.Equal(t, base.ID, *diff.BaseAnalysisID)
+		assert.Equal(t, head.ID, diff.HeadAnalysisID)
+
+		assert.Equal(t, 2, diff.New.Total)
+		assert.Equal(t, 1, diff.New.Severities[severities.High.ToString()])
+		assert.Equal(t, 1, diff.New.Severities[severities.Low.ToString()])
+		assert.Equal(t, 0, diff.New.Severities[severities.Critical.ToString()])
+		assert.Equal(t, "new-high", diff.New.Vulnerabilities[0].VulnHash)
+		assert.Equal(t, "new-low", diff.New.Vulnerabilities[1].VulnHash)
+
+		assert.Equal(t, 1, diff.Fixed.Total)
+		assert.Equal(t, 1, diff.Fixed.Severities[severities.Medium.ToString()])
+		assert.Equal(t, "fixed", diff.Fixed.Vulnerabilities[0].VulnHash)
+
+		assert.Equal(t, 1, diff.Unchanged.Total)
+		assert.Equal(t, "unchanged", diff.Unchanged.Vulnerabilities[0].VulnHash)
+	})
+
+	t.Run("should return all vulnerabilities as new when there is no base analysis", func(t *testing.T) {
+		head := newAnalysisWithVulnerabilities(newDiffVulnerability("new", severities.Critical))
+
+		diff := NewDiff(nil, head)
+
+		assert.Nil(t, diff.BaseAnalysisID)
+		assert.Equal(t, 1, diff.New.Total)
+		assert.Equal(t, 0, diff.Fixed.Total)
+		assert.Equal(t, 0, diff.Unchanged.Total)
+		assert.NotNil(t, diff.Fixed.Vulnerabilities)
+	})
+
+	t.Run("should sort vulnerabilities by severity, file and hash", func(t *testing.T) {
+		second := newDiffVulnerability("b", severities.Info)
+		second.File = "a.go"
+		third := newDiffVulnerability("a", severities.Info)
+		third.File = "b.go"
+		fourth := newDiffVulnerability("c", severities.Info)
+		fourth.File = "b.go"
+
+		diff := NewDiff(nil, newAnalysisWithVulnerabilities(fourth, third, second,
+			newDiffVulnerability("d", severities.Critical)))
+
+		var hashes []string
+		for _, vulnerability := range diff.New.Vulnerabilities {
+			hashes = append(hashes, vulnerability.VulnHash)
+		}
+
+		assert.Equal(t, []string{"d", "b", "a", "c"}, hashes)
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+)
+
+// Event is a record of the vulnerability history, containing who changed the vulnerability, what changed and why
+type Event struct {
+	EventID         uuid.UUID               `json:"eventID" gorm:"Column:event_id"`
+	VulnerabilityID uuid.UUID               `json:"vulnerabilityID" gorm:"Column:vulnerability_id"`
+	AnalysisID      uuid.UUID               `json:"analysisID" gorm:"Column:analysis_id"`
+	AccountID       uuid.UUID               `json:"accountID" gorm:"Column:account_id"`
+	AccountEmail    string                  `json:"accountEmail" gorm:"Column:account_email"`
+	AccountUsername string                  `json:"accountUsername" gorm:"Column:account_username"`
+	OldType         vulnerabilityEnums.Type `json:"oldType" gorm:"Column:old_type" example:"Vulnerability"`
+	NewType         vulnerabilityEnums.Type `json:"newType" gorm:"Column:new_type" example:"False Positive"`
+	OldSeverity     severities.Severity     `json:"oldSeverity" gorm:"Column:old_severity" example:"CRITICAL"`
+	NewSeverity     severities.Severity     `json:"newSeverity" gorm:"Column:new_severity" example:"CRITICAL"`
+	Justification   string                  `json:"justification" gorm:"Column:justification"`
+	CreatedAt       time.Time               `json:"createdAt" gorm:"Column:created_at"`
+
+	// SuppressionRuleID references the rule that classified the vulnerability when it was found by a new analysis
+	SuppressionRuleID *uuid.UUID `json:"suppressionRuleID,omitempty" gorm:"Column:suppression_rule_id"`
+
+	// PropagatedFromID references the vulnerability of another repository whose triage was carried to this one
+	PropagatedFromID *uuid.UUID `json:"propagatedFromID,omitempty" gorm:"Column:propagated_from_id"`
+}
+
+// NewEvent creates the history record of an update, it should be called before the new values are set
+func NewEvent(vulnerability *vulnerabilityEntities.Vulnerability, data *VulnerabilityData,
+	updateData *UpdateData) *Event {
+	return &Event{
+		EventID:          uuid.New(),
+		VulnerabilityID:  vulnerability.VulnerabilityID,
+		AnalysisID:       updateData.AnalysisID,
+		AccountID:        updateData.AccountID,
+		AccountEmail:     updateData.AccountEmail,
+		AccountUsername:  updateData.AccountUsername,
+		OldType:          vulnerability.Type,
+		OldSeverity:      vulnerability.Severity,
+		Justification:    data.Justification,
+		CreatedAt:        time.Now(),
+		PropagatedFromID: data.PropagatedFromID,
+	}
+}
+
+// SetNewValues sets the values of the vulnerability after the update
+func (e *Event) SetNewValues(vulnerability *vulnerabilityEntities.Vulnerability) {
+	e.NewType = vulnerability.Type
+	e.NewSeverity = vulnerability.Severity
+}
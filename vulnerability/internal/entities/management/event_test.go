@@ -0,0 +1,76 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+)
+
+func TestNewEvent(t *testing.T) {
+	t.Run("should create event with the old values, the account and the justification", func(t *testing.T) {
+		vulnerability := &vulnerabilityEntities.Vulnerability{
+			VulnerabilityID: uuid.New(),
+			Severity:        severities.Critical,
+			Type:            vulnerabilityEnums.Vulnerability,
+		}
+		data := &VulnerabilityData{Justification: "test"}
+		updateData := &UpdateData{AnalysisID: uuid.New()}
+		updateData.SetAccountData(uuid.New(), "test@horusec.io", "test")
+
+		event := NewEvent(vulnerability, data, updateData)
+		assert.NotEqual(t, uuid.Nil, event.EventID)
+		assert.Equal(t, vulnerability.VulnerabilityID, event.VulnerabilityID)
+		assert.Equal(t, updateData.AnalysisID, event.AnalysisID)
+		assert.Equal(t, updateData.AccountID, event.AccountID)
+		assert.Equal(t, "test@horusec.io", event.AccountEmail)
+		assert.Equal(t, "test", event.AccountUsername)
+		assert.Equal(t, vulnerabilityEnums.Vulnerability, event.OldType)
+		assert.Equal(t, severities.Critical, event.OldSeverity)
+		assert.Equal(t, "test", event.Justification)
+		assert.False(t, event.CreatedAt.IsZero())
+		assert.Nil(t, event.PropagatedFromID)
+	})
+
+	t.Run("should create event referencing the vulnerability of the propagated triage", func(t *testing.T) {
+		propagatedFromID := uuid.New()
+		data := &VulnerabilityData{Justification: "test", PropagatedFromID: &propagatedFromID}
+
+		event := NewEvent(&vulnerabilityEntities.Vulnerability{}, data, &UpdateData{})
+		assert.Equal(t, &propagatedFromID, event.PropagatedFromID)
+	})
+}
+
+func TestSetNewValues(t *testing.T) {
+	t.Run("should set the new values of the vulnerability", func(t *testing.T) {
+		event := &Event{OldType: vulnerabilityEnums.Vulnerability, OldSeverity: severities.Critical}
+
+		event.SetNewValues(&vulnerabilityEntities.Vulnerability{
+			Severity: severities.Low,
+			Type:     vulnerabilityEnums.FalsePositive,
+		})
+
+		assert.Equal(t, vulnerabilityEnums.Vulnerability, event.OldType)
+		assert.Equal(t, vulnerabilityEnums.FalsePositive, event.NewType)
+		assert.Equal(t, severities.Critical, event.OldSeverity)
+		assert.Equal(t, severities.Low, event.NewSeverity)
+	})
+}
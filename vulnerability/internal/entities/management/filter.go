@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -35,31 +37,92 @@ import (
 type Filter struct {
 	WorkspaceID  uuid.UUID `json:"workspaceID"`
 	RepositoryID uuid.UUID `json:"repositoryID"`
+	AnalysisID   uuid.UUID `json:"analysisID"`
 	Page         int       `json:"page"`
 	Size         int       `json:"size"`
 	VulnFile     string    `json:"vulnFile"`
 	VulnSeverity string    `json:"vulnSeverity"`
 	VulnType     string    `json:"vulnType"`
 	VulnHash     string    `json:"vulnHash"`
+	AssigneeID   uuid.UUID `json:"assigneeID"`
+	Language     string    `json:"language"`
+	SecurityTool string    `json:"securityTool"`
+	CommitAuthor string    `json:"commitAuthor"`
+	InitialDate  time.Time `json:"initialDate"`
+	FinalDate    time.Time `json:"finalDate"`
+	Search       string    `json:"search"`
+	SortBy       string    `json:"sortBy"`
+	SortOrder    string    `json:"sortOrder"`
+
+	FilePattern string `json:"filePattern"`
+
+	CursorPagination bool    `json:"cursorPagination"`
+	Cursor           *Cursor `json:"cursor"`
+	WithTotal        bool    `json:"withTotal"`
 }
 
 func (f *Filter) SetFilterDataFromRequest(r *http.Request, validateVulnFile bool) error {
-	if err := f.setPagination(r); err != nil {
+	if err := f.setPaginationByType(r); err != nil {
 		return err
 	}
 	if err := f.setVulnerabilityFilters(r, validateVulnFile); err != nil {
 		return err
 	}
+	f.setSorting(r)
 	return f.setWorkspaceAndRepositoryIDFromRequest(r)
 }
 
-// nolint:funlen // this method don't necessary break
+// SetUnpaginatedFilterDataFromRequest parses the same filters as SetFilterDataFromRequest, ignoring pagination
+// and accepting an optional analysisID url param to restrict the result to a single analysis
+func (f *Filter) SetUnpaginatedFilterDataFromRequest(r *http.Request) error {
+	if err := f.setVulnerabilityFilters(r, false); err != nil {
+		return err
+	}
+	if err := f.setWorkspaceAndRepositoryIDFromRequest(r); err != nil {
+		return err
+	}
+	if chi.URLParam(r, managementEnums.AnalysisID) != "" {
+		return f.setAnalysisIDFromRequest(r)
+	}
+
+	return nil
+}
+
+// setPaginationByType uses the cursor pagination when the cursor param is sent, even empty for the first page,
+// otherwise the page number pagination is used
+func (f *Filter) setPaginationByType(r *http.Request) error {
+	if r.URL.Query().Has(managementEnums.CursorQuery) {
+		return f.setCursorPagination(r)
+	}
+
+	return f.setPagination(r)
+}
+
+func (f *Filter) setCursorPagination(r *http.Request) error {
+	f.CursorPagination = true
+	f.WithTotal, _ = strconv.ParseBool(r.URL.Query().Get(managementEnums.WithTotalQuery))
+
+	if cursor := r.URL.Query().Get(managementEnums.CursorQuery); cursor != "" {
+		f.Cursor = &Cursor{}
+		if err := f.Cursor.Decode(cursor); err != nil {
+			return err
+		}
+	}
+
+	return f.setPaginationSize(r)
+}
+
 func (f *Filter) setPagination(r *http.Request) error {
 	page, err := strconv.Atoi(r.URL.Query().Get(managementEnums.Page))
 	if err != nil {
 		return errors.Wrap(err, managementEnums.MessageInvalidPaginationPage)
 	}
 
+	f.Page = page
+	return f.setPaginationSize(r)
+}
+
+func (f *Filter) setPaginationSize(r *http.Request) error {
 	size, err := strconv.Atoi(r.URL.Query().Get(managementEnums.Size))
 	if err != nil {
 		return errors.Wrap(err, managementEnums.MessageInvalidPaginationSize)
@@ -71,7 +134,6 @@ func (f *Filter) setPagination(r *http.Request) error {
 		f.Size = size
 	}
 
-	f.Page = page
 	return nil
 }
 
@@ -99,6 +161,16 @@ func (f *Filter) setRepositoryIDFromRequest(r *http.Request) error {
 	return nil
 }
 
+func (f *Filter) setAnalysisIDFromRequest(r *http.Request) error {
+	analysisID, err := uuid.Parse(chi.URLParam(r, managementEnums.AnalysisID))
+	if err != nil {
+		return managementEnums.ErrorInvalidAnalysisID
+	}
+
+	f.AnalysisID = analysisID
+	return nil
+}
+
 func (f *Filter) setVulnerabilityFilters(r *http.Request, validateVulnFile bool) error {
 	f.VulnSeverity = r.URL.Query().Get(managementEnums.VulnSeverityQuery)
 	f.VulnHash = r.URL.Query().Get(managementEnums.VulnHashQuery)
@@ -107,6 +179,56 @@ func (f *Filter) setVulnerabilityFilters(r *http.Request, validateVulnFile bool)
 	if validateVulnFile && f.VulnFile == "" {
 		return errors.New(managementEnums.MessageInvalidVulnFile)
 	}
+	f.setDetailsFilters(r)
+	if err := f.setDateRangeFromRequest(r); err != nil {
+		return err
+	}
+	if r.URL.Query().Get(managementEnums.AssigneeIDQuery) != "" {
+		return f.setAssigneeIDFromRequest(r)
+	}
+	return nil
+}
+
+func (f *Filter) setDetailsFilters(r *http.Request) {
+	f.Language = r.URL.Query().Get(managementEnums.LanguageQuery)
+	f.SecurityTool = r.URL.Query().Get(managementEnums.SecurityToolQuery)
+	f.CommitAuthor = r.URL.Query().Get(managementEnums.CommitAuthorQuery)
+	f.Search = r.URL.Query().Get(managementEnums.SearchQuery)
+	f.FilePattern = r.URL.Query().Get(managementEnums.FilePatternQuery)
+}
+
+func (f *Filter) setDateRangeFromRequest(r *http.Request) (err error) {
+	if f.InitialDate, err = f.parseDate(r.URL.Query().Get(managementEnums.InitialDateQuery)); err != nil {
+		return managementEnums.ErrorInvalidInitialDate
+	}
+
+	if f.FinalDate, err = f.parseDate(r.URL.Query().Get(managementEnums.FinalDateQuery)); err != nil {
+		return managementEnums.ErrorInvalidFinalDate
+	}
+
+	return nil
+}
+
+func (f *Filter) parseDate(date string) (time.Time, error) {
+	if date == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(managementEnums.DateLayout, date)
+}
+
+func (f *Filter) setSorting(r *http.Request) {
+	f.SortBy = r.URL.Query().Get(managementEnums.SortByQuery)
+	f.SortOrder = strings.ToLower(r.URL.Query().Get(managementEnums.SortOrderQuery))
+}
+
+func (f *Filter) setAssigneeIDFromRequest(r *http.Request) error {
+	assigneeID, err := uuid.Parse(r.URL.Query().Get(managementEnums.AssigneeIDQuery))
+	if err != nil {
+		return managementEnums.ErrorInvalidAssigneeID
+	}
+
+	f.AssigneeID = assigneeID
 	return nil
 }
 
@@ -115,26 +237,117 @@ func (f *Filter) Validate() error {
 		validation.Field(&f.WorkspaceID, validation.Required, validation.NotIn(uuid.Nil)),
 		validation.Field(&f.Page, validation.Min(0)),
 		validation.Field(&f.Size, validation.Min(managementEnums.DefaultPaginationSize)),
-		validation.Field(&f.VulnSeverity, validation.In(severities.Unknown.ToString(), severities.Critical.ToString(),
-			severities.High.ToString(), severities.Medium.ToString(), severities.Low.ToString(),
-			severities.Info.ToString(), managementEnums.AllFilters)),
-		validation.Field(&f.VulnType, validation.Required, validation.In(vulnerabilityEnums.Vulnerability.ToString(),
-			vulnerabilityEnums.RiskAccepted.ToString(), vulnerabilityEnums.FalsePositive.ToString(),
-			vulnerabilityEnums.Corrected.ToString())),
+		validation.Field(&f.VulnSeverity, validation.By(f.validateValues(f.getValidSeverities()))),
+		validation.Field(&f.VulnType, validation.Required,
+			validation.By(f.validateValues(f.getValidVulnerabilityTypes()))),
+		validation.Field(&f.FinalDate, validation.When(!f.InitialDate.IsZero() && !f.FinalDate.IsZero(),
+			validation.Min(f.InitialDate))),
+		validation.Field(&f.SortBy, validation.In(f.getValidSortKeys()...)),
+		validation.Field(&f.SortOrder, validation.In(managementEnums.SortOrderAsc, managementEnums.SortOrderDesc)),
+		validation.Field(&f.Cursor, validation.By(f.validateCursor)),
 	)
 }
 
+func (f *Filter) validateCursor(_ interface{}) error {
+	if f.Cursor != nil && !f.Cursor.IsSameSorting(f) {
+		return managementEnums.ErrorInvalidCursor
+	}
+
+	return nil
+}
+
+// ShouldCountTotal returns false when the total count was not requested on cursor pagination, since counting all
+// the vulnerabilities of large workspaces is slower than listing a page
+func (f *Filter) ShouldCountTotal() bool {
+	return !f.CursorPagination || f.WithTotal
+}
+
+// ValidateUnpaginated validates the filters without pagination, an empty vulnerability type matches all types
+func (f *Filter) ValidateUnpaginated() error {
+	return validation.ValidateStruct(f,
+		validation.Field(&f.WorkspaceID, validation.Required, validation.NotIn(uuid.Nil)),
+		validation.Field(&f.VulnSeverity, validation.By(f.validateValues(f.getValidSeverities()))),
+		validation.Field(&f.VulnType, validation.By(f.validateValues(f.getValidVulnerabilityTypes()))),
+		validation.Field(&f.FinalDate, validation.When(!f.InitialDate.IsZero() && !f.FinalDate.IsZero(),
+			validation.Min(f.InitialDate))),
+	)
+}
+
+// validateValues checks each one of the comma separated values of the field
+func (f *Filter) validateValues(validValues []interface{}) validation.RuleFunc {
+	return func(value interface{}) error {
+		for _, item := range f.splitValues(value.(string)) {
+			if err := validation.In(validValues...).Validate(item); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func (f *Filter) splitValues(value string) (values []string) {
+	for _, item := range strings.Split(value, managementEnums.ValuesSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+
+	return values
+}
+
+func (f *Filter) getValidSeverities() []interface{} {
+	return []interface{}{severities.Unknown.ToString(), severities.Critical.ToString(), severities.High.ToString(),
+		severities.Medium.ToString(), severities.Low.ToString(), severities.Info.ToString(), managementEnums.AllFilters}
+}
+
+func (f *Filter) getValidVulnerabilityTypes() []interface{} {
+	return []interface{}{vulnerabilityEnums.Vulnerability.ToString(), vulnerabilityEnums.RiskAccepted.ToString(),
+		vulnerabilityEnums.FalsePositive.ToString(), vulnerabilityEnums.Corrected.ToString()}
+}
+
+func (f *Filter) getValidSortKeys() []interface{} {
+	return []interface{}{managementEnums.SortBySeverity, managementEnums.SortByFile, managementEnums.SortByCreatedAt,
+		managementEnums.SortByAuthor}
+}
+
+// GetSortDirection returns the sql direction of the sorting, ascending when not informed
+func (f *Filter) GetSortDirection() string {
+	if f.SortOrder == managementEnums.SortOrderDesc {
+		return "DESC"
+	}
+
+	return "ASC"
+}
+
 func (f *Filter) GetWhereFilterQuery() (string, []interface{}) {
-	query := f.GetWorkspaceAndRepositoryIDQuery()
+	query := f.getAnalysisQuery()
 	query = f.getVulnerabilityHashQuery(query)
 	query = f.getVulnerabilitySeverityQuery(query)
 	query = f.getVulnerabilityTypeQuery(query)
 	query = f.getVulnerabilityFileQuery(query)
+	query = f.getVulnerabilityDetailsQuery(query)
+	query = f.getAssigneeQuery(query)
 	query = f.getLatestAnalysisIDByFilter(query)
 
 	return query, f.getParams()
 }
 
+// getAnalysisQuery returns the workspace and repository condition restricted to the analyses of the date range
+func (f *Filter) getAnalysisQuery() string {
+	query := f.GetWorkspaceAndRepositoryIDQuery()
+
+	if !f.InitialDate.IsZero() {
+		query += "AND analysis.created_at >= @initialDate "
+	}
+
+	if !f.FinalDate.IsZero() {
+		query += "AND analysis.created_at <= @finalDate "
+	}
+
+	return query
+}
+
 func (f *Filter) GetWorkspaceAndRepositoryIDQuery() string {
 	query := "analysis.workspace_id = @workspaceID "
 
@@ -154,16 +367,29 @@ func (f *Filter) getVulnerabilityHashQuery(query string) string {
 }
 
 func (f *Filter) getVulnerabilitySeverityQuery(query string) string {
-	if f.VulnSeverity != managementEnums.AllFilters && f.VulnSeverity != "" {
-		query += " AND vulnerabilities.severity = @vulnSeverity "
+	if len(f.getSeverities()) > 0 {
+		query += " AND vulnerabilities.severity IN @vulnSeverity "
 	}
 
 	return query
 }
 
+// getSeverities returns the severities of the filter, when all severities are requested an empty list is returned
+func (f *Filter) getSeverities() []string {
+	severitiesFilter := f.splitValues(f.VulnSeverity)
+
+	for _, severity := range severitiesFilter {
+		if severity == managementEnums.AllFilters {
+			return nil
+		}
+	}
+
+	return severitiesFilter
+}
+
 func (f *Filter) getVulnerabilityTypeQuery(query string) string {
-	if f.VulnType != "" && f.VulnHash == "" {
-		query += " AND vulnerabilities.type = @vulnType "
+	if len(f.splitValues(f.VulnType)) > 0 && f.VulnHash == "" {
+		query += " AND vulnerabilities.type IN @vulnType "
 	}
 
 	return query
@@ -177,27 +403,92 @@ func (f *Filter) getVulnerabilityFileQuery(query string) string {
 	return query
 }
 
+func (f *Filter) getVulnerabilityDetailsQuery(query string) string {
+	if len(f.splitValues(f.Language)) > 0 {
+		query += " AND vulnerabilities.language IN @language "
+	}
+
+	if len(f.splitValues(f.SecurityTool)) > 0 {
+		query += " AND vulnerabilities.security_tool IN @securityTool "
+	}
+
+	if f.CommitAuthor != "" {
+		query += " AND (vulnerabilities.commit_author ILIKE @commitAuthor ESCAPE '\\' " +
+			"OR vulnerabilities.commit_email ILIKE @commitAuthor ESCAPE '\\') "
+	}
+
+	if f.Search != "" {
+		query += " AND (vulnerabilities.details ILIKE @search ESCAPE '\\' " +
+			"OR vulnerabilities.code ILIKE @search ESCAPE '\\') "
+	}
+
+	if f.FilePattern != "" {
+		query += " AND vulnerabilities.file ~ @filePattern "
+	}
+
+	return query
+}
+
+// getAssigneeQuery filters the vulnerabilities assigned to the account, assignments are kept by repository and
+// vulnerability hash so they match the vulnerability in any analysis of the repository
+func (f *Filter) getAssigneeQuery(query string) string {
+	if f.AssigneeID != uuid.Nil {
+		query += " AND EXISTS (SELECT 1 FROM vulnerability_assignments WHERE " +
+			"vulnerability_assignments.repository_id = analysis.repository_id AND " +
+			"vulnerability_assignments.vuln_hash = vulnerabilities.vuln_hash AND " +
+			"vulnerability_assignments.assignee_id = @assigneeID) "
+	}
+
+	return query
+}
+
 func (f *Filter) getParams() []interface{} {
 	return []interface{}{
 		sql.Named("workspaceID", f.WorkspaceID),
 		sql.Named("repositoryID", f.RepositoryID),
+		sql.Named("analysisID", f.AnalysisID),
 		sql.Named("vulnHash", "%"+f.VulnHash+"%"),
-		sql.Named("vulnSeverity", f.VulnSeverity),
-		sql.Named("vulnType", f.VulnType),
+		sql.Named("vulnSeverity", f.getSeverities()),
+		sql.Named("vulnType", f.splitValues(f.VulnType)),
 		sql.Named("vulnFile", f.VulnFile),
+		sql.Named("assigneeID", f.AssigneeID),
+		sql.Named("language", f.splitValues(f.Language)),
+		sql.Named("securityTool", f.splitValues(f.SecurityTool)),
+		sql.Named("commitAuthor", f.containsPattern(f.CommitAuthor)),
+		sql.Named("search", f.containsPattern(f.Search)),
+		sql.Named("initialDate", f.InitialDate),
+		sql.Named("finalDate", f.FinalDate),
+		sql.Named("filePattern", f.getFilePatternRegex()),
 		sql.Named("size", f.Size),
 		sql.Named("skip", pagination.GetSkip(int64(f.Page), int64(f.Size))),
 	}
 }
 
+// containsPattern escapes the like wildcards of the text, so the text is searched as it was typed
+func (f *Filter) containsPattern(text string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(text) + "%"
+}
+
+func (f *Filter) getFilePatternRegex() string {
+	if f.FilePattern == "" {
+		return ""
+	}
+
+	return GlobToRegex(f.FilePattern)
+}
+
 func (f *Filter) getLatestAnalysisIDByFilter(query string) string {
+	if f.AnalysisID != uuid.Nil {
+		return query + " AND analysis.analysis_id = @analysisID"
+	}
+
 	if f.RepositoryID != uuid.Nil {
 		query += fmt.Sprintf(" AND analysis.analysis_id = (SELECT analysis_id FROM analysis WHERE %[1]s "+
-			"ORDER BY created_at DESC LIMIT 1)", f.GetWorkspaceAndRepositoryIDQuery())
+			"ORDER BY created_at DESC LIMIT 1)", f.getAnalysisQuery())
 	} else {
 		query += fmt.Sprintf(" AND analysis.analysis_id IN (SELECT DISTINCT ON(analysis.repository_id) "+
 			"analysis.analysis_id FROM analysis WHERE %[1]s ORDER BY analysis.repository_id, analysis.created_at DESC)",
-			f.GetWorkspaceAndRepositoryIDQuery())
+			f.getAnalysisQuery())
 	}
 
 	return query
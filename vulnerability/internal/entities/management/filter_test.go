@@ -16,9 +16,11 @@ package management
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi"
 	"github.com/google/uuid"
@@ -172,6 +174,89 @@ func TestSetFilterDataFromRequest(t *testing.T) {
 		assert.Error(t, filter.SetFilterDataFromRequest(r, true))
 	})
 
+	t.Run("should parse assignee id from query", func(t *testing.T) {
+		assigneeID := uuid.New()
+
+		URL := fmt.Sprintf("/test?page=1&size=15&vulnType=%s&assigneeID=%s",
+			vulnerabilityEnums.Vulnerability.ToString(), assigneeID.String())
+
+		r, _ := http.NewRequest(http.MethodGet, URL, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter := &Filter{}
+		assert.NoError(t, filter.SetFilterDataFromRequest(r, false))
+		assert.Equal(t, assigneeID, filter.AssigneeID)
+	})
+
+	t.Run("should return error when not valid assignee id", func(t *testing.T) {
+		URL := fmt.Sprintf("/test?page=1&size=15&vulnType=%s&assigneeID=test",
+			vulnerabilityEnums.Vulnerability.ToString())
+
+		r, _ := http.NewRequest(http.MethodGet, URL, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter := &Filter{}
+		assert.Equal(t, managementEnums.ErrorInvalidAssigneeID, filter.SetFilterDataFromRequest(r, false))
+	})
+
+	t.Run("should parse details, date range and sorting filters", func(t *testing.T) {
+		URL := "/test?page=1&size=15&vulnSeverity=CRITICAL,HIGH&vulnType=Vulnerability&language=Go,Leaks" +
+			"&securityTool=GoSec&commitAuthor=horusec&search=password&initialDate=2021-01-01T00:00:00Z" +
+			"&finalDate=2021-02-01T00:00:00Z&sortBy=author&sortOrder=DESC"
+
+		r, _ := http.NewRequest(http.MethodGet, URL, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter := &Filter{}
+		assert.NoError(t, filter.SetFilterDataFromRequest(r, false))
+		assert.Equal(t, "CRITICAL,HIGH", filter.VulnSeverity)
+		assert.Equal(t, "Go,Leaks", filter.Language)
+		assert.Equal(t, "GoSec", filter.SecurityTool)
+		assert.Equal(t, "horusec", filter.CommitAuthor)
+		assert.Equal(t, "password", filter.Search)
+		assert.Equal(t, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), filter.InitialDate)
+		assert.Equal(t, time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC), filter.FinalDate)
+		assert.Equal(t, managementEnums.SortByAuthor, filter.SortBy)
+		assert.Equal(t, managementEnums.SortOrderDesc, filter.SortOrder)
+		assert.NoError(t, filter.Validate())
+	})
+
+	t.Run("should return error when invalid initial date", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test?page=1&size=15&initialDate=2021-01-01", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter := &Filter{}
+		assert.Equal(t, managementEnums.ErrorInvalidInitialDate, filter.SetFilterDataFromRequest(r, false))
+	})
+
+	t.Run("should return error when invalid final date", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test?page=1&size=15&finalDate=test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter := &Filter{}
+		assert.Equal(t, managementEnums.ErrorInvalidFinalDate, filter.SetFilterDataFromRequest(r, false))
+	})
+
 	t.Run("should return error when user not sent vulnerability file and is required", func(t *testing.T) {
 		workspaceID := uuid.New()
 		repositoryID := uuid.New()
@@ -194,6 +279,100 @@ func TestSetFilterDataFromRequest(t *testing.T) {
 	})
 }
 
+func TestSetUnpaginatedFilterDataFromRequest(t *testing.T) {
+	t.Run("should parse request without pagination and with analysis id", func(t *testing.T) {
+		workspaceID := uuid.New()
+		repositoryID := uuid.New()
+		analysisID := uuid.New()
+
+		URL := fmt.Sprintf("/test?vulnSeverity=%s&vulnHash=%s", severities.Critical.ToString(), "123456")
+
+		r, _ := http.NewRequest(http.MethodGet, URL, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", workspaceID.String())
+		ctx.URLParams.Add("repositoryID", repositoryID.String())
+		ctx.URLParams.Add("analysisID", analysisID.String())
+
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter := &Filter{}
+		assert.NoError(t, filter.SetUnpaginatedFilterDataFromRequest(r))
+		assert.Equal(t, workspaceID, filter.WorkspaceID)
+		assert.Equal(t, repositoryID, filter.RepositoryID)
+		assert.Equal(t, analysisID, filter.AnalysisID)
+		assert.Equal(t, 0, filter.Size)
+		assert.Equal(t, severities.Critical.ToString(), filter.VulnSeverity)
+		assert.Empty(t, filter.VulnType)
+		assert.Equal(t, "123456", filter.VulnHash)
+	})
+
+	t.Run("should parse request without repository and analysis id", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", workspaceID.String())
+
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter := &Filter{}
+		assert.NoError(t, filter.SetUnpaginatedFilterDataFromRequest(r))
+		assert.Equal(t, workspaceID, filter.WorkspaceID)
+		assert.Equal(t, uuid.Nil, filter.RepositoryID)
+		assert.Equal(t, uuid.Nil, filter.AnalysisID)
+	})
+
+	t.Run("should return error when not valid workspaceID", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", "test")
+
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter := &Filter{}
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID, filter.SetUnpaginatedFilterDataFromRequest(r))
+	})
+
+	t.Run("should return error when not valid analysisID", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		ctx.URLParams.Add("analysisID", "test")
+
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter := &Filter{}
+		assert.Equal(t, managementEnums.ErrorInvalidAnalysisID, filter.SetUnpaginatedFilterDataFromRequest(r))
+	})
+}
+
+func TestValidateUnpaginated(t *testing.T) {
+	t.Run("should return no error when vulnerability type and pagination are empty", func(t *testing.T) {
+		filter := &Filter{
+			WorkspaceID:  uuid.New(),
+			VulnSeverity: severities.Critical.ToString(),
+		}
+
+		assert.NoError(t, filter.ValidateUnpaginated())
+	})
+
+	t.Run("should return error when invalid vulnerability type", func(t *testing.T) {
+		filter := &Filter{
+			WorkspaceID: uuid.New(),
+			VulnType:    "test",
+		}
+
+		err := filter.ValidateUnpaginated()
+		assert.Error(t, err)
+		assert.Equal(t, "vulnType: must be a valid value.", err.Error())
+	})
+}
+
 func TestValidate(t *testing.T) {
 	t.Run("should return no error when valid filter", func(t *testing.T) {
 		filter := &Filter{
@@ -224,6 +403,128 @@ func TestValidate(t *testing.T) {
 	})
 }
 
+func TestValidateMultipleValuesAndSorting(t *testing.T) {
+	newFilter := func() *Filter {
+		return &Filter{
+			WorkspaceID:  uuid.New(),
+			Size:         10,
+			VulnSeverity: "CRITICAL, HIGH",
+			VulnType:     "Vulnerability,Risk Accepted",
+		}
+	}
+
+	t.Run("should return no error when multiple valid severities and types", func(t *testing.T) {
+		assert.NoError(t, newFilter().Validate())
+	})
+
+	t.Run("should return error when one of the severities is invalid", func(t *testing.T) {
+		filter := newFilter()
+		filter.VulnSeverity = "CRITICAL,test"
+
+		assert.Error(t, filter.Validate())
+	})
+
+	t.Run("should return error when one of the types is invalid", func(t *testing.T) {
+		filter := newFilter()
+		filter.VulnType = "Vulnerability,test"
+
+		assert.Error(t, filter.ValidateUnpaginated())
+	})
+
+	t.Run("should return error when invalid sort key", func(t *testing.T) {
+		filter := newFilter()
+		filter.SortBy = "vulnerability_id; DROP TABLE vulnerabilities"
+
+		assert.Error(t, filter.Validate())
+	})
+
+	t.Run("should return error when invalid sort order", func(t *testing.T) {
+		filter := newFilter()
+		filter.SortBy = managementEnums.SortByFile
+		filter.SortOrder = "test"
+
+		assert.Error(t, filter.Validate())
+	})
+
+	t.Run("should return error when final date is before initial date", func(t *testing.T) {
+		filter := newFilter()
+		filter.InitialDate = time.Now()
+		filter.FinalDate = time.Now().Add(-time.Hour)
+
+		assert.Error(t, filter.Validate())
+		assert.Error(t, filter.ValidateUnpaginated())
+	})
+}
+
+func TestGetSortDirection(t *testing.T) {
+	t.Run("should return descending when sort order is desc", func(t *testing.T) {
+		filter := &Filter{SortOrder: managementEnums.SortOrderDesc}
+
+		assert.Equal(t, "DESC", filter.GetSortDirection())
+	})
+
+	t.Run("should return ascending by default", func(t *testing.T) {
+		filter := &Filter{}
+
+		assert.Equal(t, "ASC", filter.GetSortDirection())
+	})
+}
+
+func TestGetWhereFilterQueryDetails(t *testing.T) {
+	t.Run("should add details conditions and named params", func(t *testing.T) {
+		filter := &Filter{
+			WorkspaceID:  uuid.New(),
+			VulnSeverity: "CRITICAL,HIGH",
+			Language:     "Go, Leaks",
+			SecurityTool: "GoSec",
+			CommitAuthor: "horusec",
+			Search:       "password",
+			InitialDate:  time.Now().Add(-time.Hour),
+			FinalDate:    time.Now(),
+		}
+
+		query, params := filter.GetWhereFilterQuery()
+		assert.Contains(t, query, "analysis.workspace_id = @workspaceID AND analysis.created_at >= @initialDate "+
+			"AND analysis.created_at <= @finalDate ")
+		assert.Contains(t, query, "AND vulnerabilities.language IN @language ")
+		assert.Contains(t, query, "AND vulnerabilities.security_tool IN @securityTool ")
+		assert.Contains(t, query, `AND (vulnerabilities.commit_author ILIKE @commitAuthor ESCAPE '\' `+
+			`OR vulnerabilities.commit_email ILIKE @commitAuthor ESCAPE '\') `)
+		assert.Contains(t, query, `AND (vulnerabilities.details ILIKE @search ESCAPE '\' `+
+			`OR vulnerabilities.code ILIKE @search ESCAPE '\') `)
+		assert.Contains(t, query, "SELECT DISTINCT ON(analysis.repository_id) analysis.analysis_id FROM analysis "+
+			"WHERE analysis.workspace_id = @workspaceID AND analysis.created_at >= @initialDate")
+		assert.Contains(t, params, sql.Named("vulnSeverity", []string{"CRITICAL", "HIGH"}))
+		assert.Contains(t, params, sql.Named("language", []string{"Go", "Leaks"}))
+		assert.Contains(t, params, sql.Named("commitAuthor", "%horusec%"))
+		assert.Contains(t, params, sql.Named("search", "%password%"))
+	})
+
+	t.Run("should escape like wildcards of the searched texts", func(t *testing.T) {
+		filter := &Filter{WorkspaceID: uuid.New(), CommitAuthor: "dev_1", Search: `100%\path`}
+
+		_, params := filter.GetWhereFilterQuery()
+		assert.Contains(t, params, sql.Named("commitAuthor", `%dev\_1%`))
+		assert.Contains(t, params, sql.Named("search", `%100\%\\path%`))
+	})
+
+	t.Run("should not filter severity when all severities are requested", func(t *testing.T) {
+		filter := &Filter{WorkspaceID: uuid.New(), VulnSeverity: "HIGH,ALL"}
+
+		query, _ := filter.GetWhereFilterQuery()
+		assert.NotContains(t, query, "vulnerabilities.severity")
+	})
+
+	t.Run("should not add details conditions when empty", func(t *testing.T) {
+		filter := &Filter{WorkspaceID: uuid.New(), Language: " , "}
+
+		query, _ := filter.GetWhereFilterQuery()
+		assert.NotContains(t, query, "vulnerabilities.language")
+		assert.NotContains(t, query, "ILIKE")
+		assert.NotContains(t, query, "@initialDate")
+	})
+}
+
 func TestGetWhereFilterQuery(t *testing.T) {
 	t.Run("should return no error when valid filter without hash", func(t *testing.T) {
 		filter := &Filter{
@@ -240,8 +541,8 @@ func TestGetWhereFilterQuery(t *testing.T) {
 		assert.NotEmpty(t, query)
 		assert.Equal(t, "analysis.workspace_id = @workspaceID "+
 			"AND analysis.repository_id = @repositoryID  "+
-			"AND vulnerabilities.severity = @vulnSeverity  "+
-			"AND vulnerabilities.type = @vulnType  "+
+			"AND vulnerabilities.severity IN @vulnSeverity  "+
+			"AND vulnerabilities.type IN @vulnType  "+
 			"AND vulnerabilities.file = @vulnFile  "+
 			"AND analysis.analysis_id = ("+
 			"SELECT analysis_id FROM analysis "+
@@ -249,7 +550,7 @@ func TestGetWhereFilterQuery(t *testing.T) {
 			"AND analysis.repository_id = @repositoryID  "+
 			"ORDER BY created_at DESC LIMIT 1)", query)
 		assert.NotNil(t, params)
-		assert.Len(t, params, 8)
+		assert.Len(t, params, 17)
 	})
 	t.Run("should return no error when valid filter with hash", func(t *testing.T) {
 		filter := &Filter{
@@ -267,7 +568,7 @@ func TestGetWhereFilterQuery(t *testing.T) {
 		assert.Equal(t, "analysis.workspace_id = @workspaceID "+
 			"AND analysis.repository_id = @repositoryID  "+
 			"AND vulnerabilities.vuln_hash ILIKE @vulnHash  "+
-			"AND vulnerabilities.severity = @vulnSeverity  "+
+			"AND vulnerabilities.severity IN @vulnSeverity  "+
 			"AND vulnerabilities.file = @vulnFile  "+
 			"AND analysis.analysis_id = ("+
 			"SELECT analysis_id FROM analysis "+
@@ -275,7 +576,24 @@ func TestGetWhereFilterQuery(t *testing.T) {
 			"AND analysis.repository_id = @repositoryID  "+
 			"ORDER BY created_at DESC LIMIT 1)", query)
 		assert.NotNil(t, params)
-		assert.Len(t, params, 8)
+		assert.Len(t, params, 17)
+	})
+}
+
+func TestGetAssigneeQuery(t *testing.T) {
+	t.Run("should add assignee condition when assignee id is set", func(t *testing.T) {
+		filter := &Filter{AssigneeID: uuid.New()}
+
+		assert.Equal(t, " AND EXISTS (SELECT 1 FROM vulnerability_assignments WHERE "+
+			"vulnerability_assignments.repository_id = analysis.repository_id AND "+
+			"vulnerability_assignments.vuln_hash = vulnerabilities.vuln_hash AND "+
+			"vulnerability_assignments.assignee_id = @assigneeID) ", filter.getAssigneeQuery(""))
+	})
+
+	t.Run("should not change query when assignee id is empty", func(t *testing.T) {
+		filter := &Filter{}
+
+		assert.Equal(t, "test", filter.getAssigneeQuery("test"))
 	})
 }
 
@@ -292,6 +610,17 @@ func TestGetLatestAnalysisIDByFilter(t *testing.T) {
 			"ORDER BY analysis.repository_id, analysis.created_at DESC)", query)
 	})
 
+	t.Run("should return query by analysis id", func(t *testing.T) {
+		filter := &Filter{
+			WorkspaceID:  uuid.New(),
+			RepositoryID: uuid.New(),
+			AnalysisID:   uuid.New(),
+		}
+
+		query := filter.getLatestAnalysisIDByFilter("")
+		assert.Equal(t, " AND analysis.analysis_id = @analysisID", query)
+	})
+
 	t.Run("should return query by workspace and repository", func(t *testing.T) {
 		filter := &Filter{
 			WorkspaceID:  uuid.New(),
@@ -305,3 +634,110 @@ func TestGetLatestAnalysisIDByFilter(t *testing.T) {
 			"ORDER BY created_at DESC LIMIT 1)", query)
 	})
 }
+
+func TestSetFilterDataFromRequestCursor(t *testing.T) {
+	t.Run("should use cursor pagination when cursor is sent empty", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test?cursor=&size=20&withTotal=true&vulnType=Vulnerability", nil)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter := &Filter{}
+		assert.NoError(t, filter.SetFilterDataFromRequest(r, false))
+		assert.True(t, filter.CursorPagination)
+		assert.True(t, filter.WithTotal)
+		assert.Nil(t, filter.Cursor)
+		assert.Equal(t, 20, filter.Size)
+	})
+
+	t.Run("should decode the cursor of the request", func(t *testing.T) {
+		cursor := NewCursor(&Filter{}, "1", "", uuid.New())
+
+		r, _ := http.NewRequest(http.MethodGet, "/test?size=20&vulnType=Vulnerability&cursor="+cursor.Encode(), nil)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter := &Filter{}
+		assert.NoError(t, filter.SetFilterDataFromRequest(r, false))
+		assert.Equal(t, cursor, filter.Cursor)
+		assert.False(t, filter.WithTotal)
+	})
+
+	t.Run("should return error when invalid cursor", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test?size=20&cursor=test", nil)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter := &Filter{}
+		assert.Equal(t, managementEnums.ErrorInvalidCursor, filter.SetFilterDataFromRequest(r, false))
+	})
+
+	t.Run("should not require page when using cursor", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test?cursor=&size=invalid", nil)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter := &Filter{}
+		err := filter.SetFilterDataFromRequest(r, false)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), managementEnums.MessageInvalidPaginationSize)
+	})
+}
+
+func TestValidateCursor(t *testing.T) {
+	newFilter := func() *Filter {
+		return &Filter{
+			WorkspaceID: uuid.New(),
+			Size:        10,
+			VulnType:    vulnerabilityEnums.Vulnerability.ToString(),
+		}
+	}
+
+	t.Run("should return error when cursor was created with other sorting", func(t *testing.T) {
+		filter := newFilter()
+		filter.CursorPagination = true
+		filter.Cursor = &Cursor{SortBy: managementEnums.SortByFile}
+
+		assert.Error(t, filter.Validate())
+	})
+
+	t.Run("should validate without errors when cursor has the same sorting", func(t *testing.T) {
+		filter := newFilter()
+		filter.CursorPagination = true
+		filter.Cursor = NewCursor(filter, "1", "", uuid.New())
+
+		assert.NoError(t, filter.Validate())
+	})
+}
+
+func TestShouldCountTotal(t *testing.T) {
+	t.Run("should count total when not using cursor", func(t *testing.T) {
+		assert.True(t, (&Filter{}).ShouldCountTotal())
+	})
+
+	t.Run("should count total only when requested using cursor", func(t *testing.T) {
+		assert.False(t, (&Filter{CursorPagination: true}).ShouldCountTotal())
+		assert.True(t, (&Filter{CursorPagination: true, WithTotal: true}).ShouldCountTotal())
+	})
+}
+
+func TestGetFilePatternRegex(t *testing.T) {
+	t.Run("should return empty when without file pattern", func(t *testing.T) {
+		assert.Empty(t, (&Filter{}).getFilePatternRegex())
+	})
+
+	t.Run("should convert the glob of the file pattern to regex", func(t *testing.T) {
+		assert.Equal(t, "^test/.*$", (&Filter{FilePattern: "test/**"}).getFilePatternRegex())
+	})
+
+	t.Run("should add file pattern condition and named param", func(t *testing.T) {
+		filter := &Filter{WorkspaceID: uuid.New(), FilePattern: "test/**"}
+
+		query, params := filter.GetWhereFilterQuery()
+		assert.Contains(t, query, "vulnerabilities.file ~ @filePattern")
+		assert.Contains(t, params, sql.Named("filePattern", "^test/.*$"))
+	})
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlobToRegex(t *testing.T) {
+	t.Run("should convert the glob to regex", func(t *testing.T) {
+		assert.Equal(t, "^test/.*$", GlobToRegex("test/**"))
+		assert.Equal(t, "^src/[^/]*\\.go$", GlobToRegex("src/*.go"))
+		assert.Equal(t, "^.*/main[^/]\\.go$", GlobToRegex("**/main?.go"))
+	})
+
+	t.Run("should match the files of the glob", func(t *testing.T) {
+		regex := regexp.MustCompile(GlobToRegex("test/**"))
+		assert.True(t, regex.MatchString("test/api/main_test.go"))
+		assert.False(t, regex.MatchString("src/test/main.go"))
+
+		regex = regexp.MustCompile(GlobToRegex("src/*.go"))
+		assert.True(t, regex.MatchString("src/main.go"))
+		assert.False(t, regex.MatchString("src/api/main.go"))
+	})
+}
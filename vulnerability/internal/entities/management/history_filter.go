@@ -0,0 +1,66 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+)
+
+type HistoryFilter struct {
+	WorkspaceID     uuid.UUID `json:"workspaceID"`
+	RepositoryID    uuid.UUID `json:"repositoryID"`
+	VulnerabilityID uuid.UUID `json:"vulnerabilityID"`
+}
+
+// SetDataFromRequest sets the filter data from the url params, the repository is optional when the history is
+// requested by workspace
+func (h *HistoryFilter) SetDataFromRequest(r *http.Request) (err error) {
+	if h.WorkspaceID, err = uuid.Parse(chi.URLParam(r, managementEnums.WorkspaceID)); err != nil {
+		return managementEnums.ErrorInvalidWorkspaceID
+	}
+
+	if repositoryID := chi.URLParam(r, managementEnums.RepositoryID); repositoryID != "" {
+		if h.RepositoryID, err = uuid.Parse(repositoryID); err != nil {
+			return managementEnums.ErrorInvalidRepositoryID
+		}
+	}
+
+	if h.VulnerabilityID, err = uuid.Parse(chi.URLParam(r, managementEnums.VulnerabilityID)); err != nil {
+		return managementEnums.ErrorInvalidVulnerabilityID
+	}
+
+	return nil
+}
+
+// GetWhereFilterQuery returns a condition checking that the vulnerability belongs to an analysis of the workspace
+// or repository of the request
+func (h *HistoryFilter) GetWhereFilterQuery() (string, []interface{}) {
+	condition := "analysis.workspace_id = @workspaceID"
+	if h.RepositoryID != uuid.Nil {
+		condition += " AND analysis.repository_id = @repositoryID"
+	}
+
+	return condition, []interface{}{
+		sql.Named("workspaceID", h.WorkspaceID),
+		sql.Named("repositoryID", h.RepositoryID),
+		sql.Named("vulnerabilityID", h.VulnerabilityID),
+	}
+}
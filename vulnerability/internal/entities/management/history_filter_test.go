@@ -0,0 +1,92 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+)
+
+func newHistoryRequest(params map[string]string) *http.Request {
+	r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+	ctx := chi.NewRouteContext()
+	for key, value := range params {
+		ctx.URLParams.Add(key, value)
+	}
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func TestHistoryFilterSetDataFromRequest(t *testing.T) {
+	t.Run("should success set data with repository", func(t *testing.T) {
+		filter := &HistoryFilter{}
+		workspaceID, repositoryID, vulnerabilityID := uuid.New(), uuid.New(), uuid.New()
+
+		assert.NoError(t, filter.SetDataFromRequest(newHistoryRequest(map[string]string{
+			"workspaceID":     workspaceID.String(),
+			"repositoryID":    repositoryID.String(),
+			"vulnerabilityID": vulnerabilityID.String(),
+		})))
+		assert.Equal(t, workspaceID, filter.WorkspaceID)
+		assert.Equal(t, repositoryID, filter.RepositoryID)
+		assert.Equal(t, vulnerabilityID, filter.VulnerabilityID)
+	})
+
+	t.Run("should success set data without repository", func(t *testing.T) {
+		filter := &HistoryFilter{}
+
+		assert.NoError(t, filter.SetDataFromRequest(newHistoryRequest(map[string]string{
+			"workspaceID":     uuid.NewString(),
+			"vulnerabilityID": uuid.NewString(),
+		})))
+		assert.Equal(t, uuid.Nil, filter.RepositoryID)
+	})
+
+	t.Run("should return error when invalid ids", func(t *testing.T) {
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID,
+			(&HistoryFilter{}).SetDataFromRequest(newHistoryRequest(map[string]string{})))
+
+		assert.Equal(t, managementEnums.ErrorInvalidRepositoryID,
+			(&HistoryFilter{}).SetDataFromRequest(newHistoryRequest(map[string]string{
+				"workspaceID": uuid.NewString(), "repositoryID": "test"})))
+
+		assert.Equal(t, managementEnums.ErrorInvalidVulnerabilityID,
+			(&HistoryFilter{}).SetDataFromRequest(newHistoryRequest(map[string]string{
+				"workspaceID": uuid.NewString()})))
+	})
+}
+
+func TestHistoryFilterGetWhereFilterQuery(t *testing.T) {
+	t.Run("should return condition by workspace", func(t *testing.T) {
+		condition, params := (&HistoryFilter{WorkspaceID: uuid.New()}).GetWhereFilterQuery()
+
+		assert.Equal(t, "analysis.workspace_id = @workspaceID", condition)
+		assert.Len(t, params, 3)
+	})
+
+	t.Run("should return condition by workspace and repository", func(t *testing.T) {
+		condition, _ := (&HistoryFilter{WorkspaceID: uuid.New(), RepositoryID: uuid.New()}).GetWhereFilterQuery()
+
+		assert.Equal(t, "analysis.workspace_id = @workspaceID AND analysis.repository_id = @repositoryID", condition)
+	})
+}
@@ -24,11 +24,50 @@ import (
 type ResponseVulnerabilitiesByFile struct {
 	TotalItems int                                   `json:"totalItems"`
 	Data       []vulnerabilityEntities.Vulnerability `json:"data"`
+	NextCursor string                                `json:"nextCursor,omitempty"`
+}
+
+// SetData fills the vulnerabilities of the page, on cursor pagination one extra row is queried to know if there is
+// a next page, it's removed from the data and the last row of the page becomes the next cursor
+func (r *ResponseVulnerabilitiesByFile) SetData(rows []VulnerabilityCursorRow,
+	filter *Filter) *ResponseVulnerabilitiesByFile {
+	r.Data = []vulnerabilityEntities.Vulnerability{}
+
+	for index := range rows {
+		if filter.CursorPagination && index > 0 && index == filter.Size {
+			last := rows[index-1]
+			r.NextCursor = NewCursor(filter, last.CursorValue, "", last.VulnerabilityID).Encode()
+			break
+		}
+
+		r.Data = append(r.Data, rows[index].Vulnerability)
+	}
+
+	return r
 }
 
 type ResponseFilesVulnerable struct {
 	TotalItems int                           `json:"totalItems"`
 	Data       []ResponseDataFilesVulnerable `json:"data"`
+	NextCursor string                        `json:"nextCursor,omitempty"`
+}
+
+// SetData fills the files of the page, on cursor pagination one extra row is queried to know if there is a next
+// page, it's removed from the data and the last row of the page becomes the next cursor
+func (r *ResponseFilesVulnerable) SetData(rows []FileCursorRow, filter *Filter) *ResponseFilesVulnerable {
+	r.Data = []ResponseDataFilesVulnerable{}
+
+	for index := range rows {
+		if filter.CursorPagination && index > 0 && index == filter.Size {
+			last := rows[index-1]
+			r.NextCursor = NewCursor(filter, last.CursorValue, last.File, last.AnalysisID).Encode()
+			break
+		}
+
+		r.Data = append(r.Data, rows[index].ResponseDataFilesVulnerable)
+	}
+
+	return r
 }
 
 type ResponseDataFilesVulnerable struct {
@@ -40,3 +79,15 @@ type ResponseDataFilesVulnerable struct {
 	RepositoryID         uuid.UUID `json:"repositoryID" gorm:"Column:repository_id"`
 	RepositoryName       string    `json:"repositoryName" gorm:"Column:repository_name"`
 }
+
+// VulnerabilityCursorRow is a vulnerability of the listing with the value of the sort column used by the cursor
+type VulnerabilityCursorRow struct {
+	vulnerabilityEntities.Vulnerability
+	CursorValue string `json:"cursorValue" gorm:"Column:cursor_value"`
+}
+
+// FileCursorRow is a file of the listing with the value of the sort column used by the cursor
+type FileCursorRow struct {
+	ResponseDataFilesVulnerable
+	CursorValue string `json:"cursorValue" gorm:"Column:cursor_value"`
+}
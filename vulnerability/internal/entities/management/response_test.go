@@ -0,0 +1,93 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+)
+
+func TestResponseVulnerabilitiesByFileSetData(t *testing.T) {
+	t.Run("should set all rows when not using cursor", func(t *testing.T) {
+		rows := []VulnerabilityCursorRow{{}, {}, {}}
+
+		response := (&ResponseVulnerabilitiesByFile{}).SetData(rows, &Filter{Size: 2})
+		assert.Len(t, response.Data, 3)
+		assert.Empty(t, response.NextCursor)
+	})
+
+	t.Run("should remove the extra row and set next cursor from the last row of the page", func(t *testing.T) {
+		id := uuid.New()
+		rows := []VulnerabilityCursorRow{
+			{CursorValue: "1"},
+			{Vulnerability: vulnerabilityEntities.Vulnerability{VulnerabilityID: id}, CursorValue: "2"},
+			{CursorValue: "3"},
+		}
+
+		response := (&ResponseVulnerabilitiesByFile{}).SetData(rows, &Filter{Size: 2, CursorPagination: true})
+		assert.Len(t, response.Data, 2)
+
+		cursor := &Cursor{}
+		assert.NoError(t, cursor.Decode(response.NextCursor))
+		assert.Equal(t, "2", cursor.Value)
+		assert.Equal(t, id, cursor.ID)
+	})
+
+	t.Run("should not set next cursor on the last page", func(t *testing.T) {
+		rows := []VulnerabilityCursorRow{{}, {}}
+
+		response := (&ResponseVulnerabilitiesByFile{}).SetData(rows, &Filter{Size: 2, CursorPagination: true})
+		assert.Len(t, response.Data, 2)
+		assert.Empty(t, response.NextCursor)
+	})
+
+	t.Run("should set empty data when there are no rows", func(t *testing.T) {
+		response := (&ResponseVulnerabilitiesByFile{}).SetData(nil, &Filter{Size: 2, CursorPagination: true})
+		assert.NotNil(t, response.Data)
+		assert.Empty(t, response.Data)
+	})
+}
+
+func TestResponseFilesVulnerableSetData(t *testing.T) {
+	t.Run("should set all rows when not using cursor", func(t *testing.T) {
+		rows := []FileCursorRow{{}, {}, {}}
+
+		response := (&ResponseFilesVulnerable{}).SetData(rows, &Filter{Size: 2})
+		assert.Len(t, response.Data, 3)
+		assert.Empty(t, response.NextCursor)
+	})
+
+	t.Run("should remove the extra row and set next cursor from the last row of the page", func(t *testing.T) {
+		id := uuid.New()
+		rows := []FileCursorRow{
+			{ResponseDataFilesVulnerable: ResponseDataFilesVulnerable{File: "main.go", AnalysisID: id},
+				CursorValue: "10"},
+			{CursorValue: "5"},
+		}
+
+		response := (&ResponseFilesVulnerable{}).SetData(rows, &Filter{Size: 1, CursorPagination: true})
+		assert.Len(t, response.Data, 1)
+
+		cursor := &Cursor{}
+		assert.NoError(t, cursor.Decode(response.NextCursor))
+		assert.Equal(t, "10", cursor.Value)
+		assert.Equal(t, "main.go", cursor.File)
+		assert.Equal(t, id, cursor.ID)
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"encoding/json"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+)
+
+// RevisedAnalysis is an analysis published again after being changed, the revision allows the consumers to ignore
+// packets of the same analysis that are replayed or received out of order
+type RevisedAnalysis struct {
+	*analysisEntities.Analysis
+	Revision int `json:"revision"`
+}
+
+func NewRevisedAnalysis(analysis *analysisEntities.Analysis, revision int) *RevisedAnalysis {
+	return &RevisedAnalysis{
+		Analysis: analysis,
+		Revision: revision,
+	}
+}
+
+func (r *RevisedAnalysis) ToBytes() []byte {
+	bytes, _ := json.Marshal(r)
+
+	return bytes
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"encoding/json"
+	"testing"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRevisedAnalysis(t *testing.T) {
+	t.Run("should create a revised analysis", func(t *testing.T) {
+		analysis := &analysisEntities.Analysis{ID: uuid.New()}
+
+		revised := NewRevisedAnalysis(analysis, 2)
+		assert.Equal(t, analysis, revised.Analysis)
+		assert.Equal(t, 2, revised.Revision)
+	})
+}
+
+func TestRevisedAnalysisToBytes(t *testing.T) {
+	t.Run("should keep the analysis fields and add the revision", func(t *testing.T) {
+		analysis := &analysisEntities.Analysis{ID: uuid.New(), RepositoryName: "test"}
+
+		bytes := NewRevisedAnalysis(analysis, 3).ToBytes()
+
+		parsedAnalysis := &analysisEntities.Analysis{}
+		assert.NoError(t, json.Unmarshal(bytes, parsedAnalysis))
+		assert.Equal(t, analysis.ID, parsedAnalysis.ID)
+		assert.Equal(t, "test", parsedAnalysis.RepositoryName)
+
+		parsedRevision := map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal(bytes, &parsedRevision))
+		assert.Equal(t, float64(3), parsedRevision["revision"])
+	})
+}
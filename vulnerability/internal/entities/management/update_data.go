@@ -16,11 +16,23 @@ package management
 
 import (
 	"github.com/google/uuid"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
 )
 
+// IAccountData is implemented by the updates that keep the account that changed the vulnerabilities
+type IAccountData interface {
+	SetAccountData(accountID uuid.UUID, email, username string)
+}
+
 type UpdateData struct {
 	AnalysisID      uuid.UUID            `json:"analysisID"`
 	Vulnerabilities []*VulnerabilityData `json:"vulnerabilities"`
+	AccountID       uuid.UUID            `json:"-"`
+	AccountEmail    string               `json:"-"`
+	AccountUsername string               `json:"-"`
 }
 
 func (u *UpdateData) Validate() error {
@@ -32,3 +44,36 @@ func (u *UpdateData) Validate() error {
 
 	return nil
 }
+
+// SetAccountData sets the account that is updating the vulnerabilities, used to keep the history of the changes
+func (u *UpdateData) SetAccountData(accountID uuid.UUID, email, username string) {
+	u.AccountID = accountID
+	u.AccountEmail = email
+	u.AccountUsername = username
+}
+
+// ToPropagationData returns the triage of a vulnerability as a change of the same vulnerability in the other
+// repositories, keeping their severities and referencing the triaged vulnerability in their history
+func (u *UpdateData) ToPropagationData(data *VulnerabilityData) *BulkUpdateData {
+	vulnerabilityID := data.VulnerabilityID
+
+	return &BulkUpdateData{
+		Type:             data.Type,
+		Justification:    data.Justification,
+		ExpiresAt:        data.ExpiresAt,
+		Approver:         data.Approver,
+		PropagatedFromID: &vulnerabilityID,
+		AccountID:        u.AccountID,
+		AccountEmail:     u.AccountEmail,
+		AccountUsername:  u.AccountUsername,
+	}
+}
+
+func (u *UpdateData) ToWebhookEvent(analysis *analysisEntities.Analysis) *WebhookEvent {
+	return &WebhookEvent{
+		Type:         managementEnums.WebhookEventVulnerabilityStatusChanged,
+		WorkspaceID:  analysis.WorkspaceID,
+		RepositoryID: analysis.RepositoryID,
+		Payload:      u,
+	}
+}
@@ -15,13 +15,18 @@
 package management
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
 	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
 )
 
 func TestValidateUpdateData(t *testing.T) {
@@ -33,6 +38,7 @@ func TestValidateUpdateData(t *testing.T) {
 					VulnerabilityID: uuid.New(),
 					Severity:        severities.Critical,
 					Type:            vulnerabilityEnums.Vulnerability,
+					Justification:   "test",
 				},
 			},
 		}
@@ -40,3 +46,59 @@ func TestValidateUpdateData(t *testing.T) {
 		assert.NoError(t, filter.Validate())
 	})
 }
+
+func TestSetAccountData(t *testing.T) {
+	t.Run("should set account data and not expose it on json", func(t *testing.T) {
+		data := &UpdateData{}
+		accountID := uuid.New()
+
+		data.SetAccountData(accountID, "test@horusec.io", "test")
+		assert.Equal(t, accountID, data.AccountID)
+		assert.Equal(t, "test@horusec.io", data.AccountEmail)
+		assert.Equal(t, "test", data.AccountUsername)
+
+		bytes, err := json.Marshal(data)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(bytes), "test@horusec.io")
+	})
+}
+
+func TestToWebhookEvent(t *testing.T) {
+	t.Run("should parse update data to vulnerability status changed event", func(t *testing.T) {
+		data := &UpdateData{AnalysisID: uuid.New()}
+		analysis := &analysisEntities.Analysis{WorkspaceID: uuid.New(), RepositoryID: uuid.New()}
+
+		event := data.ToWebhookEvent(analysis)
+		assert.Equal(t, managementEnums.WebhookEventVulnerabilityStatusChanged, event.Type)
+		assert.Equal(t, analysis.WorkspaceID, event.WorkspaceID)
+		assert.Equal(t, analysis.RepositoryID, event.RepositoryID)
+		assert.Equal(t, data, event.Payload)
+	})
+}
+
+func TestToPropagationData(t *testing.T) {
+	t.Run("should return the triage of the vulnerability without its severity", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour)
+		data := &VulnerabilityData{
+			VulnerabilityID: uuid.New(),
+			Severity:        severities.Low,
+			Type:            vulnerabilityEnums.RiskAccepted,
+			Justification:   "test",
+			ExpiresAt:       &expiresAt,
+			Approver:        "security@horusec.io",
+		}
+		updateData := &UpdateData{}
+		updateData.SetAccountData(uuid.New(), "test@horusec.io", "test")
+
+		result := updateData.ToPropagationData(data)
+		assert.Empty(t, result.Severity)
+		assert.Equal(t, vulnerabilityEnums.RiskAccepted, result.Type)
+		assert.Equal(t, "test", result.Justification)
+		assert.Equal(t, &expiresAt, result.ExpiresAt)
+		assert.Equal(t, "security@horusec.io", result.Approver)
+		assert.Equal(t, data.VulnerabilityID, *result.PropagatedFromID)
+		assert.Equal(t, updateData.AccountID, result.AccountID)
+		assert.Equal(t, "test@horusec.io", result.AccountEmail)
+		assert.Equal(t, "test", result.AccountUsername)
+	})
+}
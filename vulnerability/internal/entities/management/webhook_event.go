@@ -0,0 +1,34 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type WebhookEvent struct {
+	Type         string      `json:"type"`
+	WorkspaceID  uuid.UUID   `json:"workspaceID"`
+	RepositoryID uuid.UUID   `json:"repositoryID"`
+	Payload      interface{} `json:"payload"`
+}
+
+func (w *WebhookEvent) ToBytes() []byte {
+	bytes, _ := json.Marshal(w)
+
+	return bytes
+}
@@ -0,0 +1,30 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookEventToBytes(t *testing.T) {
+	t.Run("should parse webhook event to bytes", func(t *testing.T) {
+		event := &WebhookEvent{Type: "vulnerability-status-changed", WorkspaceID: uuid.New()}
+
+		assert.Contains(t, string(event.ToBytes()), `"type":"vulnerability-status-changed"`)
+	})
+}
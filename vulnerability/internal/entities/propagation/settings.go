@@ -0,0 +1,109 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
+)
+
+// Settings is the opt-in of a workspace to carry the triage of a vulnerability to the same vulnerability found in
+// the other repositories of the workspace, including its forks
+type Settings struct {
+	WorkspaceID     uuid.UUID `json:"workspaceID" gorm:"Column:workspace_id"`
+	IsEnabled       bool      `json:"isEnabled" gorm:"Column:is_enabled"`
+	MatchBy         string    `json:"matchBy" gorm:"Column:match_by" example:"hash" enums:"hash, code"`
+	AccountID       uuid.UUID `json:"accountID" gorm:"Column:account_id"`
+	AccountEmail    string    `json:"accountEmail" gorm:"Column:account_email"`
+	AccountUsername string    `json:"accountUsername" gorm:"Column:account_username"`
+	UpdatedAt       time.Time `json:"updatedAt" gorm:"Column:updated_at"`
+}
+
+// NewDefaultSettings returns the settings of the workspaces that never changed them, with the propagation disabled
+func NewDefaultSettings(workspaceID uuid.UUID) *Settings {
+	return &Settings{
+		WorkspaceID: workspaceID,
+		MatchBy:     propagationEnums.MatchByHash,
+	}
+}
+
+func (s *Settings) ToFilter() map[string]interface{} {
+	return map[string]interface{}{"workspace_id": s.WorkspaceID}
+}
+
+// ToUpdateMap returns the values of the settings as a map, since a disabled propagation is ignored when updating
+// with the struct
+func (s *Settings) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"is_enabled":       s.IsEnabled,
+		"match_by":         s.MatchBy,
+		"account_id":       s.AccountID,
+		"account_email":    s.AccountEmail,
+		"account_username": s.AccountUsername,
+		"updated_at":       s.UpdatedAt,
+	}
+}
+
+type SettingsData struct {
+	WorkspaceID     uuid.UUID `json:"-"`
+	IsEnabled       bool      `json:"isEnabled"`
+	MatchBy         string    `json:"matchBy" example:"hash" enums:"hash, code"`
+	AccountID       uuid.UUID `json:"-"`
+	AccountEmail    string    `json:"-"`
+	AccountUsername string    `json:"-"`
+}
+
+func (s *SettingsData) Validate() error {
+	return validation.ValidateStruct(s,
+		validation.Field(&s.WorkspaceID, validation.Required, validation.NotIn(uuid.Nil.String())),
+		validation.Field(&s.MatchBy, validation.Required,
+			validation.In(propagationEnums.MatchByHash, propagationEnums.MatchByCode)),
+	)
+}
+
+// SetDataFromRequest sets the workspace only from the url, so the workspace sent in the body is ignored
+func (s *SettingsData) SetDataFromRequest(r *http.Request) (err error) {
+	if s.WorkspaceID, err = uuid.Parse(chi.URLParam(r, managementEnums.WorkspaceID)); err != nil {
+		return managementEnums.ErrorInvalidWorkspaceID
+	}
+
+	return nil
+}
+
+// SetAccountData sets the account that is changing the settings, kept to know who enabled the propagation
+func (s *SettingsData) SetAccountData(accountID uuid.UUID, email, username string) {
+	s.AccountID = accountID
+	s.AccountEmail = email
+	s.AccountUsername = username
+}
+
+func (s *SettingsData) ToSettings() *Settings {
+	return &Settings{
+		WorkspaceID:     s.WorkspaceID,
+		IsEnabled:       s.IsEnabled,
+		MatchBy:         s.MatchBy,
+		AccountID:       s.AccountID,
+		AccountEmail:    s.AccountEmail,
+		AccountUsername: s.AccountUsername,
+		UpdatedAt:       time.Now(),
+	}
+}
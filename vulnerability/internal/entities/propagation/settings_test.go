@@ -0,0 +1,127 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
+)
+
+func newRequest(workspaceID, vulnerabilityID string) *http.Request {
+	r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("workspaceID", workspaceID)
+	ctx.URLParams.Add("vulnerabilityID", vulnerabilityID)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func TestNewDefaultSettings(t *testing.T) {
+	t.Run("should create disabled settings matching by hash", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		settings := NewDefaultSettings(workspaceID)
+		assert.Equal(t, workspaceID, settings.WorkspaceID)
+		assert.False(t, settings.IsEnabled)
+		assert.Equal(t, propagationEnums.MatchByHash, settings.MatchBy)
+	})
+}
+
+func TestSettingsToFilter(t *testing.T) {
+	t.Run("should filter by workspace", func(t *testing.T) {
+		settings := &Settings{WorkspaceID: uuid.New()}
+
+		assert.Equal(t, map[string]interface{}{"workspace_id": settings.WorkspaceID}, settings.ToFilter())
+	})
+}
+
+func TestSettingsToUpdateMap(t *testing.T) {
+	t.Run("should keep disabled propagation in the update map", func(t *testing.T) {
+		settings := &Settings{MatchBy: propagationEnums.MatchByCode}
+
+		updateMap := settings.ToUpdateMap()
+		assert.Len(t, updateMap, 6)
+		assert.Equal(t, false, updateMap["is_enabled"])
+		assert.Equal(t, propagationEnums.MatchByCode, updateMap["match_by"])
+	})
+}
+
+func TestSettingsDataValidate(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		data := &SettingsData{WorkspaceID: uuid.New(), IsEnabled: true, MatchBy: propagationEnums.MatchByCode}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid match by", func(t *testing.T) {
+		data := &SettingsData{WorkspaceID: uuid.New(), MatchBy: "test"}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when without match by", func(t *testing.T) {
+		data := &SettingsData{WorkspaceID: uuid.New()}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when without workspace", func(t *testing.T) {
+		data := &SettingsData{MatchBy: propagationEnums.MatchByHash}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestSettingsDataSetDataFromRequest(t *testing.T) {
+	t.Run("should set workspace from the url", func(t *testing.T) {
+		workspaceID := uuid.New()
+		data := &SettingsData{WorkspaceID: uuid.New()}
+
+		assert.NoError(t, data.SetDataFromRequest(newRequest(workspaceID.String(), "")))
+		assert.Equal(t, workspaceID, data.WorkspaceID)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		data := &SettingsData{}
+
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID, data.SetDataFromRequest(newRequest("test", "")))
+	})
+}
+
+func TestSettingsDataToSettings(t *testing.T) {
+	t.Run("should create settings with the account data", func(t *testing.T) {
+		accountID := uuid.New()
+		data := &SettingsData{WorkspaceID: uuid.New(), IsEnabled: true, MatchBy: propagationEnums.MatchByHash}
+		data.SetAccountData(accountID, "test@horusec.io", "test")
+
+		settings := data.ToSettings()
+		assert.Equal(t, data.WorkspaceID, settings.WorkspaceID)
+		assert.True(t, settings.IsEnabled)
+		assert.Equal(t, propagationEnums.MatchByHash, settings.MatchBy)
+		assert.Equal(t, accountID, settings.AccountID)
+		assert.Equal(t, "test@horusec.io", settings.AccountEmail)
+		assert.Equal(t, "test", settings.AccountUsername)
+		assert.False(t, settings.UpdatedAt.IsZero())
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
+)
+
+// Source is the workspace and repository of a triaged vulnerability with the propagation settings of the workspace
+type Source struct {
+	WorkspaceID  uuid.UUID `gorm:"Column:workspace_id"`
+	RepositoryID uuid.UUID `gorm:"Column:repository_id"`
+	IsEnabled    bool      `gorm:"Column:is_enabled"`
+	MatchBy      string    `gorm:"Column:match_by"`
+}
+
+// GetMatchQuery returns a condition matching the vulnerabilities with the triaged one, named source. Matching by
+// code compares the code without its whitespace differences and the file name without its folders, so the same
+// finding is matched in forks with other formatting or folder structure
+func (s *Source) GetMatchQuery() string {
+	if s.MatchBy != propagationEnums.MatchByCode {
+		return "vulnerabilities.vuln_hash = source.vuln_hash"
+	}
+
+	return fmt.Sprintf("source.code <> '' AND %s = %s AND %s = %s",
+		s.getNormalizedCode("vulnerabilities"), s.getNormalizedCode("source"),
+		s.getFileName("vulnerabilities"), s.getFileName("source"))
+}
+
+func (s *Source) getNormalizedCode(table string) string {
+	return fmt.Sprintf(`btrim(regexp_replace(%s.code, '\s+', ' ', 'g'))`, table)
+}
+
+func (s *Source) getFileName(table string) string {
+	return fmt.Sprintf(`regexp_replace(%s.file, '^.*/', '')`, table)
+}
@@ -0,0 +1,43 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
+)
+
+func TestSourceGetMatchQuery(t *testing.T) {
+	t.Run("should match by vulnerability hash", func(t *testing.T) {
+		source := &Source{MatchBy: propagationEnums.MatchByHash}
+
+		assert.Equal(t, "vulnerabilities.vuln_hash = source.vuln_hash", source.GetMatchQuery())
+	})
+
+	t.Run("should match by normalized code and file name", func(t *testing.T) {
+		source := &Source{MatchBy: propagationEnums.MatchByCode}
+
+		query := source.GetMatchQuery()
+		assert.Contains(t, query, "source.code <> ''")
+		assert.Contains(t, query, `btrim(regexp_replace(vulnerabilities.code, '\s+', ' ', 'g')) = `+
+			`btrim(regexp_replace(source.code, '\s+', ' ', 'g'))`)
+		assert.Contains(t, query, "regexp_replace(vulnerabilities.file, '^.*/', '') = "+
+			"regexp_replace(source.file, '^.*/', '')")
+		assert.NotContains(t, query, "vuln_hash")
+	})
+}
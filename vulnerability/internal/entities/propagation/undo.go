@@ -0,0 +1,80 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
+)
+
+// PropagatedVulnerability is a vulnerability whose latest change was the propagation of the triage of another
+// vulnerability, with the type it had before the propagation
+type PropagatedVulnerability struct {
+	managementEntities.BulkVulnerability
+	OldType      vulnerabilityEnums.Type `json:"oldType" gorm:"Column:old_type" example:"Vulnerability"`
+	PropagatedAt time.Time               `json:"propagatedAt" gorm:"Column:propagated_at"`
+}
+
+// UndoData identifies the triaged vulnerability whose propagations are reverted, the vulnerabilities changed after
+// the propagation are kept as they are
+type UndoData struct {
+	managementEntities.HistoryFilter
+	AccountID       uuid.UUID `json:"-"`
+	AccountEmail    string    `json:"-"`
+	AccountUsername string    `json:"-"`
+}
+
+func (u *UndoData) SetDataFromRequest(r *http.Request) error {
+	return u.HistoryFilter.SetDataFromRequest(r)
+}
+
+// SetAccountData sets the account that is reverting the propagation, used to keep the history of the changes
+func (u *UndoData) SetAccountData(accountID uuid.UUID, email, username string) {
+	u.AccountID = accountID
+	u.AccountEmail = email
+	u.AccountUsername = username
+}
+
+// ToBulkUpdateData returns the change that restores the vulnerabilities to the type they had before the propagation
+func (u *UndoData) ToBulkUpdateData(oldType vulnerabilityEnums.Type) *managementEntities.BulkUpdateData {
+	return &managementEntities.BulkUpdateData{
+		Type:            oldType,
+		Justification:   fmt.Sprintf(propagationEnums.UndoJustification, u.VulnerabilityID),
+		AccountID:       u.AccountID,
+		AccountEmail:    u.AccountEmail,
+		AccountUsername: u.AccountUsername,
+	}
+}
+
+// GroupByOldType groups the propagated vulnerabilities by the type they should be restored to
+func GroupByOldType(
+	vulnerabilities []PropagatedVulnerability) map[vulnerabilityEnums.Type][]managementEntities.BulkVulnerability {
+	groups := map[vulnerabilityEnums.Type][]managementEntities.BulkVulnerability{}
+
+	for index := range vulnerabilities {
+		groups[vulnerabilities[index].OldType] = append(groups[vulnerabilities[index].OldType],
+			vulnerabilities[index].BulkVulnerability)
+	}
+
+	return groups
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
+)
+
+func TestUndoDataSetDataFromRequest(t *testing.T) {
+	t.Run("should set workspace and vulnerability from the url", func(t *testing.T) {
+		workspaceID := uuid.New()
+		vulnerabilityID := uuid.New()
+		data := &UndoData{}
+
+		assert.NoError(t, data.SetDataFromRequest(newRequest(workspaceID.String(), vulnerabilityID.String())))
+		assert.Equal(t, workspaceID, data.WorkspaceID)
+		assert.Equal(t, vulnerabilityID, data.VulnerabilityID)
+	})
+
+	t.Run("should return error when invalid vulnerability id", func(t *testing.T) {
+		data := &UndoData{}
+
+		assert.Equal(t, managementEnums.ErrorInvalidVulnerabilityID,
+			data.SetDataFromRequest(newRequest(uuid.NewString(), "test")))
+	})
+}
+
+func TestUndoDataToBulkUpdateData(t *testing.T) {
+	t.Run("should restore the old type with the account of the undo", func(t *testing.T) {
+		accountID := uuid.New()
+		data := &UndoData{}
+		data.VulnerabilityID = uuid.New()
+		data.SetAccountData(accountID, "test@horusec.io", "test")
+
+		bulkData := data.ToBulkUpdateData(vulnerabilityEnums.Vulnerability)
+		assert.Equal(t, vulnerabilityEnums.Vulnerability, bulkData.Type)
+		assert.Empty(t, bulkData.Severity)
+		assert.Equal(t, fmt.Sprintf(propagationEnums.UndoJustification, data.VulnerabilityID),
+			bulkData.Justification)
+		assert.Nil(t, bulkData.PropagatedFromID)
+		assert.Equal(t, accountID, bulkData.AccountID)
+		assert.Equal(t, "test@horusec.io", bulkData.AccountEmail)
+		assert.Equal(t, "test", bulkData.AccountUsername)
+	})
+}
+
+func TestGroupByOldType(t *testing.T) {
+	t.Run("should group the vulnerabilities by old type", func(t *testing.T) {
+		vulnerabilities := []PropagatedVulnerability{
+			{OldType: vulnerabilityEnums.Vulnerability},
+			{OldType: vulnerabilityEnums.Corrected},
+			{OldType: vulnerabilityEnums.Vulnerability},
+		}
+
+		groups := GroupByOldType(vulnerabilities)
+		assert.Len(t, groups, 2)
+		assert.Len(t, groups[vulnerabilityEnums.Vulnerability], 2)
+		assert.Len(t, groups[vulnerabilityEnums.Corrected], 1)
+	})
+
+	t.Run("should return empty groups when there are no vulnerabilities", func(t *testing.T) {
+		assert.Empty(t, GroupByOldType(nil))
+	})
+}
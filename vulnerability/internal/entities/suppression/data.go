@@ -0,0 +1,90 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"net/http"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+	suppressionEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/suppression"
+)
+
+type RuleData struct {
+	Scope           `swaggerignore:"true"`
+	Name            string                  `json:"name" example:"test files"`
+	FilePattern     string                  `json:"filePattern" example:"test/**"`
+	Details         string                  `json:"details" example:"HS-GO-1"`
+	SecurityTool    string                  `json:"securityTool" example:"HorusecEngine"`
+	Language        string                  `json:"language" example:"Go"`
+	VulnHash        string                  `json:"vulnHash"`
+	Type            vulnerabilityEnums.Type `json:"type" example:"False Positive" enums:"False Positive, Risk Accepted"`
+	Justification   string                  `json:"justification" example:"test files, not used in production"`
+	IsActive        bool                    `json:"isActive"`
+	AccountID       uuid.UUID               `json:"-"`
+	AccountEmail    string                  `json:"-"`
+	AccountUsername string                  `json:"-"`
+}
+
+func (r *RuleData) Validate() error {
+	if !r.hasMatchers() {
+		return suppressionEnums.ErrorRuleWithoutMatchers
+	}
+
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Name, validation.Required, validation.Length(1, suppressionEnums.MaxNameLength)),
+		validation.Field(&r.FilePattern, validation.Length(0, suppressionEnums.MaxMatcherLength)),
+		validation.Field(&r.Details, validation.Length(0, suppressionEnums.MaxMatcherLength)),
+		validation.Field(&r.SecurityTool, validation.Length(0, suppressionEnums.MaxNameLength)),
+		validation.Field(&r.Language, validation.Length(0, suppressionEnums.MaxNameLength)),
+		validation.Field(&r.VulnHash, validation.Length(0, suppressionEnums.MaxNameLength)),
+		validation.Field(&r.Type, validation.Required,
+			validation.In(vulnerabilityEnums.FalsePositive, vulnerabilityEnums.RiskAccepted)),
+		validation.Field(&r.Justification, validation.Required,
+			validation.Length(1, managementEnums.MaxJustificationLength)),
+	)
+}
+
+func (r *RuleData) hasMatchers() bool {
+	return r.FilePattern != "" || r.Details != "" || r.SecurityTool != "" || r.Language != "" || r.VulnHash != ""
+}
+
+func (r *RuleData) SetDataFromRequest(request *http.Request) error {
+	return r.Scope.SetDataFromRequest(request)
+}
+
+// SetAccountData sets the account that is saving the rule, registered in the history of the classified
+// vulnerabilities
+func (r *RuleData) SetAccountData(accountID uuid.UUID, email, username string) {
+	r.AccountID = accountID
+	r.AccountEmail = email
+	r.AccountUsername = username
+}
+
+func (r *RuleData) ToRule() *Rule {
+	rule := &Rule{
+		RuleID:       uuid.New(),
+		WorkspaceID:  r.WorkspaceID,
+		RepositoryID: r.GetRepositoryID(),
+		CreatedAt:    time.Now(),
+	}
+
+	return rule.Update(r)
+}
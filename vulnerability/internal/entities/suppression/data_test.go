@@ -0,0 +1,111 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	suppressionEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/suppression"
+)
+
+func newRuleData() *RuleData {
+	return &RuleData{
+		Name:          "test",
+		FilePattern:   "test/**",
+		Type:          vulnerabilityEnums.FalsePositive,
+		Justification: "test",
+		IsActive:      true,
+	}
+}
+
+func TestRuleDataValidate(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		assert.NoError(t, newRuleData().Validate())
+	})
+
+	t.Run("should return error when without matchers", func(t *testing.T) {
+		data := newRuleData()
+		data.FilePattern = ""
+
+		assert.Equal(t, suppressionEnums.ErrorRuleWithoutMatchers, data.Validate())
+	})
+
+	t.Run("should return error when type is not a classification", func(t *testing.T) {
+		data := newRuleData()
+		data.Type = vulnerabilityEnums.Vulnerability
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when without name or justification", func(t *testing.T) {
+		data := newRuleData()
+		data.Name = ""
+
+		assert.Error(t, data.Validate())
+
+		data = newRuleData()
+		data.Justification = ""
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when matcher is too long", func(t *testing.T) {
+		data := newRuleData()
+		data.Details = strings.Repeat("a", suppressionEnums.MaxMatcherLength+1)
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestRuleDataSetDataFromRequest(t *testing.T) {
+	t.Run("should set scope of the rule", func(t *testing.T) {
+		workspaceID := uuid.New()
+		data := newRuleData()
+
+		assert.NoError(t, data.SetDataFromRequest(newRequest(workspaceID.String(), "", "")))
+		assert.Equal(t, workspaceID, data.WorkspaceID)
+	})
+}
+
+func TestRuleDataToRule(t *testing.T) {
+	t.Run("should create workspace rule when workspace scope", func(t *testing.T) {
+		data := newRuleData()
+		data.WorkspaceID = uuid.New()
+		data.SetAccountData(uuid.New(), "test@horusec.io", "test")
+
+		rule := data.ToRule()
+		assert.NotEqual(t, uuid.Nil, rule.RuleID)
+		assert.Equal(t, data.WorkspaceID, rule.WorkspaceID)
+		assert.Nil(t, rule.RepositoryID)
+		assert.Equal(t, "test/**", rule.FilePattern)
+		assert.Equal(t, data.AccountID, rule.AccountID)
+		assert.Equal(t, "test", rule.AccountUsername)
+		assert.NotEmpty(t, rule.CreatedAt)
+	})
+
+	t.Run("should create repository rule when repository scope", func(t *testing.T) {
+		data := newRuleData()
+		data.RepositoryID = uuid.New()
+
+		rule := data.ToRule()
+		assert.Equal(t, data.RepositoryID, *rule.RepositoryID)
+	})
+}
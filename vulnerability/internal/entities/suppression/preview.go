@@ -0,0 +1,70 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/pagination"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+	suppressionEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/suppression"
+)
+
+// PreviewData is a rule not saved yet, used to list the unclassified vulnerabilities of the latest analyses of
+// the scope that would be classified by it when found again
+type PreviewData struct {
+	RuleData
+	Page int `json:"-"`
+	Size int `json:"-"`
+}
+
+// Validate only checks the matchers, since the preview is used while the rule is still being written
+func (p *PreviewData) Validate() error {
+	if !p.hasMatchers() {
+		return suppressionEnums.ErrorRuleWithoutMatchers
+	}
+
+	return nil
+}
+
+// SetPaginationFromRequest sets the page and size of the preview, the first page is used when not informed
+func (p *PreviewData) SetPaginationFromRequest(r *http.Request) {
+	p.Page, _ = strconv.Atoi(r.URL.Query().Get(managementEnums.Page))
+	p.Size, _ = strconv.Atoi(r.URL.Query().Get(managementEnums.Size))
+
+	if p.Page < 1 {
+		p.Page = 1
+	}
+
+	if p.Size < 1 {
+		p.Size = suppressionEnums.DefaultPreviewSize
+	}
+}
+
+func (p *PreviewData) GetPaginationParams() []interface{} {
+	return []interface{}{
+		sql.Named("size", p.Size),
+		sql.Named("skip", pagination.GetSkip(int64(p.Page), int64(p.Size))),
+	}
+}
+
+type PreviewResponse struct {
+	TotalItems int                                   `json:"totalItems"`
+	Data       []vulnerabilityEntities.Vulnerability `json:"data"`
+}
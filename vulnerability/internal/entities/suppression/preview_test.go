@@ -0,0 +1,63 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	suppressionEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/suppression"
+)
+
+func TestPreviewDataValidate(t *testing.T) {
+	t.Run("should return no error when only matchers are informed", func(t *testing.T) {
+		data := &PreviewData{RuleData: RuleData{Details: "test"}}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when without matchers", func(t *testing.T) {
+		data := &PreviewData{RuleData: RuleData{Name: "test"}}
+
+		assert.Equal(t, suppressionEnums.ErrorRuleWithoutMatchers, data.Validate())
+	})
+}
+
+func TestPreviewDataSetPaginationFromRequest(t *testing.T) {
+	t.Run("should set page and size from request", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test?page=2&size=5", nil)
+		data := &PreviewData{}
+
+		data.SetPaginationFromRequest(r)
+		assert.Equal(t, 2, data.Page)
+		assert.Equal(t, 5, data.Size)
+
+		params := data.GetPaginationParams()
+		assert.Equal(t, sql.Named("size", 5), params[0])
+		assert.Equal(t, sql.Named("skip", int64(5)), params[1])
+	})
+
+	t.Run("should set default pagination when not informed", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		data := &PreviewData{}
+
+		data.SetPaginationFromRequest(r)
+		assert.Equal(t, 1, data.Page)
+		assert.Equal(t, suppressionEnums.DefaultPreviewSize, data.Size)
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+)
+
+// Rule classifies the vulnerabilities found by new analyses that match all its informed matchers, workspace rules
+// are applied to all repositories of the workspace
+type Rule struct {
+	RuleID          uuid.UUID               `json:"ruleID" gorm:"Column:rule_id"`
+	WorkspaceID     uuid.UUID               `json:"workspaceID" gorm:"Column:workspace_id"`
+	RepositoryID    *uuid.UUID              `json:"repositoryID" gorm:"Column:repository_id"`
+	Name            string                  `json:"name" gorm:"Column:name"`
+	FilePattern     string                  `json:"filePattern" gorm:"Column:file_pattern"`
+	Details         string                  `json:"details" gorm:"Column:details"`
+	SecurityTool    string                  `json:"securityTool" gorm:"Column:security_tool"`
+	Language        string                  `json:"language" gorm:"Column:language"`
+	VulnHash        string                  `json:"vulnHash" gorm:"Column:vuln_hash"`
+	Type            vulnerabilityEnums.Type `json:"type" gorm:"Column:type" example:"False Positive"`
+	Justification   string                  `json:"justification" gorm:"Column:justification"`
+	IsActive        bool                    `json:"isActive" gorm:"Column:is_active"`
+	AccountID       uuid.UUID               `json:"accountID" gorm:"Column:account_id"`
+	AccountEmail    string                  `json:"accountEmail" gorm:"Column:account_email"`
+	AccountUsername string                  `json:"accountUsername" gorm:"Column:account_username"`
+	CreatedAt       time.Time               `json:"createdAt" gorm:"Column:created_at"`
+	UpdatedAt       time.Time               `json:"updatedAt" gorm:"Column:updated_at"`
+}
+
+// Update replaces the matchers and classification of the rule, the account is replaced by the one of the update
+// since it is the account registered in the history of the vulnerabilities classified by the rule
+func (r *Rule) Update(data *RuleData) *Rule {
+	r.Name = data.Name
+	r.FilePattern = data.FilePattern
+	r.Details = data.Details
+	r.SecurityTool = data.SecurityTool
+	r.Language = data.Language
+	r.VulnHash = data.VulnHash
+	r.Type = data.Type
+	r.Justification = data.Justification
+	r.IsActive = data.IsActive
+	r.AccountID = data.AccountID
+	r.AccountEmail = data.AccountEmail
+	r.AccountUsername = data.AccountUsername
+	r.UpdatedAt = time.Now()
+
+	return r
+}
+
+func (r *Rule) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"name":             r.Name,
+		"file_pattern":     r.FilePattern,
+		"details":          r.Details,
+		"security_tool":    r.SecurityTool,
+		"language":         r.Language,
+		"vuln_hash":        r.VulnHash,
+		"type":             r.Type,
+		"justification":    r.Justification,
+		"is_active":        r.IsActive,
+		"account_id":       r.AccountID,
+		"account_email":    r.AccountEmail,
+		"account_username": r.AccountUsername,
+		"updated_at":       r.UpdatedAt,
+	}
+}
+
+// GetMatchQuery returns the condition of the unclassified vulnerabilities matched by the rule, the file pattern is
+// a glob and the details are matched when containing the text, case insensitive
+func (r *Rule) GetMatchQuery() (string, []interface{}) {
+	query := "vulnerabilities.type = @unclassifiedType"
+
+	if r.FilePattern != "" {
+		query += " AND vulnerabilities.file ~ @filePattern"
+	}
+
+	if r.Details != "" {
+		query += " AND vulnerabilities.details ILIKE @details"
+	}
+
+	if r.SecurityTool != "" {
+		query += " AND vulnerabilities.security_tool = @securityTool"
+	}
+
+	if r.Language != "" {
+		query += " AND vulnerabilities.language = @language"
+	}
+
+	if r.VulnHash != "" {
+		query += " AND vulnerabilities.vuln_hash = @vulnHash"
+	}
+
+	return query, r.getMatchParams()
+}
+
+func (r *Rule) getMatchParams() []interface{} {
+	return []interface{}{
+		sql.Named("unclassifiedType", vulnerabilityEnums.Vulnerability),
+		sql.Named("filePattern", managementEntities.GlobToRegex(r.FilePattern)),
+		sql.Named("details", "%"+r.Details+"%"),
+		sql.Named("securityTool", r.SecurityTool),
+		sql.Named("language", r.Language),
+		sql.Named("vulnHash", r.VulnHash),
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+)
+
+func TestRuleUpdate(t *testing.T) {
+	t.Run("should replace matchers, classification and account of the rule", func(t *testing.T) {
+		rule := &Rule{Name: "old", FilePattern: "old/**", Type: vulnerabilityEnums.RiskAccepted}
+		data := &RuleData{Name: "test", Details: "HS-GO-1", Type: vulnerabilityEnums.FalsePositive,
+			Justification: "test", IsActive: true, AccountID: uuid.New(), AccountEmail: "test@horusec.io"}
+
+		rule.Update(data)
+
+		assert.Equal(t, "test", rule.Name)
+		assert.Empty(t, rule.FilePattern)
+		assert.Equal(t, "HS-GO-1", rule.Details)
+		assert.Equal(t, vulnerabilityEnums.FalsePositive, rule.Type)
+		assert.True(t, rule.IsActive)
+		assert.Equal(t, data.AccountID, rule.AccountID)
+		assert.Equal(t, "test@horusec.io", rule.AccountEmail)
+		assert.NotEmpty(t, rule.UpdatedAt)
+	})
+}
+
+func TestRuleToUpdateMap(t *testing.T) {
+	t.Run("should return all updatable columns", func(t *testing.T) {
+		rule := &Rule{Name: "test", IsActive: true}
+
+		updateMap := rule.ToUpdateMap()
+		assert.Len(t, updateMap, 13)
+		assert.Equal(t, "test", updateMap["name"])
+		assert.Equal(t, true, updateMap["is_active"])
+	})
+}
+
+func TestRuleGetMatchQuery(t *testing.T) {
+	t.Run("should match only unclassified vulnerabilities when without matchers", func(t *testing.T) {
+		rule := &Rule{}
+
+		query, params := rule.GetMatchQuery()
+		assert.Equal(t, "vulnerabilities.type = @unclassifiedType", query)
+		assert.Len(t, params, 6)
+		assert.Equal(t, sql.Named("unclassifiedType", vulnerabilityEnums.Vulnerability), params[0])
+	})
+
+	t.Run("should match by all informed matchers", func(t *testing.T) {
+		rule := &Rule{FilePattern: "test/**", Details: "HS-GO-1", SecurityTool: "HorusecEngine",
+			Language: "Go", VulnHash: "1234"}
+
+		query, params := rule.GetMatchQuery()
+		assert.Contains(t, query, "vulnerabilities.file ~ @filePattern")
+		assert.Contains(t, query, "vulnerabilities.details ILIKE @details")
+		assert.Contains(t, query, "vulnerabilities.security_tool = @securityTool")
+		assert.Contains(t, query, "vulnerabilities.language = @language")
+		assert.Contains(t, query, "vulnerabilities.vuln_hash = @vulnHash")
+		assert.Equal(t, sql.Named("filePattern", "^test/.*$"), params[1])
+		assert.Equal(t, sql.Named("details", "%HS-GO-1%"), params[2])
+	})
+}
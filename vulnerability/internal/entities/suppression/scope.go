@@ -0,0 +1,99 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+	suppressionEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/suppression"
+)
+
+// Scope identifies the rules of the request, when the repository is empty the rules of all repositories of the
+// workspace are reachable, otherwise only the rules of the repository
+type Scope struct {
+	WorkspaceID  uuid.UUID `json:"workspaceID"`
+	RepositoryID uuid.UUID `json:"repositoryID"`
+	RuleID       uuid.UUID `json:"ruleID"`
+}
+
+// SetDataFromRequest sets the scope only from the url, so the scope sent in the body of the request is ignored
+func (s *Scope) SetDataFromRequest(r *http.Request) (err error) {
+	s.RepositoryID = uuid.Nil
+
+	if s.WorkspaceID, err = uuid.Parse(chi.URLParam(r, managementEnums.WorkspaceID)); err != nil {
+		return managementEnums.ErrorInvalidWorkspaceID
+	}
+
+	if repositoryID := chi.URLParam(r, managementEnums.RepositoryID); repositoryID != "" {
+		if s.RepositoryID, err = uuid.Parse(repositoryID); err != nil {
+			return managementEnums.ErrorInvalidRepositoryID
+		}
+	}
+
+	return nil
+}
+
+func (s *Scope) SetRuleIDFromRequest(r *http.Request) (err error) {
+	if s.RuleID, err = uuid.Parse(chi.URLParam(r, suppressionEnums.RuleID)); err != nil {
+		return suppressionEnums.ErrorInvalidRuleID
+	}
+
+	return nil
+}
+
+func (s *Scope) IsRepositoryScope() bool {
+	return s.RepositoryID != uuid.Nil
+}
+
+// GetRepositoryID returns the repository of the rules created in the scope, nil for workspace rules
+func (s *Scope) GetRepositoryID() *uuid.UUID {
+	if !s.IsRepositoryScope() {
+		return nil
+	}
+
+	repositoryID := s.RepositoryID
+	return &repositoryID
+}
+
+func (s *Scope) ToFilter() map[string]interface{} {
+	filter := map[string]interface{}{
+		"rule_id":      s.RuleID,
+		"workspace_id": s.WorkspaceID,
+	}
+
+	if s.IsRepositoryScope() {
+		filter["repository_id"] = s.RepositoryID
+	}
+
+	return filter
+}
+
+// GetAnalysisQuery returns the condition of the analyses of the scope
+func (s *Scope) GetAnalysisQuery() (string, []interface{}) {
+	query := "analysis.workspace_id = @workspaceID"
+	if s.IsRepositoryScope() {
+		query += " AND analysis.repository_id = @repositoryID"
+	}
+
+	return query, []interface{}{
+		sql.Named("workspaceID", s.WorkspaceID),
+		sql.Named("repositoryID", s.RepositoryID),
+	}
+}
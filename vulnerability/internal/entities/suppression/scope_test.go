@@ -0,0 +1,133 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+	suppressionEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/suppression"
+)
+
+func newRequest(workspaceID, repositoryID, ruleID string) *http.Request {
+	r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("workspaceID", workspaceID)
+	ctx.URLParams.Add("repositoryID", repositoryID)
+	ctx.URLParams.Add("ruleID", ruleID)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func TestScopeSetDataFromRequest(t *testing.T) {
+	t.Run("should set workspace scope when without repository", func(t *testing.T) {
+		workspaceID := uuid.New()
+		scope := &Scope{}
+
+		assert.NoError(t, scope.SetDataFromRequest(newRequest(workspaceID.String(), "", "")))
+		assert.Equal(t, workspaceID, scope.WorkspaceID)
+		assert.False(t, scope.IsRepositoryScope())
+		assert.Nil(t, scope.GetRepositoryID())
+	})
+
+	t.Run("should set repository scope when with repository", func(t *testing.T) {
+		repositoryID := uuid.New()
+		scope := &Scope{}
+
+		assert.NoError(t, scope.SetDataFromRequest(newRequest(uuid.NewString(), repositoryID.String(), "")))
+		assert.True(t, scope.IsRepositoryScope())
+		assert.Equal(t, repositoryID, *scope.GetRepositoryID())
+	})
+
+	t.Run("should ignore repository of the body when workspace scope", func(t *testing.T) {
+		scope := &Scope{RepositoryID: uuid.New()}
+
+		assert.NoError(t, scope.SetDataFromRequest(newRequest(uuid.NewString(), "", "")))
+		assert.False(t, scope.IsRepositoryScope())
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		scope := &Scope{}
+
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID,
+			scope.SetDataFromRequest(newRequest("test", "", "")))
+	})
+
+	t.Run("should return error when invalid repository id", func(t *testing.T) {
+		scope := &Scope{}
+
+		assert.Equal(t, managementEnums.ErrorInvalidRepositoryID,
+			scope.SetDataFromRequest(newRequest(uuid.NewString(), "test", "")))
+	})
+}
+
+func TestScopeSetRuleIDFromRequest(t *testing.T) {
+	t.Run("should set rule id", func(t *testing.T) {
+		ruleID := uuid.New()
+		scope := &Scope{}
+
+		assert.NoError(t, scope.SetRuleIDFromRequest(newRequest("", "", ruleID.String())))
+		assert.Equal(t, ruleID, scope.RuleID)
+	})
+
+	t.Run("should return error when invalid rule id", func(t *testing.T) {
+		scope := &Scope{}
+
+		assert.Equal(t, suppressionEnums.ErrorInvalidRuleID, scope.SetRuleIDFromRequest(newRequest("", "", "test")))
+	})
+}
+
+func TestScopeToFilter(t *testing.T) {
+	t.Run("should filter by workspace when workspace scope", func(t *testing.T) {
+		scope := &Scope{WorkspaceID: uuid.New(), RuleID: uuid.New()}
+
+		filter := scope.ToFilter()
+		assert.Len(t, filter, 2)
+		assert.Equal(t, scope.RuleID, filter["rule_id"])
+		assert.Equal(t, scope.WorkspaceID, filter["workspace_id"])
+	})
+
+	t.Run("should also filter by repository when repository scope", func(t *testing.T) {
+		scope := &Scope{WorkspaceID: uuid.New(), RepositoryID: uuid.New(), RuleID: uuid.New()}
+
+		filter := scope.ToFilter()
+		assert.Len(t, filter, 3)
+		assert.Equal(t, scope.RepositoryID, filter["repository_id"])
+	})
+}
+
+func TestScopeGetAnalysisQuery(t *testing.T) {
+	t.Run("should return analyses of the workspace", func(t *testing.T) {
+		scope := &Scope{WorkspaceID: uuid.New()}
+
+		query, params := scope.GetAnalysisQuery()
+		assert.Equal(t, "analysis.workspace_id = @workspaceID", query)
+		assert.Len(t, params, 2)
+	})
+
+	t.Run("should return analyses of the repository", func(t *testing.T) {
+		scope := &Scope{WorkspaceID: uuid.New(), RepositoryID: uuid.New()}
+
+		query, _ := scope.GetAnalysisQuery()
+		assert.Contains(t, query, "analysis.repository_id = @repositoryID")
+	})
+}
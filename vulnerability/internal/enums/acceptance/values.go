@@ -0,0 +1,34 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acceptance
+
+import (
+	"time"
+
+	emailEnums "github.com/ZupIT/horusec-devkit/pkg/enums/email"
+)
+
+const (
+	RiskAcceptancesTable = "vulnerability_risk_acceptances"
+	ExpiryJobInterval    = 10 * time.Minute
+	ExpiredLimit         = 500
+	SystemUsername       = "horusec"
+	ExpiredJustification = "risk acceptance expired, reverted to vulnerability to be reviewed"
+	EnvDisableEmails     = "HORUSEC_DISABLE_EMAILS"
+	ExpiredEmailSubject  = "[Horusec] Risk acceptance expired"
+	ExpiresAtLayout      = "2006-01-02 15:04 MST"
+
+	ExpiredEmailTemplate emailEnums.Template = "risk-acceptance-expired"
+)
@@ -20,4 +20,16 @@ var (
 	ErrorInvalidWorkspaceID     = errors.New("{VULNERABILITY MANAGEMENT} invalid workspace id")
 	ErrorInvalidRepositoryID    = errors.New("{VULNERABILITY MANAGEMENT} invalid repository id")
 	ErrorInvalidVulnerabilityID = errors.New("{VULNERABILITY MANAGEMENT} invalid vulnerability id")
+	ErrorInvalidAnalysisID      = errors.New("{VULNERABILITY MANAGEMENT} invalid analysis id")
+	ErrorInvalidAccountID       = errors.New("{VULNERABILITY MANAGEMENT} invalid account id")
+	ErrorInvalidAssigneeID      = errors.New("{VULNERABILITY MANAGEMENT} invalid assignee id")
+	ErrorInvalidBaseAnalysisID  = errors.New("{VULNERABILITY MANAGEMENT} invalid base analysis id, " +
+		"it should be an analysis id or latest")
+
+	ErrorInvalidInitialDate = errors.New("{VULNERABILITY MANAGEMENT} invalid initial date, " +
+		"it should be in the format 2006-01-02T15:04:05Z")
+	ErrorInvalidFinalDate = errors.New("{VULNERABILITY MANAGEMENT} invalid final date, " +
+		"it should be in the format 2006-01-02T15:04:05Z")
+	ErrorInvalidCursor = errors.New("{VULNERABILITY MANAGEMENT} invalid cursor, it should be the next cursor " +
+		"of a previous page requested with the same sorting")
 )
@@ -17,14 +17,52 @@ package management
 const (
 	RepositoryID          = "repositoryID"
 	WorkspaceID           = "workspaceID"
+	AnalysisID            = "analysisID"
 	DefaultPaginationSize = 10
 	VulnSeverityQuery     = "vulnSeverity"
 	VulnTypeQuery         = "vulnType"
 	VulnHashQuery         = "vulnHash"
 	VulnFile              = "vulnFile"
 	AllFilters            = "ALL"
+	BaseAnalysisIDQuery   = "baseAnalysisID"
+	LatestAnalysis        = "latest"
 	Page                  = "page"
 	Size                  = "size"
 	VulnerabilitiesTable  = "vulnerabilities"
 	AnalysisTable         = "analysis"
+
+	VulnerabilityID        = "vulnerabilityID"
+	EventsTable            = "vulnerability_events"
+	MaxJustificationLength = 500
+
+	AssigneeIDQuery = "assigneeID"
+
+	LanguageQuery     = "language"
+	SecurityToolQuery = "securityTool"
+	CommitAuthorQuery = "commitAuthor"
+	InitialDateQuery  = "initialDate"
+	FinalDateQuery    = "finalDate"
+	SearchQuery       = "search"
+	SortByQuery       = "sortBy"
+	SortOrderQuery    = "sortOrder"
+	ValuesSeparator   = ","
+	DateLayout        = "2006-01-02T15:04:05Z"
+
+	SortBySeverity  = "severity"
+	SortByFile      = "file"
+	SortByCreatedAt = "createdAt"
+	SortByAuthor    = "author"
+	SortOrderAsc    = "asc"
+	SortOrderDesc   = "desc"
+
+	CursorQuery       = "cursor"
+	WithTotalQuery    = "withTotal"
+	UnknownTotalItems = -1
+
+	FilePatternQuery = "filePattern"
+	DryRunQuery      = "dryRun"
+	BulkBatchSize    = 500
+
+	WebhookEventsQueue                     = "horusec-webhook::events"
+	WebhookEventVulnerabilityStatusChanged = "vulnerability-status-changed"
 )
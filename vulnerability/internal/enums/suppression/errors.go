@@ -0,0 +1,24 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import "errors"
+
+var (
+	ErrorInvalidRuleID       = errors.New("{VULNERABILITY SUPPRESSION} invalid rule id")
+	ErrorInvalidAccountID    = errors.New("{VULNERABILITY SUPPRESSION} invalid account id")
+	ErrorRuleWithoutMatchers = errors.New("{VULNERABILITY SUPPRESSION} rule should match by at least one of " +
+		"file pattern, details, security tool, language or vulnerability hash")
+)
@@ -0,0 +1,339 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // [swagger-import]
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+
+	collaborationController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/collaboration"
+	collaborationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/collaboration"
+	collaborationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/collaboration"
+	collaborationUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/collaboration"
+)
+
+type Handler struct {
+	controller collaborationController.IController
+	useCases   collaborationUseCases.IUseCases
+	authGRPC   proto.AuthServiceClient
+	context    context.Context
+}
+
+func NewCollaborationHandler(controller collaborationController.IController,
+	useCases collaborationUseCases.IUseCases, authGRPC proto.AuthServiceClient) *Handler {
+	return &Handler{
+		controller: controller,
+		useCases:   useCases,
+		authGRPC:   authGRPC,
+		context:    context.Background(),
+	}
+}
+
+func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
+	httpUtil.StatusNoContent(w)
+}
+
+//nolint:lll //swagger notations
+// ListComments
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Get the comments of a vulnerability, from the oldest to the newest
+// @ID list-vulnerability-comments
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param vulnHash path string true "hash of the vulnerability"
+// @Success 200 {object} entities.Response{content=[]collaboration.Comment} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/vulnerabilities/{vulnHash}/comments [get]
+func (h *Handler) ListComments(w http.ResponseWriter, r *http.Request) {
+	key, err := h.useCases.VulnerabilityKeyFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	comments, err := h.controller.ListComments(key)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, comments)
+}
+
+//nolint:lll //swagger notations
+// CreateComment
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Create a comment in a vulnerability
+// @ID create-vulnerability-comment
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param vulnHash path string true "hash of the vulnerability"
+// @Param CommentData body collaboration.CommentData true "comment content info"
+// @Success 201 {object} entities.Response{content=collaboration.Comment} "CREATED"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/vulnerabilities/{vulnHash}/comments [post]
+func (h *Handler) CreateComment(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.CommentDataFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.setCommentAccountData(r, data); err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	comment, err := h.controller.CreateComment(data)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusCreated(w, comment)
+}
+
+//nolint:lll //swagger notations
+// UpdateComment
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Update the content of a comment, only the author of the comment is allowed to change it
+// @ID update-vulnerability-comment
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param vulnHash path string true "hash of the vulnerability"
+// @Param commentID path string true "commentID of the comment"
+// @Param CommentData body collaboration.CommentData true "comment content info"
+// @Success 204 "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/vulnerabilities/{vulnHash}/comments/{commentID} [patch]
+func (h *Handler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.CommentDataFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	h.changeComment(w, r, data, h.controller.UpdateComment)
+}
+
+//nolint:lll //swagger notations
+// DeleteComment
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Delete a comment, only the author of the comment is allowed to remove it
+// @ID delete-vulnerability-comment
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param vulnHash path string true "hash of the vulnerability"
+// @Param commentID path string true "commentID of the comment"
+// @Success 204 "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/vulnerabilities/{vulnHash}/comments/{commentID} [delete]
+func (h *Handler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	key, err := h.useCases.VulnerabilityKeyFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	h.changeComment(w, r, &collaborationEntities.CommentData{VulnerabilityKey: *key}, h.controller.DeleteComment)
+}
+
+func (h *Handler) changeComment(w http.ResponseWriter, r *http.Request, data *collaborationEntities.CommentData,
+	change func(data *collaborationEntities.CommentData) error) {
+	if err := h.useCases.CommentIDFromRequest(r, data); err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.setCommentAccountData(r, data); err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	if err := change(data); err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) setCommentAccountData(r *http.Request, data *collaborationEntities.CommentData) error {
+	accountData, accountID, err := h.getAccountData(r)
+	if err != nil {
+		return err
+	}
+
+	data.SetAccountData(accountID, accountData.Email, accountData.Username)
+	return nil
+}
+
+func (h *Handler) getAccountData(r *http.Request) (*proto.GetAccountDataResponse, uuid.UUID, error) {
+	accountData, err := h.authGRPC.GetAccountInfo(h.context,
+		&proto.GetAccountData{Token: r.Header.Get(jwtEnums.HorusecJWTHeader)})
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	accountID, err := uuid.Parse(accountData.AccountID)
+	if err != nil {
+		return nil, uuid.Nil, collaborationEnums.ErrorInvalidAccountID
+	}
+
+	return accountData, accountID, nil
+}
+
+//nolint:lll //swagger notations
+// GetAssignment
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Get the assignee and due date of a vulnerability
+// @ID get-vulnerability-assignment
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param vulnHash path string true "hash of the vulnerability"
+// @Success 200 {object} entities.Response{content=collaboration.Assignment} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/vulnerabilities/{vulnHash}/assignment [get]
+func (h *Handler) GetAssignment(w http.ResponseWriter, r *http.Request) {
+	key, err := h.useCases.VulnerabilityKeyFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	assignment, err := h.controller.GetAssignment(key)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, assignment)
+}
+
+//nolint:lll //swagger notations
+// SaveAssignment
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Assign a vulnerability to a member of the repository, replacing the previous assignee
+// @ID save-vulnerability-assignment
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param vulnHash path string true "hash of the vulnerability"
+// @Param AssignmentData body collaboration.AssignmentData true "assignee and due date info"
+// @Success 200 {object} entities.Response{content=collaboration.Assignment} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/vulnerabilities/{vulnHash}/assignment [put]
+func (h *Handler) SaveAssignment(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.AssignmentDataFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	_, accountID, err := h.getAccountData(r)
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	data.SetAssignedBy(accountID)
+	assignment, err := h.controller.SaveAssignment(data)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, assignment)
+}
+
+//nolint:lll //swagger notations
+// DeleteAssignment
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Remove the assignee of a vulnerability
+// @ID delete-vulnerability-assignment
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param vulnHash path string true "hash of the vulnerability"
+// @Success 204 "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/vulnerabilities/{vulnHash}/assignment [delete]
+func (h *Handler) DeleteAssignment(w http.ResponseWriter, r *http.Request) {
+	key, err := h.useCases.VulnerabilityKeyFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.controller.DeleteAssignment(key); err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) checkErrors(w http.ResponseWriter, err error) {
+	switch err {
+	case databaseEnums.ErrorNotFoundRecords:
+		httpUtil.StatusNotFound(w, err)
+	case collaborationEnums.ErrorAssigneeIsNotMember:
+		httpUtil.StatusBadRequest(w, err)
+	default:
+		httpUtil.StatusInternalServerError(w, err)
+	}
+}
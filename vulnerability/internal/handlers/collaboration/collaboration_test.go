@@ -0,0 +1,397 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	collaborationController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/collaboration"
+	collaborationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/collaboration"
+	collaborationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/collaboration"
+	collaborationUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/collaboration"
+)
+
+func newAuthGRPCMock() *proto.Mock {
+	authGRPCMock := &proto.Mock{}
+	authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{
+		AccountID: uuid.NewString(), Email: "test@horusec.io", Username: "test"}, nil)
+
+	return authGRPCMock
+}
+
+func newHandler(controllerMock *collaborationController.Mock, authGRPCMock *proto.Mock) *Handler {
+	return NewCollaborationHandler(controllerMock, collaborationUseCases.NewCollaborationUseCases(), authGRPCMock)
+}
+
+func newRequest(method string, body io.ReadCloser, workspaceID string) *http.Request {
+	r, _ := http.NewRequest(method, "/test", body)
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("workspaceID", workspaceID)
+	ctx.URLParams.Add("repositoryID", uuid.NewString())
+	ctx.URLParams.Add("vulnHash", "1234")
+	ctx.URLParams.Add("commentID", uuid.NewString())
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func newCommentBody() io.ReadCloser {
+	body, _ := parser.ParseEntityToIOReadCloser(&collaborationEntities.CommentData{Content: "test"})
+
+	return body
+}
+
+func newAssignmentBody() io.ReadCloser {
+	body, _ := parser.ParseEntityToIOReadCloser(&collaborationEntities.AssignmentData{AssigneeID: uuid.New()})
+
+	return body
+}
+
+func TestOptions(t *testing.T) {
+	t.Run("should return no content when options", func(t *testing.T) {
+		handler := newHandler(nil, nil)
+
+		w := httptest.NewRecorder()
+
+		handler.Options(w, newRequest(http.MethodOptions, nil, uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestListComments(t *testing.T) {
+	t.Run("should return 200 when success list comments", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("ListComments").Return([]collaborationEntities.Comment{{Content: "test"}}, nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).ListComments(w,
+			newRequest(http.MethodGet, nil, uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newHandler(&collaborationController.Mock{}, newAuthGRPCMock()).ListComments(w,
+			newRequest(http.MethodGet, nil, "test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("ListComments").Return([]collaborationEntities.Comment{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).ListComments(w,
+			newRequest(http.MethodGet, nil, uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestCreateComment(t *testing.T) {
+	t.Run("should return 201 when success create comment", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("CreateComment").Return(&collaborationEntities.Comment{}, nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).CreateComment(w,
+			newRequest(http.MethodPost, newCommentBody(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should return 400 when invalid comment", func(t *testing.T) {
+		body, _ := parser.ParseEntityToIOReadCloser(&collaborationEntities.CommentData{})
+
+		w := httptest.NewRecorder()
+
+		newHandler(&collaborationController.Mock{}, newAuthGRPCMock()).CreateComment(w,
+			newRequest(http.MethodPost, body, uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 401 when failed to get account data", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(&collaborationController.Mock{}, authGRPCMock).CreateComment(w,
+			newRequest(http.MethodPost, newCommentBody(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return 401 when invalid account id", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: "test"}, nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(&collaborationController.Mock{}, authGRPCMock).CreateComment(w,
+			newRequest(http.MethodPost, newCommentBody(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("CreateComment").Return(&collaborationEntities.Comment{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).CreateComment(w,
+			newRequest(http.MethodPost, newCommentBody(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestUpdateComment(t *testing.T) {
+	t.Run("should return 204 when success update comment", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("UpdateComment").Return(nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).UpdateComment(w,
+			newRequest(http.MethodPatch, newCommentBody(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 404 when comment is not found for the account", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("UpdateComment").Return(databaseEnums.ErrorNotFoundRecords)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).UpdateComment(w,
+			newRequest(http.MethodPatch, newCommentBody(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 400 when invalid comment id", func(t *testing.T) {
+		r := newRequest(http.MethodPatch, newCommentBody(), uuid.NewString())
+		chi.RouteContext(r.Context()).URLParams.Add("commentID", "test")
+
+		w := httptest.NewRecorder()
+
+		newHandler(&collaborationController.Mock{}, newAuthGRPCMock()).UpdateComment(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid body", func(t *testing.T) {
+		body, _ := parser.ParseEntityToIOReadCloser("")
+
+		w := httptest.NewRecorder()
+
+		newHandler(&collaborationController.Mock{}, newAuthGRPCMock()).UpdateComment(w,
+			newRequest(http.MethodPatch, body, uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestDeleteComment(t *testing.T) {
+	t.Run("should return 204 when success delete comment", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("DeleteComment").Return(nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).DeleteComment(w,
+			newRequest(http.MethodDelete, nil, uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newHandler(&collaborationController.Mock{}, newAuthGRPCMock()).DeleteComment(w,
+			newRequest(http.MethodDelete, nil, "test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("DeleteComment").Return(errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).DeleteComment(w,
+			newRequest(http.MethodDelete, nil, uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestGetAssignment(t *testing.T) {
+	t.Run("should return 200 when success get assignment", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("GetAssignment").Return(&collaborationEntities.Assignment{}, nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).GetAssignment(w,
+			newRequest(http.MethodGet, nil, uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when vulnerability is not assigned", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("GetAssignment").Return(
+			&collaborationEntities.Assignment{}, databaseEnums.ErrorNotFoundRecords)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).GetAssignment(w,
+			newRequest(http.MethodGet, nil, uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newHandler(&collaborationController.Mock{}, newAuthGRPCMock()).GetAssignment(w,
+			newRequest(http.MethodGet, nil, "test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSaveAssignment(t *testing.T) {
+	t.Run("should return 200 when success save assignment", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("SaveAssignment").Return(&collaborationEntities.Assignment{}, nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).SaveAssignment(w,
+			newRequest(http.MethodPut, newAssignmentBody(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when assignee is not member of the repository", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("SaveAssignment").Return(
+			&collaborationEntities.Assignment{}, collaborationEnums.ErrorAssigneeIsNotMember)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).SaveAssignment(w,
+			newRequest(http.MethodPut, newAssignmentBody(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid body", func(t *testing.T) {
+		body, _ := parser.ParseEntityToIOReadCloser(&collaborationEntities.AssignmentData{})
+
+		w := httptest.NewRecorder()
+
+		newHandler(&collaborationController.Mock{}, newAuthGRPCMock()).SaveAssignment(w,
+			newRequest(http.MethodPut, body, uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 401 when failed to get account data", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(&collaborationController.Mock{}, authGRPCMock).SaveAssignment(w,
+			newRequest(http.MethodPut, newAssignmentBody(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("SaveAssignment").Return(&collaborationEntities.Assignment{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).SaveAssignment(w,
+			newRequest(http.MethodPut, newAssignmentBody(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestDeleteAssignment(t *testing.T) {
+	t.Run("should return 204 when success delete assignment", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("DeleteAssignment").Return(nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).DeleteAssignment(w,
+			newRequest(http.MethodDelete, nil, uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 404 when vulnerability is not assigned", func(t *testing.T) {
+		controllerMock := &collaborationController.Mock{}
+		controllerMock.On("DeleteAssignment").Return(databaseEnums.ErrorNotFoundRecords)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).DeleteAssignment(w,
+			newRequest(http.MethodDelete, nil, uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newHandler(&collaborationController.Mock{}, newAuthGRPCMock()).DeleteAssignment(w,
+			newRequest(http.MethodDelete, nil, "test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
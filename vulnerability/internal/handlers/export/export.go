@@ -0,0 +1,151 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"net/http"
+
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // [swagger-import]
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	exportController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/export"
+	exportEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/export"
+	exportUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/export"
+)
+
+type Handler struct {
+	controller exportController.IController
+	useCases   exportUseCases.IUseCases
+}
+
+func NewExportHandler(controller exportController.IController, useCases exportUseCases.IUseCases) *Handler {
+	return &Handler{
+		controller: controller,
+		useCases:   useCases,
+	}
+}
+
+//nolint:lll //swagger notations
+// ExportByWorkspace
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Export the vulnerabilities of the latest analysis of each repository of the workspace
+// @ID export-vulnerabilities-by-workspace
+// @Produce  application/sarif+json,text/csv,application/x-ndjson
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param format query string true "export format" Enums(sarif, csv, ndjson)
+// @Param vulnHash query string false "vulnerability hash query string"
+// @Param assigneeID query string false "account id of the assignee query string"
+// @Param language query string false "comma separated languages query string"
+// @Param securityTool query string false "comma separated security tools query string"
+// @Param commitAuthor query string false "commit author or email query string"
+// @Param search query string false "text to search in the details and code query string"
+// @Param filePattern query string false "file glob query string, ** matches any path and * any name of a directory"
+// @Param initialDate query string false "analysis initial date query string, format 2006-01-02T15:04:05Z"
+// @Param finalDate query string false "analysis final date query string, format 2006-01-02T15:04:05Z"
+// @Param vulnType query string false "vulnerability type query string" Enums(Vulnerability, Risk Accepted, False Positive, Corrected)
+// @Param vulnSeverity query string false "vulnerability severity query string" Enums(CRITICAL, HIGH, MEDIUM, LOW, INFO)
+// @Param vulnFile query string false "vulnerability file query string"
+// @Success 200 {file} file "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/export [get]
+func (h *Handler) ExportByWorkspace(w http.ResponseWriter, r *http.Request) {
+	h.export(w, r)
+}
+
+//nolint:lll //swagger notations
+// ExportByRepository
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Export the vulnerabilities of the latest analysis of the repository
+// @ID export-vulnerabilities-by-repository
+// @Produce  application/sarif+json,text/csv,application/x-ndjson
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param format query string true "export format" Enums(sarif, csv, ndjson)
+// @Param vulnHash query string false "vulnerability hash query string"
+// @Param assigneeID query string false "account id of the assignee query string"
+// @Param language query string false "comma separated languages query string"
+// @Param securityTool query string false "comma separated security tools query string"
+// @Param commitAuthor query string false "commit author or email query string"
+// @Param search query string false "text to search in the details and code query string"
+// @Param filePattern query string false "file glob query string, ** matches any path and * any name of a directory"
+// @Param initialDate query string false "analysis initial date query string, format 2006-01-02T15:04:05Z"
+// @Param finalDate query string false "analysis final date query string, format 2006-01-02T15:04:05Z"
+// @Param vulnType query string false "vulnerability type query string" Enums(Vulnerability, Risk Accepted, False Positive, Corrected)
+// @Param vulnSeverity query string false "vulnerability severity query string" Enums(CRITICAL, HIGH, MEDIUM, LOW, INFO)
+// @Param vulnFile query string false "vulnerability file query string"
+// @Success 200 {file} file "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/export [get]
+func (h *Handler) ExportByRepository(w http.ResponseWriter, r *http.Request) {
+	h.export(w, r)
+}
+
+//nolint:lll //swagger notations
+// ExportByAnalysis
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Export the vulnerabilities of a single analysis of the repository
+// @ID export-vulnerabilities-by-analysis
+// @Produce  application/sarif+json,text/csv,application/x-ndjson
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param analysisID path string true "analysisID of the analysis"
+// @Param format query string true "export format" Enums(sarif, csv, ndjson)
+// @Param vulnHash query string false "vulnerability hash query string"
+// @Param assigneeID query string false "account id of the assignee query string"
+// @Param language query string false "comma separated languages query string"
+// @Param securityTool query string false "comma separated security tools query string"
+// @Param commitAuthor query string false "commit author or email query string"
+// @Param search query string false "text to search in the details and code query string"
+// @Param filePattern query string false "file glob query string, ** matches any path and * any name of a directory"
+// @Param initialDate query string false "analysis initial date query string, format 2006-01-02T15:04:05Z"
+// @Param finalDate query string false "analysis final date query string, format 2006-01-02T15:04:05Z"
+// @Param vulnType query string false "vulnerability type query string" Enums(Vulnerability, Risk Accepted, False Positive, Corrected)
+// @Param vulnSeverity query string false "vulnerability severity query string" Enums(CRITICAL, HIGH, MEDIUM, LOW, INFO)
+// @Param vulnFile query string false "vulnerability file query string"
+// @Success 200 {file} file "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/analysis/{analysisID}/export [get]
+func (h *Handler) ExportByAnalysis(w http.ResponseWriter, r *http.Request) {
+	h.export(w, r)
+}
+
+func (h *Handler) export(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.useCases.ExportFilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	output := newResponseWriter(w, filter.Format)
+	if err := h.controller.Export(filter, output); err != nil {
+		h.checkExportErrors(output, err)
+	}
+}
+
+func (h *Handler) checkExportErrors(output *responseWriter, err error) {
+	if output.written {
+		logger.LogError(exportEnums.MessageFailedToStreamExport, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(output.ResponseWriter, err)
+}
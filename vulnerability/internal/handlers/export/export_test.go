@@ -0,0 +1,147 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	exportController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/export"
+	exportUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/export"
+)
+
+func newExportRequest(format string, withRepository bool) *http.Request {
+	r, _ := http.NewRequest(http.MethodGet, "/test?format="+format, nil)
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("workspaceID", uuid.NewString())
+	if withRepository {
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		ctx.URLParams.Add("analysisID", uuid.NewString())
+	}
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func writeToOutput(args mock.Arguments) {
+	_, _ = args.Get(0).(io.Writer).Write([]byte("{}\n"))
+}
+
+func TestExportByWorkspace(t *testing.T) {
+	t.Run("Should return 200 and download headers when success export", func(t *testing.T) {
+		controllerMock := &exportController.Mock{}
+		controllerMock.On("Export", mock.Anything).Run(writeToOutput).Return(nil)
+
+		handler := NewExportHandler(controllerMock, exportUseCases.NewExportUseCases())
+
+		w := httptest.NewRecorder()
+
+		handler.ExportByWorkspace(w, newExportRequest("ndjson", false))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename=horusec-vulnerabilities.ndjson", w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "{}\n", w.Body.String())
+	})
+
+	t.Run("Should return 400 when invalid format", func(t *testing.T) {
+		handler := NewExportHandler(&exportController.Mock{}, exportUseCases.NewExportUseCases())
+
+		w := httptest.NewRecorder()
+
+		handler.ExportByWorkspace(w, newExportRequest("xml", false))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Should return 500 when failed before streaming", func(t *testing.T) {
+		controllerMock := &exportController.Mock{}
+		controllerMock.On("Export", mock.Anything).Return(errors.New("test"))
+
+		handler := NewExportHandler(controllerMock, exportUseCases.NewExportUseCases())
+
+		w := httptest.NewRecorder()
+
+		handler.ExportByWorkspace(w, newExportRequest("csv", false))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	})
+
+	t.Run("Should keep the streamed content when failed while streaming", func(t *testing.T) {
+		controllerMock := &exportController.Mock{}
+		controllerMock.On("Export", mock.Anything).Run(writeToOutput).Return(errors.New("test"))
+
+		handler := NewExportHandler(controllerMock, exportUseCases.NewExportUseCases())
+
+		w := httptest.NewRecorder()
+
+		handler.ExportByWorkspace(w, newExportRequest("ndjson", false))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "{}\n", w.Body.String())
+	})
+}
+
+func TestExportByRepository(t *testing.T) {
+	t.Run("Should return 200 when success export", func(t *testing.T) {
+		controllerMock := &exportController.Mock{}
+		controllerMock.On("Export", mock.Anything).Run(writeToOutput).Return(nil)
+
+		handler := NewExportHandler(controllerMock, exportUseCases.NewExportUseCases())
+
+		w := httptest.NewRecorder()
+
+		handler.ExportByRepository(w, newExportRequest("sarif", true))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/sarif+json", w.Header().Get("Content-Type"))
+	})
+}
+
+func TestExportByAnalysis(t *testing.T) {
+	t.Run("Should return 200 when success export", func(t *testing.T) {
+		controllerMock := &exportController.Mock{}
+		controllerMock.On("Export", mock.Anything).Run(writeToOutput).Return(nil)
+
+		handler := NewExportHandler(controllerMock, exportUseCases.NewExportUseCases())
+
+		w := httptest.NewRecorder()
+
+		handler.ExportByAnalysis(w, newExportRequest("csv", true))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+	})
+}
+
+func TestResponseWriter(t *testing.T) {
+	t.Run("Should flush the underlying response writer", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newResponseWriter(w, "csv").Flush()
+
+		assert.True(t, w.Flushed)
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"fmt"
+	"net/http"
+
+	exportEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/export"
+)
+
+// responseWriter sets the download headers only on the first write, so the handler is still able to answer with an
+// error while nothing has been streamed
+type responseWriter struct {
+	http.ResponseWriter
+	format  exportEnums.Format
+	written bool
+}
+
+func newResponseWriter(w http.ResponseWriter, format exportEnums.Format) *responseWriter {
+	return &responseWriter{
+		ResponseWriter: w,
+		format:         format,
+	}
+}
+
+func (r *responseWriter) Write(data []byte) (int, error) {
+	if !r.written {
+		r.written = true
+		r.Header().Set("Content-Type", r.format.GetContentType())
+		r.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.%s",
+			exportEnums.FileName, r.format.ToString()))
+		r.WriteHeader(http.StatusOK)
+	}
+
+	return r.ResponseWriter.Write(data)
+}
+
+func (r *responseWriter) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -15,27 +15,37 @@
 package management
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/google/uuid"
+
 	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
 	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // [swagger-import]
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
 
 	managementController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/management"
-	_ "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management" // [swagger-import]
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
 	managementUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/management"
 )
 
 type Handler struct {
 	controller managementController.IController
 	useCases   managementUseCases.IUseCases
+	authGRPC   proto.AuthServiceClient
+	context    context.Context
 }
 
 func NewManagementHandler(controller managementController.IController,
-	useCases managementUseCases.IUseCases) *Handler {
+	useCases managementUseCases.IUseCases, authGRPC proto.AuthServiceClient) *Handler {
 	return &Handler{
 		controller: controller,
 		useCases:   useCases,
+		authGRPC:   authGRPC,
+		context:    context.Background(),
 	}
 }
 
@@ -56,6 +66,18 @@ func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
 // @Param page query string false "page query string"
 // @Param size query string false "size query string"
 // @Param vulnHash query string false "vulnerability hash query string"
+// @Param assigneeID query string false "account id of the assignee query string"
+// @Param language query string false "comma separated languages query string"
+// @Param securityTool query string false "comma separated security tools query string"
+// @Param commitAuthor query string false "commit author or email query string"
+// @Param search query string false "text to search in the details and code query string"
+// @Param filePattern query string false "file glob query string, ** matches any path and * any name of a directory"
+// @Param initialDate query string false "analysis initial date query string, format 2006-01-02T15:04:05Z"
+// @Param finalDate query string false "analysis final date query string, format 2006-01-02T15:04:05Z"
+// @Param sortBy query string false "sort key query string" Enums(severity, file, createdAt, author)
+// @Param sortOrder query string false "sort order query string, severity asc lists the most critical first" Enums(asc, desc)
+// @Param cursor query string false "cursor query string, returned as nextCursor to list the next page instead of the page number"
+// @Param withTotal query bool false "withTotal query bool, counts the total of items when using cursor"
 // @Param vulnType query string true "vulnerability type query string" Enums(Vulnerability, Risk Accepted, False Positive, Corrected)
 // @Param vulnSeverity query string false "vulnerability severity query string" Enums(CRITICAL, HIGH, MEDIUM, LOW, INFO)
 // @Param vulnFile query string true "vulnerability file query string"
@@ -91,6 +113,18 @@ func (h *Handler) ListVulnerabilitiesByFileByRepository(w http.ResponseWriter, r
 // @Param page query string false "page query string"
 // @Param size query string false "size query string"
 // @Param vulnHash query string false "vulnerability hash query string"
+// @Param assigneeID query string false "account id of the assignee query string"
+// @Param language query string false "comma separated languages query string"
+// @Param securityTool query string false "comma separated security tools query string"
+// @Param commitAuthor query string false "commit author or email query string"
+// @Param search query string false "text to search in the details and code query string"
+// @Param filePattern query string false "file glob query string, ** matches any path and * any name of a directory"
+// @Param initialDate query string false "analysis initial date query string, format 2006-01-02T15:04:05Z"
+// @Param finalDate query string false "analysis final date query string, format 2006-01-02T15:04:05Z"
+// @Param sortBy query string false "sort key query string" Enums(severity, file, createdAt, author)
+// @Param sortOrder query string false "sort order query string, severity asc lists the most critical first" Enums(asc, desc)
+// @Param cursor query string false "cursor query string, returned as nextCursor to list the next page instead of the page number"
+// @Param withTotal query bool false "withTotal query bool, counts the total of items when using cursor"
 // @Param vulnType query string true "vulnerability type query string" Enums(Vulnerability, Risk Accepted, False Positive, Corrected)
 // @Param vulnSeverity query string false "vulnerability severity query string" Enums(CRITICAL, HIGH, MEDIUM, LOW, INFO)
 // @Success 200 {object} entities.Response{content=management.ResponseFilesVulnerable} "OK"
@@ -114,6 +148,18 @@ func (h *Handler) ListVulnerableFilesByWorkspace(w http.ResponseWriter, r *http.
 // @Param page query string false "page query string"
 // @Param size query string false "size query string"
 // @Param vulnHash query string false "vulnerability hash query string"
+// @Param assigneeID query string false "account id of the assignee query string"
+// @Param language query string false "comma separated languages query string"
+// @Param securityTool query string false "comma separated security tools query string"
+// @Param commitAuthor query string false "commit author or email query string"
+// @Param search query string false "text to search in the details and code query string"
+// @Param filePattern query string false "file glob query string, ** matches any path and * any name of a directory"
+// @Param initialDate query string false "analysis initial date query string, format 2006-01-02T15:04:05Z"
+// @Param finalDate query string false "analysis final date query string, format 2006-01-02T15:04:05Z"
+// @Param sortBy query string false "sort key query string" Enums(severity, file, createdAt, author)
+// @Param sortOrder query string false "sort order query string, severity asc lists the most critical first" Enums(asc, desc)
+// @Param cursor query string false "cursor query string, returned as nextCursor to list the next page instead of the page number"
+// @Param withTotal query bool false "withTotal query bool, counts the total of items when using cursor"
 // @Param vulnType query string true "vulnerability type query string" Enums(Vulnerability, Risk Accepted, False Positive, Corrected)
 // @Param vulnSeverity query string false "vulnerability severity query string" Enums(CRITICAL, HIGH, MEDIUM, LOW, INFO)
 // @Success 200 {object} entities.Response{content=management.ResponseFilesVulnerable} "OK"
@@ -152,6 +198,7 @@ func (h *Handler) listVulnerableFiles(w http.ResponseWriter, r *http.Request, va
 // @Param VulnerabilityData body management.UpdateData true "update vulnerability content info"
 // @Success 204 "OK"
 // @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
 // @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
 // @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/vulnerabilities [patch]
@@ -170,6 +217,7 @@ func (h *Handler) UpdateVulnerabilitiesByRepository(w http.ResponseWriter, r *ht
 // @Param VulnerabilityData body management.UpdateData true "update vulnerability content info"
 // @Success 204 "OK"
 // @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
 // @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
 // @Router /vulnerability/management/workspace/{workspaceID}/vulnerabilities [patch]
@@ -184,6 +232,11 @@ func (h *Handler) updateVulnerabilities(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if err := h.setAccountData(r, data); err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
 	if err := h.controller.UpdateVulnerabilities(data); err != nil {
 		h.checkPatchErrors(w, err)
 		return
@@ -192,6 +245,261 @@ func (h *Handler) updateVulnerabilities(w http.ResponseWriter, r *http.Request)
 	httpUtil.StatusNoContent(w)
 }
 
+//nolint:lll //swagger notations
+// BulkUpdateVulnerabilitiesByRepository
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Update severity or type of all vulnerabilities of the repository matched by the filter, use dryRun to only count them
+// @ID bulk-update-vulnerabilities-repository
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param dryRun query bool false "dryRun query bool, counts the vulnerabilities and repositories without updating them"
+// @Param vulnType query string false "comma separated vulnerability types query string"
+// @Param vulnSeverity query string false "comma separated vulnerability severities query string"
+// @Param vulnHash query string false "vulnerability hash query string"
+// @Param vulnFile query string false "vulnerability file query string"
+// @Param filePattern query string false "file glob query string, ** matches any path and * any name of a directory"
+// @Param assigneeID query string false "account id of the assignee query string"
+// @Param language query string false "comma separated languages query string"
+// @Param securityTool query string false "comma separated security tools query string"
+// @Param commitAuthor query string false "commit author or email query string"
+// @Param search query string false "text to search in the details and code query string"
+// @Param initialDate query string false "analysis initial date query string, format 2006-01-02T15:04:05Z"
+// @Param finalDate query string false "analysis final date query string, format 2006-01-02T15:04:05Z"
+// @Param BulkUpdateData body management.BulkUpdateData true "change applied to the vulnerabilities"
+// @Success 200 {object} entities.Response{content=management.BulkUpdateResult} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/vulnerabilities/bulk [post]
+func (h *Handler) BulkUpdateVulnerabilitiesByRepository(w http.ResponseWriter, r *http.Request) {
+	h.bulkUpdateVulnerabilities(w, r)
+}
+
+//nolint:lll //swagger notations
+// BulkUpdateVulnerabilitiesByWorkspace
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Update severity or type of all vulnerabilities of the workspace matched by the filter, use dryRun to only count them
+// @ID bulk-update-vulnerabilities-workspace
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param dryRun query bool false "dryRun query bool, counts the vulnerabilities and repositories without updating them"
+// @Param vulnType query string false "comma separated vulnerability types query string"
+// @Param vulnSeverity query string false "comma separated vulnerability severities query string"
+// @Param vulnHash query string false "vulnerability hash query string"
+// @Param vulnFile query string false "vulnerability file query string"
+// @Param filePattern query string false "file glob query string, ** matches any path and * any name of a directory"
+// @Param assigneeID query string false "account id of the assignee query string"
+// @Param language query string false "comma separated languages query string"
+// @Param securityTool query string false "comma separated security tools query string"
+// @Param commitAuthor query string false "commit author or email query string"
+// @Param search query string false "text to search in the details and code query string"
+// @Param initialDate query string false "analysis initial date query string, format 2006-01-02T15:04:05Z"
+// @Param finalDate query string false "analysis final date query string, format 2006-01-02T15:04:05Z"
+// @Param BulkUpdateData body management.BulkUpdateData true "change applied to the vulnerabilities"
+// @Success 200 {object} entities.Response{content=management.BulkUpdateResult} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/vulnerabilities/bulk [post]
+func (h *Handler) BulkUpdateVulnerabilitiesByWorkspace(w http.ResponseWriter, r *http.Request) {
+	h.bulkUpdateVulnerabilities(w, r)
+}
+
+func (h *Handler) bulkUpdateVulnerabilities(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.BulkUpdateDataFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.setAccountData(r, data); err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	result, err := h.controller.BulkUpdateVulnerabilities(data)
+	if err != nil {
+		h.checkPatchErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
+
+//nolint:lll //swagger notations
+// GetAnalysesDiff
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Get the new, fixed and unchanged vulnerabilities of an analysis compared with a base analysis
+// @ID get-analyses-diff
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param analysisID path string true "analysisID of the analysis"
+// @Param baseAnalysisID query string false "analysis id to compare with, when empty or latest the newest other analysis of the repository is used"
+// @Success 200 {object} entities.Response{content=management.Diff} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/analysis/{analysisID}/diff [get]
+func (h *Handler) GetAnalysesDiff(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.useCases.DiffFilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	result, err := h.controller.GetAnalysesDiff(filter)
+	if err != nil {
+		h.checkPatchErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
+
+func (h *Handler) setAccountData(r *http.Request, data managementEntities.IAccountData) error {
+	accountData, err := h.authGRPC.GetAccountInfo(h.context,
+		&proto.GetAccountData{Token: r.Header.Get(jwtEnums.HorusecJWTHeader)})
+	if err != nil {
+		return err
+	}
+
+	accountID, err := uuid.Parse(accountData.AccountID)
+	if err != nil {
+		return managementEnums.ErrorInvalidAccountID
+	}
+
+	data.SetAccountData(accountID, accountData.Email, accountData.Username)
+	return nil
+}
+
+//nolint:lll //swagger notations
+// ListVulnerabilityHistoryByRepository
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Get the history of changes of a vulnerability, from the newest to the oldest
+// @ID get-vulnerability-history-repository
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param vulnerabilityID path string true "vulnerabilityID of the vulnerability"
+// @Success 200 {object} entities.Response{content=[]management.Event} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/vulnerabilities/{vulnerabilityID}/history [get]
+func (h *Handler) ListVulnerabilityHistoryByRepository(w http.ResponseWriter, r *http.Request) {
+	h.listVulnerabilityHistory(w, r)
+}
+
+// ListVulnerabilityHistoryByWorkspace
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Get the history of changes of a vulnerability, from the newest to the oldest
+// @ID get-vulnerability-history-workspace
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param vulnerabilityID path string true "vulnerabilityID of the vulnerability"
+// @Success 200 {object} entities.Response{content=[]management.Event} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/vulnerabilities/{vulnerabilityID}/history [get]
+func (h *Handler) ListVulnerabilityHistoryByWorkspace(w http.ResponseWriter, r *http.Request) {
+	h.listVulnerabilityHistory(w, r)
+}
+
+func (h *Handler) listVulnerabilityHistory(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.useCases.HistoryFilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	result, err := h.controller.ListVulnerabilityHistory(filter)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
+
+//nolint:lll //swagger notations
+// ListPropagations
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Get the vulnerabilities of the other repositories that still have the triage propagated from a vulnerability
+// @ID list-vulnerability-propagations
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param vulnerabilityID path string true "vulnerabilityID of the triaged vulnerability"
+// @Success 200 {object} entities.Response{content=[]propagation.PropagatedVulnerability} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/vulnerabilities/{vulnerabilityID}/propagations [get]
+func (h *Handler) ListPropagations(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.useCases.HistoryFilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	result, err := h.controller.ListPropagations(filter)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
+
+//nolint:lll //swagger notations
+// UndoPropagation
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Restore the vulnerabilities that still have the triage propagated from a vulnerability to the type they had before it
+// @ID undo-vulnerability-propagations
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param vulnerabilityID path string true "vulnerabilityID of the triaged vulnerability"
+// @Success 200 {object} entities.Response{content=management.BulkUpdateResult} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/vulnerabilities/{vulnerabilityID}/propagations [delete]
+func (h *Handler) UndoPropagation(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.UndoPropagationDataFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.setAccountData(r, data); err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	result, err := h.controller.UndoPropagation(data)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
+
 func (h *Handler) checkPatchErrors(w http.ResponseWriter, err error) {
 	if err == databaseEnums.ErrorNotFoundRecords {
 		httpUtil.StatusNotFound(w, err)
@@ -29,16 +29,26 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
 	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
 	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
 
 	managementController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/management"
 	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	propagationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/propagation"
 	managementUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/management"
 )
 
+func newAuthGRPCMock() *proto.Mock {
+	authGRPCMock := &proto.Mock{}
+	authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{
+		AccountID: uuid.NewString(), Email: "test@horusec.io", Username: "test"}, nil)
+
+	return authGRPCMock
+}
+
 func TestOptions(t *testing.T) {
 	t.Run("should return no content when options", func(t *testing.T) {
-		handler := NewManagementHandler(nil, nil)
+		handler := NewManagementHandler(nil, nil, newAuthGRPCMock())
 
 		r, _ := http.NewRequest(http.MethodOptions, "/test", nil)
 
@@ -56,7 +66,7 @@ func TestGetAllVulnerabilitiesOfTheFileByRepository(t *testing.T) {
 
 		controllerMock.On("ListVulnerabilitiesByFile").Return(&managementEntities.ResponseVulnerabilitiesByFile{}, nil)
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		URL := fmt.Sprintf("/test?page=1&size=15&vulnSeverity=%s&vulnType=%s&vulnHash=%s&vulnFile=%s",
 			severities.Critical.ToString(), vulnerabilityEnums.Vulnerability.ToString(), "123456", "go.mod")
@@ -82,7 +92,7 @@ func TestGetAllVulnerabilitiesOfTheFileByRepository(t *testing.T) {
 		controllerMock.On("ListVulnerabilitiesByFile").Return(
 			&managementEntities.ResponseVulnerabilitiesByFile{}, errors.New("test"))
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		URL := fmt.Sprintf("/test?page=1&size=15&vulnSeverity=%s&vulnType=%s&vulnHash=%s&vulnFile=%s",
 			severities.Critical.ToString(), vulnerabilityEnums.Vulnerability.ToString(), "123456", "go.mod")
@@ -108,7 +118,7 @@ func TestGetAllVulnerabilitiesOfTheFileByRepository(t *testing.T) {
 		controllerMock.On("ListVulnerabilitiesByFile").Return(
 			&managementEntities.ResponseVulnerabilitiesByFile{}, errors.New("test"))
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
 		w := httptest.NewRecorder()
@@ -130,10 +140,11 @@ func TestUpdateVulnerabilitiesWorkspace(t *testing.T) {
 					Severity:        severities.Critical,
 					Type:            vulnerabilityEnums.Vulnerability,
 					VulnerabilityID: uuid.New(),
+					Justification:   "test",
 				},
 			},
 		}
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		body, err := parser.ParseEntityToIOReadCloser(data)
 		assert.NoError(t, err)
@@ -162,10 +173,11 @@ func TestUpdateVulnerabilitiesWorkspace(t *testing.T) {
 					Severity:        severities.Critical,
 					Type:            vulnerabilityEnums.Vulnerability,
 					VulnerabilityID: uuid.New(),
+					Justification:   "test",
 				},
 			},
 		}
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		body, err := parser.ParseEntityToIOReadCloser(data)
 		assert.NoError(t, err)
@@ -194,11 +206,12 @@ func TestUpdateVulnerabilitiesWorkspace(t *testing.T) {
 					Severity:        severities.Critical,
 					Type:            vulnerabilityEnums.Vulnerability,
 					VulnerabilityID: uuid.New(),
+					Justification:   "test",
 				},
 			},
 		}
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		body, err := parser.ParseEntityToIOReadCloser(data)
 		assert.NoError(t, err)
@@ -228,7 +241,7 @@ func TestUpdateVulnerabilitiesWorkspace(t *testing.T) {
 			},
 		}
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		body, err := parser.ParseEntityToIOReadCloser(data)
 		assert.NoError(t, err)
@@ -243,6 +256,83 @@ func TestUpdateVulnerabilitiesWorkspace(t *testing.T) {
 	})
 }
 
+func TestUpdateVulnerabilitiesAccountData(t *testing.T) {
+	data := &managementEntities.UpdateData{
+		Vulnerabilities: []*managementEntities.VulnerabilityData{
+			{
+				Severity:        severities.Critical,
+				Type:            vulnerabilityEnums.FalsePositive,
+				VulnerabilityID: uuid.New(),
+				Justification:   "test",
+			},
+		},
+	}
+
+	t.Run("should get account data of the token before updating", func(t *testing.T) {
+		accountID := uuid.New()
+
+		controllerMock := &managementController.Mock{}
+		controllerMock.On("UpdateVulnerabilities").Return(nil)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{
+			AccountID: accountID.String(), Email: "test@horusec.io", Username: "test"}, nil)
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), authGRPCMock)
+
+		body, err := parser.ParseEntityToIOReadCloser(data)
+		assert.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPatch, "/test", body)
+
+		handler.UpdateVulnerabilitiesByRepository(w, r)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		authGRPCMock.AssertCalled(t, "GetAccountInfo")
+	})
+
+	t.Run("should return 401 when failed to get account data", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{}, errors.New("test"))
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), authGRPCMock)
+
+		body, err := parser.ParseEntityToIOReadCloser(data)
+		assert.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPatch, "/test", body)
+
+		handler.UpdateVulnerabilitiesByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		controllerMock.AssertNotCalled(t, "UpdateVulnerabilities")
+	})
+
+	t.Run("should return 401 when invalid account id", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: "test"}, nil)
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), authGRPCMock)
+
+		body, err := parser.ParseEntityToIOReadCloser(data)
+		assert.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPatch, "/test", body)
+
+		handler.UpdateVulnerabilitiesByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		controllerMock.AssertNotCalled(t, "UpdateVulnerabilities")
+	})
+}
+
 func TestUpdateVulnerabilitiesRepository(t *testing.T) {
 	t.Run("should return 204 when vulnerabilities were successfully updated", func(t *testing.T) {
 		controllerMock := &managementController.Mock{}
@@ -254,10 +344,11 @@ func TestUpdateVulnerabilitiesRepository(t *testing.T) {
 					Severity:        severities.Critical,
 					Type:            vulnerabilityEnums.Vulnerability,
 					VulnerabilityID: uuid.New(),
+					Justification:   "test",
 				},
 			},
 		}
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		body, err := parser.ParseEntityToIOReadCloser(data)
 		assert.NoError(t, err)
@@ -286,10 +377,11 @@ func TestUpdateVulnerabilitiesRepository(t *testing.T) {
 					Severity:        severities.Critical,
 					Type:            vulnerabilityEnums.Vulnerability,
 					VulnerabilityID: uuid.New(),
+					Justification:   "test",
 				},
 			},
 		}
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		body, err := parser.ParseEntityToIOReadCloser(data)
 		assert.NoError(t, err)
@@ -318,11 +410,12 @@ func TestUpdateVulnerabilitiesRepository(t *testing.T) {
 					Severity:        severities.Critical,
 					Type:            vulnerabilityEnums.Vulnerability,
 					VulnerabilityID: uuid.New(),
+					Justification:   "test",
 				},
 			},
 		}
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		body, err := parser.ParseEntityToIOReadCloser(data)
 		assert.NoError(t, err)
@@ -352,7 +445,7 @@ func TestUpdateVulnerabilitiesRepository(t *testing.T) {
 			},
 		}
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		body, err := parser.ParseEntityToIOReadCloser(data)
 		assert.NoError(t, err)
@@ -373,7 +466,7 @@ func TestGetAllFilesVulnerableByWorkspace(t *testing.T) {
 
 		controllerMock.On("ListVulnerableFiles").Return(&managementEntities.ResponseFilesVulnerable{}, nil)
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		URL := fmt.Sprintf("/test?page=1&size=15&vulnSeverity=%s&vulnType=%s&vulnHash=%s",
 			severities.Critical.ToString(), vulnerabilityEnums.Vulnerability.ToString(), "123456")
@@ -399,7 +492,7 @@ func TestGetAllFilesVulnerableByWorkspace(t *testing.T) {
 		controllerMock.On("ListVulnerableFiles").Return(
 			&managementEntities.ResponseFilesVulnerable{}, errors.New("test"))
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		URL := fmt.Sprintf("/test?page=1&size=15&vulnSeverity=%s&vulnType=%s&vulnHash=%s",
 			severities.Critical.ToString(), vulnerabilityEnums.Vulnerability.ToString(), "123456")
@@ -425,7 +518,7 @@ func TestGetAllFilesVulnerableByWorkspace(t *testing.T) {
 		controllerMock.On("ListVulnerableFiles").Return(
 			&managementEntities.ResponseFilesVulnerable{}, errors.New("test"))
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
 		w := httptest.NewRecorder()
@@ -442,7 +535,7 @@ func TestGetAllFilesVulnerableByRepository(t *testing.T) {
 
 		controllerMock.On("ListVulnerableFiles").Return(&managementEntities.ResponseFilesVulnerable{}, nil)
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		URL := fmt.Sprintf("/test?page=1&size=15&vulnSeverity=%s&vulnType=%s&vulnHash=%s",
 			severities.Critical.ToString(), vulnerabilityEnums.Vulnerability.ToString(), "123456")
@@ -468,7 +561,7 @@ func TestGetAllFilesVulnerableByRepository(t *testing.T) {
 		controllerMock.On("ListVulnerableFiles").Return(
 			&managementEntities.ResponseFilesVulnerable{}, errors.New("test"))
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		URL := fmt.Sprintf("/test?page=1&size=15&vulnSeverity=%s&vulnType=%s&vulnHash=%s",
 			severities.Critical.ToString(), vulnerabilityEnums.Vulnerability.ToString(), "123456")
@@ -494,7 +587,7 @@ func TestGetAllFilesVulnerableByRepository(t *testing.T) {
 		controllerMock.On("ListVulnerableFiles").Return(
 			&managementEntities.ResponseFilesVulnerable{}, errors.New("test"))
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
 		w := httptest.NewRecorder()
@@ -504,3 +597,362 @@ func TestGetAllFilesVulnerableByRepository(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 }
+
+func TestGetAnalysesDiff(t *testing.T) {
+	newRequest := func(analysisID string) *http.Request {
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		ctx.URLParams.Add("analysisID", analysisID)
+
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("should return 200 when success get diff", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+		controllerMock.On("GetAnalysesDiff").Return(&managementEntities.Diff{}, nil)
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+
+		handler.GetAnalysesDiff(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid analysis id", func(t *testing.T) {
+		handler := NewManagementHandler(&managementController.Mock{}, managementUseCases.NewManagementUseCases(),
+			newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+
+		handler.GetAnalysesDiff(w, newRequest("test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 404 when analysis not found", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+		controllerMock.On("GetAnalysesDiff").Return(&managementEntities.Diff{}, databaseEnums.ErrorNotFoundRecords)
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+
+		handler.GetAnalysesDiff(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+		controllerMock.On("GetAnalysesDiff").Return(&managementEntities.Diff{}, errors.New("test"))
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+
+		handler.GetAnalysesDiff(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestListVulnerabilityHistory(t *testing.T) {
+	newRequest := func(vulnerabilityID string) *http.Request {
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		ctx.URLParams.Add("vulnerabilityID", vulnerabilityID)
+
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("should return 200 when success get history by repository", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+		controllerMock.On("ListVulnerabilityHistory").Return([]managementEntities.Event{}, nil)
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+
+		handler.ListVulnerabilityHistoryByRepository(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 200 when success get history by workspace", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+		controllerMock.On("ListVulnerabilityHistory").Return([]managementEntities.Event{}, nil)
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+
+		handler.ListVulnerabilityHistoryByWorkspace(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid vulnerability id", func(t *testing.T) {
+		handler := NewManagementHandler(&managementController.Mock{}, managementUseCases.NewManagementUseCases(),
+			newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+
+		handler.ListVulnerabilityHistoryByRepository(w, newRequest("test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+		controllerMock.On("ListVulnerabilityHistory").Return([]managementEntities.Event{}, errors.New("test"))
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+
+		handler.ListVulnerabilityHistoryByRepository(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestBulkUpdateVulnerabilities(t *testing.T) {
+	data := &managementEntities.BulkUpdateData{
+		Type:          vulnerabilityEnums.FalsePositive,
+		Justification: "test files",
+	}
+
+	newRequest := func(t *testing.T, URL string, body interface{}, repositoryID string) *http.Request {
+		readCloser, err := parser.ParseEntityToIOReadCloser(body)
+		assert.NoError(t, err)
+
+		r, _ := http.NewRequest(http.MethodPost, URL, readCloser)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		if repositoryID != "" {
+			ctx.URLParams.Add("repositoryID", repositoryID)
+		}
+
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("should return 200 when vulnerabilities of the workspace were successfully updated", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+		controllerMock.On("BulkUpdateVulnerabilities").Return(
+			&managementEntities.BulkUpdateResult{TotalVulnerabilities: 2, TotalRepositories: 1}, nil)
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+		handler.BulkUpdateVulnerabilitiesByWorkspace(w,
+			newRequest(t, "/test?vulnSeverity=INFO&securityTool=GoSec&filePattern=test/**", data, ""))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"totalVulnerabilities":2`)
+	})
+
+	t.Run("should return 200 when dry run of the repository", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+		controllerMock.On("BulkUpdateVulnerabilities").Return(
+			&managementEntities.BulkUpdateResult{DryRun: true, TotalVulnerabilities: 2, TotalRepositories: 1}, nil)
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+		handler.BulkUpdateVulnerabilitiesByRepository(w, newRequest(t, "/test?dryRun=true", data, uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"dryRun":true`)
+	})
+
+	t.Run("should return 400 when invalid filter", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+		handler.BulkUpdateVulnerabilitiesByWorkspace(w, newRequest(t, "/test?vulnSeverity=test", data, ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		controllerMock.AssertNotCalled(t, "BulkUpdateVulnerabilities")
+	})
+
+	t.Run("should return 400 when invalid request body", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+		handler.BulkUpdateVulnerabilitiesByWorkspace(w,
+			newRequest(t, "/test", &managementEntities.BulkUpdateData{Type: vulnerabilityEnums.FalsePositive}, ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		controllerMock.AssertNotCalled(t, "BulkUpdateVulnerabilities")
+	})
+
+	t.Run("should return 401 when failed to get account data", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{}, errors.New("test"))
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), authGRPCMock)
+
+		w := httptest.NewRecorder()
+		handler.BulkUpdateVulnerabilitiesByWorkspace(w, newRequest(t, "/test", data, ""))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		controllerMock.AssertNotCalled(t, "BulkUpdateVulnerabilities")
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+		controllerMock.On("BulkUpdateVulnerabilities").Return(
+			&managementEntities.BulkUpdateResult{}, errors.New("test"))
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+		handler.BulkUpdateVulnerabilitiesByWorkspace(w, newRequest(t, "/test", data, ""))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestListPropagations(t *testing.T) {
+	newRequest := func(vulnerabilityID string) *http.Request {
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("vulnerabilityID", vulnerabilityID)
+
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("should return 200 when success list propagations", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+		controllerMock.On("ListPropagations").Return(
+			[]propagationEntities.PropagatedVulnerability{{OldType: vulnerabilityEnums.Vulnerability}}, nil)
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+
+		handler.ListPropagations(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"oldType":"Vulnerability"`)
+	})
+
+	t.Run("should return 400 when invalid vulnerability id", func(t *testing.T) {
+		handler := NewManagementHandler(&managementController.Mock{}, managementUseCases.NewManagementUseCases(),
+			newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+
+		handler.ListPropagations(w, newRequest("test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+		controllerMock.On("ListPropagations").Return(
+			[]propagationEntities.PropagatedVulnerability{}, errors.New("test"))
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+
+		handler.ListPropagations(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestUndoPropagation(t *testing.T) {
+	newRequest := func(vulnerabilityID string) *http.Request {
+		r, _ := http.NewRequest(http.MethodDelete, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("vulnerabilityID", vulnerabilityID)
+
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("should return 200 when success undo propagation", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+		controllerMock.On("UndoPropagation").Return(
+			&managementEntities.BulkUpdateResult{TotalVulnerabilities: 2, TotalRepositories: 1}, nil)
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+
+		handler.UndoPropagation(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"totalVulnerabilities":2`)
+	})
+
+	t.Run("should return 400 when invalid vulnerability id", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+
+		handler.UndoPropagation(w, newRequest("test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		controllerMock.AssertNotCalled(t, "UndoPropagation")
+	})
+
+	t.Run("should return 401 when failed to get account data", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{}, errors.New("test"))
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(), authGRPCMock)
+
+		w := httptest.NewRecorder()
+
+		handler.UndoPropagation(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		controllerMock.AssertNotCalled(t, "UndoPropagation")
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+		controllerMock.On("UndoPropagation").Return(&managementEntities.BulkUpdateResult{}, errors.New("test"))
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			newAuthGRPCMock())
+
+		w := httptest.NewRecorder()
+
+		handler.UndoPropagation(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
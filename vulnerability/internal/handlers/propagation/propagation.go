@@ -0,0 +1,130 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // [swagger-import]
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+
+	propagationController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/propagation"
+	propagationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/propagation"
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
+	propagationUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/propagation"
+)
+
+type Handler struct {
+	controller propagationController.IController
+	useCases   propagationUseCases.IUseCases
+	authGRPC   proto.AuthServiceClient
+	context    context.Context
+}
+
+func NewPropagationHandler(controller propagationController.IController,
+	useCases propagationUseCases.IUseCases, authGRPC proto.AuthServiceClient) *Handler {
+	return &Handler{
+		controller: controller,
+		useCases:   useCases,
+		authGRPC:   authGRPC,
+		context:    context.Background(),
+	}
+}
+
+//nolint:lll //swagger notations
+// GetSettings
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Get the triage propagation settings of the workspace, disabled by default
+// @ID get-triage-propagation-settings
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Success 200 {object} entities.Response{content=propagation.Settings} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/triage-propagation [get]
+func (h *Handler) GetSettings(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := h.useCases.WorkspaceIDFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	settings, err := h.controller.GetSettings(workspaceID)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, settings)
+}
+
+//nolint:lll //swagger notations
+// SaveSettings
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Save the triage propagation settings of the workspace, when enabled the triage of a vulnerability is carried to the same unclassified vulnerability of the other repositories
+// @ID save-triage-propagation-settings
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param SettingsData body propagation.SettingsData true "propagation settings, matched by hash or by normalized code and file name"
+// @Success 200 {object} entities.Response{content=propagation.Settings} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/triage-propagation [put]
+func (h *Handler) SaveSettings(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.SettingsDataFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.setAccountData(r, data); err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	settings, err := h.controller.SaveSettings(data)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, settings)
+}
+
+func (h *Handler) setAccountData(r *http.Request, data *propagationEntities.SettingsData) error {
+	accountData, err := h.authGRPC.GetAccountInfo(h.context,
+		&proto.GetAccountData{Token: r.Header.Get(jwtEnums.HorusecJWTHeader)})
+	if err != nil {
+		return err
+	}
+
+	accountID, err := uuid.Parse(accountData.AccountID)
+	if err != nil {
+		return propagationEnums.ErrorInvalidAccountID
+	}
+
+	data.SetAccountData(accountID, accountData.Email, accountData.Username)
+	return nil
+}
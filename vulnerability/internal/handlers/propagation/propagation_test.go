@@ -0,0 +1,170 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	propagationController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/propagation"
+	propagationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/propagation"
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
+	propagationUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/propagation"
+)
+
+func newAuthGRPCMock() *proto.Mock {
+	authGRPCMock := &proto.Mock{}
+	authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{
+		AccountID: uuid.NewString(), Email: "test@horusec.io", Username: "test"}, nil)
+
+	return authGRPCMock
+}
+
+func newHandler(controllerMock *propagationController.Mock, authGRPCMock *proto.Mock) *Handler {
+	return NewPropagationHandler(controllerMock, propagationUseCases.NewPropagationUseCases(), authGRPCMock)
+}
+
+func newRequest(method string, body io.ReadCloser, workspaceID string) *http.Request {
+	r, _ := http.NewRequest(method, "/test", body)
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("workspaceID", workspaceID)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func newSettingsBody() io.ReadCloser {
+	body, _ := parser.ParseEntityToIOReadCloser(&propagationEntities.SettingsData{IsEnabled: true,
+		MatchBy: propagationEnums.MatchByHash})
+
+	return body
+}
+
+func TestGetSettings(t *testing.T) {
+	t.Run("should return 200 when success get settings", func(t *testing.T) {
+		controllerMock := &propagationController.Mock{}
+		controllerMock.On("GetSettings").Return(&propagationEntities.Settings{IsEnabled: true}, nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).GetSettings(w,
+			newRequest(http.MethodGet, nil, uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"isEnabled":true`)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		controllerMock := &propagationController.Mock{}
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).GetSettings(w, newRequest(http.MethodGet, nil, "test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		controllerMock.AssertNotCalled(t, "GetSettings")
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &propagationController.Mock{}
+		controllerMock.On("GetSettings").Return(&propagationEntities.Settings{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).GetSettings(w,
+			newRequest(http.MethodGet, nil, uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestSaveSettings(t *testing.T) {
+	t.Run("should return 200 when success save settings", func(t *testing.T) {
+		controllerMock := &propagationController.Mock{}
+		controllerMock.On("SaveSettings").Return(&propagationEntities.Settings{IsEnabled: true}, nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).SaveSettings(w,
+			newRequest(http.MethodPut, newSettingsBody(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid settings", func(t *testing.T) {
+		controllerMock := &propagationController.Mock{}
+		body, _ := parser.ParseEntityToIOReadCloser(&propagationEntities.SettingsData{MatchBy: "test"})
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).SaveSettings(w,
+			newRequest(http.MethodPut, body, uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		controllerMock.AssertNotCalled(t, "SaveSettings")
+	})
+
+	t.Run("should return 401 when failed to get account data", func(t *testing.T) {
+		controllerMock := &propagationController.Mock{}
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, authGRPCMock).SaveSettings(w,
+			newRequest(http.MethodPut, newSettingsBody(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		controllerMock.AssertNotCalled(t, "SaveSettings")
+	})
+
+	t.Run("should return 401 when invalid account id", func(t *testing.T) {
+		controllerMock := &propagationController.Mock{}
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: "test"}, nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, authGRPCMock).SaveSettings(w,
+			newRequest(http.MethodPut, newSettingsBody(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &propagationController.Mock{}
+		controllerMock.On("SaveSettings").Return(&propagationEntities.Settings{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).SaveSettings(w,
+			newRequest(http.MethodPut, newSettingsBody(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,301 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // [swagger-import]
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+
+	suppressionController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/suppression"
+	suppressionEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/suppression"
+	suppressionEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/suppression"
+	suppressionUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/suppression"
+)
+
+type Handler struct {
+	controller suppressionController.IController
+	useCases   suppressionUseCases.IUseCases
+	authGRPC   proto.AuthServiceClient
+	context    context.Context
+}
+
+func NewSuppressionHandler(controller suppressionController.IController,
+	useCases suppressionUseCases.IUseCases, authGRPC proto.AuthServiceClient) *Handler {
+	return &Handler{
+		controller: controller,
+		useCases:   useCases,
+		authGRPC:   authGRPC,
+		context:    context.Background(),
+	}
+}
+
+func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
+	httpUtil.StatusNoContent(w)
+}
+
+//nolint:lll //swagger notations
+// ListRules
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Get the suppression rules of the workspace, or the rules of the repository and the workspace rules applied to it
+// @ID list-suppression-rules
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string false "repositoryID of the repository"
+// @Success 200 {object} entities.Response{content=[]suppression.Rule} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/suppression-rules [get]
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/suppression-rules [get]
+func (h *Handler) ListRules(w http.ResponseWriter, r *http.Request) {
+	scope, err := h.useCases.ScopeFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	rules, err := h.controller.ListRules(scope)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, rules)
+}
+
+//nolint:lll //swagger notations
+// CreateRule
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Create a suppression rule, the unclassified vulnerabilities of the next analyses matched by it are classified with its type and justification
+// @ID create-suppression-rule
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string false "repositoryID of the repository"
+// @Param RuleData body suppression.RuleData true "matchers and classification of the rule"
+// @Success 201 {object} entities.Response{content=suppression.Rule} "CREATED"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/suppression-rules [post]
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/suppression-rules [post]
+func (h *Handler) CreateRule(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.RuleDataFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.setAccountData(r, data); err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	rule, err := h.controller.CreateRule(data)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusCreated(w, rule)
+}
+
+//nolint:lll //swagger notations
+// GetRule
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Get a suppression rule
+// @ID get-suppression-rule
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string false "repositoryID of the repository"
+// @Param ruleID path string true "ruleID of the rule"
+// @Success 200 {object} entities.Response{content=suppression.Rule} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/suppression-rules/{ruleID} [get]
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/suppression-rules/{ruleID} [get]
+func (h *Handler) GetRule(w http.ResponseWriter, r *http.Request) {
+	scope, err := h.ruleScopeFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	rule, err := h.controller.GetRule(scope)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, rule)
+}
+
+//nolint:lll //swagger notations
+// UpdateRule
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Update a suppression rule, the vulnerabilities already classified by it are kept as they are
+// @ID update-suppression-rule
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string false "repositoryID of the repository"
+// @Param ruleID path string true "ruleID of the rule"
+// @Param RuleData body suppression.RuleData true "matchers and classification of the rule"
+// @Success 200 {object} entities.Response{content=suppression.Rule} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 401 {object} entities.Response{content=string} "UNAUTHORIZED"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/suppression-rules/{ruleID} [put]
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/suppression-rules/{ruleID} [put]
+func (h *Handler) UpdateRule(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.RuleDataFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.useCases.RuleIDFromRequest(r, &data.Scope); err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.setAccountData(r, data); err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	rule, err := h.controller.UpdateRule(data)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, rule)
+}
+
+//nolint:lll //swagger notations
+// DeleteRule
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Delete a suppression rule, the vulnerabilities already classified by it are kept as they are
+// @ID delete-suppression-rule
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string false "repositoryID of the repository"
+// @Param ruleID path string true "ruleID of the rule"
+// @Success 204 "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/suppression-rules/{ruleID} [delete]
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/suppression-rules/{ruleID} [delete]
+func (h *Handler) DeleteRule(w http.ResponseWriter, r *http.Request) {
+	scope, err := h.ruleScopeFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.controller.DeleteRule(scope); err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusNoContent(w)
+}
+
+//nolint:lll //swagger notations
+// PreviewMatches
+// @Tags Vulnerabilities
+// @Security ApiKeyAuth
+// @Description Get the unclassified vulnerabilities of the latest analyses that would be matched by a suppression rule
+// @ID preview-suppression-rule
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string false "repositoryID of the repository"
+// @Param page query string false "page query string"
+// @Param size query string false "size query string"
+// @Param RuleData body suppression.RuleData true "matchers of the rule"
+// @Success 200 {object} entities.Response{content=suppression.PreviewResponse} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /vulnerability/management/workspace/{workspaceID}/suppression-rules/preview [post]
+// @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/suppression-rules/preview [post]
+func (h *Handler) PreviewMatches(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.PreviewDataFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	preview, err := h.controller.PreviewMatches(data)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, preview)
+}
+
+func (h *Handler) ruleScopeFromRequest(r *http.Request) (*suppressionEntities.Scope, error) {
+	scope, err := h.useCases.ScopeFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return scope, h.useCases.RuleIDFromRequest(r, scope)
+}
+
+func (h *Handler) setAccountData(r *http.Request, data *suppressionEntities.RuleData) error {
+	accountData, err := h.authGRPC.GetAccountInfo(h.context,
+		&proto.GetAccountData{Token: r.Header.Get(jwtEnums.HorusecJWTHeader)})
+	if err != nil {
+		return err
+	}
+
+	accountID, err := uuid.Parse(accountData.AccountID)
+	if err != nil {
+		return suppressionEnums.ErrorInvalidAccountID
+	}
+
+	data.SetAccountData(accountID, accountData.Email, accountData.Username)
+	return nil
+}
+
+func (h *Handler) checkErrors(w http.ResponseWriter, err error) {
+	if err == databaseEnums.ErrorNotFoundRecords {
+		httpUtil.StatusNotFound(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
@@ -0,0 +1,358 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	suppressionController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/suppression"
+	suppressionEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/suppression"
+	suppressionUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/suppression"
+)
+
+func newAuthGRPCMock() *proto.Mock {
+	authGRPCMock := &proto.Mock{}
+	authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{
+		AccountID: uuid.NewString(), Email: "test@horusec.io", Username: "test"}, nil)
+
+	return authGRPCMock
+}
+
+func newHandler(controllerMock *suppressionController.Mock, authGRPCMock *proto.Mock) *Handler {
+	return NewSuppressionHandler(controllerMock, suppressionUseCases.NewSuppressionUseCases(), authGRPCMock)
+}
+
+func newRequest(method string, body io.ReadCloser, workspaceID, ruleID string) *http.Request {
+	r, _ := http.NewRequest(method, "/test", body)
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("workspaceID", workspaceID)
+	ctx.URLParams.Add("ruleID", ruleID)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func newRuleBody() io.ReadCloser {
+	body, _ := parser.ParseEntityToIOReadCloser(&suppressionEntities.RuleData{Name: "test", FilePattern: "test/**",
+		Type: vulnerabilityEnums.FalsePositive, Justification: "test"})
+
+	return body
+}
+
+func TestOptions(t *testing.T) {
+	t.Run("should return no content when options", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newHandler(nil, nil).Options(w, newRequest(http.MethodOptions, nil, uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestListRules(t *testing.T) {
+	t.Run("should return 200 when success list rules", func(t *testing.T) {
+		controllerMock := &suppressionController.Mock{}
+		controllerMock.On("ListRules").Return([]suppressionEntities.Rule{{Name: "test"}}, nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).ListRules(w,
+			newRequest(http.MethodGet, nil, uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newHandler(&suppressionController.Mock{}, newAuthGRPCMock()).ListRules(w,
+			newRequest(http.MethodGet, nil, "test", ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &suppressionController.Mock{}
+		controllerMock.On("ListRules").Return([]suppressionEntities.Rule{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).ListRules(w,
+			newRequest(http.MethodGet, nil, uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestCreateRule(t *testing.T) {
+	t.Run("should return 201 when success create rule", func(t *testing.T) {
+		controllerMock := &suppressionController.Mock{}
+		controllerMock.On("CreateRule").Return(&suppressionEntities.Rule{}, nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).CreateRule(w,
+			newRequest(http.MethodPost, newRuleBody(), uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should return 400 when invalid rule", func(t *testing.T) {
+		body, _ := parser.ParseEntityToIOReadCloser(&suppressionEntities.RuleData{Name: "test"})
+
+		w := httptest.NewRecorder()
+
+		newHandler(&suppressionController.Mock{}, newAuthGRPCMock()).CreateRule(w,
+			newRequest(http.MethodPost, body, uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 401 when failed to get account data", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(&suppressionController.Mock{}, authGRPCMock).CreateRule(w,
+			newRequest(http.MethodPost, newRuleBody(), uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return 401 when invalid account id", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: "test"}, nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(&suppressionController.Mock{}, authGRPCMock).CreateRule(w,
+			newRequest(http.MethodPost, newRuleBody(), uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &suppressionController.Mock{}
+		controllerMock.On("CreateRule").Return(&suppressionEntities.Rule{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).CreateRule(w,
+			newRequest(http.MethodPost, newRuleBody(), uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestGetRule(t *testing.T) {
+	t.Run("should return 200 when success get rule", func(t *testing.T) {
+		controllerMock := &suppressionController.Mock{}
+		controllerMock.On("GetRule").Return(&suppressionEntities.Rule{}, nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).GetRule(w,
+			newRequest(http.MethodGet, nil, uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newHandler(&suppressionController.Mock{}, newAuthGRPCMock()).GetRule(w,
+			newRequest(http.MethodGet, nil, "test", uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid rule id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newHandler(&suppressionController.Mock{}, newAuthGRPCMock()).GetRule(w,
+			newRequest(http.MethodGet, nil, uuid.NewString(), "test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 404 when rule not found", func(t *testing.T) {
+		controllerMock := &suppressionController.Mock{}
+		controllerMock.On("GetRule").Return(&suppressionEntities.Rule{}, databaseEnums.ErrorNotFoundRecords)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).GetRule(w,
+			newRequest(http.MethodGet, nil, uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestUpdateRule(t *testing.T) {
+	t.Run("should return 200 when success update rule", func(t *testing.T) {
+		controllerMock := &suppressionController.Mock{}
+		controllerMock.On("UpdateRule").Return(&suppressionEntities.Rule{}, nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).UpdateRule(w,
+			newRequest(http.MethodPut, newRuleBody(), uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid rule", func(t *testing.T) {
+		body, _ := parser.ParseEntityToIOReadCloser(&suppressionEntities.RuleData{})
+
+		w := httptest.NewRecorder()
+
+		newHandler(&suppressionController.Mock{}, newAuthGRPCMock()).UpdateRule(w,
+			newRequest(http.MethodPut, body, uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid rule id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newHandler(&suppressionController.Mock{}, newAuthGRPCMock()).UpdateRule(w,
+			newRequest(http.MethodPut, newRuleBody(), uuid.NewString(), "test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 401 when failed to get account data", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(&suppressionController.Mock{}, authGRPCMock).UpdateRule(w,
+			newRequest(http.MethodPut, newRuleBody(), uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return 404 when rule not found", func(t *testing.T) {
+		controllerMock := &suppressionController.Mock{}
+		controllerMock.On("UpdateRule").Return(&suppressionEntities.Rule{}, databaseEnums.ErrorNotFoundRecords)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).UpdateRule(w,
+			newRequest(http.MethodPut, newRuleBody(), uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &suppressionController.Mock{}
+		controllerMock.On("UpdateRule").Return(&suppressionEntities.Rule{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).UpdateRule(w,
+			newRequest(http.MethodPut, newRuleBody(), uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestDeleteRule(t *testing.T) {
+	t.Run("should return 204 when success delete rule", func(t *testing.T) {
+		controllerMock := &suppressionController.Mock{}
+		controllerMock.On("DeleteRule").Return(nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).DeleteRule(w,
+			newRequest(http.MethodDelete, nil, uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 400 when invalid rule id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newHandler(&suppressionController.Mock{}, newAuthGRPCMock()).DeleteRule(w,
+			newRequest(http.MethodDelete, nil, uuid.NewString(), "test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 404 when rule not found", func(t *testing.T) {
+		controllerMock := &suppressionController.Mock{}
+		controllerMock.On("DeleteRule").Return(databaseEnums.ErrorNotFoundRecords)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).DeleteRule(w,
+			newRequest(http.MethodDelete, nil, uuid.NewString(), uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestPreviewMatches(t *testing.T) {
+	t.Run("should return 200 when success preview matches", func(t *testing.T) {
+		controllerMock := &suppressionController.Mock{}
+		controllerMock.On("PreviewMatches").Return(&suppressionEntities.PreviewResponse{}, nil)
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).PreviewMatches(w,
+			newRequest(http.MethodPost, newRuleBody(), uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when without matchers", func(t *testing.T) {
+		body, _ := parser.ParseEntityToIOReadCloser(&suppressionEntities.RuleData{})
+
+		w := httptest.NewRecorder()
+
+		newHandler(&suppressionController.Mock{}, newAuthGRPCMock()).PreviewMatches(w,
+			newRequest(http.MethodPost, body, uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &suppressionController.Mock{}
+		controllerMock.On("PreviewMatches").Return(&suppressionEntities.PreviewResponse{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newHandler(controllerMock, newAuthGRPCMock()).PreviewMatches(w,
+			newRequest(http.MethodPost, newRuleBody(), uuid.NewString(), ""))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expiry
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	acceptanceController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/acceptance"
+	acceptanceEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/acceptance"
+)
+
+type IJob interface{}
+
+type Job struct {
+	controller acceptanceController.IController
+}
+
+func NewExpiryJob(controller acceptanceController.IController) IJob {
+	j := &Job{
+		controller: controller,
+	}
+	return j.start()
+}
+
+func (j *Job) start() IJob {
+	go j.expireRiskAcceptances(time.NewTicker(acceptanceEnums.ExpiryJobInterval))
+	return j
+}
+
+func (j *Job) expireRiskAcceptances(ticker *time.Ticker) {
+	for range ticker.C {
+		j.run()
+	}
+}
+
+func (j *Job) run() {
+	if err := j.controller.ExpireRiskAcceptances(); err != nil {
+		logger.LogError(acceptanceEnums.MessageFailedToExpireRiskAcceptances, err)
+	}
+}
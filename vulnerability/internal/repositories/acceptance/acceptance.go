@@ -0,0 +1,66 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acceptance
+
+import (
+	"database/sql"
+	"time"
+
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	acceptanceEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/acceptance"
+	acceptanceEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/acceptance"
+)
+
+type IRepository interface {
+	ListExpiredRiskAcceptances(now time.Time) ([]*acceptanceEntities.Expired, error)
+}
+
+type Repository struct {
+	databaseRead database.IDatabaseRead
+}
+
+func NewAcceptanceRepository(connection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead: connection.Read,
+	}
+}
+
+// ListExpiredRiskAcceptances returns the oldest expired risk acceptances, vulnerabilities that were already
+// reclassified are ignored
+func (r *Repository) ListExpiredRiskAcceptances(now time.Time) ([]*acceptanceEntities.Expired, error) {
+	var expired []*acceptanceEntities.Expired
+
+	return expired, r.databaseRead.Raw(r.getListExpiredRiskAcceptancesQuery(), &expired,
+		sql.Named("now", now), sql.Named("riskAccepted", vulnerabilityEnums.RiskAccepted),
+		sql.Named("limit", acceptanceEnums.ExpiredLimit)).GetErrorExceptNotFound()
+}
+
+func (r *Repository) getListExpiredRiskAcceptancesQuery() string {
+	return `
+		SELECT acceptances.*, vulnerabilities.vuln_hash, vulnerabilities.file, vulnerabilities.line,
+			vulnerabilities.severity, analysis.workspace_id, analysis.repository_id, analysis.repository_name,
+			COALESCE(accepters.language, '') AS account_language, COALESCE(approvers.language, '') AS approver_language
+		FROM vulnerability_risk_acceptances AS acceptances
+		JOIN vulnerabilities ON vulnerabilities.vulnerability_id = acceptances.vulnerability_id
+		JOIN analysis ON analysis.analysis_id = acceptances.analysis_id
+		LEFT JOIN accounts AS accepters ON accepters.email = acceptances.account_email
+		LEFT JOIN accounts AS approvers ON approvers.email = acceptances.approver
+		WHERE acceptances.expires_at <= @now AND vulnerabilities.type = @riskAccepted
+		ORDER BY acceptances.expires_at
+		LIMIT @limit
+	`
+}
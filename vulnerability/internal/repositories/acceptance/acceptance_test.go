@@ -0,0 +1,69 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acceptance
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	acceptanceEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/acceptance"
+)
+
+func TestListExpiredRiskAcceptances(t *testing.T) {
+	t.Run("should success list expired risk acceptances", func(t *testing.T) {
+		expired := &acceptanceEntities.Expired{VulnHash: "1234", AccountLanguage: "pt-BR"}
+		expired.VulnerabilityID = uuid.New()
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, []*acceptanceEntities.Expired{expired}))
+
+		repository := NewAcceptanceRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListExpiredRiskAcceptances(time.Now())
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, expired.VulnerabilityID, result[0].VulnerabilityID)
+		assert.Equal(t, "1234", result[0].VulnHash)
+		assert.Equal(t, "pt-BR", result[0].AccountLanguage)
+	})
+
+	t.Run("should return no error when there are no expired risk acceptances", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, nil, []*acceptanceEntities.Expired{}))
+
+		repository := NewAcceptanceRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListExpiredRiskAcceptances(time.Now())
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should return error when failed to list expired risk acceptances", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewAcceptanceRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.ListExpiredRiskAcceptances(time.Now())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	collaborationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/collaboration"
+	collaborationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/collaboration"
+)
+
+type IRepository interface {
+	ListComments(key *collaborationEntities.VulnerabilityKey) ([]collaborationEntities.Comment, error)
+	GetAssignment(key *collaborationEntities.VulnerabilityKey) (*collaborationEntities.Assignment, error)
+	IsRepositoryMember(accountID, workspaceID, repositoryID uuid.UUID) (bool, error)
+}
+
+type Repository struct {
+	databaseRead database.IDatabaseRead
+}
+
+func NewCollaborationRepository(connection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead: connection.Read,
+	}
+}
+
+// ListComments returns the comments of the vulnerability from the oldest to the newest
+func (r *Repository) ListComments(
+	key *collaborationEntities.VulnerabilityKey) ([]collaborationEntities.Comment, error) {
+	comments := []collaborationEntities.Comment{}
+
+	return comments, r.databaseRead.Raw(r.getListCommentsQuery(), &comments,
+		sql.Named("workspaceID", key.WorkspaceID), sql.Named("repositoryID", key.RepositoryID),
+		sql.Named("vulnHash", key.VulnHash)).GetErrorExceptNotFound()
+}
+
+func (r *Repository) getListCommentsQuery() string {
+	return `
+		SELECT * FROM vulnerability_comments
+		WHERE workspace_id = @workspaceID AND repository_id = @repositoryID AND vuln_hash = @vulnHash
+		ORDER BY created_at
+	`
+}
+
+func (r *Repository) GetAssignment(
+	key *collaborationEntities.VulnerabilityKey) (*collaborationEntities.Assignment, error) {
+	assignment := &collaborationEntities.Assignment{}
+
+	return assignment, r.databaseRead.Find(assignment, key.ToFilter(),
+		collaborationEnums.AssignmentsTable).GetError()
+}
+
+// IsRepositoryMember checks if the account has access to the repository, directly or as workspace admin
+func (r *Repository) IsRepositoryMember(accountID, workspaceID, repositoryID uuid.UUID) (bool, error) {
+	var count int
+
+	err := r.databaseRead.Raw(r.getIsRepositoryMemberQuery(), &count, sql.Named("accountID", accountID),
+		sql.Named("workspaceID", workspaceID), sql.Named("repositoryID", repositoryID)).GetErrorExceptNotFound()
+
+	return count > 0, err
+}
+
+func (r *Repository) getIsRepositoryMemberQuery() string {
+	return `
+		SELECT COUNT(*) FROM (
+			SELECT account_id FROM account_repository
+			WHERE account_id = @accountID AND repository_id = @repositoryID
+			UNION
+			SELECT account_id FROM account_workspace
+			WHERE account_id = @accountID AND workspace_id = @workspaceID AND role = 'admin'
+		) AS members
+	`
+}
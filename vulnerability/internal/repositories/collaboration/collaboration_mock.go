@@ -0,0 +1,46 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	collaborationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/collaboration"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) ListComments(_ *collaborationEntities.VulnerabilityKey) ([]collaborationEntities.Comment, error) {
+	args := m.MethodCalled("ListComments")
+
+	return args.Get(0).([]collaborationEntities.Comment), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetAssignment(_ *collaborationEntities.VulnerabilityKey) (*collaborationEntities.Assignment, error) {
+	args := m.MethodCalled("GetAssignment")
+
+	return args.Get(0).(*collaborationEntities.Assignment), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) IsRepositoryMember(_, _, _ uuid.UUID) (bool, error) {
+	args := m.MethodCalled("IsRepositoryMember")
+
+	return args.Get(0).(bool), utilsMock.ReturnNilOrError(args, 1)
+}
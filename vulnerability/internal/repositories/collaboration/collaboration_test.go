@@ -0,0 +1,133 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	collaborationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/collaboration"
+)
+
+func TestNewCollaborationRepository(t *testing.T) {
+	t.Run("should success create a new repository", func(t *testing.T) {
+		assert.NotNil(t, NewCollaborationRepository(&database.Connection{}))
+	})
+}
+
+func TestListComments(t *testing.T) {
+	t.Run("should success list comments", func(t *testing.T) {
+		comment := collaborationEntities.Comment{CommentID: uuid.New(), Content: "test"}
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil,
+			[]collaborationEntities.Comment{comment}))
+
+		repository := NewCollaborationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListComments(&collaborationEntities.VulnerabilityKey{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, comment.CommentID, result[0].CommentID)
+		assert.Equal(t, "test", result[0].Content)
+	})
+
+	t.Run("should return no error when there are no comments", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, databaseEnums.ErrorNotFoundRecords, nil))
+
+		repository := NewCollaborationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListComments(&collaborationEntities.VulnerabilityKey{})
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should return error when failed to list comments", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewCollaborationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.ListComments(&collaborationEntities.VulnerabilityKey{})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetAssignment(t *testing.T) {
+	t.Run("should success get assignment", func(t *testing.T) {
+		assignment := &collaborationEntities.Assignment{AssigneeID: uuid.New()}
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, assignment))
+
+		repository := NewCollaborationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.GetAssignment(&collaborationEntities.VulnerabilityKey{})
+		assert.NoError(t, err)
+		assert.Equal(t, assignment.AssigneeID, result.AssigneeID)
+	})
+
+	t.Run("should return not found when vulnerability is not assigned", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(0, databaseEnums.ErrorNotFoundRecords, nil))
+
+		repository := NewCollaborationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.GetAssignment(&collaborationEntities.VulnerabilityKey{})
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestIsRepositoryMember(t *testing.T) {
+	t.Run("should return true when account is member of the repository", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, 1))
+
+		repository := NewCollaborationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		isMember, err := repository.IsRepositoryMember(uuid.New(), uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.True(t, isMember)
+	})
+
+	t.Run("should return false when account is not member of the repository", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, 0))
+
+		repository := NewCollaborationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		isMember, err := repository.IsRepositoryMember(uuid.New(), uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.False(t, isMember)
+	})
+
+	t.Run("should return error when failed to check membership", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewCollaborationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.IsRepositoryMember(uuid.New(), uuid.New(), uuid.New())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	exportEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/export"
+	exportEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/export"
+)
+
+type IRepository interface {
+	ListVulnerabilitiesBatch(filter *exportEntities.Filter,
+		lastVulnerabilityID uuid.UUID) ([]exportEntities.Vulnerability, error)
+}
+
+type Repository struct {
+	databaseRead database.IDatabaseRead
+}
+
+func NewExportRepository(connection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead: connection.Read,
+	}
+}
+
+// ListVulnerabilitiesBatch returns the next batch of vulnerabilities after the last vulnerability id received,
+// using keyset pagination so deep batches cost the same as the first one
+func (r *Repository) ListVulnerabilitiesBatch(filter *exportEntities.Filter,
+	lastVulnerabilityID uuid.UUID) ([]exportEntities.Vulnerability, error) {
+	vulnerabilities := []exportEntities.Vulnerability{}
+
+	query, params := r.getVulnerabilitiesBatchQuery(filter, lastVulnerabilityID)
+
+	return vulnerabilities, r.databaseRead.Raw(query, &vulnerabilities, params...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) getVulnerabilitiesBatchQuery(filter *exportEntities.Filter,
+	lastVulnerabilityID uuid.UUID) (string, []interface{}) {
+	condition, params := filter.GetWhereFilterQuery()
+
+	params = append(params, sql.Named("lastVulnerabilityID", lastVulnerabilityID),
+		sql.Named("batchSize", exportEnums.BatchSize))
+
+	return fmt.Sprintf(`
+		SELECT vulnerabilities.*, analysis.analysis_id, analysis.repository_id,
+			repositories.name AS repository_name FROM analysis
+		JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id
+		JOIN repositories ON analysis.repository_id = repositories.repository_id
+		JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id
+		WHERE %[1]s AND vulnerabilities.vulnerability_id > @lastVulnerabilityID
+		ORDER BY vulnerabilities.vulnerability_id
+		LIMIT @batchSize
+	`, condition), params
+}
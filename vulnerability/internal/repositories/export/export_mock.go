@@ -0,0 +1,35 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	exportEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/export"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) ListVulnerabilitiesBatch(_ *exportEntities.Filter,
+	_ uuid.UUID) ([]exportEntities.Vulnerability, error) {
+	args := m.MethodCalled("ListVulnerabilitiesBatch")
+
+	return args.Get(0).([]exportEntities.Vulnerability), utilsMock.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,68 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	exportEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/export"
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+)
+
+func TestListVulnerabilitiesBatch(t *testing.T) {
+	t.Run("Should success list a batch of vulnerabilities", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, []exportEntities.Vulnerability{{}}))
+
+		repository := NewExportRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListVulnerabilitiesBatch(&exportEntities.Filter{}, uuid.Nil)
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("Should return error when failed to list vulnerabilities", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewExportRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.ListVulnerabilitiesBatch(&exportEntities.Filter{}, uuid.Nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetVulnerabilitiesBatchQuery(t *testing.T) {
+	t.Run("Should use keyset pagination after the last vulnerability id", func(t *testing.T) {
+		repository := &Repository{}
+		filter := &exportEntities.Filter{
+			Filter: managementEntities.Filter{WorkspaceID: uuid.New(), AnalysisID: uuid.New()},
+		}
+
+		query, params := repository.getVulnerabilitiesBatchQuery(filter, uuid.New())
+		assert.Contains(t, query, "analysis.analysis_id = @analysisID AND "+
+			"vulnerabilities.vulnerability_id > @lastVulnerabilityID")
+		assert.Contains(t, query, "ORDER BY vulnerabilities.vulnerability_id")
+		assert.Contains(t, query, "LIMIT @batchSize")
+		assert.Len(t, params, 19)
+	})
+}
@@ -15,6 +15,7 @@
 package management
 
 import (
+	"database/sql"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -33,6 +34,12 @@ type IRepository interface {
 	ListVulnerableFiles(filter *managementEntities.Filter) (*managementEntities.ResponseFilesVulnerable, error)
 	GetVulnerability(vulnerabilityID uuid.UUID) (vuln *vulnerabilityEntities.Vulnerability, err error)
 	GetAnalysis(analysisID uuid.UUID) (analysis *analysisEntities.Analysis, err error)
+	GetLatestAnalysisID(repositoryID, ignoredAnalysisID uuid.UUID) (uuid.UUID, error)
+	GetAnalysisRevision(analysisID uuid.UUID) (int, error)
+	ListVulnerabilityHistory(filter *managementEntities.HistoryFilter) ([]managementEntities.Event, error)
+	CountBulkVulnerabilities(filter *managementEntities.Filter) (*managementEntities.BulkUpdateResult, error)
+	ListBulkVulnerabilities(filter *managementEntities.Filter,
+		lastVulnerabilityID uuid.UUID) ([]managementEntities.BulkVulnerability, error)
 }
 
 type Repository struct {
@@ -56,15 +63,13 @@ func (r *Repository) ListVulnerableFiles(
 		return nil, err
 	}
 
-	responseData, err := r.getFilesVulnerablePaginated(filter)
+	rows, err := r.getFilesVulnerablePaginated(filter)
 	if err != nil {
 		return nil, err
 	}
 
-	return &managementEntities.ResponseFilesVulnerable{
-		TotalItems: totalItems,
-		Data:       responseData,
-	}, nil
+	response := &managementEntities.ResponseFilesVulnerable{TotalItems: totalItems}
+	return response.SetData(rows, filter), nil
 }
 
 func (r *Repository) ListVulnerabilitiesByFile(
@@ -74,24 +79,30 @@ func (r *Repository) ListVulnerabilitiesByFile(
 		return nil, err
 	}
 
-	responseData, err := r.getVulnerabilitiesOfTheFilePaginated(filter)
+	rows, err := r.getVulnerabilitiesOfTheFilePaginated(filter)
 	if err != nil {
 		return nil, err
 	}
 
-	return &managementEntities.ResponseVulnerabilitiesByFile{
-		TotalItems: totalItems,
-		Data:       responseData,
-	}, nil
+	response := &managementEntities.ResponseVulnerabilitiesByFile{TotalItems: totalItems}
+	return response.SetData(rows, filter), nil
 }
 
 func (r *Repository) getTotalFilesVulnerable(filter *managementEntities.Filter) (count int, err error) {
+	if !filter.ShouldCountTotal() {
+		return managementEnums.UnknownTotalItems, nil
+	}
+
 	query, params := r.getTotalFilesVulnerableQuery(filter)
 
 	return count, r.databaseRead.Raw(query, &count, params...).GetErrorExceptNotFound()
 }
 
 func (r *Repository) getTotalVulnerabilitiesOfTheFile(filter *managementEntities.Filter) (count int, err error) {
+	if !filter.ShouldCountTotal() {
+		return managementEnums.UnknownTotalItems, nil
+	}
+
 	query, params := r.getTotalVulnerabilitiesOfTheFileQuery(filter)
 
 	return count, r.databaseRead.Raw(query, &count, params...).GetErrorExceptNotFound()
@@ -123,33 +134,38 @@ func (r *Repository) getTotalVulnerabilitiesOfTheFileQuery(filter *managementEnt
 }
 
 func (r *Repository) getFilesVulnerablePaginated(
-	filter *managementEntities.Filter) ([]managementEntities.ResponseDataFilesVulnerable, error) {
-	responseData := []managementEntities.ResponseDataFilesVulnerable{}
+	filter *managementEntities.Filter) ([]managementEntities.FileCursorRow, error) {
+	rows := []managementEntities.FileCursorRow{}
 
 	query, params := r.getFilesVulnerablePaginatedQuery(filter)
 
-	return responseData, r.databaseRead.Raw(query, &responseData, params...).GetErrorExceptNotFound()
+	return rows, r.databaseRead.Raw(query, &rows, params...).GetErrorExceptNotFound()
 }
 
 func (r *Repository) getVulnerabilitiesOfTheFilePaginated(
-	filter *managementEntities.Filter) ([]vulnerabilityEntities.Vulnerability, error) {
-	responseData := []vulnerabilityEntities.Vulnerability{}
+	filter *managementEntities.Filter) ([]managementEntities.VulnerabilityCursorRow, error) {
+	rows := []managementEntities.VulnerabilityCursorRow{}
 
 	query, params := r.getVulnerabilitiesOfTheFilePaginatedQuery(filter)
 
-	return responseData, r.databaseRead.Raw(query, &responseData, params...).GetErrorExceptNotFound()
+	return rows, r.databaseRead.Raw(query, &rows, params...).GetErrorExceptNotFound()
 }
 
 // nolint:funlen // query is not necessary broken
 func (r *Repository) getFilesVulnerablePaginatedQuery(
 	filter *managementEntities.Filter) (string, []interface{}) {
 	condition, params := filter.GetWhereFilterQuery()
+	column := r.getFilesVulnerableSortColumn(filter)
+	keyset, params := r.getKeysetQuery(filter, column, params,
+		"COALESCE(tmpTable.file, ''), tmpTable.analysis_id", "@cursorFile, CAST(@cursorID AS UUID)")
+	limit, params := r.getPaginationQuery(filter, params)
 
 	return fmt.Sprintf(`
-		SELECT * FROM (
+		SELECT *, (%[3]s)::text AS cursor_value FROM (
 			SELECT COUNT(vulnerabilities.vulnerability_id) as total_vulnerabilities,
 				vulnerabilities.file, analysis.created_at, analysis.analysis_id,
-				repositories.repository_id, repositories.name as repository_name FROM analysis
+				repositories.repository_id, repositories.name as repository_name,
+				MIN(%[6]s) as severity_rank, MIN(vulnerabilities.commit_author) as commit_author FROM analysis
 			JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id
 			JOIN repositories ON analysis.repository_id = repositories.repository_id
 			JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id
@@ -157,27 +173,115 @@ func (r *Repository) getFilesVulnerablePaginatedQuery(
 			GROUP BY vulnerabilities.file, analysis.created_at, analysis.analysis_id,
 				repositories.repository_id, repositories.name
 		) AS tmpTable
-		ORDER BY tmpTable.total_vulnerabilities DESC, tmpTable.file
-		LIMIT @size OFFSET @skip
-	`, condition), params
+		%[4]s
+		ORDER BY %[2]s
+		%[5]s
+	`, condition, r.getFilesVulnerableOrderBy(filter, column), column.expression, keyset, limit,
+		r.getSeverityRank("vulnerabilities.severity")), params
+}
+
+// getFilesVulnerableSortColumn maps the sort key of the filter to the columns of the files query, by default the
+// files with more vulnerabilities are listed first
+func (r *Repository) getFilesVulnerableSortColumn(filter *managementEntities.Filter) *sortColumn {
+	switch filter.SortBy {
+	case managementEnums.SortBySeverity:
+		return newSortColumn("tmpTable.severity_rank", "INTEGER", filter.GetSortDirection())
+	case managementEnums.SortByFile:
+		return newSortColumn("COALESCE(tmpTable.file, '')", "VARCHAR", filter.GetSortDirection())
+	case managementEnums.SortByCreatedAt:
+		return newSortColumn("tmpTable.created_at", "TIMESTAMP", filter.GetSortDirection())
+	case managementEnums.SortByAuthor:
+		return newSortColumn("COALESCE(tmpTable.commit_author, '')", "VARCHAR", filter.GetSortDirection())
+	default:
+		return newSortColumn("tmpTable.total_vulnerabilities", "INTEGER", "DESC")
+	}
+}
+
+// getFilesVulnerableOrderBy keeps the previous order of the files with the same total of vulnerabilities on page
+// number pagination, the cursor pagination needs every column in the same direction to compare them as a row
+func (r *Repository) getFilesVulnerableOrderBy(filter *managementEntities.Filter, column *sortColumn) string {
+	if filter.SortBy == "" && !filter.CursorPagination {
+		return "tmpTable.total_vulnerabilities DESC, tmpTable.file"
+	}
+
+	return fmt.Sprintf("%[1]s %[2]s, COALESCE(tmpTable.file, '') %[2]s, tmpTable.analysis_id %[2]s",
+		column.expression, column.direction)
 }
 
 func (r *Repository) getVulnerabilitiesOfTheFilePaginatedQuery(
 	filter *managementEntities.Filter) (string, []interface{}) {
 	condition, params := filter.GetWhereFilterQuery()
+	column := r.getVulnerabilitiesOfTheFileSortColumn(filter)
+	keyset, params := r.getKeysetQuery(filter, column, params, "tmpTable.vulnerability_id", "CAST(@cursorID AS UUID)")
+	limit, params := r.getPaginationQuery(filter, params)
 
 	return fmt.Sprintf(`
-		SELECT * FROM (
-			SELECT vulnerabilities.* FROM analysis
+		SELECT *, (%[3]s)::text AS cursor_value FROM (
+			SELECT vulnerabilities.*, analysis_vulnerabilities.created_at FROM analysis
 			JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id
 			JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id
 			WHERE %[1]s
 		) AS tmpTable
-		ORDER BY CASE tmpTable.severity
+		%[4]s
+		ORDER BY %[2]s
+		%[5]s
+	`, condition, r.getVulnerabilitiesOfTheFileOrderBy(column), column.expression, keyset, limit), params
+}
+
+func (r *Repository) getVulnerabilitiesOfTheFileOrderBy(column *sortColumn) string {
+	return fmt.Sprintf("%[1]s %[2]s, tmpTable.vulnerability_id %[2]s", column.expression, column.direction)
+}
+
+// getVulnerabilitiesOfTheFileSortColumn maps the sort key of the filter to the columns of the vulnerabilities
+// query, by default the most critical vulnerabilities are listed first
+func (r *Repository) getVulnerabilitiesOfTheFileSortColumn(filter *managementEntities.Filter) *sortColumn {
+	switch filter.SortBy {
+	case managementEnums.SortByFile:
+		return newSortColumn("COALESCE(tmpTable.file, '')", "VARCHAR", filter.GetSortDirection())
+	case managementEnums.SortByCreatedAt:
+		return newSortColumn("tmpTable.created_at", "DATE", filter.GetSortDirection())
+	case managementEnums.SortByAuthor:
+		return newSortColumn("COALESCE(tmpTable.commit_author, '')", "VARCHAR", filter.GetSortDirection())
+	default:
+		return newSortColumn(r.getSeverityRank("tmpTable.severity"), "INTEGER", filter.GetSortDirection())
+	}
+}
+
+// getKeysetQuery returns the condition to start the page after the row of the cursor, comparing the sort column
+// and the columns that break its ties as a row in the direction of the sorting
+func (r *Repository) getKeysetQuery(filter *managementEntities.Filter, column *sortColumn, params []interface{},
+	tieColumns, tieParams string) (string, []interface{}) {
+	if !filter.CursorPagination || filter.Cursor == nil {
+		return "", params
+	}
+
+	operator := ">"
+	if column.direction == "DESC" {
+		operator = "<"
+	}
+
+	return fmt.Sprintf("WHERE (%s, %s) %s (CAST(@cursorValue AS %s), %s)", column.expression, tieColumns, operator,
+			column.sqlType, tieParams),
+		append(params, sql.Named("cursorValue", filter.Cursor.Value), sql.Named("cursorFile", filter.Cursor.File),
+			sql.Named("cursorID", filter.Cursor.ID))
+}
+
+// getPaginationQuery returns the limit of the page, on cursor pagination one extra row is queried to know if there
+// is a next page without counting all the rows
+func (r *Repository) getPaginationQuery(filter *managementEntities.Filter,
+	params []interface{}) (string, []interface{}) {
+	if !filter.CursorPagination {
+		return "LIMIT @size OFFSET @skip", params
+	}
+
+	return "LIMIT @cursorLimit", append(params, sql.Named("cursorLimit", filter.Size+1))
+}
+
+// getSeverityRank returns the position of the severity of the column, from the most to the least critical
+func (r *Repository) getSeverityRank(column string) string {
+	return fmt.Sprintf(`CASE %s
 		WHEN 'CRITICAL' THEN 1 WHEN 'HIGH' THEN 2 WHEN 'MEDIUM' THEN 3 WHEN 'LOW' THEN 4
-		WHEN 'UNKNOWN' THEN 5 WHEN 'INFO' THEN 6 END
-		LIMIT @size OFFSET @skip
-	`, condition), params
+		WHEN 'UNKNOWN' THEN 5 WHEN 'INFO' THEN 6 ELSE 7 END`, column)
 }
 
 func (r *Repository) GetVulnerability(vulnerabilityID uuid.UUID) (*vulnerabilityEntities.Vulnerability, error) {
@@ -198,3 +302,117 @@ func (r *Repository) GetAnalysis(analysisID uuid.UUID) (*analysisEntities.Analys
 	return analysis, r.databaseRead.FindPreload(analysis, r.useCases.FilterAnalysisByID(analysisID),
 		preloads, managementEnums.AnalysisTable).GetError()
 }
+
+// GetLatestAnalysisID returns the id of the newest analysis of the repository, except the ignored one. When the
+// repository has no other analysis an empty id will be returned
+func (r *Repository) GetLatestAnalysisID(repositoryID, ignoredAnalysisID uuid.UUID) (uuid.UUID, error) {
+	var analysisIDs []uuid.UUID
+
+	if err := r.databaseRead.Raw(r.getLatestAnalysisIDQuery(), &analysisIDs,
+		sql.Named("repositoryID", repositoryID), sql.Named("ignoredAnalysisID", ignoredAnalysisID),
+	).GetErrorExceptNotFound(); err != nil || len(analysisIDs) == 0 {
+		return uuid.Nil, err
+	}
+
+	return analysisIDs[0], nil
+}
+
+func (r *Repository) getLatestAnalysisIDQuery() string {
+	return `
+		SELECT analysis_id FROM analysis
+		WHERE repository_id = @repositoryID AND analysis_id <> @ignoredAnalysisID
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+}
+
+// GetAnalysisRevision returns the revision of the analysis, which is increased every time its vulnerabilities change
+// so the consumers can tell which packet of the analysis is the newest one
+func (r *Repository) GetAnalysisRevision(analysisID uuid.UUID) (revision int, err error) {
+	return revision, r.databaseRead.Raw(r.getAnalysisRevisionQuery(), &revision,
+		sql.Named("analysisID", analysisID)).GetError()
+}
+
+func (r *Repository) getAnalysisRevisionQuery() string {
+	return `
+		SELECT revision FROM analysis
+		WHERE analysis_id = @analysisID
+	`
+}
+
+// ListVulnerabilityHistory returns the events of the vulnerability from the newest to the oldest, vulnerabilities
+// that not belong to the workspace or repository of the filter returns an empty history
+func (r *Repository) ListVulnerabilityHistory(
+	filter *managementEntities.HistoryFilter) ([]managementEntities.Event, error) {
+	events := []managementEntities.Event{}
+
+	query, params := r.getVulnerabilityHistoryQuery(filter)
+
+	return events, r.databaseRead.Raw(query, &events, params...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) getVulnerabilityHistoryQuery(filter *managementEntities.HistoryFilter) (string, []interface{}) {
+	condition, params := filter.GetWhereFilterQuery()
+
+	return fmt.Sprintf(`
+		SELECT vulnerability_events.* FROM vulnerability_events
+		WHERE vulnerability_events.vulnerability_id = @vulnerabilityID AND EXISTS (
+			SELECT 1 FROM analysis_vulnerabilities
+			JOIN analysis ON analysis.analysis_id = analysis_vulnerabilities.analysis_id
+			WHERE analysis_vulnerabilities.vulnerability_id = @vulnerabilityID AND %[1]s
+		)
+		ORDER BY vulnerability_events.created_at DESC
+	`, condition), params
+}
+
+// CountBulkVulnerabilities returns how many vulnerabilities and repositories are matched by the filter of a bulk
+// update
+func (r *Repository) CountBulkVulnerabilities(
+	filter *managementEntities.Filter) (*managementEntities.BulkUpdateResult, error) {
+	result := &managementEntities.BulkUpdateResult{}
+
+	query, params := r.getCountBulkVulnerabilitiesQuery(filter)
+
+	return result, r.databaseRead.Raw(query, result, params...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) getCountBulkVulnerabilitiesQuery(filter *managementEntities.Filter) (string, []interface{}) {
+	condition, params := filter.GetWhereFilterQuery()
+
+	return fmt.Sprintf(`
+		SELECT COUNT(DISTINCT vulnerabilities.vulnerability_id) AS total_vulnerabilities,
+			COUNT(DISTINCT analysis.repository_id) AS total_repositories FROM analysis
+		JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id
+		JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id
+		WHERE %[1]s
+	`, condition), params
+}
+
+// ListBulkVulnerabilities returns the next batch of vulnerabilities matched by the filter of a bulk update, after
+// the last vulnerability of the previous batch. Since the vulnerabilities are sorted by id, the updated ones that
+// still match the filter are not listed again
+func (r *Repository) ListBulkVulnerabilities(filter *managementEntities.Filter,
+	lastVulnerabilityID uuid.UUID) ([]managementEntities.BulkVulnerability, error) {
+	vulnerabilities := []managementEntities.BulkVulnerability{}
+
+	query, params := r.getListBulkVulnerabilitiesQuery(filter, lastVulnerabilityID)
+
+	return vulnerabilities, r.databaseRead.Raw(query, &vulnerabilities, params...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) getListBulkVulnerabilitiesQuery(filter *managementEntities.Filter,
+	lastVulnerabilityID uuid.UUID) (string, []interface{}) {
+	condition, params := filter.GetWhereFilterQuery()
+	params = append(params, sql.Named("lastVulnerabilityID", lastVulnerabilityID),
+		sql.Named("batchSize", managementEnums.BulkBatchSize))
+
+	return fmt.Sprintf(`
+		SELECT DISTINCT ON (vulnerabilities.vulnerability_id) vulnerabilities.*, analysis.analysis_id,
+			analysis.repository_id FROM analysis
+		JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id
+		JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id
+		WHERE %[1]s AND vulnerabilities.vulnerability_id > @lastVulnerabilityID
+		ORDER BY vulnerabilities.vulnerability_id
+		LIMIT @batchSize
+	`, condition), params
+}
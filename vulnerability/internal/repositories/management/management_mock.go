@@ -52,3 +52,34 @@ func (m *Mock) GetAnalysis(_ uuid.UUID) (analysis *analysisEntities.Analysis, er
 
 	return args.Get(0).(*analysisEntities.Analysis), utilsMock.ReturnNilOrError(args, 1)
 }
+
+func (m *Mock) GetLatestAnalysisID(_, _ uuid.UUID) (uuid.UUID, error) {
+	args := m.MethodCalled("GetLatestAnalysisID")
+
+	return args.Get(0).(uuid.UUID), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetAnalysisRevision(_ uuid.UUID) (int, error) {
+	args := m.MethodCalled("GetAnalysisRevision")
+
+	return args.Get(0).(int), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListVulnerabilityHistory(_ *managementEntities.HistoryFilter) ([]managementEntities.Event, error) {
+	args := m.MethodCalled("ListVulnerabilityHistory")
+
+	return args.Get(0).([]managementEntities.Event), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) CountBulkVulnerabilities(_ *managementEntities.Filter) (*managementEntities.BulkUpdateResult, error) {
+	args := m.MethodCalled("CountBulkVulnerabilities")
+
+	return args.Get(0).(*managementEntities.BulkUpdateResult), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListBulkVulnerabilities(_ *managementEntities.Filter,
+	_ uuid.UUID) ([]managementEntities.BulkVulnerability, error) {
+	args := m.MethodCalled("ListBulkVulnerabilities")
+
+	return args.Get(0).([]managementEntities.BulkVulnerability), utilsMock.ReturnNilOrError(args, 1)
+}
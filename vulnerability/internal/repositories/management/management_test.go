@@ -15,6 +15,7 @@
 package management
 
 import (
+	"database/sql"
 	"errors"
 	"testing"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
 
 	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
 	managementUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/management"
 )
 
@@ -167,3 +169,340 @@ func TestGetAnalysis(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestGetLatestAnalysisID(t *testing.T) {
+	t.Run("should success get latest analysis id", func(t *testing.T) {
+		analysisID := uuid.New()
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, []uuid.UUID{analysisID}))
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		repository := NewManagementRepository(databaseConnection, managementUseCases.NewManagementUseCases())
+
+		result, err := repository.GetLatestAnalysisID(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, analysisID, result)
+	})
+
+	t.Run("should return empty id when repository has no other analysis", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, nil, []uuid.UUID{}))
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		repository := NewManagementRepository(databaseConnection, managementUseCases.NewManagementUseCases())
+
+		result, err := repository.GetLatestAnalysisID(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.Nil, result)
+	})
+
+	t.Run("should return error when failed to get latest analysis id", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		repository := NewManagementRepository(databaseConnection, managementUseCases.NewManagementUseCases())
+
+		_, err := repository.GetLatestAnalysisID(uuid.New(), uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestGetAnalysisRevision(t *testing.T) {
+	t.Run("should return the revision of the analysis", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, 2))
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		repository := NewManagementRepository(databaseConnection, managementUseCases.NewManagementUseCases())
+
+		result, err := repository.GetAnalysisRevision(uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result)
+	})
+
+	t.Run("should return error when failed to get the revision", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		repository := NewManagementRepository(databaseConnection, managementUseCases.NewManagementUseCases())
+
+		_, err := repository.GetAnalysisRevision(uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestListVulnerabilityHistory(t *testing.T) {
+	t.Run("should success list vulnerability history", func(t *testing.T) {
+		eventID := uuid.New()
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil,
+			[]managementEntities.Event{{EventID: eventID, Justification: "test"}}))
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		repository := NewManagementRepository(databaseConnection, managementUseCases.NewManagementUseCases())
+
+		result, err := repository.ListVulnerabilityHistory(&managementEntities.HistoryFilter{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, eventID, result[0].EventID)
+	})
+
+	t.Run("should return empty history when not found records", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, nil, []managementEntities.Event{}))
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		repository := NewManagementRepository(databaseConnection, managementUseCases.NewManagementUseCases())
+
+		result, err := repository.ListVulnerabilityHistory(&managementEntities.HistoryFilter{})
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should return error when failed to list vulnerability history", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		repository := NewManagementRepository(databaseConnection, managementUseCases.NewManagementUseCases())
+
+		_, err := repository.ListVulnerabilityHistory(&managementEntities.HistoryFilter{})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetFilesVulnerableOrderBy(t *testing.T) {
+	t.Run("should order by total of vulnerabilities when sort is not informed", func(t *testing.T) {
+		repository := &Repository{}
+		filter := &managementEntities.Filter{}
+
+		assert.Equal(t, "tmpTable.total_vulnerabilities DESC, tmpTable.file",
+			repository.getFilesVulnerableOrderBy(filter, repository.getFilesVulnerableSortColumn(filter)))
+	})
+
+	t.Run("should order by the column of the sort key and direction", func(t *testing.T) {
+		repository := &Repository{}
+		filter := &managementEntities.Filter{SortBy: managementEnums.SortBySeverity,
+			SortOrder: managementEnums.SortOrderDesc}
+
+		assert.Equal(t, "tmpTable.severity_rank DESC, COALESCE(tmpTable.file, '') DESC, tmpTable.analysis_id DESC",
+			repository.getFilesVulnerableOrderBy(filter, repository.getFilesVulnerableSortColumn(filter)))
+
+		filter = &managementEntities.Filter{SortBy: managementEnums.SortByAuthor}
+		assert.Equal(t, "COALESCE(tmpTable.commit_author, '') ASC, COALESCE(tmpTable.file, '') ASC, "+
+			"tmpTable.analysis_id ASC",
+			repository.getFilesVulnerableOrderBy(filter, repository.getFilesVulnerableSortColumn(filter)))
+	})
+
+	t.Run("should order by total of vulnerabilities and break ties when using cursor", func(t *testing.T) {
+		repository := &Repository{}
+		filter := &managementEntities.Filter{CursorPagination: true}
+
+		assert.Equal(t, "tmpTable.total_vulnerabilities DESC, COALESCE(tmpTable.file, '') DESC, "+
+			"tmpTable.analysis_id DESC",
+			repository.getFilesVulnerableOrderBy(filter, repository.getFilesVulnerableSortColumn(filter)))
+	})
+
+	t.Run("should use the severity rank and author on the files query", func(t *testing.T) {
+		repository := &Repository{}
+
+		query, _ := repository.getFilesVulnerablePaginatedQuery(&managementEntities.Filter{
+			SortBy: managementEnums.SortByCreatedAt})
+		assert.Contains(t, query, "MIN(CASE vulnerabilities.severity")
+		assert.Contains(t, query, "MIN(vulnerabilities.commit_author) as commit_author")
+		assert.Contains(t, query, "ORDER BY tmpTable.created_at ASC, COALESCE(tmpTable.file, '') ASC, "+
+			"tmpTable.analysis_id ASC")
+	})
+}
+
+func TestGetVulnerabilitiesOfTheFileOrderBy(t *testing.T) {
+	t.Run("should order by severity when sort is not informed", func(t *testing.T) {
+		repository := &Repository{}
+
+		orderBy := repository.getVulnerabilitiesOfTheFileOrderBy(
+			repository.getVulnerabilitiesOfTheFileSortColumn(&managementEntities.Filter{}))
+		assert.Contains(t, orderBy, "CASE tmpTable.severity")
+		assert.Contains(t, orderBy, "WHEN 'CRITICAL' THEN 1")
+		assert.Contains(t, orderBy, "END ASC, tmpTable.vulnerability_id ASC")
+	})
+
+	t.Run("should order by the column of the sort key and direction", func(t *testing.T) {
+		repository := &Repository{}
+
+		assert.Equal(t, "COALESCE(tmpTable.file, '') DESC, tmpTable.vulnerability_id DESC",
+			repository.getVulnerabilitiesOfTheFileOrderBy(repository.getVulnerabilitiesOfTheFileSortColumn(
+				&managementEntities.Filter{SortBy: managementEnums.SortByFile, SortOrder: managementEnums.SortOrderDesc})))
+		assert.Equal(t, "tmpTable.created_at ASC, tmpTable.vulnerability_id ASC",
+			repository.getVulnerabilitiesOfTheFileOrderBy(repository.getVulnerabilitiesOfTheFileSortColumn(
+				&managementEntities.Filter{SortBy: managementEnums.SortByCreatedAt})))
+	})
+
+	t.Run("should select the date the vulnerability was added to the analysis", func(t *testing.T) {
+		repository := &Repository{}
+
+		query, _ := repository.getVulnerabilitiesOfTheFilePaginatedQuery(&managementEntities.Filter{
+			SortBy: managementEnums.SortByAuthor})
+		assert.Contains(t, query, "SELECT vulnerabilities.*, analysis_vulnerabilities.created_at FROM analysis")
+		assert.Contains(t, query, "ORDER BY COALESCE(tmpTable.commit_author, '') ASC, tmpTable.vulnerability_id ASC")
+	})
+}
+
+func TestCursorPagination(t *testing.T) {
+	t.Run("should use limit and offset when not using cursor", func(t *testing.T) {
+		repository := &Repository{}
+
+		query, params := repository.getVulnerabilitiesOfTheFilePaginatedQuery(&managementEntities.Filter{Size: 10})
+		assert.Contains(t, query, "LIMIT @size OFFSET @skip")
+		assert.NotContains(t, query, "@cursorValue")
+		assert.Len(t, params, 17)
+	})
+
+	t.Run("should query one extra row without keyset on the first page", func(t *testing.T) {
+		repository := &Repository{}
+
+		query, params := repository.getVulnerabilitiesOfTheFilePaginatedQuery(&managementEntities.Filter{
+			Size: 10, CursorPagination: true})
+		assert.Contains(t, query, "LIMIT @cursorLimit")
+		assert.NotContains(t, query, "@cursorValue")
+		assert.Contains(t, params, sql.Named("cursorLimit", 11))
+	})
+
+	t.Run("should start after the cursor of the vulnerabilities in the sort direction", func(t *testing.T) {
+		repository := &Repository{}
+		cursor := &managementEntities.Cursor{Value: "2", ID: uuid.New()}
+
+		query, params := repository.getVulnerabilitiesOfTheFilePaginatedQuery(&managementEntities.Filter{
+			Size: 10, CursorPagination: true, Cursor: cursor})
+		assert.Contains(t, query, "END, tmpTable.vulnerability_id) > (CAST(@cursorValue AS INTEGER), "+
+			"CAST(@cursorID AS UUID))")
+		assert.Contains(t, params, sql.Named("cursorValue", "2"))
+		assert.Contains(t, params, sql.Named("cursorID", cursor.ID))
+
+		query, _ = repository.getVulnerabilitiesOfTheFilePaginatedQuery(&managementEntities.Filter{
+			Size: 10, CursorPagination: true, Cursor: cursor, SortBy: managementEnums.SortByCreatedAt,
+			SortOrder: managementEnums.SortOrderDesc})
+		assert.Contains(t, query, "WHERE (tmpTable.created_at, tmpTable.vulnerability_id) < "+
+			"(CAST(@cursorValue AS DATE), CAST(@cursorID AS UUID))")
+	})
+
+	t.Run("should start after the cursor of the files in the sort direction", func(t *testing.T) {
+		repository := &Repository{}
+		cursor := &managementEntities.Cursor{Value: "5", File: "main.go", ID: uuid.New()}
+
+		query, params := repository.getFilesVulnerablePaginatedQuery(&managementEntities.Filter{
+			Size: 10, CursorPagination: true, Cursor: cursor})
+		assert.Contains(t, query, "WHERE (tmpTable.total_vulnerabilities, COALESCE(tmpTable.file, ''), "+
+			"tmpTable.analysis_id) < (CAST(@cursorValue AS INTEGER), @cursorFile, CAST(@cursorID AS UUID))")
+		assert.Contains(t, query, "(tmpTable.total_vulnerabilities)::text AS cursor_value")
+		assert.Contains(t, params, sql.Named("cursorFile", "main.go"))
+	})
+
+	t.Run("should not count the total of items when using cursor without total", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, nil, []managementEntities.FileCursorRow{
+			{CursorValue: "3"}, {CursorValue: "2"}}))
+
+		databaseConnection := &database.Connection{
+			Read:  databaseMock,
+			Write: databaseMock,
+		}
+
+		repository := NewManagementRepository(databaseConnection, managementUseCases.NewManagementUseCases())
+
+		result, err := repository.ListVulnerableFiles(&managementEntities.Filter{Size: 1, CursorPagination: true})
+		assert.NoError(t, err)
+		assert.Equal(t, managementEnums.UnknownTotalItems, result.TotalItems)
+		assert.Len(t, result.Data, 1)
+		assert.NotEmpty(t, result.NextCursor)
+		databaseMock.AssertNumberOfCalls(t, "Raw", 1)
+	})
+}
+
+func TestCountBulkVulnerabilities(t *testing.T) {
+	t.Run("should success count the vulnerabilities and repositories of the filter", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, nil,
+			&managementEntities.BulkUpdateResult{TotalVulnerabilities: 10, TotalRepositories: 2}))
+
+		repository := NewManagementRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			managementUseCases.NewManagementUseCases())
+
+		result, err := repository.CountBulkVulnerabilities(&managementEntities.Filter{WorkspaceID: uuid.New()})
+		assert.NoError(t, err)
+		assert.Equal(t, 10, result.TotalVulnerabilities)
+		assert.Equal(t, 2, result.TotalRepositories)
+	})
+
+	t.Run("should return error when failed to count", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewManagementRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			managementUseCases.NewManagementUseCases())
+
+		_, err := repository.CountBulkVulnerabilities(&managementEntities.Filter{WorkspaceID: uuid.New()})
+		assert.Error(t, err)
+	})
+
+	t.Run("should count distinct vulnerabilities and repositories", func(t *testing.T) {
+		repository := &Repository{}
+
+		query, _ := repository.getCountBulkVulnerabilitiesQuery(&managementEntities.Filter{FilePattern: "test/**"})
+		assert.Contains(t, query, "COUNT(DISTINCT vulnerabilities.vulnerability_id) AS total_vulnerabilities")
+		assert.Contains(t, query, "COUNT(DISTINCT analysis.repository_id) AS total_repositories")
+		assert.Contains(t, query, "vulnerabilities.file ~ @filePattern")
+	})
+}
+
+func TestListBulkVulnerabilities(t *testing.T) {
+	t.Run("should success list the batch of vulnerabilities", func(t *testing.T) {
+		repositoryID := uuid.New()
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, nil, []managementEntities.BulkVulnerability{
+			{RepositoryID: repositoryID}}))
+
+		repository := NewManagementRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			managementUseCases.NewManagementUseCases())
+
+		result, err := repository.ListBulkVulnerabilities(&managementEntities.Filter{}, uuid.Nil)
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, repositoryID, result[0].RepositoryID)
+	})
+
+	t.Run("should return error when failed to list", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewManagementRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			managementUseCases.NewManagementUseCases())
+
+		_, err := repository.ListBulkVulnerabilities(&managementEntities.Filter{}, uuid.Nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("should use keyset pagination after the last vulnerability id", func(t *testing.T) {
+		repository := &Repository{}
+		lastVulnerabilityID := uuid.New()
+
+		query, params := repository.getListBulkVulnerabilitiesQuery(&managementEntities.Filter{}, lastVulnerabilityID)
+		assert.Contains(t, query, "vulnerabilities.vulnerability_id > @lastVulnerabilityID")
+		assert.Contains(t, query, "ORDER BY vulnerabilities.vulnerability_id")
+		assert.Contains(t, query, "analysis.analysis_id,")
+		assert.Contains(t, params, sql.Named("lastVulnerabilityID", lastVulnerabilityID))
+		assert.Contains(t, params, sql.Named("batchSize", managementEnums.BulkBatchSize))
+	})
+}
@@ -0,0 +1,30 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+// sortColumn is the sql expression used to sort a listing, with the type used to compare it with the cursor value
+type sortColumn struct {
+	expression string
+	sqlType    string
+	direction  string
+}
+
+func newSortColumn(expression, sqlType, direction string) *sortColumn {
+	return &sortColumn{
+		expression: expression,
+		sqlType:    sqlType,
+		direction:  direction,
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	propagationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/propagation"
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
+)
+
+type IRepository interface {
+	GetSettings(workspaceID uuid.UUID) (*propagationEntities.Settings, error)
+	GetSource(analysisID uuid.UUID) (*propagationEntities.Source, error)
+	ListTargets(source *propagationEntities.Source,
+		vulnerabilityID uuid.UUID) ([]managementEntities.BulkVulnerability, error)
+	ListPropagated(filter *managementEntities.HistoryFilter) ([]propagationEntities.PropagatedVulnerability, error)
+}
+
+type Repository struct {
+	databaseRead database.IDatabaseRead
+}
+
+func NewPropagationRepository(connection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead: connection.Read,
+	}
+}
+
+// GetSettings returns the propagation settings of the workspace, the default settings are returned when the
+// workspace never changed them
+func (r *Repository) GetSettings(workspaceID uuid.UUID) (*propagationEntities.Settings, error) {
+	settings := propagationEntities.NewDefaultSettings(workspaceID)
+
+	err := r.databaseRead.Find(settings, settings.ToFilter(), propagationEnums.SettingsTable).GetError()
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return propagationEntities.NewDefaultSettings(workspaceID), nil
+	}
+
+	return settings, err
+}
+
+// GetSource returns the workspace and repository of the analysis with the propagation settings of the workspace
+func (r *Repository) GetSource(analysisID uuid.UUID) (*propagationEntities.Source, error) {
+	source := &propagationEntities.Source{}
+
+	return source, r.databaseRead.Raw(r.getSourceQuery(), source, sql.Named("analysisID", analysisID)).GetError()
+}
+
+func (r *Repository) getSourceQuery() string {
+	return fmt.Sprintf(`
+		SELECT analysis.workspace_id, analysis.repository_id, COALESCE(settings.is_enabled, FALSE) AS is_enabled,
+			COALESCE(settings.match_by, '%[1]s') AS match_by FROM analysis
+		LEFT JOIN triage_propagation_settings AS settings ON settings.workspace_id = analysis.workspace_id
+		WHERE analysis.analysis_id = @analysisID
+	`, propagationEnums.MatchByHash)
+}
+
+// ListTargets returns the unclassified vulnerabilities of the latest analysis of the other repositories of the
+// workspace matched with the triaged vulnerability. Vulnerabilities already triaged in their own repositories are
+// never listed, so their decisions are not replaced
+func (r *Repository) ListTargets(source *propagationEntities.Source,
+	vulnerabilityID uuid.UUID) ([]managementEntities.BulkVulnerability, error) {
+	vulnerabilities := []managementEntities.BulkVulnerability{}
+
+	return vulnerabilities, r.databaseRead.Raw(r.getListTargetsQuery(source), &vulnerabilities,
+		sql.Named("workspaceID", source.WorkspaceID), sql.Named("repositoryID", source.RepositoryID),
+		sql.Named("vulnerabilityID", vulnerabilityID), sql.Named("unclassifiedType", vulnerabilityEnums.Vulnerability),
+	).GetErrorExceptNotFound()
+}
+
+func (r *Repository) getListTargetsQuery(source *propagationEntities.Source) string {
+	return fmt.Sprintf(`
+		SELECT DISTINCT ON (vulnerabilities.vulnerability_id) vulnerabilities.*, analysis.analysis_id,
+			analysis.repository_id FROM analysis
+		JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id
+		JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id
+		JOIN vulnerabilities AS source ON source.vulnerability_id = @vulnerabilityID
+		WHERE analysis.workspace_id = @workspaceID AND analysis.repository_id <> @repositoryID
+			AND vulnerabilities.type = @unclassifiedType AND %[1]s AND %[2]s
+		ORDER BY vulnerabilities.vulnerability_id, analysis.created_at DESC
+	`, source.GetMatchQuery(), r.getLatestAnalysisCondition())
+}
+
+// getLatestAnalysisCondition keeps only the latest analysis of each repository of the workspace, since the older
+// ones are never the current state of the repository and their changes would be published to the wrong analysis
+func (r *Repository) getLatestAnalysisCondition() string {
+	return `analysis.analysis_id IN (SELECT DISTINCT ON (latest.repository_id) latest.analysis_id FROM analysis
+		AS latest WHERE latest.workspace_id = @workspaceID ORDER BY latest.repository_id, latest.created_at DESC)`
+}
+
+// ListPropagated returns the vulnerabilities of the workspace whose latest change was the propagation of the
+// triage of the vulnerability of the filter, which are the ones reverted by an undo
+func (r *Repository) ListPropagated(
+	filter *managementEntities.HistoryFilter) ([]propagationEntities.PropagatedVulnerability, error) {
+	vulnerabilities := []propagationEntities.PropagatedVulnerability{}
+
+	query, params := r.getListPropagatedQuery(filter)
+
+	return vulnerabilities, r.databaseRead.Raw(query, &vulnerabilities, params...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) getListPropagatedQuery(filter *managementEntities.HistoryFilter) (string, []interface{}) {
+	condition, params := filter.GetWhereFilterQuery()
+
+	return fmt.Sprintf(`
+		SELECT DISTINCT ON (vulnerabilities.vulnerability_id) vulnerabilities.*, analysis.analysis_id,
+			analysis.repository_id, events.old_type, events.created_at AS propagated_at FROM analysis
+		JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id
+		JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id
+		JOIN LATERAL (
+			SELECT vulnerability_events.old_type, vulnerability_events.propagated_from_id,
+				vulnerability_events.created_at FROM vulnerability_events
+			WHERE vulnerability_events.vulnerability_id = vulnerabilities.vulnerability_id
+			ORDER BY vulnerability_events.created_at DESC
+			LIMIT 1
+		) AS events ON events.propagated_from_id = @vulnerabilityID
+		WHERE %[1]s AND %[2]s
+		ORDER BY vulnerabilities.vulnerability_id, analysis.created_at DESC
+	`, condition, r.getLatestAnalysisCondition()), params
+}
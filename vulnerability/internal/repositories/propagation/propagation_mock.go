@@ -0,0 +1,55 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	propagationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/propagation"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GetSettings(_ uuid.UUID) (*propagationEntities.Settings, error) {
+	args := m.MethodCalled("GetSettings")
+
+	return args.Get(0).(*propagationEntities.Settings), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetSource(_ uuid.UUID) (*propagationEntities.Source, error) {
+	args := m.MethodCalled("GetSource")
+
+	return args.Get(0).(*propagationEntities.Source), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListTargets(_ *propagationEntities.Source,
+	_ uuid.UUID) ([]managementEntities.BulkVulnerability, error) {
+	args := m.MethodCalled("ListTargets")
+
+	return args.Get(0).([]managementEntities.BulkVulnerability), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListPropagated(
+	_ *managementEntities.HistoryFilter) ([]propagationEntities.PropagatedVulnerability, error) {
+	args := m.MethodCalled("ListPropagated")
+
+	return args.Get(0).([]propagationEntities.PropagatedVulnerability), utilsMock.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,236 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	propagationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/propagation"
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
+)
+
+func TestNewPropagationRepository(t *testing.T) {
+	t.Run("should success create a new repository", func(t *testing.T) {
+		assert.NotNil(t, NewPropagationRepository(&database.Connection{}))
+	})
+}
+
+func TestGetSettings(t *testing.T) {
+	t.Run("should success get the settings of the workspace", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &propagationEntities.Settings{
+			WorkspaceID: workspaceID, IsEnabled: true, MatchBy: propagationEnums.MatchByCode}))
+
+		repository := NewPropagationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.GetSettings(workspaceID)
+		assert.NoError(t, err)
+		assert.True(t, result.IsEnabled)
+		assert.Equal(t, propagationEnums.MatchByCode, result.MatchBy)
+	})
+
+	t.Run("should return the default settings when the workspace never changed them", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(0, databaseEnums.ErrorNotFoundRecords, nil))
+
+		repository := NewPropagationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.GetSettings(workspaceID)
+		assert.NoError(t, err)
+		assert.Equal(t, propagationEntities.NewDefaultSettings(workspaceID), result)
+	})
+
+	t.Run("should return error when failed to get the settings", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewPropagationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.GetSettings(uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestGetSource(t *testing.T) {
+	t.Run("should success get the source of the analysis", func(t *testing.T) {
+		repositoryID := uuid.New()
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, &propagationEntities.Source{
+			RepositoryID: repositoryID, IsEnabled: true, MatchBy: propagationEnums.MatchByHash}))
+
+		repository := NewPropagationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.GetSource(uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, repositoryID, result.RepositoryID)
+		assert.True(t, result.IsEnabled)
+	})
+
+	t.Run("should return error when the analysis does not exist", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, databaseEnums.ErrorNotFoundRecords, nil))
+
+		repository := NewPropagationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.GetSource(uuid.New())
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestGetSourceQuery(t *testing.T) {
+	t.Run("should default to disabled propagation matching by hash", func(t *testing.T) {
+		query := (&Repository{}).getSourceQuery()
+
+		assert.Contains(t, query, "COALESCE(settings.is_enabled, FALSE) AS is_enabled")
+		assert.Contains(t, query, "COALESCE(settings.match_by, 'hash') AS match_by")
+		assert.Contains(t, query, "LEFT JOIN triage_propagation_settings")
+	})
+}
+
+func TestListTargets(t *testing.T) {
+	t.Run("should success list the targets", func(t *testing.T) {
+		vulnerability := managementEntities.BulkVulnerability{RepositoryID: uuid.New()}
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil,
+			[]managementEntities.BulkVulnerability{vulnerability}))
+
+		repository := NewPropagationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListTargets(&propagationEntities.Source{}, uuid.New())
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, vulnerability.RepositoryID, result[0].RepositoryID)
+	})
+
+	t.Run("should return no error when there are no targets", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, databaseEnums.ErrorNotFoundRecords, nil))
+
+		repository := NewPropagationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListTargets(&propagationEntities.Source{}, uuid.New())
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should return error when failed to list the targets", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewPropagationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.ListTargets(&propagationEntities.Source{}, uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestGetListTargetsQuery(t *testing.T) {
+	t.Run("should list only unclassified vulnerabilities of the other repositories", func(t *testing.T) {
+		query := (&Repository{}).getListTargetsQuery(&propagationEntities.Source{MatchBy: propagationEnums.MatchByHash})
+
+		assert.Contains(t, query, "analysis.repository_id <> @repositoryID")
+		assert.Contains(t, query, "vulnerabilities.type = @unclassifiedType")
+		assert.Contains(t, query, "vulnerabilities.vuln_hash = source.vuln_hash")
+	})
+
+	t.Run("should match by code when the workspace matches by code", func(t *testing.T) {
+		source := &propagationEntities.Source{MatchBy: propagationEnums.MatchByCode}
+
+		query := (&Repository{}).getListTargetsQuery(source)
+		assert.Contains(t, query, source.GetMatchQuery())
+		assert.NotContains(t, query, "vuln_hash")
+	})
+}
+
+func TestGetLatestAnalysisCondition(t *testing.T) {
+	t.Run("should keep only the newest analysis when the repository has two analyses", func(t *testing.T) {
+		condition := (&Repository{}).getLatestAnalysisCondition()
+
+		assert.Contains(t, condition, "analysis.analysis_id IN (SELECT DISTINCT ON (latest.repository_id)")
+		assert.Contains(t, condition, "latest.workspace_id = @workspaceID")
+		assert.Contains(t, condition, "ORDER BY latest.repository_id, latest.created_at DESC")
+	})
+
+	t.Run("should restrict the targets and the propagated vulnerabilities to the latest analyses", func(t *testing.T) {
+		repository := &Repository{}
+		targetsQuery := repository.getListTargetsQuery(&propagationEntities.Source{MatchBy: propagationEnums.MatchByHash})
+		propagatedQuery, _ := repository.getListPropagatedQuery(&managementEntities.HistoryFilter{})
+
+		assert.Contains(t, targetsQuery, repository.getLatestAnalysisCondition())
+		assert.Contains(t, propagatedQuery, repository.getLatestAnalysisCondition())
+	})
+}
+
+func TestListPropagated(t *testing.T) {
+	t.Run("should success list the propagated vulnerabilities", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil,
+			[]propagationEntities.PropagatedVulnerability{{OldType: "Vulnerability"}}))
+
+		repository := NewPropagationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListPropagated(&managementEntities.HistoryFilter{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("should return no error when there are no propagated vulnerabilities", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, databaseEnums.ErrorNotFoundRecords, nil))
+
+		repository := NewPropagationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListPropagated(&managementEntities.HistoryFilter{})
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should return error when failed to list the propagated vulnerabilities", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewPropagationRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.ListPropagated(&managementEntities.HistoryFilter{})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetListPropagatedQuery(t *testing.T) {
+	t.Run("should list only vulnerabilities whose latest event is the propagation", func(t *testing.T) {
+		query, params := (&Repository{}).getListPropagatedQuery(&managementEntities.HistoryFilter{})
+
+		assert.Contains(t, query, "ORDER BY vulnerability_events.created_at DESC")
+		assert.Contains(t, query, "LIMIT 1")
+		assert.Contains(t, query, "events.propagated_from_id = @vulnerabilityID")
+		assert.Contains(t, query, "analysis.workspace_id = @workspaceID")
+		assert.Len(t, params, 3)
+	})
+}
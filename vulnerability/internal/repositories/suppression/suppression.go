@@ -0,0 +1,108 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"database/sql"
+	"fmt"
+
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	suppressionEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/suppression"
+	suppressionEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/suppression"
+)
+
+type IRepository interface {
+	ListRules(scope *suppressionEntities.Scope) ([]suppressionEntities.Rule, error)
+	GetRule(scope *suppressionEntities.Scope) (*suppressionEntities.Rule, error)
+	PreviewMatches(data *suppressionEntities.PreviewData) (*suppressionEntities.PreviewResponse, error)
+}
+
+type Repository struct {
+	databaseRead database.IDatabaseRead
+}
+
+func NewSuppressionRepository(connection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead: connection.Read,
+	}
+}
+
+// ListRules returns the rules of the workspace, when the scope is a repository only the rules of the repository
+// and the workspace rules are returned
+func (r *Repository) ListRules(scope *suppressionEntities.Scope) ([]suppressionEntities.Rule, error) {
+	rules := []suppressionEntities.Rule{}
+
+	return rules, r.databaseRead.Raw(r.getListRulesQuery(scope), &rules,
+		sql.Named("workspaceID", scope.WorkspaceID), sql.Named("repositoryID", scope.RepositoryID)).
+		GetErrorExceptNotFound()
+}
+
+func (r *Repository) getListRulesQuery(scope *suppressionEntities.Scope) string {
+	condition := "workspace_id = @workspaceID"
+	if scope.IsRepositoryScope() {
+		condition += " AND (repository_id = @repositoryID OR repository_id IS NULL)"
+	}
+
+	return fmt.Sprintf(`
+		SELECT * FROM suppression_rules
+		WHERE %[1]s
+		ORDER BY created_at
+	`, condition)
+}
+
+// GetRule returns the rule of the scope, workspace rules are not reachable by a repository scope
+func (r *Repository) GetRule(scope *suppressionEntities.Scope) (*suppressionEntities.Rule, error) {
+	rule := &suppressionEntities.Rule{}
+
+	return rule, r.databaseRead.Find(rule, scope.ToFilter(), suppressionEnums.RulesTable).GetError()
+}
+
+// PreviewMatches returns the unclassified vulnerabilities of the latest analysis of each repository of the scope
+// matched by the rule, which are the ones expected to be classified by it in the next analyses
+func (r *Repository) PreviewMatches(
+	data *suppressionEntities.PreviewData) (*suppressionEntities.PreviewResponse, error) {
+	preview := &suppressionEntities.PreviewResponse{Data: []vulnerabilityEntities.Vulnerability{}}
+
+	query, params := r.getPreviewMatchesQuery(data, "COUNT(DISTINCT vulnerabilities.vulnerability_id) AS total_items")
+	if err := r.databaseRead.Raw(query, preview, params...).GetErrorExceptNotFound(); err != nil {
+		return nil, err
+	}
+
+	query, params = r.getPreviewMatchesQuery(data,
+		"DISTINCT ON (vulnerabilities.vulnerability_id) vulnerabilities.*")
+	query += " ORDER BY vulnerabilities.vulnerability_id LIMIT @size OFFSET @skip"
+
+	return preview, r.databaseRead.Raw(query, &preview.Data,
+		append(params, data.GetPaginationParams()...)...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) getPreviewMatchesQuery(data *suppressionEntities.PreviewData,
+	selection string) (string, []interface{}) {
+	analysisCondition, params := data.GetAnalysisQuery()
+	matchCondition, matchParams := data.ToRule().GetMatchQuery()
+
+	return fmt.Sprintf(`
+		SELECT %[1]s FROM analysis
+		JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id
+		JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id
+		WHERE analysis.analysis_id IN (
+			SELECT DISTINCT ON (analysis.repository_id) analysis.analysis_id FROM analysis
+			WHERE %[2]s
+			ORDER BY analysis.repository_id, analysis.created_at DESC
+		) AND %[3]s
+	`, selection, analysisCondition, matchCondition), append(params, matchParams...)
+}
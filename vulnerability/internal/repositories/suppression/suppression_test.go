@@ -0,0 +1,177 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	suppressionEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/suppression"
+)
+
+func newPreviewData() *suppressionEntities.PreviewData {
+	return &suppressionEntities.PreviewData{
+		RuleData: suppressionEntities.RuleData{FilePattern: "test/**"},
+		Page:     1,
+		Size:     10,
+	}
+}
+
+func TestNewSuppressionRepository(t *testing.T) {
+	t.Run("should success create a new repository", func(t *testing.T) {
+		assert.NotNil(t, NewSuppressionRepository(&database.Connection{}))
+	})
+}
+
+func TestListRules(t *testing.T) {
+	t.Run("should success list rules", func(t *testing.T) {
+		rule := suppressionEntities.Rule{RuleID: uuid.New(), Name: "test"}
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, []suppressionEntities.Rule{rule}))
+
+		repository := NewSuppressionRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListRules(&suppressionEntities.Scope{RepositoryID: uuid.New()})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, rule.RuleID, result[0].RuleID)
+	})
+
+	t.Run("should return no error when there are no rules", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, databaseEnums.ErrorNotFoundRecords, nil))
+
+		repository := NewSuppressionRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.ListRules(&suppressionEntities.Scope{})
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should return error when failed to list rules", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewSuppressionRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.ListRules(&suppressionEntities.Scope{})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetListRulesQuery(t *testing.T) {
+	t.Run("should list only workspace rules when workspace scope", func(t *testing.T) {
+		repository := &Repository{}
+
+		query := repository.getListRulesQuery(&suppressionEntities.Scope{WorkspaceID: uuid.New()})
+		assert.NotContains(t, query, "repository_id")
+	})
+
+	t.Run("should list repository and workspace rules when repository scope", func(t *testing.T) {
+		repository := &Repository{}
+
+		query := repository.getListRulesQuery(&suppressionEntities.Scope{RepositoryID: uuid.New()})
+		assert.Contains(t, query, "(repository_id = @repositoryID OR repository_id IS NULL)")
+	})
+}
+
+func TestGetRule(t *testing.T) {
+	t.Run("should success get rule", func(t *testing.T) {
+		rule := &suppressionEntities.Rule{RuleID: uuid.New()}
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, rule))
+
+		repository := NewSuppressionRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.GetRule(&suppressionEntities.Scope{})
+		assert.NoError(t, err)
+		assert.Equal(t, rule.RuleID, result.RuleID)
+	})
+
+	t.Run("should return not found when rule does not exist", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(0, databaseEnums.ErrorNotFoundRecords, nil))
+
+		repository := NewSuppressionRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.GetRule(&suppressionEntities.Scope{})
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestPreviewMatches(t *testing.T) {
+	t.Run("should success preview matched vulnerabilities", func(t *testing.T) {
+		vulnerability := vulnerabilityEntities.Vulnerability{VulnerabilityID: uuid.New()}
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil,
+			&suppressionEntities.PreviewResponse{TotalItems: 1})).Once()
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil,
+			[]vulnerabilityEntities.Vulnerability{vulnerability})).Once()
+
+		repository := NewSuppressionRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.PreviewMatches(newPreviewData())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.TotalItems)
+		assert.Len(t, result.Data, 1)
+		assert.Equal(t, vulnerability.VulnerabilityID, result.Data[0].VulnerabilityID)
+	})
+
+	t.Run("should return empty preview when there are no matches", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, databaseEnums.ErrorNotFoundRecords, nil))
+
+		repository := NewSuppressionRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		result, err := repository.PreviewMatches(newPreviewData())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result.TotalItems)
+		assert.Empty(t, result.Data)
+	})
+
+	t.Run("should return error when failed to count matches", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewSuppressionRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+
+		_, err := repository.PreviewMatches(newPreviewData())
+		assert.Error(t, err)
+		databaseMock.AssertNumberOfCalls(t, "Raw", 1)
+	})
+}
+
+func TestGetPreviewMatchesQuery(t *testing.T) {
+	t.Run("should query latest analyses of the scope matched by the rule", func(t *testing.T) {
+		repository := &Repository{}
+
+		query, params := repository.getPreviewMatchesQuery(newPreviewData(), "vulnerabilities.*")
+		assert.Contains(t, query, "SELECT vulnerabilities.* FROM analysis")
+		assert.Contains(t, query, "ORDER BY analysis.repository_id, analysis.created_at DESC")
+		assert.Contains(t, query, "vulnerabilities.file ~ @filePattern")
+		assert.Len(t, params, 8)
+	})
+}
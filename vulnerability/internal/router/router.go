@@ -23,8 +23,13 @@ import (
 
 	"github.com/ZupIT/horusec-platform/vulnerability/docs"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/enums/routes"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/collaboration"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/export"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/health"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/management"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/propagation"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/suppression"
+	expiryJob "github.com/ZupIT/horusec-platform/vulnerability/internal/jobs/expiry"
 )
 
 type IRouter interface {
@@ -35,18 +40,30 @@ type Router struct {
 	httpRouter.IRouter
 	swagger.ISwagger
 	middlewares.IAuthzMiddleware
-	healthHandler     *health.Handler
-	managementHandler *management.Handler
+	healthHandler        *health.Handler
+	managementHandler    *management.Handler
+	exportHandler        *export.Handler
+	collaborationHandler *collaboration.Handler
+	suppressionHandler   *suppression.Handler
+	propagationHandler   *propagation.Handler
+	expiryJob            expiryJob.IJob
 }
 
 func NewHTTPRouter(routerHTTP httpRouter.IRouter, authzMiddleware middlewares.IAuthzMiddleware,
-	healthHandler *health.Handler, managementHandler *management.Handler) IRouter {
+	healthHandler *health.Handler, managementHandler *management.Handler, exportHandler *export.Handler,
+	collaborationHandler *collaboration.Handler, suppressionHandler *suppression.Handler,
+	propagationHandler *propagation.Handler, expiryJob expiryJob.IJob) IRouter {
 	router := &Router{
-		IRouter:           routerHTTP,
-		IAuthzMiddleware:  authzMiddleware,
-		ISwagger:          swagger.NewSwagger(routerHTTP.GetMux(), "8001"),
-		healthHandler:     healthHandler,
-		managementHandler: managementHandler,
+		IRouter:              routerHTTP,
+		IAuthzMiddleware:     authzMiddleware,
+		ISwagger:             swagger.NewSwagger(routerHTTP.GetMux(), "8001"),
+		healthHandler:        healthHandler,
+		managementHandler:    managementHandler,
+		exportHandler:        exportHandler,
+		collaborationHandler: collaborationHandler,
+		suppressionHandler:   suppressionHandler,
+		propagationHandler:   propagationHandler,
+		expiryJob:            expiryJob,
 	}
 
 	return router.setRoutes()
@@ -80,9 +97,72 @@ func (r *Router) routerVulnerabilities() {
 			r.managementHandler.UpdateVulnerabilitiesByWorkspace)
 		router.With(r.IsRepositorySupervisor).Patch("/workspace/{workspaceID}/repository/{repositoryID}/"+
 			"vulnerabilities", r.managementHandler.UpdateVulnerabilitiesByRepository)
+		router.With(r.IsWorkspaceAdmin).Post("/workspace/{workspaceID}/vulnerabilities/bulk",
+			r.managementHandler.BulkUpdateVulnerabilitiesByWorkspace)
+		router.With(r.IsRepositorySupervisor).Post("/workspace/{workspaceID}/repository/{repositoryID}/"+
+			"vulnerabilities/bulk", r.managementHandler.BulkUpdateVulnerabilitiesByRepository)
+		router.With(r.IsRepositoryMember).Get("/workspace/{workspaceID}/repository/{repositoryID}/analysis/"+
+			"{analysisID}/diff", r.managementHandler.GetAnalysesDiff)
+		router.With(r.IsWorkspaceAdmin).Get("/workspace/{workspaceID}/vulnerabilities/{vulnerabilityID}/history",
+			r.managementHandler.ListVulnerabilityHistoryByWorkspace)
+		router.With(r.IsRepositoryMember).Get("/workspace/{workspaceID}/repository/{repositoryID}/"+
+			"vulnerabilities/{vulnerabilityID}/history", r.managementHandler.ListVulnerabilityHistoryByRepository)
+		r.routerExport(router)
+		r.routerCollaboration(router)
+		r.routerSuppression(router)
+		r.routerPropagation(router)
 	})
 }
 
+func (r *Router) routerCollaboration(router chi.Router) {
+	pattern := "/workspace/{workspaceID}/repository/{repositoryID}/vulnerabilities/{vulnHash}"
+
+	router.With(r.IsRepositoryMember).Get(pattern+"/comments", r.collaborationHandler.ListComments)
+	router.With(r.IsRepositoryMember).Post(pattern+"/comments", r.collaborationHandler.CreateComment)
+	router.With(r.IsRepositoryMember).Patch(pattern+"/comments/{commentID}", r.collaborationHandler.UpdateComment)
+	router.With(r.IsRepositoryMember).Delete(pattern+"/comments/{commentID}", r.collaborationHandler.DeleteComment)
+	router.With(r.IsRepositoryMember).Get(pattern+"/assignment", r.collaborationHandler.GetAssignment)
+	router.With(r.IsRepositorySupervisor).Put(pattern+"/assignment", r.collaborationHandler.SaveAssignment)
+	router.With(r.IsRepositorySupervisor).Delete(pattern+"/assignment", r.collaborationHandler.DeleteAssignment)
+}
+
+func (r *Router) routerSuppression(router chi.Router) {
+	workspacePattern := "/workspace/{workspaceID}/suppression-rules"
+	repositoryPattern := "/workspace/{workspaceID}/repository/{repositoryID}/suppression-rules"
+
+	router.With(r.IsWorkspaceAdmin).Get(workspacePattern, r.suppressionHandler.ListRules)
+	router.With(r.IsWorkspaceAdmin).Post(workspacePattern, r.suppressionHandler.CreateRule)
+	router.With(r.IsWorkspaceAdmin).Post(workspacePattern+"/preview", r.suppressionHandler.PreviewMatches)
+	router.With(r.IsWorkspaceAdmin).Get(workspacePattern+"/{ruleID}", r.suppressionHandler.GetRule)
+	router.With(r.IsWorkspaceAdmin).Put(workspacePattern+"/{ruleID}", r.suppressionHandler.UpdateRule)
+	router.With(r.IsWorkspaceAdmin).Delete(workspacePattern+"/{ruleID}", r.suppressionHandler.DeleteRule)
+	router.With(r.IsRepositorySupervisor).Get(repositoryPattern, r.suppressionHandler.ListRules)
+	router.With(r.IsRepositorySupervisor).Post(repositoryPattern, r.suppressionHandler.CreateRule)
+	router.With(r.IsRepositorySupervisor).Post(repositoryPattern+"/preview", r.suppressionHandler.PreviewMatches)
+	router.With(r.IsRepositorySupervisor).Get(repositoryPattern+"/{ruleID}", r.suppressionHandler.GetRule)
+	router.With(r.IsRepositorySupervisor).Put(repositoryPattern+"/{ruleID}", r.suppressionHandler.UpdateRule)
+	router.With(r.IsRepositorySupervisor).Delete(repositoryPattern+"/{ruleID}", r.suppressionHandler.DeleteRule)
+}
+
+func (r *Router) routerPropagation(router chi.Router) {
+	router.With(r.IsWorkspaceAdmin).Get("/workspace/{workspaceID}/triage-propagation",
+		r.propagationHandler.GetSettings)
+	router.With(r.IsWorkspaceAdmin).Put("/workspace/{workspaceID}/triage-propagation",
+		r.propagationHandler.SaveSettings)
+	router.With(r.IsWorkspaceAdmin).Get("/workspace/{workspaceID}/vulnerabilities/{vulnerabilityID}/propagations",
+		r.managementHandler.ListPropagations)
+	router.With(r.IsWorkspaceAdmin).Delete("/workspace/{workspaceID}/vulnerabilities/{vulnerabilityID}/propagations",
+		r.managementHandler.UndoPropagation)
+}
+
+func (r *Router) routerExport(router chi.Router) {
+	router.With(r.IsWorkspaceAdmin).Get("/workspace/{workspaceID}/export", r.exportHandler.ExportByWorkspace)
+	router.With(r.IsRepositoryMember).Get("/workspace/{workspaceID}/repository/{repositoryID}/export",
+		r.exportHandler.ExportByRepository)
+	router.With(r.IsRepositoryMember).Get("/workspace/{workspaceID}/repository/{repositoryID}/analysis/"+
+		"{analysisID}/export", r.exportHandler.ExportByAnalysis)
+}
+
 func (r *Router) routerSwagger() {
 	r.SetupSwagger()
 
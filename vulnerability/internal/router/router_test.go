@@ -23,8 +23,13 @@ import (
 	httpRouter "github.com/ZupIT/horusec-devkit/pkg/services/http/router"
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
 
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/collaboration"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/export"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/health"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/management"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/propagation"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/suppression"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/jobs/expiry"
 )
 
 func TestNewHTTPRouter(t *testing.T) {
@@ -32,6 +37,7 @@ func TestNewHTTPRouter(t *testing.T) {
 		router := httpRouter.NewHTTPRouter(&cors.Options{}, "8009")
 
 		assert.NotEmpty(t, NewHTTPRouter(router, &middlewares.AuthzMiddleware{}, &health.Handler{},
-			&management.Handler{}))
+			&management.Handler{}, &export.Handler{}, &collaboration.Handler{}, &suppression.Handler{},
+			&propagation.Handler{}, &expiry.Job{}))
 	})
 }
@@ -0,0 +1,84 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	collaborationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/collaboration"
+	collaborationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/collaboration"
+)
+
+type IUseCases interface {
+	VulnerabilityKeyFromRequest(request *http.Request) (*collaborationEntities.VulnerabilityKey, error)
+	CommentDataFromRequest(request *http.Request) (*collaborationEntities.CommentData, error)
+	CommentIDFromRequest(request *http.Request, data *collaborationEntities.CommentData) error
+	AssignmentDataFromRequest(request *http.Request) (*collaborationEntities.AssignmentData, error)
+}
+
+type UseCases struct{}
+
+func NewCollaborationUseCases() IUseCases {
+	return &UseCases{}
+}
+
+func (u *UseCases) VulnerabilityKeyFromRequest(
+	request *http.Request) (*collaborationEntities.VulnerabilityKey, error) {
+	key := &collaborationEntities.VulnerabilityKey{}
+
+	return key, key.SetDataFromRequest(request)
+}
+
+func (u *UseCases) CommentDataFromRequest(request *http.Request) (*collaborationEntities.CommentData, error) {
+	data := &collaborationEntities.CommentData{}
+
+	if err := parser.ParseBodyToEntity(request.Body, data); err != nil {
+		return nil, err
+	}
+
+	if err := data.SetDataFromRequest(request); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (u *UseCases) CommentIDFromRequest(request *http.Request, data *collaborationEntities.CommentData) (err error) {
+	if data.CommentID, err = uuid.Parse(chi.URLParam(request, collaborationEnums.CommentID)); err != nil {
+		return collaborationEnums.ErrorInvalidCommentID
+	}
+
+	return nil
+}
+
+func (u *UseCases) AssignmentDataFromRequest(
+	request *http.Request) (*collaborationEntities.AssignmentData, error) {
+	data := &collaborationEntities.AssignmentData{}
+
+	if err := parser.ParseBodyToEntity(request.Body, data); err != nil {
+		return nil, err
+	}
+
+	if err := data.SetDataFromRequest(request); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
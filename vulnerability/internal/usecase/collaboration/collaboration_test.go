@@ -0,0 +1,168 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collaboration
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	collaborationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/collaboration"
+	collaborationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/collaboration"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+)
+
+func newRequest(body io.ReadCloser, workspaceID, commentID string) *http.Request {
+	r, _ := http.NewRequest(http.MethodPost, "/test", body)
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("workspaceID", workspaceID)
+	ctx.URLParams.Add("repositoryID", uuid.NewString())
+	ctx.URLParams.Add("vulnHash", "1234")
+	ctx.URLParams.Add("commentID", commentID)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func TestNewCollaborationUseCases(t *testing.T) {
+	t.Run("should success create a new use cases", func(t *testing.T) {
+		assert.NotNil(t, NewCollaborationUseCases())
+	})
+}
+
+func TestVulnerabilityKeyFromRequest(t *testing.T) {
+	t.Run("should success get vulnerability key from request", func(t *testing.T) {
+		useCases := NewCollaborationUseCases()
+
+		key, err := useCases.VulnerabilityKeyFromRequest(newRequest(nil, uuid.NewString(), ""))
+		assert.NoError(t, err)
+		assert.Equal(t, "1234", key.VulnHash)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		useCases := NewCollaborationUseCases()
+
+		_, err := useCases.VulnerabilityKeyFromRequest(newRequest(nil, "test", ""))
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID, err)
+	})
+}
+
+func TestCommentDataFromRequest(t *testing.T) {
+	t.Run("should success get comment data from request", func(t *testing.T) {
+		useCases := NewCollaborationUseCases()
+
+		readCloser, _ := parser.ParseEntityToIOReadCloser(&collaborationEntities.CommentData{Content: "test"})
+
+		data, err := useCases.CommentDataFromRequest(newRequest(readCloser, uuid.NewString(), ""))
+		assert.NoError(t, err)
+		assert.Equal(t, "test", data.Content)
+		assert.Equal(t, "1234", data.VulnHash)
+	})
+
+	t.Run("should return error when invalid content", func(t *testing.T) {
+		useCases := NewCollaborationUseCases()
+
+		readCloser, _ := parser.ParseEntityToIOReadCloser(&collaborationEntities.CommentData{})
+
+		_, err := useCases.CommentDataFromRequest(newRequest(readCloser, uuid.NewString(), ""))
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		useCases := NewCollaborationUseCases()
+
+		readCloser, _ := parser.ParseEntityToIOReadCloser(&collaborationEntities.CommentData{Content: "test"})
+
+		_, err := useCases.CommentDataFromRequest(newRequest(readCloser, "test", ""))
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID, err)
+	})
+
+	t.Run("should return error when failed to parse body", func(t *testing.T) {
+		useCases := NewCollaborationUseCases()
+
+		readCloser, _ := parser.ParseEntityToIOReadCloser("")
+
+		_, err := useCases.CommentDataFromRequest(newRequest(readCloser, uuid.NewString(), ""))
+		assert.Error(t, err)
+	})
+}
+
+func TestCommentIDFromRequest(t *testing.T) {
+	t.Run("should success set comment id from request", func(t *testing.T) {
+		useCases := NewCollaborationUseCases()
+		commentID := uuid.New()
+		data := &collaborationEntities.CommentData{}
+
+		assert.NoError(t, useCases.CommentIDFromRequest(newRequest(nil, uuid.NewString(), commentID.String()), data))
+		assert.Equal(t, commentID, data.CommentID)
+	})
+
+	t.Run("should return error when invalid comment id", func(t *testing.T) {
+		useCases := NewCollaborationUseCases()
+
+		assert.Equal(t, collaborationEnums.ErrorInvalidCommentID, useCases.CommentIDFromRequest(
+			newRequest(nil, uuid.NewString(), "test"), &collaborationEntities.CommentData{}))
+	})
+}
+
+func TestAssignmentDataFromRequest(t *testing.T) {
+	t.Run("should success get assignment data from request", func(t *testing.T) {
+		useCases := NewCollaborationUseCases()
+		assigneeID := uuid.New()
+
+		readCloser, _ := parser.ParseEntityToIOReadCloser(
+			&collaborationEntities.AssignmentData{AssigneeID: assigneeID})
+
+		data, err := useCases.AssignmentDataFromRequest(newRequest(readCloser, uuid.NewString(), ""))
+		assert.NoError(t, err)
+		assert.Equal(t, assigneeID, data.AssigneeID)
+		assert.Equal(t, "1234", data.VulnHash)
+	})
+
+	t.Run("should return error when invalid assignee", func(t *testing.T) {
+		useCases := NewCollaborationUseCases()
+
+		readCloser, _ := parser.ParseEntityToIOReadCloser(&collaborationEntities.AssignmentData{})
+
+		_, err := useCases.AssignmentDataFromRequest(newRequest(readCloser, uuid.NewString(), ""))
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		useCases := NewCollaborationUseCases()
+
+		readCloser, _ := parser.ParseEntityToIOReadCloser(
+			&collaborationEntities.AssignmentData{AssigneeID: uuid.New()})
+
+		_, err := useCases.AssignmentDataFromRequest(newRequest(readCloser, "test", ""))
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID, err)
+	})
+
+	t.Run("should return error when failed to parse body", func(t *testing.T) {
+		useCases := NewCollaborationUseCases()
+
+		readCloser, _ := parser.ParseEntityToIOReadCloser("")
+
+		_, err := useCases.AssignmentDataFromRequest(newRequest(readCloser, uuid.NewString(), ""))
+		assert.Error(t, err)
+	})
+}
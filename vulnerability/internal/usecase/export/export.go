@@ -0,0 +1,40 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"net/http"
+
+	exportEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/export"
+)
+
+type IUseCases interface {
+	ExportFilterFromRequest(request *http.Request) (*exportEntities.Filter, error)
+}
+
+type UseCases struct{}
+
+func NewExportUseCases() IUseCases {
+	return &UseCases{}
+}
+
+func (u *UseCases) ExportFilterFromRequest(request *http.Request) (*exportEntities.Filter, error) {
+	filter := &exportEntities.Filter{}
+	if err := filter.SetFilterDataFromRequest(request); err != nil {
+		return nil, err
+	}
+
+	return filter, filter.Validate()
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	exportEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/export"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+)
+
+func TestNewExportUseCases(t *testing.T) {
+	t.Run("Should success create a new use cases", func(t *testing.T) {
+		assert.NotNil(t, NewExportUseCases())
+	})
+}
+
+func TestExportFilterFromRequest(t *testing.T) {
+	t.Run("Should success parse request to export filter", func(t *testing.T) {
+		analysisID := uuid.New()
+		r, _ := http.NewRequest(http.MethodGet, "/test?format=csv&vulnType=Vulnerability", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		ctx.URLParams.Add("analysisID", analysisID.String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter, err := NewExportUseCases().ExportFilterFromRequest(r)
+		assert.NoError(t, err)
+		assert.Equal(t, exportEnums.CSV, filter.Format)
+		assert.Equal(t, analysisID, filter.AnalysisID)
+	})
+
+	t.Run("Should return error when invalid workspace id", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test?format=csv", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", "test")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter, err := NewExportUseCases().ExportFilterFromRequest(r)
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID, err)
+		assert.Nil(t, filter)
+	})
+
+	t.Run("Should return error when invalid format", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test?format=xml", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		_, err := NewExportUseCases().ExportFilterFromRequest(r)
+		assert.Error(t, err)
+	})
+}
@@ -19,10 +19,12 @@ import (
 	"net/http"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
 
 	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	propagationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/propagation"
 )
 
 type IUseCases interface {
@@ -30,6 +32,11 @@ type IUseCases interface {
 	ManagementFilterFromRequest(request *http.Request, validateVulnFile bool) (*managementEntities.Filter, error)
 	FilterVulnerabilityByID(vulnerabilityID uuid.UUID) map[string]interface{}
 	FilterAnalysisByID(analysisID uuid.UUID) map[string]interface{}
+	IncrementAnalysisRevision() map[string]interface{}
+	DiffFilterFromRequest(request *http.Request) (*managementEntities.DiffFilter, error)
+	HistoryFilterFromRequest(request *http.Request) (*managementEntities.HistoryFilter, error)
+	BulkUpdateDataFromRequest(request *http.Request) (*managementEntities.BulkUpdateData, error)
+	UndoPropagationDataFromRequest(request *http.Request) (*propagationEntities.UndoData, error)
 }
 
 type UseCases struct{}
@@ -65,3 +72,42 @@ func (u *UseCases) FilterVulnerabilityByID(vulnerabilityID uuid.UUID) map[string
 func (u *UseCases) FilterAnalysisByID(analysisID uuid.UUID) map[string]interface{} {
 	return map[string]interface{}{"analysis_id": analysisID}
 }
+
+// IncrementAnalysisRevision returns the update that increases the revision of the analysis in the database, so it
+// is done in the same transaction that changes its vulnerabilities
+func (u *UseCases) IncrementAnalysisRevision() map[string]interface{} {
+	return map[string]interface{}{"revision": gorm.Expr("revision + 1")}
+}
+
+func (u *UseCases) DiffFilterFromRequest(request *http.Request) (*managementEntities.DiffFilter, error) {
+	filter := &managementEntities.DiffFilter{}
+
+	return filter, filter.SetDataFromRequest(request)
+}
+
+func (u *UseCases) HistoryFilterFromRequest(request *http.Request) (*managementEntities.HistoryFilter, error) {
+	filter := &managementEntities.HistoryFilter{}
+
+	return filter, filter.SetDataFromRequest(request)
+}
+
+// BulkUpdateDataFromRequest parses the change of the bulk update from the body and its filter from the request params
+func (u *UseCases) BulkUpdateDataFromRequest(request *http.Request) (*managementEntities.BulkUpdateData, error) {
+	data := &managementEntities.BulkUpdateData{}
+
+	if err := parser.ParseBodyToEntity(request.Body, &data); err != nil {
+		return nil, err
+	}
+
+	if err := data.SetFilterDataFromRequest(request); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (u *UseCases) UndoPropagationDataFromRequest(request *http.Request) (*propagationEntities.UndoData, error) {
+	data := &propagationEntities.UndoData{}
+
+	return data, data.SetDataFromRequest(request)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
 	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
@@ -42,6 +43,7 @@ func TestUpdateDataFromIOReadCloser(t *testing.T) {
 					VulnerabilityID: uuid.New(),
 					Severity:        severities.Critical,
 					Type:            vulnerabilityEnums.FalsePositive,
+					Justification:   "test",
 				},
 			},
 		}
@@ -129,3 +131,142 @@ func TestFilterAnalysisByID(t *testing.T) {
 		})
 	})
 }
+
+func TestIncrementAnalysisRevision(t *testing.T) {
+	t.Run("should increment the revision stored in the database", func(t *testing.T) {
+		update := NewManagementUseCases().IncrementAnalysisRevision()
+
+		assert.Equal(t, gorm.Expr("revision + 1"), update["revision"])
+	})
+}
+
+func TestDiffFilterFromRequest(t *testing.T) {
+	t.Run("should success parse request to diff filter", func(t *testing.T) {
+		analysisID := uuid.New()
+		r, _ := http.NewRequest(http.MethodGet, "/test?baseAnalysisID=latest", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		ctx.URLParams.Add("analysisID", analysisID.String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter, err := NewManagementUseCases().DiffFilterFromRequest(r)
+		assert.NoError(t, err)
+		assert.Equal(t, analysisID, filter.AnalysisID)
+		assert.True(t, filter.IsLatestBase())
+	})
+
+	t.Run("should return error when invalid analysis id", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		ctx.URLParams.Add("analysisID", "test")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		_, err := NewManagementUseCases().DiffFilterFromRequest(r)
+		assert.Error(t, err)
+	})
+}
+
+func TestHistoryFilterFromRequest(t *testing.T) {
+	t.Run("should success parse request to history filter", func(t *testing.T) {
+		vulnerabilityID := uuid.New()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("vulnerabilityID", vulnerabilityID.String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		filter, err := NewManagementUseCases().HistoryFilterFromRequest(r)
+		assert.NoError(t, err)
+		assert.Equal(t, vulnerabilityID, filter.VulnerabilityID)
+	})
+
+	t.Run("should return error when invalid vulnerability id", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("vulnerabilityID", "test")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		_, err := NewManagementUseCases().HistoryFilterFromRequest(r)
+		assert.Error(t, err)
+	})
+}
+
+func TestBulkUpdateDataFromRequest(t *testing.T) {
+	newRequest := func(URL string, body interface{}) *http.Request {
+		readCloser, _ := parser.ParseEntityToIOReadCloser(body)
+		r, _ := http.NewRequest(http.MethodPost, URL, readCloser)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("should success parse request to bulk update data", func(t *testing.T) {
+		r := newRequest("/test?dryRun=true&filePattern=test/**", &managementEntities.BulkUpdateData{
+			Type: vulnerabilityEnums.FalsePositive, Justification: "test"})
+
+		data, err := NewManagementUseCases().BulkUpdateDataFromRequest(r)
+		assert.NoError(t, err)
+		assert.True(t, data.DryRun)
+		assert.Equal(t, vulnerabilityEnums.FalsePositive, data.Type)
+		assert.Equal(t, "test/**", data.Filter.FilePattern)
+	})
+
+	t.Run("should return error when invalid request body", func(t *testing.T) {
+		r := newRequest("/test", "test")
+
+		_, err := NewManagementUseCases().BulkUpdateDataFromRequest(r)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid filter", func(t *testing.T) {
+		r := newRequest("/test?vulnType=test", &managementEntities.BulkUpdateData{
+			Type: vulnerabilityEnums.FalsePositive, Justification: "test"})
+
+		_, err := NewManagementUseCases().BulkUpdateDataFromRequest(r)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid change", func(t *testing.T) {
+		r := newRequest("/test", &managementEntities.BulkUpdateData{Justification: "test"})
+
+		_, err := NewManagementUseCases().BulkUpdateDataFromRequest(r)
+		assert.Error(t, err)
+	})
+}
+
+func TestUndoPropagationDataFromRequest(t *testing.T) {
+	t.Run("should success parse request to undo propagation data", func(t *testing.T) {
+		vulnerabilityID := uuid.New()
+		r, _ := http.NewRequest(http.MethodDelete, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("vulnerabilityID", vulnerabilityID.String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		data, err := NewManagementUseCases().UndoPropagationDataFromRequest(r)
+		assert.NoError(t, err)
+		assert.Equal(t, vulnerabilityID, data.VulnerabilityID)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodDelete, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", "test")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		_, err := NewManagementUseCases().UndoPropagationDataFromRequest(r)
+		assert.Error(t, err)
+	})
+}
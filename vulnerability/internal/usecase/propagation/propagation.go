@@ -0,0 +1,61 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	propagationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/propagation"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+)
+
+type IUseCases interface {
+	WorkspaceIDFromRequest(request *http.Request) (uuid.UUID, error)
+	SettingsDataFromRequest(request *http.Request) (*propagationEntities.SettingsData, error)
+}
+
+type UseCases struct{}
+
+func NewPropagationUseCases() IUseCases {
+	return &UseCases{}
+}
+
+func (u *UseCases) WorkspaceIDFromRequest(request *http.Request) (uuid.UUID, error) {
+	workspaceID, err := uuid.Parse(chi.URLParam(request, managementEnums.WorkspaceID))
+	if err != nil {
+		return uuid.Nil, managementEnums.ErrorInvalidWorkspaceID
+	}
+
+	return workspaceID, nil
+}
+
+func (u *UseCases) SettingsDataFromRequest(request *http.Request) (*propagationEntities.SettingsData, error) {
+	data := &propagationEntities.SettingsData{}
+
+	if err := parser.ParseBodyToEntity(request.Body, data); err != nil {
+		return nil, err
+	}
+
+	if err := data.SetDataFromRequest(request); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
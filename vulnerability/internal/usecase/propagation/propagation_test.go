@@ -0,0 +1,100 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	propagationEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/propagation"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+	propagationEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/propagation"
+)
+
+func newRequest(body io.ReadCloser, workspaceID string) *http.Request {
+	r, _ := http.NewRequest(http.MethodPut, "/test", body)
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("workspaceID", workspaceID)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func newSettingsBody(matchBy string) io.ReadCloser {
+	body, _ := parser.ParseEntityToIOReadCloser(&propagationEntities.SettingsData{IsEnabled: true, MatchBy: matchBy})
+
+	return body
+}
+
+func TestNewPropagationUseCases(t *testing.T) {
+	t.Run("should success create a new use cases", func(t *testing.T) {
+		assert.NotNil(t, NewPropagationUseCases())
+	})
+}
+
+func TestWorkspaceIDFromRequest(t *testing.T) {
+	t.Run("should success get workspace id from request", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		result, err := NewPropagationUseCases().WorkspaceIDFromRequest(newRequest(nil, workspaceID.String()))
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceID, result)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		_, err := NewPropagationUseCases().WorkspaceIDFromRequest(newRequest(nil, "test"))
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID, err)
+	})
+}
+
+func TestSettingsDataFromRequest(t *testing.T) {
+	t.Run("should success get settings data from request", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		data, err := NewPropagationUseCases().SettingsDataFromRequest(
+			newRequest(newSettingsBody(propagationEnums.MatchByCode), workspaceID.String()))
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceID, data.WorkspaceID)
+		assert.True(t, data.IsEnabled)
+		assert.Equal(t, propagationEnums.MatchByCode, data.MatchBy)
+	})
+
+	t.Run("should return error when invalid body", func(t *testing.T) {
+		body, _ := parser.ParseEntityToIOReadCloser("test")
+
+		_, err := NewPropagationUseCases().SettingsDataFromRequest(newRequest(body, uuid.NewString()))
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		_, err := NewPropagationUseCases().SettingsDataFromRequest(
+			newRequest(newSettingsBody(propagationEnums.MatchByHash), "test"))
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID, err)
+	})
+
+	t.Run("should return error when invalid match by", func(t *testing.T) {
+		_, err := NewPropagationUseCases().SettingsDataFromRequest(
+			newRequest(newSettingsBody("test"), uuid.NewString()))
+		assert.Error(t, err)
+	})
+}
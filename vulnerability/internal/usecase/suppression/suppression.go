@@ -0,0 +1,75 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"net/http"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	suppressionEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/suppression"
+)
+
+type IUseCases interface {
+	ScopeFromRequest(request *http.Request) (*suppressionEntities.Scope, error)
+	RuleIDFromRequest(request *http.Request, scope *suppressionEntities.Scope) error
+	RuleDataFromRequest(request *http.Request) (*suppressionEntities.RuleData, error)
+	PreviewDataFromRequest(request *http.Request) (*suppressionEntities.PreviewData, error)
+}
+
+type UseCases struct{}
+
+func NewSuppressionUseCases() IUseCases {
+	return &UseCases{}
+}
+
+func (u *UseCases) ScopeFromRequest(request *http.Request) (*suppressionEntities.Scope, error) {
+	scope := &suppressionEntities.Scope{}
+
+	return scope, scope.SetDataFromRequest(request)
+}
+
+func (u *UseCases) RuleIDFromRequest(request *http.Request, scope *suppressionEntities.Scope) error {
+	return scope.SetRuleIDFromRequest(request)
+}
+
+func (u *UseCases) RuleDataFromRequest(request *http.Request) (*suppressionEntities.RuleData, error) {
+	data := &suppressionEntities.RuleData{}
+
+	if err := parser.ParseBodyToEntity(request.Body, data); err != nil {
+		return nil, err
+	}
+
+	if err := data.SetDataFromRequest(request); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (u *UseCases) PreviewDataFromRequest(request *http.Request) (*suppressionEntities.PreviewData, error) {
+	data := &suppressionEntities.PreviewData{}
+
+	if err := parser.ParseBodyToEntity(request.Body, data); err != nil {
+		return nil, err
+	}
+
+	if err := data.SetDataFromRequest(request); err != nil {
+		return nil, err
+	}
+
+	data.SetPaginationFromRequest(request)
+	return data, data.Validate()
+}
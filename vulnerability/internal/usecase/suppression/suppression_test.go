@@ -0,0 +1,152 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	suppressionEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/suppression"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+	suppressionEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/suppression"
+)
+
+func newRequest(body io.ReadCloser, workspaceID, ruleID string) *http.Request {
+	r, _ := http.NewRequest(http.MethodPost, "/test?page=2", body)
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("workspaceID", workspaceID)
+	ctx.URLParams.Add("repositoryID", uuid.NewString())
+	ctx.URLParams.Add("ruleID", ruleID)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func newRuleBody() io.ReadCloser {
+	body, _ := parser.ParseEntityToIOReadCloser(&suppressionEntities.RuleData{Name: "test", FilePattern: "test/**",
+		Type: vulnerabilityEnums.FalsePositive, Justification: "test"})
+
+	return body
+}
+
+func TestNewSuppressionUseCases(t *testing.T) {
+	t.Run("should success create a new use cases", func(t *testing.T) {
+		assert.NotNil(t, NewSuppressionUseCases())
+	})
+}
+
+func TestScopeFromRequest(t *testing.T) {
+	t.Run("should success get scope from request", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		scope, err := NewSuppressionUseCases().ScopeFromRequest(newRequest(nil, workspaceID.String(), ""))
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceID, scope.WorkspaceID)
+		assert.True(t, scope.IsRepositoryScope())
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		_, err := NewSuppressionUseCases().ScopeFromRequest(newRequest(nil, "test", ""))
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID, err)
+	})
+}
+
+func TestRuleIDFromRequest(t *testing.T) {
+	t.Run("should success get rule id from request", func(t *testing.T) {
+		ruleID := uuid.New()
+		scope := &suppressionEntities.Scope{}
+
+		assert.NoError(t, NewSuppressionUseCases().RuleIDFromRequest(
+			newRequest(nil, uuid.NewString(), ruleID.String()), scope))
+		assert.Equal(t, ruleID, scope.RuleID)
+	})
+
+	t.Run("should return error when invalid rule id", func(t *testing.T) {
+		assert.Equal(t, suppressionEnums.ErrorInvalidRuleID, NewSuppressionUseCases().RuleIDFromRequest(
+			newRequest(nil, uuid.NewString(), "test"), &suppressionEntities.Scope{}))
+	})
+}
+
+func TestRuleDataFromRequest(t *testing.T) {
+	t.Run("should success get rule data from request", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		data, err := NewSuppressionUseCases().RuleDataFromRequest(newRequest(newRuleBody(), workspaceID.String(), ""))
+		assert.NoError(t, err)
+		assert.Equal(t, "test/**", data.FilePattern)
+		assert.Equal(t, workspaceID, data.WorkspaceID)
+	})
+
+	t.Run("should return error when invalid body", func(t *testing.T) {
+		readCloser, _ := parser.ParseEntityToIOReadCloser("test")
+
+		_, err := NewSuppressionUseCases().RuleDataFromRequest(newRequest(readCloser, uuid.NewString(), ""))
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		_, err := NewSuppressionUseCases().RuleDataFromRequest(newRequest(newRuleBody(), "test", ""))
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID, err)
+	})
+
+	t.Run("should return error when invalid rule", func(t *testing.T) {
+		readCloser, _ := parser.ParseEntityToIOReadCloser(&suppressionEntities.RuleData{Name: "test"})
+
+		_, err := NewSuppressionUseCases().RuleDataFromRequest(newRequest(readCloser, uuid.NewString(), ""))
+		assert.Equal(t, suppressionEnums.ErrorRuleWithoutMatchers, err)
+	})
+}
+
+func TestPreviewDataFromRequest(t *testing.T) {
+	t.Run("should success get preview data from request", func(t *testing.T) {
+		readCloser, _ := parser.ParseEntityToIOReadCloser(&suppressionEntities.RuleData{Details: "test"})
+
+		data, err := NewSuppressionUseCases().PreviewDataFromRequest(newRequest(readCloser, uuid.NewString(), ""))
+		assert.NoError(t, err)
+		assert.Equal(t, "test", data.Details)
+		assert.Equal(t, 2, data.Page)
+		assert.Equal(t, suppressionEnums.DefaultPreviewSize, data.Size)
+	})
+
+	t.Run("should return error when invalid body", func(t *testing.T) {
+		readCloser, _ := parser.ParseEntityToIOReadCloser("test")
+
+		_, err := NewSuppressionUseCases().PreviewDataFromRequest(newRequest(readCloser, uuid.NewString(), ""))
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid workspace id", func(t *testing.T) {
+		readCloser, _ := parser.ParseEntityToIOReadCloser(&suppressionEntities.RuleData{Details: "test"})
+
+		_, err := NewSuppressionUseCases().PreviewDataFromRequest(newRequest(readCloser, "test", ""))
+		assert.Equal(t, managementEnums.ErrorInvalidWorkspaceID, err)
+	})
+
+	t.Run("should return error when without matchers", func(t *testing.T) {
+		readCloser, _ := parser.ParseEntityToIOReadCloser(&suppressionEntities.RuleData{})
+
+		_, err := NewSuppressionUseCases().PreviewDataFromRequest(newRequest(readCloser, uuid.NewString(), ""))
+		assert.Equal(t, suppressionEnums.ErrorRuleWithoutMatchers, err)
+	})
+}
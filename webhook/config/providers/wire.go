@@ -26,6 +26,9 @@ import (
 	webhookController "github.com/ZupIT/horusec-platform/webhook/internal/controllers/webhook"
 	webhookEvent "github.com/ZupIT/horusec-platform/webhook/internal/events/webhook"
 	"github.com/ZupIT/horusec-platform/webhook/internal/handlers/webhook"
+	deliveryJob "github.com/ZupIT/horusec-platform/webhook/internal/jobs/delivery"
+	analysisRepository "github.com/ZupIT/horusec-platform/webhook/internal/repositories/analysis"
+	deliveryRepository "github.com/ZupIT/horusec-platform/webhook/internal/repositories/delivery"
 	webhookRepository "github.com/ZupIT/horusec-platform/webhook/internal/repositories/webhook"
 
 	"github.com/ZupIT/horusec-platform/webhook/internal/handlers/health"
@@ -60,11 +63,14 @@ var providers = wire.NewSet(
 	middlewares.NewAuthzMiddleware,
 
 	webhookRepository.NewWebhookRepository,
+	deliveryRepository.NewDeliveryRepository,
+	analysisRepository.NewAnalysisRepository,
 
 	webhookController.NewWebhookController,
 	dispatcher.NewDispatcherController,
 
 	webhookEvent.NewWebhookEvent,
+	deliveryJob.NewDeliveryJob,
 
 	health.NewHealthHandler,
 	webhook.NewWebhookHandler,
@@ -23,6 +23,9 @@ import (
 	webhook4 "github.com/ZupIT/horusec-platform/webhook/internal/events/webhook"
 	"github.com/ZupIT/horusec-platform/webhook/internal/handlers/health"
 	webhook3 "github.com/ZupIT/horusec-platform/webhook/internal/handlers/webhook"
+	delivery2 "github.com/ZupIT/horusec-platform/webhook/internal/jobs/delivery"
+	"github.com/ZupIT/horusec-platform/webhook/internal/repositories/analysis"
+	"github.com/ZupIT/horusec-platform/webhook/internal/repositories/delivery"
 	"github.com/ZupIT/horusec-platform/webhook/internal/repositories/webhook"
 	"github.com/ZupIT/horusec-platform/webhook/internal/router"
 )
@@ -46,14 +49,17 @@ func Initialize(defaultPort string) (router.IRouter, error) {
 	}
 	handler := health.NewHealthHandler(connection, clientConnInterface, iBroker)
 	iWebhookRepository := webhook.NewWebhookRepository(connection)
-	iWebhookController := webhook2.NewWebhookController(iWebhookRepository)
-	webhookHandler := webhook3.NewWebhookHandler(iWebhookController)
-	iDispatcherController := dispatcher.NewDispatcherController(iWebhookRepository)
+	iDeliveryRepository := delivery.NewDeliveryRepository(connection)
+	iWebhookController := webhook2.NewWebhookController(iWebhookRepository, iDeliveryRepository)
+	iAnalysisRepository := analysis.NewAnalysisRepository(connection)
+	iDispatcherController := dispatcher.NewDispatcherController(iWebhookRepository, iDeliveryRepository, iAnalysisRepository)
+	webhookHandler := webhook3.NewWebhookHandler(iWebhookController, iDispatcherController)
 	iEvent := webhook4.NewWebhookEvent(iBroker, iDispatcherController)
-	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, webhookHandler, iEvent)
+	iJob := delivery2.NewDeliveryJob(iDispatcherController)
+	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, webhookHandler, iEvent, iJob)
 	return routerIRouter, nil
 }
 
 // wire.go:
 
-var providers = wire.NewSet(auth.NewAuthGRPCConnection, proto.NewAuthServiceClient, app.NewAppConfig, config2.NewBrokerConfig, broker.NewBroker, config.NewDatabaseConfig, database.NewDatabaseReadAndWrite, cors.NewCorsConfig, router2.NewHTTPRouter, middlewares.NewAuthzMiddleware, webhook.NewWebhookRepository, webhook2.NewWebhookController, dispatcher.NewDispatcherController, webhook4.NewWebhookEvent, health.NewHealthHandler, webhook3.NewWebhookHandler, router.NewHTTPRouter)
+var providers = wire.NewSet(auth.NewAuthGRPCConnection, proto.NewAuthServiceClient, app.NewAppConfig, config2.NewBrokerConfig, broker.NewBroker, config.NewDatabaseConfig, database.NewDatabaseReadAndWrite, cors.NewCorsConfig, router2.NewHTTPRouter, middlewares.NewAuthzMiddleware, webhook.NewWebhookRepository, delivery.NewDeliveryRepository, analysis.NewAnalysisRepository, webhook2.NewWebhookController, dispatcher.NewDispatcherController, webhook4.NewWebhookEvent, delivery2.NewDeliveryJob, health.NewHealthHandler, webhook3.NewWebhookHandler, router.NewHTTPRouter)
@@ -15,52 +15,345 @@
 package dispatcher
 
 import (
+	"bytes"
+	netHTTP "net/http"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	"github.com/ZupIT/horusec-devkit/pkg/services/http/request"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 	"github.com/google/uuid"
 
+	deliveryEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/delivery"
+	diffEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/diff"
+	eventEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/event"
 	webhookEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+	analysisRepository "github.com/ZupIT/horusec-platform/webhook/internal/repositories/analysis"
+	"github.com/ZupIT/horusec-platform/webhook/internal/repositories/delivery"
 	"github.com/ZupIT/horusec-platform/webhook/internal/repositories/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/services/formatter"
+	"github.com/ZupIT/horusec-platform/webhook/internal/services/signature"
 )
 
 type IDispatcherController interface {
 	DispatchRequest(entity *analysis.Analysis) error
+	DispatchEvent(event *eventEntity.Event) error
+	RetryPendingDeliveries() error
+	Redeliver(workspaceID, webhookID, deliveryID uuid.UUID) (*deliveryEntity.Delivery, error)
+	TestWebhook(workspaceID, webhookID uuid.UUID) (*deliveryEntity.TestResult, error)
 }
 
 type Controller struct {
-	repository  webhook.IWebhookRepository
-	httpRequest request.IRequest
+	repository         webhook.IWebhookRepository
+	deliveryRepository delivery.IDeliveryRepository
+	analysisRepository analysisRepository.IAnalysisRepository
+	httpRequest        request.IRequest
+	signature          signature.ISignature
+	formatter          formatter.IFormatter
 }
 
-func NewDispatcherController(repository webhook.IWebhookRepository) IDispatcherController {
+func NewDispatcherController(repository webhook.IWebhookRepository, deliveryRepository delivery.IDeliveryRepository,
+	analysisRepository analysisRepository.IAnalysisRepository) IDispatcherController {
 	const DefaultTimeoutOnRequests = 10
 	return &Controller{
-		repository:  repository,
-		httpRequest: request.NewHTTPRequestService(DefaultTimeoutOnRequests),
+		repository:         repository,
+		deliveryRepository: deliveryRepository,
+		analysisRepository: analysisRepository,
+		httpRequest:        request.NewHTTPRequestService(DefaultTimeoutOnRequests),
+		signature:          signature.NewSignatureService(),
+		formatter:          formatter.NewFormatterService(),
 	}
 }
 
 func (c *Controller) DispatchRequest(entity *analysis.Analysis) error {
-	webhookFound, err := c.repository.ListOne(map[string]interface{}{"repository_id": entity.RepositoryID})
+	event := eventEntity.NewEventFromAnalysis(entity)
+
+	webhooks, err := c.repository.ListSubscribed(event.WorkspaceID, event.RepositoryID, event.Type)
+	if err != nil {
+		return err
+	}
+
+	if event.Diff, err = c.getDiff(*webhooks, entity); err != nil {
+		return err
+	}
+
+	return c.fanOut(c.filterWebhooks(*webhooks, entity, event.Diff), event)
+}
+
+func (c *Controller) filterWebhooks(webhooks []webhookEntity.Webhook, entity *analysis.Analysis,
+	analysisDiff *diffEntity.Diff) (matched []webhookEntity.Webhook) {
+	for index := range webhooks {
+		if webhooks[index].Filters.Match(entity, analysisDiff) {
+			matched = append(matched, webhooks[index])
+		}
+	}
+
+	return matched
+}
+
+// getDiff only searches the previous analysis when at least one webhook filter or payload format requires it
+func (c *Controller) getDiff(webhooks []webhookEntity.Webhook, entity *analysis.Analysis) (*diffEntity.Diff, error) {
+	for index := range webhooks {
+		if webhooks[index].RequiresDiff() {
+			previous, err := c.analysisRepository.GetPreviousAnalysis(entity)
+			if err != nil {
+				return nil, err
+			}
+
+			return diffEntity.NewDiff(previous, entity), nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *Controller) DispatchEvent(event *eventEntity.Event) error {
+	webhooks, err := c.repository.ListSubscribed(event.WorkspaceID, event.RepositoryID, event.Type)
+	if err != nil {
+		return err
+	}
+
+	return c.fanOut(*webhooks, event)
+}
+
+// fanOut delivers the event to every webhook even when some of them fail, the failures are logged one by one
+// and a single error is returned, so the event can be consumed again without duplicating the saved deliveries
+func (c *Controller) fanOut(webhooks []webhookEntity.Webhook, event *eventEntity.Event) error {
+	var failed bool
+
+	for index := range webhooks {
+		if err := c.deliver(&webhooks[index], event); err != nil {
+			logger.LogError(enums.MessageFailedToDeliverEvent+webhooks[index].WebhookID.String(), err)
+			failed = true
+		}
+	}
+
+	if failed {
+		return enums.ErrorFailedToDeliver
+	}
+
+	return nil
+}
+
+// deliver renders the payload in the webhook format before saving the delivery, so retries send the same body.
+// When the payload could not be rendered, the delivery is saved with the event payload and registered as failed
+func (c *Controller) deliver(webhookFound *webhookEntity.Webhook, event *eventEntity.Event) error {
+	exists, err := c.deliveryRepository.ExistsByEventKey(webhookFound.WebhookID, event.GetKey())
+	if err != nil || exists {
+		return err
+	}
+
+	payload, formatErr := c.formatter.Format(webhookFound, event)
+	if formatErr != nil {
+		payload = event.Payload
+	}
+
+	entity := deliveryEntity.NewDelivery(webhookFound.WebhookID, event.Type, payload).SetEventKey(event.GetKey())
+	if err := c.deliveryRepository.Save(entity); err != nil {
+		return err
+	}
+
+	if formatErr != nil {
+		return c.registerAttempt(entity, deliveryEntity.NewResult(time.Now(), 0, nil, formatErr), entity.Attempts+1)
+	}
+
+	return c.attempt(webhookFound, entity, webhookFound.MaxAttempts)
+}
+
+// RetryPendingDeliveries retries every claimed delivery even when some of them fail, the failures are logged one by
+// one and a single error is returned, so a broken delivery does not hold back the others of the batch
+func (c *Controller) RetryPendingDeliveries() error {
+	deliveries, err := c.deliveryRepository.ClaimPending(enums.DeliveryRetryBatchSize, enums.DeliveryRetryLeaseTime)
 	if err != nil {
 		return err
 	}
+
+	var failed bool
+
+	for index := range *deliveries {
+		if err := c.retry(&(*deliveries)[index]); err != nil {
+			logger.LogError(enums.MessageFailedToRetryDelivery+(*deliveries)[index].DeliveryID.String(), err)
+			failed = true
+		}
+	}
+
+	if failed {
+		return enums.ErrorFailedToRetry
+	}
+
+	return nil
+}
+
+func (c *Controller) retry(entity *deliveryEntity.Delivery) error {
+	webhookFound, err := c.repository.ListOne(map[string]interface{}{"webhook_id": entity.WebhookID})
+	if err != nil {
+		return err
+	}
+
+	return c.attempt(webhookFound, entity, webhookFound.MaxAttempts)
+}
+
+// Redeliver sends the delivery again right away, even when it has already succeeded or exceeded the max attempts.
+// A failure on a manual redelivery will not schedule more retries than the webhook max attempts allows.
+func (c *Controller) Redeliver(workspaceID, webhookID, deliveryID uuid.UUID) (*deliveryEntity.Delivery, error) {
+	webhookFound, err := c.repository.ListOne(map[string]interface{}{
+		"workspace_id": workspaceID, "webhook_id": webhookID})
+	if err != nil {
+		return nil, err
+	}
+
 	if webhookFound.WebhookID == uuid.Nil {
-		return nil
+		return nil, enums.ErrorWebhookNotFound
+	}
+
+	entity, err := c.deliveryRepository.ListOne(map[string]interface{}{
+		"delivery_id": deliveryID, "webhook_id": webhookID})
+	if err != nil {
+		return nil, err
 	}
-	return c.sendHTTPRequest(webhookFound, entity)
+
+	return entity, c.attempt(webhookFound, entity, c.getRedeliverMaxAttempts(webhookFound, entity))
 }
 
-func (c *Controller) sendHTTPRequest(webhookFound *webhookEntity.Webhook, entity *analysis.Analysis) error {
-	req, err := c.httpRequest.NewHTTPRequest(webhookFound.Method, webhookFound.URL, entity,
-		webhookFound.Headers.GetMapHeaders())
+// TestWebhook sends a sample analysis to the webhook and returns the remote response, nothing is saved.
+// Errors while rendering or sending the payload are returned on the result, since they are part of the test
+func (c *Controller) TestWebhook(workspaceID, webhookID uuid.UUID) (*deliveryEntity.TestResult, error) {
+	webhookFound, err := c.repository.ListOne(map[string]interface{}{
+		"workspace_id": workspaceID, "webhook_id": webhookID})
 	if err != nil {
+		return nil, err
+	}
+
+	if webhookFound.WebhookID == uuid.Nil {
+		return nil, enums.ErrorWebhookNotFound
+	}
+
+	event := eventEntity.NewSampleEvent(webhookFound.WorkspaceID, webhookFound.RepositoryID)
+	payload, err := c.formatter.Format(webhookFound, event)
+	if err != nil {
+		return deliveryEntity.NewResult(time.Now(), 0, nil, err).ToTestResult(), nil
+	}
+
+	entity := deliveryEntity.NewDelivery(webhookFound.WebhookID, event.Type, payload)
+	return c.sendHTTPRequest(webhookFound, entity).ToTestResult(), nil
+}
+
+func (c *Controller) getRedeliverMaxAttempts(webhookFound *webhookEntity.Webhook,
+	entity *deliveryEntity.Delivery) int {
+	if entity.Attempts >= webhookFound.MaxAttempts {
+		return entity.Attempts + 1
+	}
+
+	return webhookFound.MaxAttempts
+}
+
+func (c *Controller) attempt(webhookFound *webhookEntity.Webhook, entity *deliveryEntity.Delivery,
+	maxAttempts int) error {
+	return c.registerAttempt(entity, c.sendHTTPRequest(webhookFound, entity), maxAttempts)
+}
+
+func (c *Controller) registerAttempt(entity *deliveryEntity.Delivery, result *deliveryEntity.Result,
+	maxAttempts int) error {
+	attempt := entity.RegisterAttempt(result, maxAttempts)
+	c.logFailedAttempt(entity, attempt)
+
+	if err := c.deliveryRepository.SaveAttempt(attempt); err != nil {
 		return err
 	}
+
+	return c.deliveryRepository.Update(entity)
+}
+
+func (c *Controller) logFailedAttempt(entity *deliveryEntity.Delivery, attempt *deliveryEntity.Attempt) {
+	if attempt.Error == "" {
+		return
+	}
+
+	if entity.IsFailed() {
+		logger.LogWarn(enums.MessageDeliveryMaxAttemptsExceed+entity.DeliveryID.String(), attempt.Error)
+		return
+	}
+
+	logger.LogWarn(enums.MessageDeliveryScheduledToRetry+entity.DeliveryID.String(), attempt.Error)
+}
+
+// sendHTTPRequest sends the stored payload bytes as they are, since the signature is calculated over the exact body
+func (c *Controller) sendHTTPRequest(webhookFound *webhookEntity.Webhook,
+	entity *deliveryEntity.Delivery) *deliveryEntity.Result {
+	startTime := time.Now()
+	req, err := c.newHTTPRequest(webhookFound, entity)
+	if err != nil {
+		return deliveryEntity.NewResult(startTime, 0, nil, err)
+	}
+
 	res, err := c.httpRequest.DoRequest(req, nil)
 	if err != nil {
-		return err
+		return deliveryEntity.NewResult(startTime, 0, nil, err)
 	}
+
 	defer res.CloseBody()
-	return res.ErrorByStatusCode()
+	body, err := res.GetBodyBytes()
+	return deliveryEntity.NewResult(startTime, res.GetStatusCode(), body, err).SetResponseHeaders(res.Header)
+}
+
+func (c *Controller) newHTTPRequest(webhookFound *webhookEntity.Webhook,
+	entity *deliveryEntity.Delivery) (*netHTTP.Request, error) {
+	headers, err := c.getHeaders(webhookFound, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := netHTTP.NewRequest(strings.ToUpper(webhookFound.Method), webhookFound.URL,
+		bytes.NewReader(entity.Payload))
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	return req, nil
+}
+
+// getHeaders sets the content type of the payload format, a content type header configured on the webhook replaces it
+func (c *Controller) getHeaders(webhookFound *webhookEntity.Webhook,
+	entity *deliveryEntity.Delivery) (map[string]string, error) {
+	headers := map[string]string{enums.HeaderContentType: c.formatter.ContentType(webhookFound, entity.Payload)}
+	for key, value := range webhookFound.Headers.GetMapHeaders() {
+		if key != "" && value != "" {
+			headers[netHTTP.CanonicalHeaderKey(key)] = value
+		}
+	}
+
+	headers[enums.HeaderEventType] = entity.EventType.ToString()
+	headers[enums.HeaderDelivery] = entity.DeliveryID.String()
+	return headers, c.setSignatureHeaders(headers, webhookFound, entity.Payload)
+}
+
+// setSignatureHeaders signs the body with each valid secret of the webhook, during a secret rotation grace period
+// both the new and the previous signatures are sent, so the receiver can validate with any of them
+func (c *Controller) setSignatureHeaders(headers map[string]string, webhookFound *webhookEntity.Webhook,
+	body []byte) error {
+	timestamp := time.Now().Unix()
+	headers[enums.HeaderTimestamp] = strconv.FormatInt(timestamp, 10)
+
+	var signatures []string
+	for _, encryptedSecret := range webhookFound.GetValidSecrets() {
+		secret, err := c.signature.Decrypt(encryptedSecret)
+		if err != nil {
+			return err
+		}
+
+		signatures = append(signatures, c.signature.Sign(secret, timestamp, body))
+	}
+
+	if len(signatures) > 0 {
+		headers[enums.HeaderSignature] = strings.Join(signatures, ",")
+	}
+
+	return nil
 }
@@ -17,7 +17,11 @@ package dispatcher
 import (
 	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
+
+	deliveryEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/delivery"
+	eventEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/event"
 )
 
 type Mock struct {
@@ -28,3 +32,23 @@ func (m *Mock) DispatchRequest(_ *analysis.Analysis) error {
 	args := m.MethodCalled("DispatchRequest")
 	return utilsMock.ReturnNilOrError(args, 0)
 }
+
+func (m *Mock) DispatchEvent(_ *eventEntity.Event) error {
+	args := m.MethodCalled("DispatchEvent")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) RetryPendingDeliveries() error {
+	args := m.MethodCalled("RetryPendingDeliveries")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) Redeliver(_, _, _ uuid.UUID) (*deliveryEntity.Delivery, error) {
+	args := m.MethodCalled("Redeliver")
+	return args.Get(0).(*deliveryEntity.Delivery), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) TestWebhook(_, _ uuid.UUID) (*deliveryEntity.TestResult, error) {
+	args := m.MethodCalled("TestWebhook")
+	return args.Get(0).(*deliveryEntity.TestResult), utilsMock.ReturnNilOrError(args, 1)
+}
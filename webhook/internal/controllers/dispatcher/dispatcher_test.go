@@ -16,87 +16,688 @@ package dispatcher
 
 import (
 	"errors"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	vulnerabilityEntity "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
 	"github.com/ZupIT/horusec-devkit/pkg/services/http/request"
 	"github.com/ZupIT/horusec-devkit/pkg/services/http/request/entities"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/delivery"
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/event"
 	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+	repositoryAnalysis "github.com/ZupIT/horusec-platform/webhook/internal/repositories/analysis"
+	repositoryDelivery "github.com/ZupIT/horusec-platform/webhook/internal/repositories/delivery"
 	repositoryWebhook "github.com/ZupIT/horusec-platform/webhook/internal/repositories/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/services/formatter"
+	"github.com/ZupIT/horusec-platform/webhook/internal/services/signature"
 )
 
+func newSuccessResponse() *entities.HTTPResponse {
+	return &entities.HTTPResponse{Response: &http.Response{
+		StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("ok"))}}
+}
+
+func newDeliveryRepositoryMock() *repositoryDelivery.Mock {
+	deliveryRepoMock := &repositoryDelivery.Mock{}
+	deliveryRepoMock.On("ExistsByEventKey").Return(false, nil)
+	deliveryRepoMock.On("Save").Return(nil)
+	deliveryRepoMock.On("SaveAttempt").Return(nil)
+	deliveryRepoMock.On("Update").Return(nil)
+	return deliveryRepoMock
+}
+
+func TestMain(m *testing.M) {
+	_ = os.Setenv(enums.EnvWebhookSecretKey, "horusec-webhook-secret-key-for-tests")
+	os.Exit(m.Run())
+}
+
 func TestNewDispatcherController(t *testing.T) {
-	assert.NotEmpty(t, NewDispatcherController(&repositoryWebhook.Mock{}))
+	assert.NotEmpty(t, NewDispatcherController(&repositoryWebhook.Mock{}, &repositoryDelivery.Mock{},
+		&repositoryAnalysis.Mock{}))
 }
 
 func TestController_DispatchRequest(t *testing.T) {
 	t.Run("Should dispatch request without error", func(t *testing.T) {
 		httpRequestMock := &request.Mock{}
-		httpRequestMock.On("NewHTTPRequest").Return(&http.Request{}, nil)
-		httpRequestMock.On("DoRequest").Return(&entities.HTTPResponse{Response: &http.Response{StatusCode: http.StatusOK}}, nil)
+		httpRequestMock.On("DoRequest").Return(newSuccessResponse(), nil)
 		repoMock := &repositoryWebhook.Mock{}
-		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New()}, nil)
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{{WebhookID: uuid.New()}}, nil)
+		deliveryRepoMock := newDeliveryRepositoryMock()
 		controller := &Controller{
-			repository:  repoMock,
-			httpRequest: httpRequestMock,
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
 		}
 		err := controller.DispatchRequest(&analysis.Analysis{})
 		assert.NoError(t, err)
+		deliveryRepoMock.AssertNumberOfCalls(t, "Save", 1)
+		deliveryRepoMock.AssertNumberOfCalls(t, "SaveAttempt", 1)
 	})
 	t.Run("Should NOT dispatch request because not exists webhook", func(t *testing.T) {
 		httpRequestMock := &request.Mock{}
-		httpRequestMock.On("NewHTTPRequest").Return(&http.Request{}, nil)
-		httpRequestMock.On("DoRequest").Return(&entities.HTTPResponse{Response: &http.Response{StatusCode: http.StatusOK}}, nil)
 		repoMock := &repositoryWebhook.Mock{}
-		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{}, nil)
+		deliveryRepoMock := newDeliveryRepositoryMock()
 		controller := &Controller{
-			repository:  repoMock,
-			httpRequest: httpRequestMock,
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
+		}
+		err := controller.DispatchRequest(&analysis.Analysis{})
+		assert.NoError(t, err)
+		deliveryRepoMock.AssertNotCalled(t, "Save")
+	})
+	t.Run("Should dispatch request only to webhooks that match the filters", func(t *testing.T) {
+		httpRequestMock := &request.Mock{}
+		httpRequestMock.On("DoRequest").Return(newSuccessResponse(), nil)
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{
+			{WebhookID: uuid.New(), Filters: webhook.Filters{MinSeverity: severities.High}},
+			{WebhookID: uuid.New(), Filters: webhook.Filters{MinSeverity: severities.Critical}},
+			{WebhookID: uuid.New(), Filters: webhook.Filters{OnlyNewFindings: true}},
+		}, nil)
+		analysisRepoMock := &repositoryAnalysis.Mock{}
+		analysisRepoMock.On("GetPreviousAnalysis").Return(&analysis.Analysis{
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+				{Vulnerability: vulnerabilityEntity.Vulnerability{Severity: severities.High, VulnHash: "hash"}},
+			},
+		}, nil)
+		deliveryRepoMock := newDeliveryRepositoryMock()
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			analysisRepository: analysisRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
+		}
+		err := controller.DispatchRequest(&analysis.Analysis{
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+				{Vulnerability: vulnerabilityEntity.Vulnerability{Severity: severities.High, VulnHash: "hash"}},
+			},
+		})
+		assert.NoError(t, err)
+		deliveryRepoMock.AssertNumberOfCalls(t, "Save", 1)
+		analysisRepoMock.AssertNumberOfCalls(t, "GetPreviousAnalysis", 1)
+	})
+	t.Run("Should search previous analysis when payload format uses the diff", func(t *testing.T) {
+		httpRequestMock := &request.Mock{}
+		httpRequestMock.On("DoRequest").Return(newSuccessResponse(), nil)
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{
+			{WebhookID: uuid.New(), Format: enums.FormatSummary}}, nil)
+		analysisRepoMock := &repositoryAnalysis.Mock{}
+		analysisRepoMock.On("GetPreviousAnalysis").Return((*analysis.Analysis)(nil), nil)
+		deliveryRepoMock := newDeliveryRepositoryMock()
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			analysisRepository: analysisRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
+		}
+		err := controller.DispatchRequest(&analysis.Analysis{
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+				{Vulnerability: vulnerabilityEntity.Vulnerability{Severity: severities.High, VulnHash: "hash"}},
+			},
+		})
+		assert.NoError(t, err)
+		deliveryRepoMock.AssertNumberOfCalls(t, "Save", 1)
+		analysisRepoMock.AssertNumberOfCalls(t, "GetPreviousAnalysis", 1)
+	})
+	t.Run("Should NOT search previous analysis when no filter requires it", func(t *testing.T) {
+		httpRequestMock := &request.Mock{}
+		httpRequestMock.On("DoRequest").Return(newSuccessResponse(), nil)
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{{WebhookID: uuid.New()}}, nil)
+		analysisRepoMock := &repositoryAnalysis.Mock{}
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: newDeliveryRepositoryMock(),
+			analysisRepository: analysisRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
 		}
 		err := controller.DispatchRequest(&analysis.Analysis{})
 		assert.NoError(t, err)
+		analysisRepoMock.AssertNotCalled(t, "GetPreviousAnalysis")
+	})
+	t.Run("Should return error when failed to get previous analysis", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{
+			{WebhookID: uuid.New(), Filters: webhook.Filters{OnlyNewFindings: true}}}, nil)
+		analysisRepoMock := &repositoryAnalysis.Mock{}
+		analysisRepoMock.On("GetPreviousAnalysis").Return(&analysis.Analysis{}, errors.New("unexpected error"))
+		deliveryRepoMock := newDeliveryRepositoryMock()
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			analysisRepository: analysisRepoMock,
+			httpRequest:        &request.Mock{},
+			formatter:          formatter.NewFormatterService(),
+		}
+		err := controller.DispatchRequest(&analysis.Analysis{})
+		assert.Error(t, err)
+		deliveryRepoMock.AssertNotCalled(t, "Save")
 	})
 	t.Run("Should return error because on list return unexpected error", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{}, errors.New("unexpected error"))
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: newDeliveryRepositoryMock(),
+			httpRequest:        &request.Mock{},
+			formatter:          formatter.NewFormatterService(),
+		}
+		err := controller.DispatchRequest(&analysis.Analysis{})
+		assert.Error(t, err)
+	})
+	t.Run("Should register failed attempt without error when mount request return error", func(t *testing.T) {
 		httpRequestMock := &request.Mock{}
-		httpRequestMock.On("NewHTTPRequest").Return(&http.Request{}, nil)
-		httpRequestMock.On("DoRequest").Return(&entities.HTTPResponse{Response: &http.Response{StatusCode: http.StatusOK}}, nil)
 		repoMock := &repositoryWebhook.Mock{}
-		repoMock.On("ListOne").Return(&webhook.Webhook{}, errors.New("unexpected error"))
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{{WebhookID: uuid.New(), MaxAttempts: 5,
+			URL: "://invalid"}}, nil)
+		deliveryRepoMock := newDeliveryRepositoryMock()
 		controller := &Controller{
-			repository:  repoMock,
-			httpRequest: httpRequestMock,
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
+		}
+		err := controller.DispatchRequest(&analysis.Analysis{})
+		assert.NoError(t, err)
+		deliveryRepoMock.AssertNumberOfCalls(t, "SaveAttempt", 1)
+		httpRequestMock.AssertNotCalled(t, "DoRequest")
+	})
+	t.Run("Should register failed attempt without error when do request return error", func(t *testing.T) {
+		httpRequestMock := &request.Mock{}
+		httpRequestMock.On("DoRequest").Return(&entities.HTTPResponse{}, errors.New("unexpected error"))
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{{WebhookID: uuid.New(), MaxAttempts: 1}}, nil)
+		deliveryRepoMock := newDeliveryRepositoryMock()
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
+		}
+		err := controller.DispatchRequest(&analysis.Analysis{})
+		assert.NoError(t, err)
+		deliveryRepoMock.AssertNumberOfCalls(t, "Update", 1)
+	})
+	t.Run("Should return error when save delivery return unexpected error", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{{WebhookID: uuid.New()}}, nil)
+		deliveryRepoMock := &repositoryDelivery.Mock{}
+		deliveryRepoMock.On("ExistsByEventKey").Return(false, nil)
+		deliveryRepoMock.On("Save").Return(errors.New("unexpected error"))
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        &request.Mock{},
+			formatter:          formatter.NewFormatterService(),
 		}
 		err := controller.DispatchRequest(&analysis.Analysis{})
 		assert.Error(t, err)
 	})
-	t.Run("Should return error because on mount request is return unexpected error", func(t *testing.T) {
+	t.Run("Should return error when save attempt return unexpected error", func(t *testing.T) {
 		httpRequestMock := &request.Mock{}
-		httpRequestMock.On("NewHTTPRequest").Return(&http.Request{}, errors.New("unexpected error"))
-		httpRequestMock.On("DoRequest").Return(&entities.HTTPResponse{Response: &http.Response{StatusCode: http.StatusOK}}, nil)
+		httpRequestMock.On("DoRequest").Return(newSuccessResponse(), nil)
 		repoMock := &repositoryWebhook.Mock{}
-		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New()}, nil)
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{{WebhookID: uuid.New()}}, nil)
+		deliveryRepoMock := &repositoryDelivery.Mock{}
+		deliveryRepoMock.On("ExistsByEventKey").Return(false, nil)
+		deliveryRepoMock.On("Save").Return(nil)
+		deliveryRepoMock.On("SaveAttempt").Return(errors.New("unexpected error"))
 		controller := &Controller{
-			repository:  repoMock,
-			httpRequest: httpRequestMock,
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
 		}
 		err := controller.DispatchRequest(&analysis.Analysis{})
 		assert.Error(t, err)
 	})
-	t.Run("Should return error because on do request is return unexpected error", func(t *testing.T) {
+}
+
+func TestController_DispatchEvent(t *testing.T) {
+	t.Run("Should fan out event to every subscribed webhook", func(t *testing.T) {
+		httpRequestMock := &request.Mock{}
+		httpRequestMock.On("DoRequest").Return(newSuccessResponse(), nil)
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{{WebhookID: uuid.New()}, {WebhookID: uuid.New()}}, nil)
+		deliveryRepoMock := newDeliveryRepositoryMock()
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
+		}
+		err := controller.DispatchEvent(&event.Event{Type: enums.EventRepositoryCreated, WorkspaceID: uuid.New()})
+		assert.NoError(t, err)
+		httpRequestMock.AssertNumberOfCalls(t, "DoRequest", 2)
+		deliveryRepoMock.AssertNumberOfCalls(t, "Save", 2)
+	})
+	t.Run("Should send to all webhooks even when one of them fails", func(t *testing.T) {
 		httpRequestMock := &request.Mock{}
-		httpRequestMock.On("NewHTTPRequest").Return(&http.Request{}, nil)
 		httpRequestMock.On("DoRequest").Return(&entities.HTTPResponse{}, errors.New("unexpected error"))
 		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{{WebhookID: uuid.New()}, {WebhookID: uuid.New()}}, nil)
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: newDeliveryRepositoryMock(),
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
+		}
+		err := controller.DispatchEvent(&event.Event{Type: enums.EventTokenExpiring, WorkspaceID: uuid.New()})
+		assert.NoError(t, err)
+		httpRequestMock.AssertNumberOfCalls(t, "DoRequest", 2)
+	})
+	t.Run("Should save delivery of all webhooks and return error when one of them fails to save", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{{WebhookID: uuid.New()}, {WebhookID: uuid.New()}}, nil)
+		deliveryRepoMock := &repositoryDelivery.Mock{}
+		deliveryRepoMock.On("ExistsByEventKey").Return(false, nil)
+		deliveryRepoMock.On("Save").Return(errors.New("unexpected error"))
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        &request.Mock{},
+			formatter:          formatter.NewFormatterService(),
+		}
+		err := controller.DispatchEvent(&event.Event{Type: enums.EventRepositoryCreated, WorkspaceID: uuid.New()})
+		assert.Equal(t, enums.ErrorFailedToDeliver, err)
+		deliveryRepoMock.AssertNumberOfCalls(t, "Save", 2)
+	})
+	t.Run("Should NOT deliver again to webhooks that already have a delivery of the event", func(t *testing.T) {
+		httpRequestMock := &request.Mock{}
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{{WebhookID: uuid.New()}}, nil)
+		deliveryRepoMock := &repositoryDelivery.Mock{}
+		deliveryRepoMock.On("ExistsByEventKey").Return(true, nil)
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
+		}
+		err := controller.DispatchEvent(&event.Event{Type: enums.EventRepositoryCreated, WorkspaceID: uuid.New()})
+		assert.NoError(t, err)
+		deliveryRepoMock.AssertNotCalled(t, "Save")
+		httpRequestMock.AssertNotCalled(t, "DoRequest")
+	})
+	t.Run("Should return error when check existing delivery fails", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListSubscribed").Return(&[]webhook.Webhook{{WebhookID: uuid.New()}}, nil)
+		deliveryRepoMock := &repositoryDelivery.Mock{}
+		deliveryRepoMock.On("ExistsByEventKey").Return(false, errors.New("unexpected error"))
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			formatter:          formatter.NewFormatterService(),
+		}
+		err := controller.DispatchEvent(&event.Event{Type: enums.EventRepositoryCreated, WorkspaceID: uuid.New()})
+		assert.Error(t, err)
+		deliveryRepoMock.AssertNotCalled(t, "Save")
+	})
+}
+
+func TestController_RetryPendingDeliveries(t *testing.T) {
+	t.Run("Should retry all claimed deliveries without error", func(t *testing.T) {
+		httpRequestMock := &request.Mock{}
+		httpRequestMock.On("DoRequest").Return(newSuccessResponse(), nil)
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New(), MaxAttempts: 5}, nil)
+		deliveryRepoMock := newDeliveryRepositoryMock()
+		deliveryRepoMock.On("ClaimPending").Return(&[]delivery.Delivery{{DeliveryID: uuid.New(), Attempts: 1},
+			{DeliveryID: uuid.New(), Attempts: 2}}, nil)
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
+		}
+		assert.NoError(t, controller.RetryPendingDeliveries())
+		httpRequestMock.AssertNumberOfCalls(t, "DoRequest", 2)
+		deliveryRepoMock.AssertNumberOfCalls(t, "Update", 2)
+	})
+	t.Run("Should return error when claim pending return unexpected error", func(t *testing.T) {
+		deliveryRepoMock := &repositoryDelivery.Mock{}
+		deliveryRepoMock.On("ClaimPending").Return(&[]delivery.Delivery{}, errors.New("unexpected error"))
+		controller := &Controller{
+			repository:         &repositoryWebhook.Mock{},
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        &request.Mock{},
+			formatter:          formatter.NewFormatterService(),
+		}
+		assert.Error(t, controller.RetryPendingDeliveries())
+	})
+	t.Run("Should return error when list webhook return unexpected error", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, errors.New("unexpected error"))
+		deliveryRepoMock := &repositoryDelivery.Mock{}
+		deliveryRepoMock.On("ClaimPending").Return(&[]delivery.Delivery{{DeliveryID: uuid.New()}}, nil)
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        &request.Mock{},
+			formatter:          formatter.NewFormatterService(),
+		}
+		assert.Error(t, controller.RetryPendingDeliveries())
+	})
+	t.Run("Should retry the other deliveries when one of them fails", func(t *testing.T) {
+		httpRequestMock := &request.Mock{}
+		httpRequestMock.On("DoRequest").Return(newSuccessResponse(), nil)
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, errors.New("unexpected error")).Once()
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New(), MaxAttempts: 5}, nil)
+		deliveryRepoMock := newDeliveryRepositoryMock()
+		deliveryRepoMock.On("ClaimPending").Return(&[]delivery.Delivery{{DeliveryID: uuid.New(), Attempts: 1},
+			{DeliveryID: uuid.New(), Attempts: 2}}, nil)
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
+		}
+		assert.Equal(t, enums.ErrorFailedToRetry, controller.RetryPendingDeliveries())
+		repoMock.AssertNumberOfCalls(t, "ListOne", 2)
+		httpRequestMock.AssertNumberOfCalls(t, "DoRequest", 1)
+	})
+}
+
+func TestController_Redeliver(t *testing.T) {
+	t.Run("Should redeliver without error", func(t *testing.T) {
+		httpRequestMock := &request.Mock{}
+		httpRequestMock.On("DoRequest").Return(newSuccessResponse(), nil)
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New(), MaxAttempts: 1}, nil)
+		deliveryRepoMock := newDeliveryRepositoryMock()
+		deliveryRepoMock.On("ListOne").Return(&delivery.Delivery{DeliveryID: uuid.New(),
+			Status: enums.DeliveryFailed, Attempts: 1}, nil)
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
+		}
+		res, err := controller.Redeliver(uuid.New(), uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, enums.DeliverySuccess, res.Status)
+		assert.Equal(t, 2, res.Attempts)
+	})
+	t.Run("Should keep delivery failed when redeliver fails after max attempts", func(t *testing.T) {
+		httpRequestMock := &request.Mock{}
+		httpRequestMock.On("DoRequest").Return(&entities.HTTPResponse{}, errors.New("unexpected error"))
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New(), MaxAttempts: 2}, nil)
+		deliveryRepoMock := newDeliveryRepositoryMock()
+		deliveryRepoMock.On("ListOne").Return(&delivery.Delivery{DeliveryID: uuid.New(),
+			Status: enums.DeliveryFailed, Attempts: 2}, nil)
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatter.NewFormatterService(),
+		}
+		res, err := controller.Redeliver(uuid.New(), uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, enums.DeliveryFailed, res.Status)
+		assert.Nil(t, res.NextRetryAt)
+	})
+	t.Run("Should return error not found when webhook not exists in workspace", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: &repositoryDelivery.Mock{},
+			httpRequest:        &request.Mock{},
+			formatter:          formatter.NewFormatterService(),
+		}
+		_, err := controller.Redeliver(uuid.New(), uuid.New(), uuid.New())
+		assert.Equal(t, enums.ErrorWebhookNotFound, err)
+	})
+	t.Run("Should return error when list webhook return unexpected error", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, errors.New("unexpected error"))
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: &repositoryDelivery.Mock{},
+			httpRequest:        &request.Mock{},
+			formatter:          formatter.NewFormatterService(),
+		}
+		_, err := controller.Redeliver(uuid.New(), uuid.New(), uuid.New())
+		assert.Error(t, err)
+	})
+	t.Run("Should return error when list delivery return unexpected error", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New()}, nil)
+		deliveryRepoMock := &repositoryDelivery.Mock{}
+		deliveryRepoMock.On("ListOne").Return(&delivery.Delivery{}, errors.New("unexpected error"))
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        &request.Mock{},
+			formatter:          formatter.NewFormatterService(),
+		}
+		_, err := controller.Redeliver(uuid.New(), uuid.New(), uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestController_getHeaders(t *testing.T) {
+	t.Run("Should add event type, delivery and timestamp headers to webhook headers", func(t *testing.T) {
+		webhookFound := &webhook.Webhook{Headers: webhook.HeaderType{{Key: "x-authorization", Value: "token"}}}
+		entity := delivery.NewDelivery(uuid.New(), enums.EventNewAnalysis, []byte("{}"))
+		headers, err := (&Controller{formatter: formatter.NewFormatterService()}).getHeaders(webhookFound, entity)
+		assert.NoError(t, err)
+		assert.Equal(t, "token", headers["X-Authorization"])
+		assert.Equal(t, enums.ContentTypeJSON, headers[enums.HeaderContentType])
+		assert.Equal(t, enums.EventNewAnalysis.ToString(), headers[enums.HeaderEventType])
+		assert.Equal(t, entity.DeliveryID.String(), headers[enums.HeaderDelivery])
+		assert.NotEmpty(t, headers[enums.HeaderTimestamp])
+		assert.NotContains(t, headers, enums.HeaderSignature)
+	})
+	t.Run("Should send template payload that is not a json as plain text", func(t *testing.T) {
+		webhookFound := &webhook.Webhook{Format: enums.FormatTemplate}
+		entity := delivery.NewDelivery(uuid.New(), enums.EventNewAnalysis, []byte("new analysis finished"))
+		headers, err := (&Controller{formatter: formatter.NewFormatterService()}).getHeaders(webhookFound, entity)
+		assert.NoError(t, err)
+		assert.Equal(t, enums.ContentTypeText, headers[enums.HeaderContentType])
+	})
+	t.Run("Should use content type configured on the webhook headers", func(t *testing.T) {
+		webhookFound := &webhook.Webhook{Format: enums.FormatTemplate,
+			Headers: webhook.HeaderType{{Key: "content-type", Value: "application/xml"}}}
+		entity := delivery.NewDelivery(uuid.New(), enums.EventNewAnalysis, []byte("<analysis/>"))
+		headers, err := (&Controller{formatter: formatter.NewFormatterService()}).getHeaders(webhookFound, entity)
+		assert.NoError(t, err)
+		assert.Equal(t, "application/xml", headers[enums.HeaderContentType])
+	})
+	t.Run("Should add signature header signed with webhook secret", func(t *testing.T) {
+		signatureService := signature.NewSignatureService()
+		encryptedSecret, _ := signatureService.Encrypt("my-secret")
+		webhookFound := &webhook.Webhook{Secret: encryptedSecret}
+		entity := delivery.NewDelivery(uuid.New(), enums.EventNewAnalysis, []byte(`{"id":"1"}`))
+		headers, err := (&Controller{signature: signatureService,
+			formatter: formatter.NewFormatterService()}).getHeaders(webhookFound, entity)
+		assert.NoError(t, err)
+		timestamp, _ := strconv.ParseInt(headers[enums.HeaderTimestamp], 10, 64)
+		assert.Equal(t, signatureService.Sign("my-secret", timestamp, []byte(`{"id":"1"}`)),
+			headers[enums.HeaderSignature])
+	})
+	t.Run("Should add signatures of new and previous secret during grace period", func(t *testing.T) {
+		signatureMock := &signature.Mock{}
+		signatureMock.On("Decrypt").Return("secret", nil)
+		signatureMock.On("Sign").Return("sha256=signature")
+		webhookFound := (&webhook.Webhook{Secret: "old"}).SetSecret("new", time.Hour)
+		entity := delivery.NewDelivery(uuid.New(), enums.EventNewAnalysis, []byte("{}"))
+		headers, err := (&Controller{signature: signatureMock,
+			formatter: formatter.NewFormatterService()}).getHeaders(webhookFound, entity)
+		assert.NoError(t, err)
+		assert.Equal(t, "sha256=signature,sha256=signature", headers[enums.HeaderSignature])
+	})
+	t.Run("Should return error when decrypt secret fails", func(t *testing.T) {
+		signatureMock := &signature.Mock{}
+		signatureMock.On("Decrypt").Return("", enums.ErrorInvalidSecret)
+		webhookFound := &webhook.Webhook{Secret: "invalid"}
+		entity := delivery.NewDelivery(uuid.New(), enums.EventNewAnalysis, []byte("{}"))
+		_, err := (&Controller{signature: signatureMock,
+			formatter: formatter.NewFormatterService()}).getHeaders(webhookFound, entity)
+		assert.Equal(t, enums.ErrorInvalidSecret, err)
+	})
+}
+
+func TestController_sendHTTPRequest(t *testing.T) {
+	t.Run("Should return failed result without send request when sign fails", func(t *testing.T) {
+		httpRequestMock := &request.Mock{}
+		signatureMock := &signature.Mock{}
+		signatureMock.On("Decrypt").Return("", enums.ErrorInvalidSecret)
+		controller := &Controller{httpRequest: httpRequestMock, signature: signatureMock,
+			formatter: formatter.NewFormatterService()}
+		result := controller.sendHTTPRequest(&webhook.Webhook{Secret: "invalid"},
+			delivery.NewDelivery(uuid.New(), enums.EventNewAnalysis, []byte("{}")))
+		assert.Equal(t, enums.ErrorInvalidSecret, result.Err)
+		httpRequestMock.AssertNotCalled(t, "DoRequest")
+	})
+}
+
+func TestController_deliver(t *testing.T) {
+	t.Run("Should send payload rendered in the webhook format", func(t *testing.T) {
+		var receivedBody []byte
+		var receivedHeaders http.Header
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedBody, _ = io.ReadAll(r.Body)
+			receivedHeaders = r.Header
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		formatterMock := &formatter.Mock{}
+		formatterMock.On("Format").Return([]byte(`{"text": "summary"}`), nil)
+		formatterMock.On("ContentType").Return(enums.ContentTypeJSON)
+		deliveryRepoMock := newDeliveryRepositoryMock()
+		controller := &Controller{
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        request.NewHTTPRequestService(10),
+			formatter:          formatterMock,
+		}
+		webhookFound := &webhook.Webhook{WebhookID: uuid.New(), Method: http.MethodPost, URL: server.URL,
+			Format: enums.FormatSlack, MaxAttempts: 5}
+		err := controller.deliver(webhookFound, &event.Event{Type: enums.EventNewAnalysis, Payload: []byte("{}")})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"text": "summary"}`, string(receivedBody))
+		assert.Equal(t, enums.EventNewAnalysis.ToString(), receivedHeaders.Get(enums.HeaderEventType))
+		assert.Equal(t, enums.ContentTypeJSON, receivedHeaders.Get(enums.HeaderContentType))
+	})
+	t.Run("Should register failed delivery without send request when format fails", func(t *testing.T) {
+		httpRequestMock := &request.Mock{}
+		formatterMock := &formatter.Mock{}
+		formatterMock.On("Format").Return([]byte{}, errors.New("template: webhook: executing"))
+		deliveryRepoMock := newDeliveryRepositoryMock()
+		controller := &Controller{
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        httpRequestMock,
+			formatter:          formatterMock,
+		}
+		webhookFound := &webhook.Webhook{WebhookID: uuid.New(), Format: enums.FormatTemplate, MaxAttempts: 5}
+		err := controller.deliver(webhookFound, &event.Event{Type: enums.EventNewAnalysis, Payload: []byte("{}")})
+		assert.NoError(t, err)
+		httpRequestMock.AssertNotCalled(t, "DoRequest")
+		deliveryRepoMock.AssertNumberOfCalls(t, "Update", 1)
+	})
+}
+
+func TestController_TestWebhook(t *testing.T) {
+	t.Run("Should send sample analysis and return the remote response without saving", func(t *testing.T) {
+		var receivedBody []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedBody, _ = io.ReadAll(r.Body)
+			w.Header().Set("X-Request-Id", "test")
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte("accepted"))
+		}))
+		defer server.Close()
+
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New(), WorkspaceID: uuid.New(),
+			Method: http.MethodPost, URL: server.URL}, nil)
+		deliveryRepoMock := &repositoryDelivery.Mock{}
+		controller := &Controller{
+			repository:         repoMock,
+			deliveryRepository: deliveryRepoMock,
+			httpRequest:        request.NewHTTPRequestService(10),
+			formatter:          formatter.NewFormatterService(),
+		}
+		result, err := controller.TestWebhook(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, http.StatusAccepted, result.StatusCode)
+		assert.Equal(t, "accepted", result.ResponseBody)
+		assert.Equal(t, "test", result.ResponseHeaders["X-Request-Id"])
+		assert.Empty(t, result.Error)
+		assert.Contains(t, string(receivedBody), enums.SampleRepositoryName)
+		deliveryRepoMock.AssertNotCalled(t, "Save")
+	})
+	t.Run("Should return failed result when remote returns unexpected status code", func(t *testing.T) {
+		httpRequestMock := &request.Mock{}
+		httpRequestMock.On("DoRequest").Return(&entities.HTTPResponse{Response: &http.Response{
+			StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("not found"))}}, nil)
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New(), Method: http.MethodPost,
+			URL: "http://localhost"}, nil)
+		controller := &Controller{
+			repository:  repoMock,
+			httpRequest: httpRequestMock,
+			formatter:   formatter.NewFormatterService(),
+		}
+		result, err := controller.TestWebhook(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.Equal(t, http.StatusNotFound, result.StatusCode)
+		assert.Equal(t, "unexpected status code 404", result.Error)
+	})
+	t.Run("Should return failed result without send request when format fails", func(t *testing.T) {
+		httpRequestMock := &request.Mock{}
+		formatterMock := &formatter.Mock{}
+		formatterMock.On("Format").Return([]byte{}, errors.New("template: webhook: executing"))
+		repoMock := &repositoryWebhook.Mock{}
 		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New()}, nil)
 		controller := &Controller{
 			repository:  repoMock,
 			httpRequest: httpRequestMock,
+			formatter:   formatterMock,
 		}
-		err := controller.DispatchRequest(&analysis.Analysis{})
+		result, err := controller.TestWebhook(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.Equal(t, "template: webhook: executing", result.Error)
+		httpRequestMock.AssertNotCalled(t, "DoRequest")
+	})
+	t.Run("Should return error when webhook not exists", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
+		controller := &Controller{repository: repoMock}
+		_, err := controller.TestWebhook(uuid.New(), uuid.New())
+		assert.Equal(t, enums.ErrorWebhookNotFound, err)
+	})
+	t.Run("Should return error when failed to find webhook", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, errors.New("unexpected error"))
+		controller := &Controller{repository: repoMock}
+		_, err := controller.TestWebhook(uuid.New(), uuid.New())
 		assert.Error(t, err)
 	})
 }
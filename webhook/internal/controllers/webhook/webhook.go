@@ -15,55 +15,123 @@
 package webhook
 
 import (
-	"github.com/google/uuid"
+	"time"
 
-	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+	"github.com/google/uuid"
 
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/delivery"
 	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+	repositoryDelivery "github.com/ZupIT/horusec-platform/webhook/internal/repositories/delivery"
 	repositoryWebhook "github.com/ZupIT/horusec-platform/webhook/internal/repositories/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/services/signature"
 )
 
 type IWebhookController interface {
-	Save(entity *webhook.Webhook) (uuid.UUID, error)
-	Update(entity *webhook.Webhook, webhookID uuid.UUID) error
+	Save(entity *webhook.Webhook) (*webhook.Secret, error)
+	Update(entity *webhook.Webhook, workspaceID, webhookID uuid.UUID) error
 	ListAll(workspaceID uuid.UUID) (*[]webhook.WithRepository, error)
-	Remove(webhookID uuid.UUID) error
+	Remove(workspaceID, webhookID uuid.UUID) error
+	ListDeliveries(workspaceID, webhookID uuid.UUID, page, size int) (*[]delivery.Delivery, error)
+	RotateSecret(workspaceID, webhookID uuid.UUID, gracePeriod time.Duration) (*webhook.Secret, error)
 }
 
 type Controller struct {
-	repository repositoryWebhook.IWebhookRepository
+	repository         repositoryWebhook.IWebhookRepository
+	deliveryRepository repositoryDelivery.IDeliveryRepository
+	signature          signature.ISignature
 }
 
-func NewWebhookController(repository repositoryWebhook.IWebhookRepository) IWebhookController {
+func NewWebhookController(repository repositoryWebhook.IWebhookRepository,
+	deliveryRepository repositoryDelivery.IDeliveryRepository) IWebhookController {
 	return &Controller{
-		repository: repository,
+		repository:         repository,
+		deliveryRepository: deliveryRepository,
+		signature:          signature.NewSignatureService(),
 	}
 }
 
-func (c *Controller) Save(entity *webhook.Webhook) (uuid.UUID, error) {
-	existing, err := c.repository.ListOne(map[string]interface{}{"repository_id": entity.RepositoryID})
+func (c *Controller) Save(entity *webhook.Webhook) (*webhook.Secret, error) {
+	existing, err := c.repository.ListOne(map[string]interface{}{
+		"workspace_id": entity.WorkspaceID, "repository_id": entity.RepositoryID, "url": entity.URL})
 	if err != nil {
-		return uuid.Nil, err
+		return nil, err
 	}
 	if existing.WebhookID != uuid.Nil {
-		return uuid.Nil, enums.ErrorWebhookDuplicate
+		return nil, enums.ErrorWebhookDuplicate
 	}
-	entity = entity.GenerateID().GenerateCreateAt()
+	secret, encryptedSecret, err := c.generateSecret()
+	if err != nil {
+		return nil, err
+	}
+	entity = entity.GenerateID().GenerateCreateAt().SetSecret(encryptedSecret, 0)
 	if err := c.repository.Save(entity); err != nil {
-		return uuid.Nil, err
+		return nil, err
+	}
+	return entity.ToSecret(secret), nil
+}
+
+func (c *Controller) generateSecret() (secret, encryptedSecret string, err error) {
+	secret, err = c.signature.GenerateSecret()
+	if err != nil {
+		return "", "", err
 	}
-	return entity.WebhookID, nil
+	encryptedSecret, err = c.signature.Encrypt(secret)
+	return secret, encryptedSecret, err
 }
 
-func (c *Controller) Update(entity *webhook.Webhook, webhookID uuid.UUID) error {
+// Update changes the webhook only when it belongs to the workspace, which can not be changed by the body
+func (c *Controller) Update(entity *webhook.Webhook, workspaceID, webhookID uuid.UUID) error {
+	if _, err := c.getWorkspaceWebhook(workspaceID, webhookID); err != nil {
+		return err
+	}
+	entity.WorkspaceID = workspaceID
 	entity = entity.GenerateUpdatedAt()
-	return c.repository.Update(entity, webhookID)
+	return c.repository.Update(entity, workspaceID, webhookID)
 }
 
 func (c *Controller) ListAll(workspaceID uuid.UUID) (*[]webhook.WithRepository, error) {
 	return c.repository.ListAll(workspaceID)
 }
 
-func (c *Controller) Remove(webhookID uuid.UUID) error {
-	return c.repository.Remove(webhookID)
+func (c *Controller) Remove(workspaceID, webhookID uuid.UUID) error {
+	if _, err := c.getWorkspaceWebhook(workspaceID, webhookID); err != nil {
+		return err
+	}
+	return c.repository.Remove(workspaceID, webhookID)
+}
+
+func (c *Controller) getWorkspaceWebhook(workspaceID, webhookID uuid.UUID) (*webhook.Webhook, error) {
+	existing, err := c.repository.ListOne(map[string]interface{}{"workspace_id": workspaceID, "webhook_id": webhookID})
+	if err != nil {
+		return nil, err
+	}
+	if existing.WebhookID == uuid.Nil {
+		return nil, enums.ErrorWebhookNotFound
+	}
+	return existing, nil
+}
+
+func (c *Controller) ListDeliveries(workspaceID, webhookID uuid.UUID, page, size int) (*[]delivery.Delivery, error) {
+	if _, err := c.getWorkspaceWebhook(workspaceID, webhookID); err != nil {
+		return nil, err
+	}
+	return c.deliveryRepository.ListByWebhook(webhookID, page, size)
+}
+
+func (c *Controller) RotateSecret(workspaceID, webhookID uuid.UUID,
+	gracePeriod time.Duration) (*webhook.Secret, error) {
+	existing, err := c.getWorkspaceWebhook(workspaceID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	secret, encryptedSecret, err := c.generateSecret()
+	if err != nil {
+		return nil, err
+	}
+	existing = existing.SetSecret(encryptedSecret, gracePeriod).GenerateUpdatedAt()
+	if err := c.repository.UpdateSecret(existing); err != nil {
+		return nil, err
+	}
+	return existing.ToSecret(secret), nil
 }
@@ -15,10 +15,13 @@
 package webhook
 
 import (
+	"time"
+
 	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/delivery"
 	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
 )
 
@@ -26,12 +29,12 @@ type Mock struct {
 	mock.Mock
 }
 
-func (m *Mock) Save(_ *webhook.Webhook) (uuid.UUID, error) {
+func (m *Mock) Save(_ *webhook.Webhook) (*webhook.Secret, error) {
 	args := m.MethodCalled("Save")
-	return args.Get(0).(uuid.UUID), utilsMock.ReturnNilOrError(args, 1)
+	return args.Get(0).(*webhook.Secret), utilsMock.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) Update(_ *webhook.Webhook, _ uuid.UUID) error {
+func (m *Mock) Update(_ *webhook.Webhook, _, _ uuid.UUID) error {
 	args := m.MethodCalled("Update")
 	return utilsMock.ReturnNilOrError(args, 0)
 }
@@ -41,7 +44,17 @@ func (m *Mock) ListAll(_ uuid.UUID) (*[]webhook.WithRepository, error) {
 	return args.Get(0).(*[]webhook.WithRepository), utilsMock.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) Remove(_ uuid.UUID) error {
+func (m *Mock) Remove(_, _ uuid.UUID) error {
 	args := m.MethodCalled("Remove")
 	return utilsMock.ReturnNilOrError(args, 0)
 }
+
+func (m *Mock) ListDeliveries(_, _ uuid.UUID, _, _ int) (*[]delivery.Delivery, error) {
+	args := m.MethodCalled("ListDeliveries")
+	return args.Get(0).(*[]delivery.Delivery), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) RotateSecret(_, _ uuid.UUID, _ time.Duration) (*webhook.Secret, error) {
+	args := m.MethodCalled("RotateSecret")
+	return args.Get(0).(*webhook.Secret), utilsMock.ReturnNilOrError(args, 1)
+}
@@ -16,95 +16,188 @@ package webhook
 
 import (
 	"errors"
+	"os"
 	"testing"
+	"time"
 
-	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/delivery"
 	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
 	enums2 "github.com/ZupIT/horusec-platform/webhook/internal/enums"
+	repositoryDelivery "github.com/ZupIT/horusec-platform/webhook/internal/repositories/delivery"
 	repositoryWebhook "github.com/ZupIT/horusec-platform/webhook/internal/repositories/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/services/signature"
 )
 
+func TestMain(m *testing.M) {
+	_ = os.Setenv(enums2.EnvWebhookSecretKey, "horusec-webhook-secret-key-for-tests")
+	os.Exit(m.Run())
+}
+
 func TestController_ListAll(t *testing.T) {
 	t.Run("Should return all webhooks without errors", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
 		repoMock.On("ListAll").Return(&[]webhook.WithRepository{{}}, nil)
-		res, err := NewWebhookController(repoMock).ListAll(uuid.New())
+		res, err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).ListAll(uuid.New())
 		assert.NoError(t, err)
 		assert.NotEmpty(t, res)
 	})
 	t.Run("Should return error unknown on list all webhooks", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
 		repoMock.On("ListAll").Return(&[]webhook.WithRepository{}, errors.New("unexpected error"))
-		res, err := NewWebhookController(repoMock).ListAll(uuid.New())
+		res, err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).ListAll(uuid.New())
 		assert.Error(t, err)
 		assert.Empty(t, res)
 	})
 	t.Run("Should return not error but return empty list if data is nil", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
 		repoMock.On("ListAll").Return(&[]webhook.WithRepository{}, nil)
-		res, err := NewWebhookController(repoMock).ListAll(uuid.New())
+		res, err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).ListAll(uuid.New())
 		assert.NoError(t, err)
 		assert.Empty(t, res)
 	})
 }
 
 func TestController_Save(t *testing.T) {
-	t.Run("Should save new webhook without error", func(t *testing.T) {
+	t.Run("Should save new webhook with signing secret without error", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
 		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
 		repoMock.On("Save").Return(nil)
-		webhookID, err := NewWebhookController(repoMock).Save(&webhook.Webhook{})
+		res, err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).Save(&webhook.Webhook{})
 		assert.NoError(t, err)
-		assert.NotEqual(t, uuid.Nil, webhookID)
+		assert.NotEqual(t, uuid.Nil, res.WebhookID)
+		assert.NotEmpty(t, res.Secret)
+		assert.Nil(t, res.PreviousSecretExpiresAt)
 	})
 	t.Run("Should save new webhook with error duplicate webhook", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
 		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New()}, nil)
 		repoMock.On("Save").Return(nil)
-		webhookID, err := NewWebhookController(repoMock).Save(&webhook.Webhook{})
+		res, err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).Save(&webhook.Webhook{})
 		assert.Error(t, err)
 		assert.Equal(t, enums2.ErrorWebhookDuplicate, err)
-		assert.Equal(t, uuid.Nil, webhookID)
+		assert.Nil(t, res)
 	})
 	t.Run("Should save new webhook with error unexpected on listone", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
 		repoMock.On("ListOne").Return(&webhook.Webhook{}, errors.New("unexpected error"))
 		repoMock.On("Save").Return(nil)
-		webhookID, err := NewWebhookController(repoMock).Save(&webhook.Webhook{})
+		res, err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).Save(&webhook.Webhook{})
 		assert.Error(t, err)
 		assert.NotEqual(t, enums2.ErrorWebhookDuplicate, err)
-		assert.Equal(t, uuid.Nil, webhookID)
+		assert.Nil(t, res)
 	})
 	t.Run("Should save new webhook with error unexpected on save", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
 		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
 		repoMock.On("Save").Return(errors.New("unexpected error"))
-		webhookID, err := NewWebhookController(repoMock).Save(&webhook.Webhook{})
+		res, err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).Save(&webhook.Webhook{})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+	t.Run("Should save new webhook with error on generate secret", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
+		signatureMock := &signature.Mock{}
+		signatureMock.On("GenerateSecret").Return("", errors.New("unexpected error"))
+		controller := &Controller{repository: repoMock, signature: signatureMock}
+		res, err := controller.Save(&webhook.Webhook{})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		repoMock.AssertNotCalled(t, "Save")
+	})
+}
+
+func TestController_RotateSecret(t *testing.T) {
+	t.Run("Should rotate secret keeping the previous one during grace period", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New(), Secret: "encrypted-old"}, nil)
+		repoMock.On("UpdateSecret").Return(nil)
+		signatureMock := &signature.Mock{}
+		signatureMock.On("GenerateSecret").Return("new", nil)
+		signatureMock.On("Encrypt").Return("encrypted-new", nil)
+		controller := &Controller{repository: repoMock, signature: signatureMock}
+		res, err := controller.RotateSecret(uuid.New(), uuid.New(), time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, "new", res.Secret)
+		assert.NotNil(t, res.PreviousSecretExpiresAt)
+	})
+	t.Run("Should rotate secret revoking the previous one when grace period is zero", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New(), Secret: "encrypted-old"}, nil)
+		repoMock.On("UpdateSecret").Return(nil)
+		controller := NewWebhookController(repoMock, &repositoryDelivery.Mock{})
+		res, err := controller.RotateSecret(uuid.New(), uuid.New(), 0)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Secret)
+		assert.Nil(t, res.PreviousSecretExpiresAt)
+	})
+	t.Run("Should return error not found when webhook not exists in workspace", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
+		res, err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).RotateSecret(uuid.New(), uuid.New(), 0)
+		assert.Equal(t, enums2.ErrorWebhookNotFound, err)
+		assert.Nil(t, res)
+	})
+	t.Run("Should return error unexpected on list webhook", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, errors.New("unexpected error"))
+		res, err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).RotateSecret(uuid.New(), uuid.New(), 0)
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+	t.Run("Should return error on encrypt secret", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New()}, nil)
+		signatureMock := &signature.Mock{}
+		signatureMock.On("GenerateSecret").Return("new", nil)
+		signatureMock.On("Encrypt").Return("", errors.New("unexpected error"))
+		controller := &Controller{repository: repoMock, signature: signatureMock}
+		res, err := controller.RotateSecret(uuid.New(), uuid.New(), 0)
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+	t.Run("Should return error unexpected on update secret", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New()}, nil)
+		repoMock.On("UpdateSecret").Return(errors.New("unexpected error"))
+		res, err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).RotateSecret(uuid.New(), uuid.New(), 0)
 		assert.Error(t, err)
-		assert.Equal(t, uuid.Nil, webhookID)
+		assert.Nil(t, res)
 	})
 }
 
 func TestController_Update(t *testing.T) {
 	t.Run("Should update repository without error", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New()}, nil)
 		repoMock.On("Update").Return(nil)
-		err := NewWebhookController(repoMock).Update(&webhook.Webhook{}, uuid.New())
+		entity := &webhook.Webhook{WorkspaceID: uuid.New()}
+		workspaceID := uuid.New()
+		err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).Update(entity, workspaceID, uuid.New())
 		assert.NoError(t, err)
+		assert.Equal(t, workspaceID, entity.WorkspaceID)
 	})
-	t.Run("Should update repository with error not found", func(t *testing.T) {
+	t.Run("Should return not found when webhook is not of the workspace", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
-		repoMock.On("Update").Return(enums.ErrorNotFoundRecords)
-		err := NewWebhookController(repoMock).Update(&webhook.Webhook{}, uuid.New())
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
+		err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).Update(&webhook.Webhook{}, uuid.New(), uuid.New())
+		assert.Equal(t, enums2.ErrorWebhookNotFound, err)
+		repoMock.AssertNotCalled(t, "Update")
+	})
+	t.Run("Should return error when find webhook", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, errors.New("unexpected error"))
+		err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).Update(&webhook.Webhook{}, uuid.New(), uuid.New())
 		assert.Error(t, err)
 	})
 	t.Run("Should update repository with error unexpected", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New()}, nil)
 		repoMock.On("Update").Return(errors.New("unexpected error"))
-		err := NewWebhookController(repoMock).Update(&webhook.Webhook{}, uuid.New())
+		err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).Update(&webhook.Webhook{}, uuid.New(), uuid.New())
 		assert.Error(t, err)
 	})
 }
@@ -112,20 +205,55 @@ func TestController_Update(t *testing.T) {
 func TestController_Remove(t *testing.T) {
 	t.Run("Should remove repository without error", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New()}, nil)
 		repoMock.On("Remove").Return(nil)
-		err := NewWebhookController(repoMock).Remove(uuid.New())
+		err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).Remove(uuid.New(), uuid.New())
 		assert.NoError(t, err)
 	})
-	t.Run("Should remove repository with error not found", func(t *testing.T) {
+	t.Run("Should return not found when webhook is not of the workspace", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
-		repoMock.On("Remove").Return(enums.ErrorNotFoundRecords)
-		err := NewWebhookController(repoMock).Remove(uuid.New())
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
+		err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).Remove(uuid.New(), uuid.New())
+		assert.Equal(t, enums2.ErrorWebhookNotFound, err)
+		repoMock.AssertNotCalled(t, "Remove")
+	})
+	t.Run("Should return error when find webhook", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, errors.New("unexpected error"))
+		err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).Remove(uuid.New(), uuid.New())
 		assert.Error(t, err)
 	})
 	t.Run("Should remove repository with error unexpected", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New()}, nil)
 		repoMock.On("Remove").Return(errors.New("unexpected error"))
-		err := NewWebhookController(repoMock).Remove(uuid.New())
+		err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).Remove(uuid.New(), uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestController_ListDeliveries(t *testing.T) {
+	t.Run("Should return deliveries of webhook without error", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New()}, nil)
+		deliveryRepoMock := &repositoryDelivery.Mock{}
+		deliveryRepoMock.On("ListByWebhook").Return(&[]delivery.Delivery{{DeliveryID: uuid.New()}}, nil)
+		res, err := NewWebhookController(repoMock, deliveryRepoMock).ListDeliveries(uuid.New(), uuid.New(), 1, 10)
+		assert.NoError(t, err)
+		assert.Len(t, *res, 1)
+	})
+	t.Run("Should return error not found when webhook not exists in workspace", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
+		res, err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).ListDeliveries(uuid.New(), uuid.New(), 1, 10)
+		assert.Equal(t, enums2.ErrorWebhookNotFound, err)
+		assert.Nil(t, res)
+	})
+	t.Run("Should return error unexpected on list webhook", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, errors.New("unexpected error"))
+		res, err := NewWebhookController(repoMock, &repositoryDelivery.Mock{}).ListDeliveries(uuid.New(), uuid.New(), 1, 10)
 		assert.Error(t, err)
+		assert.Nil(t, res)
 	})
 }
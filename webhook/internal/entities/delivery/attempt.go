@@ -0,0 +1,92 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delivery
+
+import (
+	"fmt"
+	netHTTP "net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+type Result struct {
+	StatusCode      int
+	Latency         time.Duration
+	ResponseBody    string
+	ResponseHeaders map[string]string
+	Err             error
+}
+
+func NewResult(startTime time.Time, statusCode int, responseBody []byte, err error) *Result {
+	return &Result{
+		StatusCode:   statusCode,
+		Latency:      time.Since(startTime),
+		ResponseBody: truncate(string(responseBody)),
+		Err:          err,
+	}
+}
+
+func (r *Result) SetResponseHeaders(headers netHTTP.Header) *Result {
+	r.ResponseHeaders = map[string]string{}
+	for key := range headers {
+		r.ResponseHeaders[key] = headers.Get(key)
+	}
+
+	return r
+}
+
+func (r *Result) IsSuccess() bool {
+	return r.Err == nil && r.StatusCode >= 200 && r.StatusCode < 300
+}
+
+func (r *Result) GetErrorMessage() string {
+	if r.Err != nil {
+		return r.Err.Error()
+	}
+
+	if !r.IsSuccess() {
+		return fmt.Sprintf("unexpected status code %d", r.StatusCode)
+	}
+
+	return ""
+}
+
+func truncate(value string) string {
+	runes := []rune(value)
+	if len(runes) > enums.ResponseBodyMaxLength {
+		return string(runes[:enums.ResponseBodyMaxLength])
+	}
+
+	return value
+}
+
+type Attempt struct {
+	AttemptID    uuid.UUID  `json:"attemptID" gorm:"primary_key"`
+	DeliveryID   uuid.UUID  `json:"deliveryID"`
+	Number       int        `json:"number" example:"1"`
+	StatusCode   int        `json:"statusCode" example:"200"`
+	Latency      int64      `json:"latency" example:"150"`
+	ResponseBody string     `json:"responseBody"`
+	Error        string     `json:"error"`
+	NextRetryAt  *time.Time `json:"nextRetryAt" example:"2021-12-30T23:59:59Z"`
+	CreatedAt    time.Time  `json:"createdAt" example:"2021-12-30T23:59:59Z"`
+}
+
+func (a *Attempt) GetTable() string {
+	return "webhook_delivery_attempts"
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delivery
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+func TestNewResult(t *testing.T) {
+	t.Run("Should truncate response body", func(t *testing.T) {
+		body := strings.Repeat("á", enums.ResponseBodyMaxLength+10)
+		result := NewResult(time.Now(), http.StatusOK, []byte(body), nil)
+		assert.Equal(t, enums.ResponseBodyMaxLength, len([]rune(result.ResponseBody)))
+	})
+	t.Run("Should keep response body when lower than max length", func(t *testing.T) {
+		result := NewResult(time.Now(), http.StatusOK, []byte("ok"), nil)
+		assert.Equal(t, "ok", result.ResponseBody)
+	})
+}
+
+func TestResult_GetErrorMessage(t *testing.T) {
+	t.Run("Should return error message from error", func(t *testing.T) {
+		result := &Result{Err: errors.New("unexpected error")}
+		assert.False(t, result.IsSuccess())
+		assert.Equal(t, "unexpected error", result.GetErrorMessage())
+	})
+	t.Run("Should return error message from status code", func(t *testing.T) {
+		result := &Result{StatusCode: http.StatusInternalServerError}
+		assert.Equal(t, "unexpected status code 500", result.GetErrorMessage())
+	})
+	t.Run("Should return empty error message when success", func(t *testing.T) {
+		result := &Result{StatusCode: http.StatusNoContent}
+		assert.True(t, result.IsSuccess())
+		assert.Empty(t, result.GetErrorMessage())
+	})
+}
+
+func TestResult_SetResponseHeaders(t *testing.T) {
+	t.Run("Should set first value of each response header", func(t *testing.T) {
+		headers := http.Header{}
+		headers.Add("X-Request-Id", "first")
+		headers.Add("X-Request-Id", "second")
+		headers.Set("Content-Type", "text/plain")
+
+		result := NewResult(time.Now(), http.StatusOK, nil, nil).SetResponseHeaders(headers)
+		assert.Equal(t, map[string]string{"X-Request-Id": "first", "Content-Type": "text/plain"},
+			result.ResponseHeaders)
+	})
+}
+
+func TestAttempt_GetTable(t *testing.T) {
+	assert.Equal(t, "webhook_delivery_attempts", (&Attempt{}).GetTable())
+}
@@ -0,0 +1,121 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delivery
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+type Delivery struct {
+	DeliveryID  uuid.UUID            `json:"deliveryID" gorm:"primary_key"`
+	WebhookID   uuid.UUID            `json:"webhookID"`
+	EventKey    *string              `json:"-"`
+	EventType   enums.EventType      `json:"eventType" example:"new-analysis"`
+	Payload     PayloadType          `json:"-"`
+	Status      enums.DeliveryStatus `json:"status" example:"pending" enums:"pending, success, failed"`
+	Attempts    int                  `json:"attempts" example:"1"`
+	NextRetryAt *time.Time           `json:"nextRetryAt" example:"2021-12-30T23:59:59Z"`
+	CreatedAt   time.Time            `json:"createdAt" example:"2021-12-30T23:59:59Z"`
+	UpdatedAt   time.Time            `json:"updatedAt" example:"2021-12-30T23:59:59Z"`
+	History     []Attempt            `json:"history" gorm:"-"`
+}
+
+// NewDelivery leases the first attempt as the retry job does, so when the first attempt could not be registered
+// the saved delivery is still sent again by the retry job after the lease time
+func NewDelivery(webhookID uuid.UUID, eventType enums.EventType, payload []byte) *Delivery {
+	now := time.Now()
+	leaseUntil := now.Add(enums.DeliveryRetryLeaseTime)
+
+	return &Delivery{
+		DeliveryID:  uuid.New(),
+		WebhookID:   webhookID,
+		EventType:   eventType,
+		Payload:     payload,
+		Status:      enums.DeliveryPending,
+		NextRetryAt: &leaseUntil,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// SetEventKey identifies the event delivered, only one delivery per webhook is saved for the same event key
+func (d *Delivery) SetEventKey(eventKey string) *Delivery {
+	d.EventKey = &eventKey
+	return d
+}
+
+func (d *Delivery) GetTable() string {
+	return "webhook_deliveries"
+}
+
+func (d *Delivery) RegisterAttempt(result *Result, maxAttempts int) *Attempt {
+	d.Attempts++
+	d.UpdatedAt = time.Now()
+	d.setStatusAndNextRetry(result, maxAttempts)
+
+	return &Attempt{
+		AttemptID:    uuid.New(),
+		DeliveryID:   d.DeliveryID,
+		Number:       d.Attempts,
+		StatusCode:   result.StatusCode,
+		Latency:      result.Latency.Milliseconds(),
+		ResponseBody: result.ResponseBody,
+		Error:        result.GetErrorMessage(),
+		NextRetryAt:  d.NextRetryAt,
+		CreatedAt:    d.UpdatedAt,
+	}
+}
+
+func (d *Delivery) setStatusAndNextRetry(result *Result, maxAttempts int) {
+	d.NextRetryAt = nil
+
+	switch {
+	case result.IsSuccess():
+		d.Status = enums.DeliverySuccess
+	case d.Attempts >= maxAttempts:
+		d.Status = enums.DeliveryFailed
+	default:
+		nextRetryAt := d.UpdatedAt.Add(d.getBackoff())
+		d.Status = enums.DeliveryPending
+		d.NextRetryAt = &nextRetryAt
+	}
+}
+
+func (d *Delivery) getBackoff() time.Duration {
+	backoff := float64(enums.DeliveryBackoffBaseInterval) * math.Pow(2, float64(d.Attempts-1))
+	if backoff > float64(enums.DeliveryBackoffMaxInterval) {
+		return enums.DeliveryBackoffMaxInterval
+	}
+
+	return time.Duration(backoff)
+}
+
+func (d *Delivery) IsFailed() bool {
+	return d.Status == enums.DeliveryFailed
+}
+
+func (d *Delivery) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"status":        d.Status,
+		"attempts":      d.Attempts,
+		"next_retry_at": d.NextRetryAt,
+		"updated_at":    d.UpdatedAt,
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delivery
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+func TestNewDelivery(t *testing.T) {
+	t.Run("Should create a pending delivery", func(t *testing.T) {
+		entity := NewDelivery(uuid.New(), enums.EventNewAnalysis, []byte("{}"))
+		assert.NotEqual(t, uuid.Nil, entity.DeliveryID)
+		assert.Equal(t, enums.DeliveryPending, entity.Status)
+		assert.Equal(t, "webhook_deliveries", entity.GetTable())
+		assert.Equal(t, enums.DeliveryRetryLeaseTime, entity.NextRetryAt.Sub(entity.CreatedAt))
+	})
+}
+
+func TestDelivery_SetEventKey(t *testing.T) {
+	t.Run("Should set the event key of the delivery", func(t *testing.T) {
+		entity := NewDelivery(uuid.New(), enums.EventNewAnalysis, []byte("{}")).SetEventKey("test")
+		assert.Equal(t, "test", *entity.EventKey)
+	})
+}
+
+func TestDelivery_RegisterAttempt(t *testing.T) {
+	t.Run("Should set status success when attempt succeed", func(t *testing.T) {
+		entity := NewDelivery(uuid.New(), enums.EventNewAnalysis, []byte("{}"))
+		attempt := entity.RegisterAttempt(NewResult(time.Now(), http.StatusOK, []byte("ok"), nil), 5)
+		assert.Equal(t, enums.DeliverySuccess, entity.Status)
+		assert.Nil(t, entity.NextRetryAt)
+		assert.Equal(t, 1, attempt.Number)
+		assert.Equal(t, entity.DeliveryID, attempt.DeliveryID)
+		assert.Empty(t, attempt.Error)
+	})
+	t.Run("Should schedule retry with exponential backoff when attempt fails", func(t *testing.T) {
+		entity := NewDelivery(uuid.New(), enums.EventNewAnalysis, []byte("{}"))
+		entity.RegisterAttempt(NewResult(time.Now(), http.StatusInternalServerError, nil, nil), 5)
+		assert.Equal(t, enums.DeliveryPending, entity.Status)
+		assert.Equal(t, enums.DeliveryBackoffBaseInterval, entity.NextRetryAt.Sub(entity.UpdatedAt))
+
+		attempt := entity.RegisterAttempt(NewResult(time.Now(), 0, nil, errors.New("timeout")), 5)
+		assert.Equal(t, 2*enums.DeliveryBackoffBaseInterval, entity.NextRetryAt.Sub(entity.UpdatedAt))
+		assert.Equal(t, "timeout", attempt.Error)
+		assert.Equal(t, entity.NextRetryAt, attempt.NextRetryAt)
+	})
+	t.Run("Should limit backoff to max interval", func(t *testing.T) {
+		entity := &Delivery{Attempts: 15}
+		entity.RegisterAttempt(NewResult(time.Now(), http.StatusBadGateway, nil, nil), enums.MaxAllowedAttempts)
+		assert.Equal(t, enums.DeliveryBackoffMaxInterval, entity.NextRetryAt.Sub(entity.UpdatedAt))
+	})
+	t.Run("Should set status failed when exceed max attempts", func(t *testing.T) {
+		entity := &Delivery{Attempts: 4}
+		entity.RegisterAttempt(NewResult(time.Now(), http.StatusNotFound, nil, nil), 5)
+		assert.True(t, entity.IsFailed())
+		assert.Nil(t, entity.NextRetryAt)
+	})
+}
+
+func TestDelivery_ToUpdateMap(t *testing.T) {
+	t.Run("Should return map with the mutable fields", func(t *testing.T) {
+		entity := &Delivery{Status: enums.DeliveryFailed, Attempts: 3}
+		updateMap := entity.ToUpdateMap()
+		assert.Equal(t, enums.DeliveryFailed, updateMap["status"])
+		assert.Equal(t, 3, updateMap["attempts"])
+	})
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delivery
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// PayloadType is the rendered body of the delivery, it is not always a json since webhooks can use custom templates
+type PayloadType []byte
+
+func (p PayloadType) Value() (driver.Value, error) {
+	return string(p), nil
+}
+
+func (p *PayloadType) Scan(value interface{}) error {
+	switch data := value.(type) {
+	case []byte:
+		*p = append((*p)[0:0], data...)
+	case string:
+		*p = PayloadType(data)
+	default:
+		return fmt.Errorf("[]byte assertion failed")
+	}
+
+	return nil
+}
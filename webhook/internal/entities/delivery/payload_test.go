@@ -0,0 +1,46 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayloadType_Value(t *testing.T) {
+	t.Run("Should return payload as string", func(t *testing.T) {
+		value, err := PayloadType(`{"id":"1"}`).Value()
+		assert.NoError(t, err)
+		assert.Equal(t, `{"id":"1"}`, value)
+	})
+}
+
+func TestPayloadType_Scan(t *testing.T) {
+	t.Run("Should scan payload from bytes", func(t *testing.T) {
+		payload := PayloadType{}
+		assert.NoError(t, payload.Scan([]byte(`{"id":"1"}`)))
+		assert.Equal(t, `{"id":"1"}`, string(payload))
+	})
+	t.Run("Should scan payload from string", func(t *testing.T) {
+		payload := PayloadType{}
+		assert.NoError(t, payload.Scan(`{"id":"1"}`))
+		assert.Equal(t, `{"id":"1"}`, string(payload))
+	})
+	t.Run("Should return error when scan invalid type", func(t *testing.T) {
+		payload := PayloadType{}
+		assert.Error(t, payload.Scan(1))
+	})
+}
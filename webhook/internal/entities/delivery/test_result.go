@@ -0,0 +1,35 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delivery
+
+type TestResult struct {
+	Success         bool              `json:"success" example:"true"`
+	StatusCode      int               `json:"statusCode" example:"200"`
+	ResponseHeaders map[string]string `json:"responseHeaders"`
+	ResponseBody    string            `json:"responseBody"`
+	Latency         int64             `json:"latency" example:"150"`
+	Error           string            `json:"error"`
+}
+
+func (r *Result) ToTestResult() *TestResult {
+	return &TestResult{
+		Success:         r.IsSuccess(),
+		StatusCode:      r.StatusCode,
+		ResponseHeaders: r.ResponseHeaders,
+		ResponseBody:    r.ResponseBody,
+		Latency:         r.Latency.Milliseconds(),
+		Error:           r.GetErrorMessage(),
+	}
+}
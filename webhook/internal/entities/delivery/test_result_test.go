@@ -0,0 +1,42 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delivery
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResult_ToTestResult(t *testing.T) {
+	t.Run("Should parse success result to test result", func(t *testing.T) {
+		result := NewResult(time.Now(), http.StatusOK, []byte("ok"), nil).
+			SetResponseHeaders(http.Header{"X-Request-Id": {"test"}}).ToTestResult()
+		assert.True(t, result.Success)
+		assert.Equal(t, http.StatusOK, result.StatusCode)
+		assert.Equal(t, "ok", result.ResponseBody)
+		assert.Equal(t, "test", result.ResponseHeaders["X-Request-Id"])
+		assert.Empty(t, result.Error)
+	})
+	t.Run("Should parse failed result to test result", func(t *testing.T) {
+		result := NewResult(time.Now(), 0, nil, errors.New("connection refused")).ToTestResult()
+		assert.False(t, result.Success)
+		assert.Equal(t, "connection refused", result.Error)
+		assert.Nil(t, result.ResponseHeaders)
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/google/uuid"
+)
+
+type Group struct {
+	Total      int            `json:"total" example:"3"`
+	Severities map[string]int `json:"severities"`
+}
+
+type Diff struct {
+	BaseAnalysisID *uuid.UUID `json:"baseAnalysisID,omitempty" example:"00000000-0000-0000-0000-000000000000"`
+	New            *Group     `json:"new"`
+	Fixed          *Group     `json:"fixed"`
+	Unchanged      *Group     `json:"unchanged"`
+	baseHashes     map[string]bool
+}
+
+// NewDiff compares the vulnerabilities of the analysis with the ones of the base analysis by vulnerability hash,
+// counting the new, fixed and unchanged ones by severity. As on the summary, only the vulnerabilities not classified
+// as false positive, risk accepted or corrected are counted. When there is no base analysis all findings are new
+func NewDiff(base, head *analysis.Analysis) *Diff {
+	diff := &Diff{New: newGroup(), Fixed: newGroup(), Unchanged: newGroup(), baseHashes: map[string]bool{}}
+	baseVulnerabilities := map[string]vulnerability.Vulnerability{}
+	if base != nil {
+		diff.BaseAnalysisID = &base.ID
+		baseVulnerabilities = mapVulnerabilitiesByHash(base)
+	}
+
+	headVulnerabilities := mapVulnerabilitiesByHash(head)
+	for hash := range headVulnerabilities {
+		if _, ok := baseVulnerabilities[hash]; ok {
+			diff.Unchanged.add(headVulnerabilities[hash])
+			continue
+		}
+
+		diff.New.add(headVulnerabilities[hash])
+	}
+
+	for hash := range baseVulnerabilities {
+		diff.baseHashes[hash] = true
+		if _, ok := headVulnerabilities[hash]; !ok {
+			diff.Fixed.add(baseVulnerabilities[hash])
+		}
+	}
+
+	return diff
+}
+
+func newGroup() *Group {
+	group := &Group{Severities: map[string]int{}}
+	for _, severity := range severities.Values() {
+		group.Severities[severity.ToString()] = 0
+	}
+
+	return group
+}
+
+func mapVulnerabilitiesByHash(entity *analysis.Analysis) map[string]vulnerability.Vulnerability {
+	vulnerabilities := map[string]vulnerability.Vulnerability{}
+	for index := range entity.AnalysisVulnerabilities {
+		vuln := entity.AnalysisVulnerabilities[index].Vulnerability
+		if _, ok := vulnerabilities[vuln.VulnHash]; !ok {
+			vulnerabilities[vuln.VulnHash] = vuln
+		}
+	}
+
+	return vulnerabilities
+}
+
+func (g *Group) add(vuln vulnerability.Vulnerability) {
+	if vuln.Type != vulnerabilityEnums.Vulnerability {
+		return
+	}
+
+	g.Total++
+	g.Severities[vuln.Severity.ToString()]++
+}
+
+// IsNew informs if the vulnerability hash was not found on the base analysis
+func (d *Diff) IsNew(vulnHash string) bool {
+	return !d.baseHashes[vulnHash]
+}
+
+// String describes the counts by severity from the most to the least severe, like "3 HIGH, 1 LOW"
+func (g *Group) String() string {
+	var counts []string
+	for _, severity := range severities.Values() {
+		if count := g.Severities[severity.ToString()]; count > 0 {
+			counts = append(counts, fmt.Sprintf("%d %s", count, severity.ToString()))
+		}
+	}
+
+	return strings.Join(counts, ", ")
+}
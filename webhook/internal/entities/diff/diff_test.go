@@ -0,0 +1,94 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalysis(vulnerabilities ...vulnerability.Vulnerability) *analysis.Analysis {
+	entity := &analysis.Analysis{ID: uuid.New()}
+	for index := range vulnerabilities {
+		entity.AnalysisVulnerabilities = append(entity.AnalysisVulnerabilities,
+			analysis.AnalysisVulnerabilities{Vulnerability: vulnerabilities[index]})
+	}
+
+	return entity
+}
+
+func newVulnerability(hash string, severity severities.Severity) vulnerability.Vulnerability {
+	return vulnerability.Vulnerability{VulnHash: hash, Severity: severity, Type: vulnerabilityEnums.Vulnerability}
+}
+
+func TestNewDiff(t *testing.T) {
+	t.Run("Should count new, fixed and unchanged vulnerabilities by severity", func(t *testing.T) {
+		base := newAnalysis(newVulnerability("fixed", severities.Low), newVulnerability("unchanged", severities.Info))
+		head := newAnalysis(newVulnerability("unchanged", severities.Info), newVulnerability("new1", severities.High),
+			newVulnerability("new2", severities.High), newVulnerability("new2", severities.High),
+			newVulnerability("new3", severities.Critical))
+
+		diff := NewDiff(base, head)
+
+		assert.Equal(t, base.ID, *diff.BaseAnalysisID)
+		assert.Equal(t, 3, diff.New.Total)
+		assert.Equal(t, 2, diff.New.Severities[severities.High.ToString()])
+		assert.Equal(t, 1, diff.New.Severities[severities.Critical.ToString()])
+		assert.Equal(t, 1, diff.Fixed.Total)
+		assert.Equal(t, 1, diff.Fixed.Severities[severities.Low.ToString()])
+		assert.Equal(t, 1, diff.Unchanged.Total)
+		assert.True(t, diff.IsNew("new1"))
+		assert.False(t, diff.IsNew("unchanged"))
+		assert.False(t, diff.IsNew("fixed"))
+	})
+
+	t.Run("Should consider all vulnerabilities as new without base analysis", func(t *testing.T) {
+		diff := NewDiff(nil, newAnalysis(newVulnerability("new", severities.Medium)))
+
+		assert.Nil(t, diff.BaseAnalysisID)
+		assert.Equal(t, 1, diff.New.Total)
+		assert.Equal(t, 0, diff.Fixed.Total)
+		assert.True(t, diff.IsNew("new"))
+	})
+}
+
+func TestNewDiffClassifiedVulnerabilities(t *testing.T) {
+	t.Run("Should not count vulnerabilities classified as false positive, risk accepted or corrected", func(t *testing.T) {
+		falsePositive := newVulnerability("false-positive", severities.High)
+		falsePositive.Type = vulnerabilityEnums.FalsePositive
+
+		diff := NewDiff(nil, newAnalysis(falsePositive, newVulnerability("new", severities.Low)))
+
+		assert.Equal(t, 1, diff.New.Total)
+		assert.Equal(t, 0, diff.New.Severities[severities.High.ToString()])
+		assert.True(t, diff.IsNew("false-positive"))
+	})
+}
+
+func TestGroupString(t *testing.T) {
+	t.Run("Should describe the counts from the most to the least severe", func(t *testing.T) {
+		diff := NewDiff(nil, newAnalysis(newVulnerability("1", severities.Low), newVulnerability("2", severities.High),
+			newVulnerability("3", severities.High)))
+
+		assert.Equal(t, "2 HIGH, 1 LOW", diff.New.String())
+		assert.Equal(t, "", diff.Fixed.String())
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/diff"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+type Event struct {
+	Type         enums.EventType `json:"type"`
+	WorkspaceID  uuid.UUID       `json:"workspaceID"`
+	RepositoryID uuid.UUID       `json:"repositoryID"`
+	Payload      json.RawMessage `json:"payload"`
+	Diff         *diff.Diff      `json:"-"`
+	Key          string          `json:"-"`
+}
+
+func NewEventFromAnalysis(entity *analysis.Analysis) *Event {
+	return &Event{
+		Type:         enums.EventNewAnalysis,
+		WorkspaceID:  entity.WorkspaceID,
+		RepositoryID: entity.RepositoryID,
+		Payload:      entity.ToBytes(),
+		Key:          entity.ID.String(),
+	}
+}
+
+func (e *Event) Validate() error {
+	return validation.ValidateStruct(e,
+		validation.Field(&e.Type, validation.Required, validation.In(enums.EventNewAnalysis,
+			enums.EventVulnerabilityStatusChanged, enums.EventRepositoryCreated, enums.EventTokenExpiring)),
+		validation.Field(&e.WorkspaceID, validation.Required, validation.NotIn(uuid.Nil.String())),
+	)
+}
+
+func (e *Event) ToBytes() []byte {
+	bytes, _ := json.Marshal(e)
+
+	return bytes
+}
+
+// GetKey identifies the event to keep its deliveries idempotent, when the same packet is consumed again.
+// Events without a key are identified by the hash of its content
+func (e *Event) GetKey() string {
+	if e.Key != "" {
+		return e.Type.ToString() + ":" + e.Key
+	}
+
+	hash := sha256.Sum256(e.ToBytes())
+	return e.Type.ToString() + ":" + hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,76 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+func TestNewEventFromAnalysis(t *testing.T) {
+	t.Run("Should create new analysis event with analysis as payload", func(t *testing.T) {
+		entity := &analysis.Analysis{WorkspaceID: uuid.New(), RepositoryID: uuid.New()}
+		event := NewEventFromAnalysis(entity)
+		assert.Equal(t, enums.EventNewAnalysis, event.Type)
+		assert.Equal(t, entity.WorkspaceID, event.WorkspaceID)
+		assert.Equal(t, entity.RepositoryID, event.RepositoryID)
+		assert.Equal(t, entity.ToBytes(), []byte(event.Payload))
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("Should return no error when event is valid", func(t *testing.T) {
+		event := &Event{Type: enums.EventRepositoryCreated, WorkspaceID: uuid.New()}
+		assert.NoError(t, event.Validate())
+	})
+	t.Run("Should return error when event type is invalid", func(t *testing.T) {
+		event := &Event{Type: "test", WorkspaceID: uuid.New()}
+		assert.Error(t, event.Validate())
+	})
+	t.Run("Should return error when workspace id is empty", func(t *testing.T) {
+		event := &Event{Type: enums.EventRepositoryCreated}
+		assert.Error(t, event.Validate())
+	})
+}
+
+func TestToBytes(t *testing.T) {
+	t.Run("Should parse event to bytes", func(t *testing.T) {
+		event := &Event{Type: enums.EventRepositoryCreated, WorkspaceID: uuid.New()}
+		assert.Contains(t, string(event.ToBytes()), `"type":"repository-created"`)
+	})
+}
+
+func TestGetKey(t *testing.T) {
+	t.Run("Should return analysis id as key of new analysis event", func(t *testing.T) {
+		entity := &analysis.Analysis{ID: uuid.New(), WorkspaceID: uuid.New()}
+		event := NewEventFromAnalysis(entity)
+		assert.Equal(t, "new-analysis:"+entity.ID.String(), event.GetKey())
+	})
+	t.Run("Should return same key for same event content", func(t *testing.T) {
+		event := &Event{Type: enums.EventRepositoryCreated, WorkspaceID: uuid.New(), Payload: []byte(`{"name":"a"}`)}
+		other := &Event{Type: event.Type, WorkspaceID: event.WorkspaceID, Payload: []byte(`{"name":"a"}`)}
+		assert.Equal(t, event.GetKey(), other.GetKey())
+	})
+	t.Run("Should return different key for different event content", func(t *testing.T) {
+		event := &Event{Type: enums.EventRepositoryCreated, WorkspaceID: uuid.New(), Payload: []byte(`{"name":"a"}`)}
+		other := &Event{Type: event.Type, WorkspaceID: event.WorkspaceID, Payload: []byte(`{"name":"b"}`)}
+		assert.NotEqual(t, event.GetKey(), other.GetKey())
+	})
+}
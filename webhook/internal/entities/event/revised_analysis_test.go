@@ -0,0 +1,38 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevisedAnalysis_IsRevision(t *testing.T) {
+	t.Run("Should return false when analysis is published for the first time", func(t *testing.T) {
+		entity := &RevisedAnalysis{}
+		assert.NoError(t, json.Unmarshal((&analysis.Analysis{ID: uuid.New()}).ToBytes(), entity))
+		assert.False(t, entity.IsRevision())
+		assert.NotEqual(t, uuid.Nil, entity.ID)
+	})
+	t.Run("Should return true when analysis is published again", func(t *testing.T) {
+		entity := &RevisedAnalysis{}
+		assert.NoError(t, json.Unmarshal([]byte(`{"id":"`+uuid.NewString()+`","revision":2}`), entity))
+		assert.True(t, entity.IsRevision())
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+func TestNewSampleEvent(t *testing.T) {
+	t.Run("Should create sample analysis event of the repository", func(t *testing.T) {
+		workspaceID := uuid.New()
+		repositoryID := uuid.New()
+
+		event := NewSampleEvent(workspaceID, &repositoryID)
+		assert.NoError(t, event.Validate())
+		assert.Equal(t, enums.EventNewAnalysis, event.Type)
+		assert.Equal(t, repositoryID, event.RepositoryID)
+		assert.Equal(t, 1, event.Diff.New.Total)
+
+		entity := &analysis.Analysis{}
+		assert.NoError(t, json.Unmarshal(event.Payload, entity))
+		assert.Equal(t, workspaceID, entity.WorkspaceID)
+		assert.Equal(t, enums.SampleRepositoryName, entity.RepositoryName)
+		assert.Len(t, entity.AnalysisVulnerabilities, 1)
+	})
+	t.Run("Should generate repository id when webhook is not of a repository", func(t *testing.T) {
+		assert.NotEqual(t, uuid.Nil, NewSampleEvent(uuid.New(), nil).RepositoryID)
+
+		repositoryID := uuid.Nil
+		assert.NotEqual(t, uuid.Nil, NewSampleEvent(uuid.New(), &repositoryID).RepositoryID)
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package summary
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/diff"
+	eventEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/event"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+type Summary struct {
+	Event                enums.EventType    `json:"event" example:"new-analysis"`
+	WorkspaceID          uuid.UUID          `json:"workspaceID" example:"00000000-0000-0000-0000-000000000000"`
+	WorkspaceName        string             `json:"workspaceName,omitempty" example:"my-workspace"`
+	RepositoryID         uuid.UUID          `json:"repositoryID" example:"00000000-0000-0000-0000-000000000000"`
+	RepositoryName       string             `json:"repositoryName,omitempty" example:"my-repository"`
+	AnalysisID           *uuid.UUID         `json:"analysisID,omitempty" example:"00000000-0000-0000-0000-000000000000"`
+	Status               string             `json:"status,omitempty" example:"success"`
+	TotalVulnerabilities int                `json:"totalVulnerabilities" example:"10"`
+	Severities           map[string]int     `json:"severities"`
+	Diff                 *diff.Diff         `json:"diff,omitempty"`
+	Link                 string             `json:"link" example:"http://localhost:8043"`
+	Analysis             *analysis.Analysis `json:"-"`
+}
+
+// NewSummary creates a compact version of the event, the vulnerabilities are counted by severity only when the
+// event payload is an analysis, ignoring the ones already classified as false positive, risk accepted or corrected.
+// The diff with the previous analysis is included when it was calculated for the event
+func NewSummary(event *eventEntity.Event, managerURL string) (*Summary, error) {
+	summary := &Summary{
+		Event:        event.Type,
+		WorkspaceID:  event.WorkspaceID,
+		RepositoryID: event.RepositoryID,
+		Severities:   newSeveritiesCount(),
+		Diff:         event.Diff,
+	}
+
+	if event.Type == enums.EventNewAnalysis {
+		if err := summary.setAnalysis(event.Payload); err != nil {
+			return nil, err
+		}
+	}
+
+	return summary.setLink(managerURL), nil
+}
+
+func newSeveritiesCount() map[string]int {
+	count := map[string]int{}
+	for _, severity := range severities.Values() {
+		count[severity.ToString()] = 0
+	}
+
+	return count
+}
+
+func (s *Summary) setAnalysis(payload json.RawMessage) error {
+	entity := &analysis.Analysis{}
+	if err := json.Unmarshal(payload, entity); err != nil {
+		return err
+	}
+
+	s.Analysis = entity
+	s.AnalysisID = &entity.ID
+	s.WorkspaceName = entity.WorkspaceName
+	s.RepositoryID = entity.RepositoryID
+	s.RepositoryName = entity.RepositoryName
+	s.Status = string(entity.Status)
+	s.countVulnerabilities(entity.AnalysisVulnerabilities)
+	return nil
+}
+
+func (s *Summary) countVulnerabilities(vulnerabilities []analysis.AnalysisVulnerabilities) {
+	for index := range vulnerabilities {
+		if vulnerabilities[index].Vulnerability.Type != vulnerability.Vulnerability {
+			continue
+		}
+
+		s.TotalVulnerabilities++
+		s.Severities[vulnerabilities[index].Vulnerability.Severity.ToString()]++
+	}
+}
+
+func (s *Summary) setLink(managerURL string) *Summary {
+	if s.RepositoryID == uuid.Nil {
+		s.Link = fmt.Sprintf("%s/overview/workspace/%s/vulnerabilities", managerURL, s.WorkspaceID)
+		return s
+	}
+
+	s.Link = fmt.Sprintf("%s/overview/workspace/%s/repository/%s/vulnerabilities",
+		managerURL, s.WorkspaceID, s.RepositoryID)
+	return s
+}
+
+func (s *Summary) IsAnalysis() bool {
+	return s.Analysis != nil
+}
+
+func (s *Summary) GetTitle() string {
+	if !s.IsAnalysis() {
+		return fmt.Sprintf("Horusec event %s on workspace %s", s.Event, s.WorkspaceID)
+	}
+
+	title := fmt.Sprintf("Horusec analysis of repository %s finished with status %s: %d vulnerabilities found",
+		s.getRepositoryName(), s.Status, s.TotalVulnerabilities)
+	if s.HasNewFindings() {
+		title += fmt.Sprintf(", %d new (%s)", s.Diff.New.Total, s.Diff.New.String())
+	}
+
+	return title
+}
+
+func (s *Summary) HasNewFindings() bool {
+	return s.Diff != nil && s.Diff.New.Total > 0
+}
+
+func (s *Summary) getRepositoryName() string {
+	if s.RepositoryName != "" {
+		return s.RepositoryName
+	}
+
+	return s.RepositoryID.String()
+}
+
+func (s *Summary) HasHighRisk() bool {
+	return s.Severities[severities.Critical.ToString()] > 0 || s.Severities[severities.High.ToString()] > 0
+}
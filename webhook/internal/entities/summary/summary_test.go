@@ -0,0 +1,112 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package summary
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	vulnerabilityEntity "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	analysisEnums "github.com/ZupIT/horusec-devkit/pkg/enums/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/diff"
+	eventEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/event"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+func newAnalysis() *analysis.Analysis {
+	return &analysis.Analysis{
+		ID:             uuid.New(),
+		WorkspaceID:    uuid.New(),
+		RepositoryID:   uuid.New(),
+		RepositoryName: "my-repository",
+		Status:         analysisEnums.Success,
+		AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+			{Vulnerability: vulnerabilityEntity.Vulnerability{VulnHash: "critical", Severity: severities.Critical,
+				Type: vulnerability.Vulnerability}},
+			{Vulnerability: vulnerabilityEntity.Vulnerability{VulnHash: "low", Severity: severities.Low,
+				Type: vulnerability.Vulnerability}},
+			{Vulnerability: vulnerabilityEntity.Vulnerability{VulnHash: "high", Severity: severities.High,
+				Type: vulnerability.FalsePositive}},
+		},
+	}
+}
+
+func TestNewSummary(t *testing.T) {
+	t.Run("Should count vulnerabilities by severity of new analysis", func(t *testing.T) {
+		entity := newAnalysis()
+		summary, err := NewSummary(eventEntity.NewEventFromAnalysis(entity), "http://localhost:8043")
+		assert.NoError(t, err)
+		assert.True(t, summary.IsAnalysis())
+		assert.Equal(t, entity.ID, *summary.AnalysisID)
+		assert.Equal(t, 2, summary.TotalVulnerabilities)
+		assert.Equal(t, 1, summary.Severities[severities.Critical.ToString()])
+		assert.Equal(t, 0, summary.Severities[severities.High.ToString()])
+		assert.Equal(t, 1, summary.Severities[severities.Low.ToString()])
+		assert.True(t, summary.HasHighRisk())
+		assert.Equal(t, "http://localhost:8043/overview/workspace/"+entity.WorkspaceID.String()+
+			"/repository/"+entity.RepositoryID.String()+"/vulnerabilities", summary.Link)
+		assert.Contains(t, summary.GetTitle(), "my-repository")
+	})
+	t.Run("Should create summary of event without analysis", func(t *testing.T) {
+		event := &eventEntity.Event{Type: enums.EventTokenExpiring, WorkspaceID: uuid.New(), Payload: []byte("{}")}
+		summary, err := NewSummary(event, "http://localhost:8043")
+		assert.NoError(t, err)
+		assert.False(t, summary.IsAnalysis())
+		assert.False(t, summary.HasHighRisk())
+		assert.Nil(t, summary.AnalysisID)
+		assert.Equal(t, "http://localhost:8043/overview/workspace/"+event.WorkspaceID.String()+"/vulnerabilities",
+			summary.Link)
+		assert.Contains(t, summary.GetTitle(), enums.EventTokenExpiring.ToString())
+	})
+	t.Run("Should use repository id on title when repository name is empty", func(t *testing.T) {
+		entity := newAnalysis()
+		entity.RepositoryName = ""
+		summary, err := NewSummary(eventEntity.NewEventFromAnalysis(entity), "")
+		assert.NoError(t, err)
+		assert.Contains(t, summary.GetTitle(), entity.RepositoryID.String())
+	})
+	t.Run("Should include new findings of the diff on title", func(t *testing.T) {
+		entity := newAnalysis()
+		event := eventEntity.NewEventFromAnalysis(entity)
+		event.Diff = diff.NewDiff(nil, entity)
+
+		summary, err := NewSummary(event, "")
+		assert.NoError(t, err)
+		assert.True(t, summary.HasNewFindings())
+		assert.Equal(t, 2, summary.Diff.New.Total)
+		assert.True(t, strings.HasSuffix(summary.GetTitle(), "2 vulnerabilities found, 2 new (1 CRITICAL, 1 LOW)"))
+	})
+	t.Run("Should not include new findings on title when diff has no new findings", func(t *testing.T) {
+		entity := newAnalysis()
+		event := eventEntity.NewEventFromAnalysis(entity)
+		event.Diff = diff.NewDiff(entity, entity)
+
+		summary, err := NewSummary(event, "")
+		assert.NoError(t, err)
+		assert.False(t, summary.HasNewFindings())
+		assert.True(t, strings.HasSuffix(summary.GetTitle(), "2 vulnerabilities found"))
+	})
+	t.Run("Should return error when analysis payload is invalid", func(t *testing.T) {
+		event := &eventEntity.Event{Type: enums.EventNewAnalysis, WorkspaceID: uuid.New(), Payload: []byte("[]")}
+		_, err := NewSummary(event, "")
+		assert.Error(t, err)
+	})
+}
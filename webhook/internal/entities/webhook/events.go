@@ -0,0 +1,48 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+type EventsType []enums.EventType
+
+func (e EventsType) Value() (driver.Value, error) {
+	return json.Marshal(e)
+}
+
+func (e *EventsType) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("[]byte assertion failed")
+	}
+
+	return json.Unmarshal(b, e)
+}
+
+func (e EventsType) Contains(eventType enums.EventType) bool {
+	for _, event := range e {
+		if event == eventType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+func TestEventsType(t *testing.T) {
+	t.Run("Should get value with success", func(t *testing.T) {
+		expectedBytes, err := json.Marshal(EventsType{enums.EventNewAnalysis})
+		assert.NoError(t, err)
+		valueBytes, err := EventsType{enums.EventNewAnalysis}.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedBytes, valueBytes)
+	})
+	t.Run("Should scan value with error when data type is wrong", func(t *testing.T) {
+		events := &EventsType{}
+		assert.Error(t, events.Scan("wrong data type"))
+		assert.Empty(t, events)
+	})
+	t.Run("Should scan value with success", func(t *testing.T) {
+		events := &EventsType{}
+		assert.NoError(t, events.Scan([]byte(`["new-analysis","repository-created"]`)))
+		assert.Len(t, *events, 2)
+	})
+	t.Run("Should return if contains event type", func(t *testing.T) {
+		events := EventsType{enums.EventNewAnalysis, enums.EventRepositoryCreated}
+		assert.True(t, events.Contains(enums.EventRepositoryCreated))
+		assert.False(t, events.Contains(enums.EventTokenExpiring))
+	})
+}
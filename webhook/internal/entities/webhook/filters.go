@@ -0,0 +1,134 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	analysisEnums "github.com/ZupIT/horusec-devkit/pkg/enums/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/diff"
+)
+
+type Filters struct {
+	MinSeverity        severities.Severity  `json:"minSeverity" example:"HIGH" enums:"CRITICAL, HIGH, MEDIUM, LOW, UNKNOWN, INFO"`                              //nolint:lll // notations
+	VulnerabilityTypes []vulnerability.Type `json:"vulnerabilityTypes" example:"Vulnerability" enums:"Vulnerability, Risk Accepted, False Positive, Corrected"` //nolint:lll // notations
+	OnlyNewFindings    bool                 `json:"onlyNewFindings" example:"true"`
+	OnlyFailedAnalyses bool                 `json:"onlyFailedAnalyses" example:"false"`
+}
+
+func (f Filters) Value() (driver.Value, error) {
+	return json.Marshal(f)
+}
+
+func (f *Filters) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("[]byte assertion failed")
+	}
+
+	return json.Unmarshal(b, f)
+}
+
+func (f Filters) Validate() error {
+	return validation.ValidateStruct(&f,
+		validation.Field(&f.MinSeverity, validation.In(severities.Critical, severities.High, severities.Medium,
+			severities.Low, severities.Unknown, severities.Info)),
+		validation.Field(&f.VulnerabilityTypes, validation.Each(validation.In(vulnerability.Vulnerability,
+			vulnerability.RiskAccepted, vulnerability.FalsePositive, vulnerability.Corrected))),
+	)
+}
+
+// RequiresPreviousAnalysis informs if the vulnerabilities of the previous analysis of the same repository
+// are necessary to evaluate the filters
+func (f *Filters) RequiresPreviousAnalysis() bool {
+	return f.OnlyNewFindings
+}
+
+// Match checks if the analysis satisfies all the filters. When any vulnerability filter is set, at least one
+// vulnerability of the analysis must satisfy all of them. The diff with the previous analysis of the same
+// repository is used to ignore the findings that are not new
+func (f *Filters) Match(entity *analysis.Analysis, analysisDiff *diff.Diff) bool {
+	if f.OnlyFailedAnalyses && entity.Status != analysisEnums.Error {
+		return false
+	}
+
+	if !f.hasVulnerabilityFilters() {
+		return true
+	}
+
+	for index := range entity.AnalysisVulnerabilities {
+		if f.matchVulnerability(&entity.AnalysisVulnerabilities[index], analysisDiff) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *Filters) hasVulnerabilityFilters() bool {
+	return f.MinSeverity != "" || len(f.VulnerabilityTypes) > 0 || f.OnlyNewFindings
+}
+
+func (f *Filters) matchVulnerability(entity *analysis.AnalysisVulnerabilities, analysisDiff *diff.Diff) bool {
+	if f.OnlyNewFindings && analysisDiff != nil && !analysisDiff.IsNew(entity.Vulnerability.VulnHash) {
+		return false
+	}
+
+	return f.matchSeverity(entity.Vulnerability.Severity) && f.matchType(entity.Vulnerability.Type)
+}
+
+// matchSeverity uses the order of the severities values, from the most to the least severe
+func (f *Filters) matchSeverity(severity severities.Severity) bool {
+	if f.MinSeverity == "" {
+		return true
+	}
+
+	for _, value := range severities.Values() {
+		if value == severity {
+			return true
+		}
+
+		if value == f.MinSeverity {
+			return false
+		}
+	}
+
+	return false
+}
+
+func (f *Filters) matchType(vulnerabilityType vulnerability.Type) bool {
+	if len(f.VulnerabilityTypes) == 0 {
+		return true
+	}
+
+	for _, value := range f.VulnerabilityTypes {
+		if value == vulnerabilityType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,150 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	vulnerabilityEntity "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	analysisEnums "github.com/ZupIT/horusec-devkit/pkg/enums/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/diff"
+)
+
+func newAnalysisVulnerability(hash string, severity severities.Severity,
+	vulnerabilityType vulnerability.Type) analysis.AnalysisVulnerabilities {
+	return analysis.AnalysisVulnerabilities{
+		Vulnerability: vulnerabilityEntity.Vulnerability{VulnHash: hash, Severity: severity, Type: vulnerabilityType},
+	}
+}
+
+func newAnalysisFixture(status analysisEnums.Status,
+	vulnerabilities ...analysis.AnalysisVulnerabilities) *analysis.Analysis {
+	return &analysis.Analysis{Status: status, AnalysisVulnerabilities: vulnerabilities}
+}
+
+func TestFilters_Value(t *testing.T) {
+	t.Run("Should parse filters to json", func(t *testing.T) {
+		value, err := Filters{MinSeverity: severities.High}.Value()
+		assert.NoError(t, err)
+		assert.Contains(t, string(value.([]byte)), `"minSeverity":"HIGH"`)
+	})
+}
+
+func TestFilters_Scan(t *testing.T) {
+	t.Run("Should scan filters from json", func(t *testing.T) {
+		filters := &Filters{}
+		assert.NoError(t, filters.Scan([]byte(`{"minSeverity":"HIGH","onlyNewFindings":true}`)))
+		assert.Equal(t, &Filters{MinSeverity: severities.High, OnlyNewFindings: true}, filters)
+	})
+	t.Run("Should keep empty filters when value is null", func(t *testing.T) {
+		filters := &Filters{}
+		assert.NoError(t, filters.Scan(nil))
+		assert.Equal(t, &Filters{}, filters)
+	})
+	t.Run("Should return error when value is not bytes", func(t *testing.T) {
+		assert.Error(t, (&Filters{}).Scan("test"))
+	})
+}
+
+func TestFilters_Validate(t *testing.T) {
+	t.Run("Should not return error when filters are valid", func(t *testing.T) {
+		assert.NoError(t, Filters{}.Validate())
+		assert.NoError(t, Filters{MinSeverity: severities.Low,
+			VulnerabilityTypes: []vulnerability.Type{vulnerability.Vulnerability}}.Validate())
+	})
+	t.Run("Should return error when min severity is invalid", func(t *testing.T) {
+		assert.Error(t, Filters{MinSeverity: "test"}.Validate())
+	})
+	t.Run("Should return error when vulnerability type is invalid", func(t *testing.T) {
+		assert.Error(t, Filters{VulnerabilityTypes: []vulnerability.Type{"test"}}.Validate())
+	})
+}
+
+func TestFilters_RequiresPreviousAnalysis(t *testing.T) {
+	t.Run("Should require previous analysis only when filtering new findings", func(t *testing.T) {
+		assert.True(t, (&Filters{OnlyNewFindings: true}).RequiresPreviousAnalysis())
+		assert.False(t, (&Filters{MinSeverity: severities.High}).RequiresPreviousAnalysis())
+	})
+}
+
+func TestFilters_Match(t *testing.T) {
+	critical := newAnalysisVulnerability("critical", severities.Critical, vulnerability.Vulnerability)
+	high := newAnalysisVulnerability("high", severities.High, vulnerability.Vulnerability)
+	medium := newAnalysisVulnerability("medium", severities.Medium, vulnerability.Vulnerability)
+	highFalsePositive := newAnalysisVulnerability("high-fp", severities.High, vulnerability.FalsePositive)
+	info := newAnalysisVulnerability("info", severities.Info, vulnerability.Vulnerability)
+
+	t.Run("Should match any analysis when filters are empty", func(t *testing.T) {
+		assert.True(t, (&Filters{}).Match(newAnalysisFixture(analysisEnums.Success), nil))
+		assert.True(t, (&Filters{}).Match(newAnalysisFixture(analysisEnums.Error, medium), nil))
+	})
+	t.Run("Should match when analysis has vulnerability with min severity or above", func(t *testing.T) {
+		filters := &Filters{MinSeverity: severities.High}
+		assert.True(t, filters.Match(newAnalysisFixture(analysisEnums.Success, medium, high), nil))
+		assert.True(t, filters.Match(newAnalysisFixture(analysisEnums.Success, critical), nil))
+	})
+	t.Run("Should not match when all vulnerabilities are below min severity", func(t *testing.T) {
+		filters := &Filters{MinSeverity: severities.High}
+		assert.False(t, filters.Match(newAnalysisFixture(analysisEnums.Success, medium, info), nil))
+		assert.False(t, filters.Match(newAnalysisFixture(analysisEnums.Success), nil))
+	})
+	t.Run("Should match only vulnerabilities of the included types", func(t *testing.T) {
+		filters := &Filters{VulnerabilityTypes: []vulnerability.Type{vulnerability.Vulnerability}}
+		assert.False(t, filters.Match(newAnalysisFixture(analysisEnums.Success, highFalsePositive), nil))
+		assert.True(t, filters.Match(newAnalysisFixture(analysisEnums.Success, highFalsePositive, info), nil))
+	})
+	t.Run("Should require severity and type on the same vulnerability", func(t *testing.T) {
+		filters := &Filters{MinSeverity: severities.High,
+			VulnerabilityTypes: []vulnerability.Type{vulnerability.Vulnerability}}
+		assert.False(t, filters.Match(newAnalysisFixture(analysisEnums.Success, highFalsePositive, medium), nil))
+		assert.True(t, filters.Match(newAnalysisFixture(analysisEnums.Success, highFalsePositive, critical), nil))
+	})
+	t.Run("Should match only findings not present on previous analysis", func(t *testing.T) {
+		filters := &Filters{OnlyNewFindings: true, MinSeverity: severities.High,
+			VulnerabilityTypes: []vulnerability.Type{vulnerability.Vulnerability}}
+		previous := newAnalysisFixture(analysisEnums.Success, critical, high)
+
+		entity := newAnalysisFixture(analysisEnums.Success, critical, high, medium)
+		assert.False(t, filters.Match(entity, diff.NewDiff(previous, entity)))
+
+		entity = newAnalysisFixture(analysisEnums.Success, critical, high)
+		assert.True(t, filters.Match(entity, diff.NewDiff(newAnalysisFixture(analysisEnums.Success, critical), entity)))
+	})
+	t.Run("Should match all findings as new when there is no previous analysis", func(t *testing.T) {
+		filters := &Filters{OnlyNewFindings: true}
+
+		entity := newAnalysisFixture(analysisEnums.Success, info)
+		assert.True(t, filters.Match(entity, diff.NewDiff(nil, entity)))
+
+		entity = newAnalysisFixture(analysisEnums.Success)
+		assert.False(t, filters.Match(entity, diff.NewDiff(nil, entity)))
+	})
+	t.Run("Should match only failed analyses", func(t *testing.T) {
+		filters := &Filters{OnlyFailedAnalyses: true}
+		assert.True(t, filters.Match(newAnalysisFixture(analysisEnums.Error), nil))
+		assert.False(t, filters.Match(newAnalysisFixture(analysisEnums.Success, critical), nil))
+		assert.False(t, filters.Match(newAnalysisFixture(analysisEnums.Running), nil))
+	})
+	t.Run("Should combine failed analyses with vulnerability filters", func(t *testing.T) {
+		filters := &Filters{OnlyFailedAnalyses: true, MinSeverity: severities.Critical}
+		assert.True(t, filters.Match(newAnalysisFixture(analysisEnums.Error, critical), nil))
+		assert.False(t, filters.Match(newAnalysisFixture(analysisEnums.Error, high), nil))
+	})
+}
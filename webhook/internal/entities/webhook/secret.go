@@ -0,0 +1,41 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+type Secret struct {
+	WebhookID               uuid.UUID  `json:"webhookID" example:"00000000-0000-0000-0000-000000000000"`
+	Secret                  string     `json:"secret"`
+	PreviousSecretExpiresAt *time.Time `json:"previousSecretExpiresAt,omitempty" example:"2021-12-30T23:59:59Z"`
+}
+
+type RotateSecret struct {
+	GracePeriodHours *int `json:"gracePeriodHours" example:"24"`
+}
+
+func (r *RotateSecret) GetGracePeriod() time.Duration {
+	if r.GracePeriodHours == nil {
+		return enums.DefaultGracePeriodHours * time.Hour
+	}
+
+	return time.Duration(*r.GracePeriodHours) * time.Hour
+}
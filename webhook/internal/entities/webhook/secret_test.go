@@ -0,0 +1,34 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+func TestRotateSecret_GetGracePeriod(t *testing.T) {
+	t.Run("Should return default grace period when not informed", func(t *testing.T) {
+		assert.Equal(t, enums.DefaultGracePeriodHours*time.Hour, (&RotateSecret{}).GetGracePeriod())
+	})
+	t.Run("Should return informed grace period", func(t *testing.T) {
+		hours := 0
+		assert.Equal(t, time.Duration(0), (&RotateSecret{GracePeriodHours: &hours}).GetGracePeriod())
+	})
+}
@@ -18,18 +18,29 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
 )
 
 type Webhook struct {
-	WebhookID    uuid.UUID  `json:"webhookID" gorm:"primary_key"`
-	Description  string     `json:"description"`
-	URL          string     `json:"url" example:"http://my-domain.io/api"`
-	Method       string     `json:"method" example:"POST" enums:"POST"`
-	Headers      HeaderType `json:"headers"`
-	RepositoryID uuid.UUID  `json:"repositoryID" example:"00000000-0000-0000-0000-000000000000"`
-	WorkspaceID  uuid.UUID  `json:"workspaceID" example:"00000000-0000-0000-0000-000000000000"`
-	CreatedAt    time.Time  `json:"createdAt" example:"2021-12-30T23:59:59Z"`
-	UpdatedAt    time.Time  `json:"updatedAt" example:"2021-12-30T23:59:59Z"`
+	WebhookID    uuid.UUID           `json:"webhookID" gorm:"primary_key"`
+	Description  string              `json:"description"`
+	URL          string              `json:"url" example:"http://my-domain.io/api"`
+	Method       string              `json:"method" example:"POST" enums:"POST"`
+	Headers      HeaderType          `json:"headers"`
+	Events       EventsType          `json:"events" example:"new-analysis" enums:"new-analysis, vulnerability-status-changed, repository-created, token-expiring"` //nolint:lll // notations
+	MaxAttempts  int                 `json:"maxAttempts" example:"5"`
+	Format       enums.PayloadFormat `json:"format" example:"full" enums:"full, summary, slack, teams, template"`
+	Template     string              `json:"template" example:"{\"text\": {{ json .Title }}}"`
+	Filters      Filters             `json:"filters"`
+	RepositoryID *uuid.UUID          `json:"repositoryID" example:"00000000-0000-0000-0000-000000000000"`
+	WorkspaceID  uuid.UUID           `json:"workspaceID" example:"00000000-0000-0000-0000-000000000000"`
+	CreatedAt    time.Time           `json:"createdAt" example:"2021-12-30T23:59:59Z"`
+	UpdatedAt    time.Time           `json:"updatedAt" example:"2021-12-30T23:59:59Z"`
+
+	Secret                  string     `json:"-"`
+	PreviousSecret          string     `json:"-"`
+	PreviousSecretExpiresAt *time.Time `json:"-"`
 }
 
 type WithRepository struct {
@@ -41,6 +52,36 @@ func (w *Webhook) GetTable() string {
 	return "webhooks"
 }
 
+func (w *Webhook) SetDefaultValues() *Webhook {
+	if len(w.Events) == 0 {
+		w.Events = EventsType{enums.EventNewAnalysis}
+	}
+
+	if w.Format == "" {
+		w.Format = enums.FormatFull
+	}
+
+	if w.MaxAttempts == 0 {
+		w.MaxAttempts = enums.DefaultMaxAttempts
+	}
+
+	if w.IsWorkspaceWebhook() {
+		w.RepositoryID = nil
+	}
+
+	return w
+}
+
+func (w *Webhook) IsWorkspaceWebhook() bool {
+	return w.RepositoryID == nil || *w.RepositoryID == uuid.Nil
+}
+
+// RequiresDiff informs if the diff with the previous analysis is used by the filters or by the payload format,
+// the full format sends the analysis untouched so the diff is only used by the other ones
+func (w *Webhook) RequiresDiff() bool {
+	return w.Filters.RequiresPreviousAnalysis() || (w.Format != "" && w.Format != enums.FormatFull)
+}
+
 func (w *Webhook) GenerateID() *Webhook {
 	w.WebhookID = uuid.New()
 	return w
@@ -55,3 +96,66 @@ func (w *Webhook) GenerateUpdatedAt() *Webhook {
 	w.UpdatedAt = time.Now()
 	return w
 }
+
+// SetSecret replaces the encrypted signing secret, when a grace period is informed the current secret
+// still will be used to sign the payloads until the grace period expires
+func (w *Webhook) SetSecret(encryptedSecret string, gracePeriod time.Duration) *Webhook {
+	w.PreviousSecret = ""
+	w.PreviousSecretExpiresAt = nil
+
+	if w.Secret != "" && gracePeriod > 0 {
+		expiresAt := time.Now().Add(gracePeriod)
+		w.PreviousSecret = w.Secret
+		w.PreviousSecretExpiresAt = &expiresAt
+	}
+
+	w.Secret = encryptedSecret
+	return w
+}
+
+func (w *Webhook) GetValidSecrets() (secrets []string) {
+	if w.Secret != "" {
+		secrets = append(secrets, w.Secret)
+	}
+
+	if w.PreviousSecret != "" && w.PreviousSecretExpiresAt != nil && w.PreviousSecretExpiresAt.After(time.Now()) {
+		secrets = append(secrets, w.PreviousSecret)
+	}
+
+	return secrets
+}
+
+// ToUpdateMap returns every editable column, so the fields sent empty are cleared instead of ignored. The secrets
+// are only changed by the rotation
+func (w *Webhook) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"description":   w.Description,
+		"url":           w.URL,
+		"method":        w.Method,
+		"headers":       w.Headers,
+		"events":        w.Events,
+		"max_attempts":  w.MaxAttempts,
+		"format":        w.Format,
+		"template":      w.Template,
+		"filters":       w.Filters,
+		"repository_id": w.RepositoryID,
+		"updated_at":    w.UpdatedAt,
+	}
+}
+
+func (w *Webhook) ToSecretUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"secret":                     w.Secret,
+		"previous_secret":            w.PreviousSecret,
+		"previous_secret_expires_at": w.PreviousSecretExpiresAt,
+		"updated_at":                 w.UpdatedAt,
+	}
+}
+
+func (w *Webhook) ToSecret(secret string) *Secret {
+	return &Secret{
+		WebhookID:               w.WebhookID,
+		Secret:                  secret,
+		PreviousSecretExpiresAt: w.PreviousSecretExpiresAt,
+	}
+}
@@ -20,6 +20,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
 )
 
 func TestWebhook(t *testing.T) {
@@ -39,4 +41,100 @@ func TestWebhook(t *testing.T) {
 		wh := &Webhook{}
 		assert.NotEqual(t, time.Time{}, wh.GenerateUpdatedAt())
 	})
+	t.Run("Should set default events when events is empty", func(t *testing.T) {
+		wh := (&Webhook{}).SetDefaultValues()
+		assert.Equal(t, EventsType{enums.EventNewAnalysis}, wh.Events)
+	})
+	t.Run("Should set default max attempts when max attempts is empty", func(t *testing.T) {
+		wh := (&Webhook{}).SetDefaultValues()
+		assert.Equal(t, enums.DefaultMaxAttempts, wh.MaxAttempts)
+	})
+	t.Run("Should set default format when format is empty", func(t *testing.T) {
+		wh := (&Webhook{}).SetDefaultValues()
+		assert.Equal(t, enums.FormatFull, wh.Format)
+	})
+	t.Run("Should keep format when format is not empty", func(t *testing.T) {
+		wh := (&Webhook{Format: enums.FormatSlack}).SetDefaultValues()
+		assert.Equal(t, enums.FormatSlack, wh.Format)
+	})
+	t.Run("Should keep events when events is not empty", func(t *testing.T) {
+		wh := (&Webhook{Events: EventsType{enums.EventTokenExpiring}}).SetDefaultValues()
+		assert.Equal(t, EventsType{enums.EventTokenExpiring}, wh.Events)
+	})
+	t.Run("Should set repository id nil when is workspace webhook", func(t *testing.T) {
+		repositoryID := uuid.Nil
+		wh := (&Webhook{RepositoryID: &repositoryID}).SetDefaultValues()
+		assert.Nil(t, wh.RepositoryID)
+		assert.True(t, wh.IsWorkspaceWebhook())
+	})
+	t.Run("Should return false when is repository webhook", func(t *testing.T) {
+		repositoryID := uuid.New()
+		wh := &Webhook{RepositoryID: &repositoryID}
+		assert.False(t, wh.IsWorkspaceWebhook())
+	})
+}
+
+func TestWebhook_SetSecret(t *testing.T) {
+	t.Run("Should set secret without previous secret when webhook has no secret", func(t *testing.T) {
+		wh := (&Webhook{}).SetSecret("new", time.Hour)
+		assert.Equal(t, "new", wh.Secret)
+		assert.Empty(t, wh.PreviousSecret)
+		assert.Nil(t, wh.PreviousSecretExpiresAt)
+		assert.Equal(t, []string{"new"}, wh.GetValidSecrets())
+	})
+	t.Run("Should keep previous secret valid during grace period", func(t *testing.T) {
+		wh := (&Webhook{Secret: "old"}).SetSecret("new", time.Hour)
+		assert.Equal(t, "old", wh.PreviousSecret)
+		assert.NotNil(t, wh.PreviousSecretExpiresAt)
+		assert.Equal(t, []string{"new", "old"}, wh.GetValidSecrets())
+	})
+	t.Run("Should revoke previous secret when grace period is zero", func(t *testing.T) {
+		wh := (&Webhook{Secret: "old", PreviousSecret: "older"}).SetSecret("new", 0)
+		assert.Empty(t, wh.PreviousSecret)
+		assert.Equal(t, []string{"new"}, wh.GetValidSecrets())
+	})
+	t.Run("Should ignore previous secret when grace period expired", func(t *testing.T) {
+		expiredAt := time.Now().Add(-time.Minute)
+		wh := &Webhook{Secret: "new", PreviousSecret: "old", PreviousSecretExpiresAt: &expiredAt}
+		assert.Equal(t, []string{"new"}, wh.GetValidSecrets())
+	})
+	t.Run("Should return empty secrets when webhook has no secret", func(t *testing.T) {
+		assert.Empty(t, (&Webhook{}).GetValidSecrets())
+	})
+	t.Run("Should return secret update map and secret response", func(t *testing.T) {
+		wh := (&Webhook{WebhookID: uuid.New(), Secret: "old"}).SetSecret("new", time.Hour)
+		assert.Equal(t, "new", wh.ToSecretUpdateMap()["secret"])
+		assert.Equal(t, "old", wh.ToSecretUpdateMap()["previous_secret"])
+		assert.Equal(t, wh.WebhookID, wh.ToSecret("plain").WebhookID)
+		assert.Equal(t, "plain", wh.ToSecret("plain").Secret)
+	})
+}
+
+func TestWebhook_ToUpdateMap(t *testing.T) {
+	t.Run("Should return empty values to clear the fields sent empty", func(t *testing.T) {
+		updateMap := (&Webhook{URL: "http://example.com", Template: "", Filters: Filters{}, RepositoryID: nil,
+			Secret: "secret", PreviousSecret: "previous"}).ToUpdateMap()
+		assert.Nil(t, updateMap["repository_id"])
+		assert.Contains(t, updateMap, "repository_id")
+		assert.Equal(t, "", updateMap["template"])
+		assert.Equal(t, Filters{}, updateMap["filters"])
+		assert.Equal(t, "http://example.com", updateMap["url"])
+		assert.Len(t, updateMap, 11)
+	})
+	t.Run("Should not return the secret columns", func(t *testing.T) {
+		updateMap := (&Webhook{Secret: "secret", PreviousSecret: "previous"}).ToUpdateMap()
+		assert.NotContains(t, updateMap, "secret")
+		assert.NotContains(t, updateMap, "previous_secret")
+		assert.NotContains(t, updateMap, "previous_secret_expires_at")
+	})
+}
+
+func TestWebhook_RequiresDiff(t *testing.T) {
+	t.Run("Should require diff when filtering new findings or when format is not full", func(t *testing.T) {
+		assert.True(t, (&Webhook{Filters: Filters{OnlyNewFindings: true}}).RequiresDiff())
+		assert.True(t, (&Webhook{Format: enums.FormatSlack}).RequiresDiff())
+		assert.True(t, (&Webhook{Format: enums.FormatSummary}).RequiresDiff())
+		assert.False(t, (&Webhook{Format: enums.FormatFull}).RequiresDiff())
+		assert.False(t, (&Webhook{}).RequiresDiff())
+	})
 }
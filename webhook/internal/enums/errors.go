@@ -18,7 +18,14 @@ import "errors"
 
 var (
 	ErrorWebhookNotFound  = errors.New("{HORUSEC} webhook not found to dispatch http request")
-	ErrorWebhookDuplicate = errors.New("{HORUSEC} webhook already exists to repository selected")
+	ErrorWebhookDuplicate = errors.New("{HORUSEC} webhook with this url already exists to repository selected")
 	ErrorWrongWorkspaceID = errors.New("{HORUSEC} workspaceID is not valid uuid")
 	ErrorWrongWebhookID   = errors.New("{HORUSEC} webhookID is not valid uuid")
+	ErrorWrongDeliveryID  = errors.New("{HORUSEC} deliveryID is not valid uuid")
+	ErrorWrongPagination  = errors.New("{HORUSEC} page and size must be valid positive numbers")
+	ErrorInvalidSecret    = errors.New("{HORUSEC} webhook secret could not be decrypted")
+	ErrorInvalidTemplate  = errors.New("{HORUSEC} webhook template is not a valid go text/template")
+	ErrorFailedToDeliver  = errors.New("{HORUSEC} event could not be delivered to one or more webhooks")
+	ErrorFailedToRetry    = errors.New("{HORUSEC} one or more pending webhook deliveries could not be retried")
+	ErrorInvalidSecretKey = errors.New("{HORUSEC} webhook secret key is missing or too short")
 )
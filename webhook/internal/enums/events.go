@@ -0,0 +1,47 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+type EventType string
+
+const (
+	EventNewAnalysis                EventType = "new-analysis"
+	EventVulnerabilityStatusChanged EventType = "vulnerability-status-changed"
+	EventRepositoryCreated          EventType = "repository-created"
+	EventTokenExpiring              EventType = "token-expiring"
+)
+
+func EventTypeValues() []EventType {
+	return []EventType{
+		EventNewAnalysis,
+		EventVulnerabilityStatusChanged,
+		EventRepositoryCreated,
+		EventTokenExpiring,
+	}
+}
+
+func (e EventType) IsValid() bool {
+	for _, value := range EventTypeValues() {
+		if e == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e EventType) ToString() string {
+	return string(e)
+}
@@ -0,0 +1,25 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	MessageFailedToRetryDeliveries   = "{HORUSEC} failed to retry pending webhook deliveries"
+	MessageDeliveryScheduledToRetry  = "{HORUSEC} webhook delivery failed and was scheduled to retry -> "
+	MessageDeliveryMaxAttemptsExceed = "{HORUSEC} webhook delivery failed and exceeded max attempts -> "
+	MessageFailedToDeliverEvent      = "{HORUSEC} failed to save event delivery to webhook -> "
+	MessageFailedToRetryDelivery     = "{HORUSEC} failed to retry pending webhook delivery -> "
+	MessageInvalidSecretKey          = "{HORUSEC} webhook secret key environment variable (HORUSEC_WEBHOOK_SECRET_KEY) " +
+		"is required and must have at least 32 characters"
+)
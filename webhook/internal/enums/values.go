@@ -14,9 +14,48 @@
 
 package enums
 
+import "time"
+
+const (
+	DefaultPort          = "8004"
+	BaseRouter           = "/webhook"
+	HealthRouter         = BaseRouter + "/health"
+	WebhookRouter        = BaseRouter + "/webhook/{workspaceID}"
+	EventsQueue          = "horusec-webhook::events"
+	HeaderEventType      = "X-Horusec-Event"
+	HeaderDelivery       = "X-Horusec-Delivery"
+	HeaderSignature      = "X-Horusec-Signature"
+	HeaderTimestamp      = "X-Horusec-Timestamp"
+	SignaturePrefix      = "sha256="
+	HeaderContentType    = "Content-Type"
+	ContentTypeJSON      = "application/json"
+	ContentTypeText      = "text/plain; charset=utf-8"
+	ManagerLinkTitle     = "View on Horusec"
+	SampleRepositoryName = "horusec-webhook-test"
+	SampleWorkspaceName  = "horusec-webhook-test"
+	WebhookID            = "webhookID"
+	DeliveryID           = "deliveryID"
+	Page                 = "page"
+	Size                 = "size"
+)
+
+const (
+	DefaultMaxAttempts          = 5
+	MaxAllowedAttempts          = 20
+	DefaultPaginationSize       = 10
+	ResponseBodyMaxLength       = 1000
+	DeliveryRetryBatchSize      = 50
+	DeliveryRetryInterval       = 15 * time.Second
+	DeliveryRetryLeaseTime      = 2 * time.Minute
+	DeliveryBackoffBaseInterval = 30 * time.Second
+	DeliveryBackoffMaxInterval  = 6 * time.Hour
+	SecretLength                = 32
+	DefaultGracePeriodHours     = 24
+	MaxGracePeriodHours         = 168
+	MaxTemplateLength           = 10000
+)
+
 const (
-	DefaultPort   = "8004"
-	BaseRouter    = "/webhook"
-	HealthRouter  = BaseRouter + "/health"
-	WebhookRouter = BaseRouter + "/webhook/{workspaceID}"
+	EnvWebhookSecretKey = "HORUSEC_WEBHOOK_SECRET_KEY"
+	SecretKeyMinLength  = 32
 )
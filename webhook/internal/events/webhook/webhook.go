@@ -24,6 +24,8 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
 
 	"github.com/ZupIT/horusec-platform/webhook/internal/controllers/dispatcher"
+	eventEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/event"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
 )
 
 type IEvent interface{}
@@ -44,19 +46,54 @@ func NewWebhookEvent(iBroker broker.IBroker, controller dispatcher.IDispatcherCo
 func (e *Event) consumeQueues() IEvent {
 	go e.broker.Consume(queues.HorusecWebhook.ToString(), exchange.NewAnalysis, exchange.Fanout,
 		e.handleNewAnalysis)
+	go e.broker.Consume(enums.EventsQueue, "", "", e.handleEvent)
 	return e
 }
 
+// handleNewAnalysis acknowledges the packet only after the deliveries are saved, packets that could not be parsed
+// are discarded since they will never succeed, while dispatch failures are sent back to the queue to try again.
+// Analysis published again after a change are not new analysis, so they are also discarded
 func (e *Event) handleNewAnalysis(brokerPacket packet.IPacket) {
 	logger.LogInfo("{HORUSEC} Packet received from new analysis")
-	entity := analysis.Analysis{}
+	entity := eventEntity.RevisedAnalysis{Analysis: &analysis.Analysis{}}
 	if err := parser.ParsePacketToEntity(brokerPacket, &entity); err != nil {
-		logger.LogError("{HORUSEC} Read packet error", err)
+		logger.LogError("{HORUSEC} Unparsable new analysis packet discarded", err)
+		_ = brokerPacket.Ack()
 		return
 	}
 
-	if err := e.controller.DispatchRequest(&entity); err != nil {
+	if entity.IsRevision() {
+		logger.LogInfo("{HORUSEC} Revision of analysis already notified discarded")
+		_ = brokerPacket.Ack()
+		return
+	}
+
+	if err := e.controller.DispatchRequest(entity.Analysis); err != nil {
 		logger.LogError("{HORUSEC} Error on dispatch new analysis", err)
+		_ = brokerPacket.Nack()
+		return
+	}
+	_ = brokerPacket.Ack()
+}
+
+func (e *Event) handleEvent(brokerPacket packet.IPacket) {
+	logger.LogInfo("{HORUSEC} Packet received from webhook events")
+	entity := eventEntity.Event{}
+	if err := parser.ParsePacketToEntity(brokerPacket, &entity); err != nil {
+		logger.LogError("{HORUSEC} Unparsable webhook event packet discarded", err)
+		_ = brokerPacket.Ack()
+		return
+	}
+
+	if err := entity.Validate(); err != nil {
+		logger.LogError("{HORUSEC} Invalid webhook event discarded", err)
+		_ = brokerPacket.Ack()
+		return
+	}
+
+	if err := e.controller.DispatchEvent(&entity); err != nil {
+		logger.LogError("{HORUSEC} Error on dispatch webhook event", err)
+		_ = brokerPacket.Nack()
 		return
 	}
 	_ = brokerPacket.Ack()
@@ -22,12 +22,34 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
 	"github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+	"github.com/google/uuid"
 	"github.com/streadway/amqp"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ZupIT/horusec-platform/webhook/internal/controllers/dispatcher"
+	eventEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/event"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
 )
 
+type acknowledgerMock struct {
+	acked  bool
+	nacked bool
+}
+
+func (a *acknowledgerMock) Ack(_ uint64, _ bool) error {
+	a.acked = true
+	return nil
+}
+
+func (a *acknowledgerMock) Nack(_ uint64, _, _ bool) error {
+	a.nacked = true
+	return nil
+}
+
+func (a *acknowledgerMock) Reject(_ uint64, _ bool) error {
+	return nil
+}
+
 func TestNewWebhookEvent(t *testing.T) {
 	t.Run("Should start consume queues without not panics", func(t *testing.T) {
 		message := &amqp.Delivery{}
@@ -38,28 +60,114 @@ func TestNewWebhookEvent(t *testing.T) {
 		brokerMock.On("ConsumeHandlerFunc").Return(entity)
 		controllerMock := &dispatcher.Mock{}
 		controllerMock.On("DispatchRequest").Return(nil)
+		controllerMock.On("DispatchEvent").Return(nil)
 		assert.NotPanics(t, func() {
 			NewWebhookEvent(brokerMock, controllerMock)
 			time.Sleep(5 * time.Second)
 			brokerMock.AssertCalled(t, "ConsumeHandlerFunc")
 		})
 	})
-	t.Run("Should return error on parse packet to analysis", func(t *testing.T) {
+	t.Run("Should discard packet when parse packet to analysis fails", func(t *testing.T) {
+		acknowledger := &acknowledgerMock{}
 		event := &Event{}
 		assert.NotPanics(t, func() {
-			event.handleNewAnalysis(packet.NewPacket(&amqp.Delivery{}))
+			event.handleNewAnalysis(packet.NewPacket(&amqp.Delivery{Acknowledger: acknowledger}))
 		})
+		assert.True(t, acknowledger.acked)
+		assert.False(t, acknowledger.nacked)
 	})
-	t.Run("Should return error on parse packet to analysis", func(t *testing.T) {
+	t.Run("Should nack packet when dispatch new analysis fails", func(t *testing.T) {
+		acknowledger := &acknowledgerMock{}
 		controllerMock := &dispatcher.Mock{}
 		controllerMock.On("DispatchRequest").Return(errors.New("unexpected error"))
 		event := &Event{
 			controller: controllerMock,
 		}
-		pkg := packet.NewPacket(&amqp.Delivery{})
+		pkg := packet.NewPacket(&amqp.Delivery{Acknowledger: acknowledger})
 		pkg.SetBody([]byte("{}"))
 		assert.NotPanics(t, func() {
 			event.handleNewAnalysis(pkg)
 		})
+		assert.True(t, acknowledger.nacked)
+		assert.False(t, acknowledger.acked)
+	})
+	t.Run("Should discard revision of analysis without dispatch", func(t *testing.T) {
+		acknowledger := &acknowledgerMock{}
+		controllerMock := &dispatcher.Mock{}
+		event := &Event{
+			controller: controllerMock,
+		}
+		pkg := packet.NewPacket(&amqp.Delivery{Acknowledger: acknowledger})
+		pkg.SetBody([]byte(`{"revision":1}`))
+		assert.NotPanics(t, func() {
+			event.handleNewAnalysis(pkg)
+		})
+		assert.True(t, acknowledger.acked)
+		controllerMock.AssertNotCalled(t, "DispatchRequest")
+	})
+	t.Run("Should ack packet after dispatch new analysis", func(t *testing.T) {
+		acknowledger := &acknowledgerMock{}
+		controllerMock := &dispatcher.Mock{}
+		controllerMock.On("DispatchRequest").Return(nil)
+		event := &Event{
+			controller: controllerMock,
+		}
+		pkg := packet.NewPacket(&amqp.Delivery{Acknowledger: acknowledger})
+		pkg.SetBody([]byte("{}"))
+		assert.NotPanics(t, func() {
+			event.handleNewAnalysis(pkg)
+		})
+		assert.True(t, acknowledger.acked)
+	})
+}
+
+func TestHandleEvent(t *testing.T) {
+	t.Run("Should dispatch event without panics", func(t *testing.T) {
+		controllerMock := &dispatcher.Mock{}
+		controllerMock.On("DispatchEvent").Return(nil)
+		event := &Event{
+			controller: controllerMock,
+		}
+		pkg := packet.NewPacket(&amqp.Delivery{})
+		pkg.SetBody((&eventEntity.Event{Type: enums.EventRepositoryCreated, WorkspaceID: uuid.New()}).ToBytes())
+		assert.NotPanics(t, func() {
+			event.handleEvent(pkg)
+		})
+		controllerMock.AssertCalled(t, "DispatchEvent")
+	})
+	t.Run("Should discard packet when parse packet to event fails", func(t *testing.T) {
+		acknowledger := &acknowledgerMock{}
+		event := &Event{}
+		assert.NotPanics(t, func() {
+			event.handleEvent(packet.NewPacket(&amqp.Delivery{Acknowledger: acknowledger}))
+		})
+		assert.True(t, acknowledger.acked)
+	})
+	t.Run("Should discard invalid event without dispatch", func(t *testing.T) {
+		controllerMock := &dispatcher.Mock{}
+		event := &Event{
+			controller: controllerMock,
+		}
+		pkg := packet.NewPacket(&amqp.Delivery{})
+		pkg.SetBody([]byte("{}"))
+		assert.NotPanics(t, func() {
+			event.handleEvent(pkg)
+		})
+		controllerMock.AssertNotCalled(t, "DispatchEvent")
+	})
+	t.Run("Should nack packet when dispatch event fails", func(t *testing.T) {
+		acknowledger := &acknowledgerMock{}
+		controllerMock := &dispatcher.Mock{}
+		controllerMock.On("DispatchEvent").Return(errors.New("unexpected error"))
+		event := &Event{
+			controller: controllerMock,
+		}
+		pkg := packet.NewPacket(&amqp.Delivery{Acknowledger: acknowledger})
+		pkg.SetBody((&eventEntity.Event{Type: enums.EventTokenExpiring, WorkspaceID: uuid.New()}).ToBytes())
+		assert.NotPanics(t, func() {
+			event.handleEvent(pkg)
+		})
+		assert.True(t, acknowledger.nacked)
+		assert.False(t, acknowledger.acked)
 	})
 }
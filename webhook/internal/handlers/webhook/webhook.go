@@ -26,18 +26,22 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
 
+	controllerDispatcher "github.com/ZupIT/horusec-platform/webhook/internal/controllers/dispatcher"
 	controllerWebhook "github.com/ZupIT/horusec-platform/webhook/internal/controllers/webhook"
 	useCase "github.com/ZupIT/horusec-platform/webhook/internal/usecases/webhook"
 )
 
 type Handler struct {
 	controller controllerWebhook.IWebhookController
+	dispatcher controllerDispatcher.IDispatcherController
 	useCase    useCase.IUseCaseWebhook
 }
 
-func NewWebhookHandler(controller controllerWebhook.IWebhookController) *Handler {
+func NewWebhookHandler(controller controllerWebhook.IWebhookController,
+	dispatcher controllerDispatcher.IDispatcherController) *Handler {
 	return &Handler{
 		controller: controller,
+		dispatcher: dispatcher,
 		useCase:    useCase.NewUseCaseWebhook(),
 	}
 }
@@ -86,17 +90,13 @@ func (h *Handler) ListAll(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
 // @Router /webhook/webhook/{workspaceID}/{webhookID} [delete]
 func (h *Handler) Remove(w netHTTP.ResponseWriter, r *netHTTP.Request) {
-	webhookID, err := h.useCase.ExtractWebhookIDFromURL(r)
+	workspaceID, webhookID, err := h.extractWorkspaceAndWebhookID(r)
 	if err != nil {
 		httpUtil.StatusBadRequest(w, err)
 		return
 	}
-	if err := h.controller.Remove(webhookID); err != nil {
-		if err == enums.ErrorNotFoundRecords {
-			httpUtil.StatusNotFound(w, err)
-		} else {
-			httpUtil.StatusInternalServerError(w, err)
-		}
+	if err := h.controller.Remove(workspaceID, webhookID); err != nil {
+		h.checkNotFoundError(w, err)
 	} else {
 		httpUtil.StatusNoContent(w)
 	}
@@ -117,7 +117,7 @@ func (h *Handler) Remove(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
 // @Router /webhook/webhook/{workspaceID}/{webhookID} [put]
 func (h *Handler) Update(w netHTTP.ResponseWriter, r *netHTTP.Request) {
-	webhookID, err := h.useCase.ExtractWebhookIDFromURL(r)
+	workspaceID, webhookID, err := h.extractWorkspaceAndWebhookID(r)
 	if err != nil {
 		httpUtil.StatusBadRequest(w, err)
 		return
@@ -127,16 +127,12 @@ func (h *Handler) Update(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 		httpUtil.StatusBadRequest(w, err)
 		return
 	}
-	h.updateWebhook(w, body, webhookID)
+	h.updateWebhook(w, body, workspaceID, webhookID)
 }
 
-func (h *Handler) updateWebhook(w netHTTP.ResponseWriter, body *webhook.Webhook, webhookID uuid.UUID) {
-	if err := h.controller.Update(body, webhookID); err != nil {
-		if err == enums.ErrorNotFoundRecords {
-			httpUtil.StatusNotFound(w, err)
-			return
-		}
-		httpUtil.StatusInternalServerError(w, err)
+func (h *Handler) updateWebhook(w netHTTP.ResponseWriter, body *webhook.Webhook, workspaceID, webhookID uuid.UUID) {
+	if err := h.controller.Update(body, workspaceID, webhookID); err != nil {
+		h.checkNotFoundError(w, err)
 		return
 	}
 	httpUtil.StatusNoContent(w)
@@ -145,13 +141,13 @@ func (h *Handler) updateWebhook(w netHTTP.ResponseWriter, body *webhook.Webhook,
 // Save
 // @Tags Webhook
 // @Security ApiKeyAuth
-// @Description Save webhook by id
+// @Description Save webhook by id, the returned secret is used to sign the payloads and will not be shown again
 // @ID SaveWebhook
 // @Accept  json
 // @Produce  json
 // @Param workspaceID path string true "workspaceID of the workspace"
 // @Param webhookToSave body webhook.Webhook true "update webhook content info"
-// @Success 200 {object} entities.Response{content=string} "NO CONTENT"
+// @Success 200 {object} entities.Response{content=webhook.Secret} "OK"
 // @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
 // @Router /webhook/webhook/{workspaceID} [post]
@@ -161,7 +157,7 @@ func (h *Handler) Save(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 		httpUtil.StatusBadRequest(w, err)
 		return
 	}
-	webhookID, err := h.controller.Save(body)
+	secret, err := h.controller.Save(body)
 	if err != nil {
 		if err == enumsWebhook.ErrorWebhookDuplicate {
 			httpUtil.StatusConflict(w, err)
@@ -169,6 +165,157 @@ func (h *Handler) Save(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 			httpUtil.StatusInternalServerError(w, err)
 		}
 	} else {
-		httpUtil.StatusOK(w, webhookID)
+		httpUtil.StatusOK(w, secret)
+	}
+}
+
+// ListDeliveries
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Description Get the delivery log of a webhook, with the history of attempts of each delivery
+// @ID GetWebhookDeliveries
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param webhookID path string true "webhookID of the webhook"
+// @Param page query string false "page of the pagination, default 1"
+// @Param size query string false "size of the pagination, default 10"
+// @Success 200 {object} entities.Response{content=[]delivery.Delivery} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /webhook/webhook/{workspaceID}/{webhookID}/deliveries [get]
+func (h *Handler) ListDeliveries(w netHTTP.ResponseWriter, r *netHTTP.Request) {
+	workspaceID, webhookID, err := h.extractWorkspaceAndWebhookID(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+	page, size, err := h.useCase.ExtractPaginationFromURL(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+	deliveries, err := h.controller.ListDeliveries(workspaceID, webhookID, page, size)
+	if err != nil {
+		h.checkNotFoundError(w, err)
+		return
+	}
+	httpUtil.StatusOK(w, deliveries)
+}
+
+// Redeliver
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Description Send again a delivery of a webhook
+// @ID RedeliverWebhookDelivery
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param webhookID path string true "webhookID of the webhook"
+// @Param deliveryID path string true "deliveryID of the delivery"
+// @Success 200 {object} entities.Response{content=delivery.Delivery} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /webhook/webhook/{workspaceID}/{webhookID}/deliveries/{deliveryID}/redeliver [post]
+func (h *Handler) Redeliver(w netHTTP.ResponseWriter, r *netHTTP.Request) {
+	workspaceID, webhookID, err := h.extractWorkspaceAndWebhookID(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+	deliveryID, err := h.useCase.ExtractDeliveryIDFromURL(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+	delivery, err := h.dispatcher.Redeliver(workspaceID, webhookID, deliveryID)
+	if err != nil {
+		h.checkNotFoundError(w, err)
+		return
+	}
+	httpUtil.StatusOK(w, delivery)
+}
+
+// RotateSecret
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Description Generate a new secret to sign the payloads of a webhook, the previous secret still signs the payloads during the grace period
+// @ID RotateWebhookSecret
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param webhookID path string true "webhookID of the webhook"
+// @Param rotateSecret body webhook.RotateSecret false "grace period in hours, default 24"
+// @Success 200 {object} entities.Response{content=webhook.Secret} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /webhook/webhook/{workspaceID}/{webhookID}/secret/rotate [post]
+func (h *Handler) RotateSecret(w netHTTP.ResponseWriter, r *netHTTP.Request) {
+	workspaceID, webhookID, err := h.extractWorkspaceAndWebhookID(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+	body, err := h.useCase.DecodeRotateSecretFromIoRead(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+	secret, err := h.controller.RotateSecret(workspaceID, webhookID, body.GetGracePeriod())
+	if err != nil {
+		h.checkNotFoundError(w, err)
+		return
+	}
+	httpUtil.StatusOK(w, secret)
+}
+
+// TestWebhook
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Description Send a sample analysis to the webhook to check its configuration, nothing is saved on the delivery log
+// @ID TestWebhook
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param webhookID path string true "webhookID of the webhook"
+// @Success 200 {object} entities.Response{content=delivery.TestResult} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /webhook/webhook/{workspaceID}/{webhookID}/test [post]
+func (h *Handler) TestWebhook(w netHTTP.ResponseWriter, r *netHTTP.Request) {
+	workspaceID, webhookID, err := h.extractWorkspaceAndWebhookID(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+	result, err := h.dispatcher.TestWebhook(workspaceID, webhookID)
+	if err != nil {
+		h.checkNotFoundError(w, err)
+		return
+	}
+	httpUtil.StatusOK(w, result)
+}
+
+func (h *Handler) extractWorkspaceAndWebhookID(r *netHTTP.Request) (workspaceID, webhookID uuid.UUID, err error) {
+	workspaceID, err = h.useCase.ExtractWorkspaceIDFromURL(r)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	webhookID, err = h.useCase.ExtractWebhookIDFromURL(r)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return workspaceID, webhookID, nil
+}
+
+func (h *Handler) checkNotFoundError(w netHTTP.ResponseWriter, err error) {
+	if err == enums.ErrorNotFoundRecords || err == enumsWebhook.ErrorWebhookNotFound {
+		httpUtil.StatusNotFound(w, err)
+		return
 	}
+	httpUtil.StatusInternalServerError(w, err)
 }
@@ -27,7 +27,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ZupIT/horusec-platform/webhook/internal/controllers/dispatcher"
 	"github.com/ZupIT/horusec-platform/webhook/internal/controllers/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/delivery"
 	webhookEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
 	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
 	useCaseWebhook "github.com/ZupIT/horusec-platform/webhook/internal/usecases/webhook"
@@ -38,7 +40,7 @@ func TestHandler_Options(t *testing.T) {
 		controllerMock := &webhook.Mock{}
 		w := httptest.NewRecorder()
 		r, _ := http.NewRequest("", "/test", nil)
-		NewWebhookHandler(controllerMock).Options(w, r)
+		NewWebhookHandler(controllerMock, &dispatcher.Mock{}).Options(w, r)
 		assert.Equal(t, http.StatusNoContent, w.Code)
 	})
 }
@@ -111,6 +113,7 @@ func TestHandler_Remove(t *testing.T) {
 		controllerMock := &webhook.Mock{}
 		controllerMock.On("Remove").Return(nil)
 		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
 		handler := &Handler{
 			controller: controllerMock,
@@ -129,6 +132,7 @@ func TestHandler_Remove(t *testing.T) {
 		controllerMock := &webhook.Mock{}
 		controllerMock.On("Remove").Return(nil)
 		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.Nil, enums.ErrorWrongWebhookID)
 		handler := &Handler{
 			controller: controllerMock,
@@ -147,6 +151,26 @@ func TestHandler_Remove(t *testing.T) {
 		controllerMock := &webhook.Mock{}
 		controllerMock.On("Remove").Return(enums2.ErrorNotFoundRecords)
 		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		handler := &Handler{
+			controller: controllerMock,
+			useCase:    useCaseMock,
+		}
+		handler.Remove(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should return status not found when call Remove and webhook is not of the workspace", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("", "/test", nil)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("webhookID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		controllerMock := &webhook.Mock{}
+		controllerMock.On("Remove").Return(enums.ErrorWebhookNotFound)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
 		handler := &Handler{
 			controller: controllerMock,
@@ -165,6 +189,7 @@ func TestHandler_Remove(t *testing.T) {
 		controllerMock := &webhook.Mock{}
 		controllerMock.On("Remove").Return(errors.New("unexpected error"))
 		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
 		handler := &Handler{
 			controller: controllerMock,
@@ -183,7 +208,7 @@ func TestHandler_Save(t *testing.T) {
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		controllerMock := &webhook.Mock{}
-		controllerMock.On("Save").Return(uuid.New(), nil)
+		controllerMock.On("Save").Return(&webhookEntity.Secret{WebhookID: uuid.New()}, nil)
 		useCaseMock := &useCaseWebhook.Mock{}
 		useCaseMock.On("DecodeWebhookFromIoRead").Return(&webhookEntity.Webhook{}, nil)
 		handler := &Handler{
@@ -200,7 +225,7 @@ func TestHandler_Save(t *testing.T) {
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		controllerMock := &webhook.Mock{}
-		controllerMock.On("Save").Return(uuid.New(), nil)
+		controllerMock.On("Save").Return(&webhookEntity.Secret{WebhookID: uuid.New()}, nil)
 		useCaseMock := &useCaseWebhook.Mock{}
 		useCaseMock.On("DecodeWebhookFromIoRead").Return(&webhookEntity.Webhook{}, enumsParser.ErrorBodyEmpty)
 		handler := &Handler{
@@ -218,7 +243,7 @@ func TestHandler_Save(t *testing.T) {
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		controllerMock := &webhook.Mock{}
-		controllerMock.On("Save").Return(uuid.Nil, enums.ErrorWebhookDuplicate)
+		controllerMock.On("Save").Return(&webhookEntity.Secret{}, enums.ErrorWebhookDuplicate)
 		useCaseMock := &useCaseWebhook.Mock{}
 		useCaseMock.On("DecodeWebhookFromIoRead").Return(&webhookEntity.Webhook{}, nil)
 		handler := &Handler{
@@ -236,7 +261,7 @@ func TestHandler_Save(t *testing.T) {
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		controllerMock := &webhook.Mock{}
-		controllerMock.On("Save").Return(uuid.Nil, errors.New("unexpected error"))
+		controllerMock.On("Save").Return(&webhookEntity.Secret{}, errors.New("unexpected error"))
 		useCaseMock := &useCaseWebhook.Mock{}
 		useCaseMock.On("DecodeWebhookFromIoRead").Return(&webhookEntity.Webhook{}, nil)
 		handler := &Handler{
@@ -258,6 +283,7 @@ func TestHandler_Update(t *testing.T) {
 		controllerMock := &webhook.Mock{}
 		controllerMock.On("Update").Return(nil)
 		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
 		useCaseMock.On("DecodeWebhookFromIoRead").Return(&webhookEntity.Webhook{}, nil)
 		handler := &Handler{
@@ -276,6 +302,7 @@ func TestHandler_Update(t *testing.T) {
 		controllerMock := &webhook.Mock{}
 		controllerMock.On("Update").Return(nil)
 		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
 		useCaseMock.On("DecodeWebhookFromIoRead").Return(&webhookEntity.Webhook{}, enumsParser.ErrorBodyEmpty)
 		handler := &Handler{
@@ -294,6 +321,7 @@ func TestHandler_Update(t *testing.T) {
 		controllerMock := &webhook.Mock{}
 		controllerMock.On("Update").Return(nil)
 		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.Nil, enums.ErrorWrongWebhookID)
 		useCaseMock.On("DecodeWebhookFromIoRead").Return(&webhookEntity.Webhook{}, nil)
 		handler := &Handler{
@@ -313,6 +341,27 @@ func TestHandler_Update(t *testing.T) {
 		controllerMock := &webhook.Mock{}
 		controllerMock.On("Update").Return(enums2.ErrorNotFoundRecords)
 		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("DecodeWebhookFromIoRead").Return(&webhookEntity.Webhook{}, nil)
+		handler := &Handler{
+			controller: controllerMock,
+			useCase:    useCaseMock,
+		}
+		handler.Update(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should return status not found when call Update and webhook is not of the workspace", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("", "/test", nil)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("webhookID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		controllerMock := &webhook.Mock{}
+		controllerMock.On("Update").Return(enums.ErrorWebhookNotFound)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
 		useCaseMock.On("DecodeWebhookFromIoRead").Return(&webhookEntity.Webhook{}, nil)
 		handler := &Handler{
@@ -332,6 +381,7 @@ func TestHandler_Update(t *testing.T) {
 		controllerMock := &webhook.Mock{}
 		controllerMock.On("Update").Return(errors.New("unexpected error"))
 		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
 		useCaseMock.On("DecodeWebhookFromIoRead").Return(&webhookEntity.Webhook{}, nil)
 		handler := &Handler{
@@ -342,3 +392,311 @@ func TestHandler_Update(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
 }
+
+func TestHandler_ListDeliveries(t *testing.T) {
+	t.Run("Should return status ok when call ListDeliveries", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		controllerMock := &webhook.Mock{}
+		controllerMock.On("ListDeliveries").Return(&[]delivery.Delivery{{DeliveryID: uuid.New()}}, nil)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractPaginationFromURL").Return(1, 10, nil)
+		handler := &Handler{
+			controller: controllerMock,
+			useCase:    useCaseMock,
+		}
+		handler.ListDeliveries(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+	t.Run("Should return status bad request when call ListDeliveries with wrong workspaceID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.Nil, enums.ErrorWrongWorkspaceID)
+		handler := &Handler{
+			controller: &webhook.Mock{},
+			useCase:    useCaseMock,
+		}
+		handler.ListDeliveries(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return status bad request when call ListDeliveries with wrong webhookID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.Nil, enums.ErrorWrongWebhookID)
+		handler := &Handler{
+			controller: &webhook.Mock{},
+			useCase:    useCaseMock,
+		}
+		handler.ListDeliveries(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return status bad request when call ListDeliveries with wrong pagination", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractPaginationFromURL").Return(0, 0, enums.ErrorWrongPagination)
+		handler := &Handler{
+			controller: &webhook.Mock{},
+			useCase:    useCaseMock,
+		}
+		handler.ListDeliveries(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return status not found when call ListDeliveries and webhook not exists", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		controllerMock := &webhook.Mock{}
+		controllerMock.On("ListDeliveries").Return(&[]delivery.Delivery{}, enums.ErrorWebhookNotFound)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractPaginationFromURL").Return(1, 10, nil)
+		handler := &Handler{
+			controller: controllerMock,
+			useCase:    useCaseMock,
+		}
+		handler.ListDeliveries(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should return status internal server error when call ListDeliveries with unexpected error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		controllerMock := &webhook.Mock{}
+		controllerMock.On("ListDeliveries").Return(&[]delivery.Delivery{}, errors.New("unexpected error"))
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractPaginationFromURL").Return(1, 10, nil)
+		handler := &Handler{
+			controller: controllerMock,
+			useCase:    useCaseMock,
+		}
+		handler.ListDeliveries(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestHandler_Redeliver(t *testing.T) {
+	t.Run("Should return status ok when call Redeliver", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		dispatcherMock := &dispatcher.Mock{}
+		dispatcherMock.On("Redeliver").Return(&delivery.Delivery{DeliveryID: uuid.New()}, nil)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractDeliveryIDFromURL").Return(uuid.New(), nil)
+		handler := &Handler{
+			dispatcher: dispatcherMock,
+			useCase:    useCaseMock,
+		}
+		handler.Redeliver(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+	t.Run("Should return status bad request when call Redeliver with wrong webhookID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.Nil, enums.ErrorWrongWebhookID)
+		handler := &Handler{
+			dispatcher: &dispatcher.Mock{},
+			useCase:    useCaseMock,
+		}
+		handler.Redeliver(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return status bad request when call Redeliver with wrong deliveryID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractDeliveryIDFromURL").Return(uuid.Nil, enums.ErrorWrongDeliveryID)
+		handler := &Handler{
+			dispatcher: &dispatcher.Mock{},
+			useCase:    useCaseMock,
+		}
+		handler.Redeliver(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return status not found when call Redeliver and delivery not exists", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		dispatcherMock := &dispatcher.Mock{}
+		dispatcherMock.On("Redeliver").Return(&delivery.Delivery{}, enums2.ErrorNotFoundRecords)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractDeliveryIDFromURL").Return(uuid.New(), nil)
+		handler := &Handler{
+			dispatcher: dispatcherMock,
+			useCase:    useCaseMock,
+		}
+		handler.Redeliver(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should return status internal server error when call Redeliver with unexpected error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		dispatcherMock := &dispatcher.Mock{}
+		dispatcherMock.On("Redeliver").Return(&delivery.Delivery{}, errors.New("unexpected error"))
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractDeliveryIDFromURL").Return(uuid.New(), nil)
+		handler := &Handler{
+			dispatcher: dispatcherMock,
+			useCase:    useCaseMock,
+		}
+		handler.Redeliver(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestHandler_RotateSecret(t *testing.T) {
+	t.Run("Should return status ok when call RotateSecret", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		controllerMock := &webhook.Mock{}
+		controllerMock.On("RotateSecret").Return(&webhookEntity.Secret{Secret: "secret"}, nil)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("DecodeRotateSecretFromIoRead").Return(&webhookEntity.RotateSecret{}, nil)
+		handler := &Handler{
+			controller: controllerMock,
+			useCase:    useCaseMock,
+		}
+		handler.RotateSecret(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+	t.Run("Should return status bad request when call RotateSecret with wrong webhookID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.Nil, enums.ErrorWrongWebhookID)
+		handler := &Handler{
+			controller: &webhook.Mock{},
+			useCase:    useCaseMock,
+		}
+		handler.RotateSecret(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return status bad request when call RotateSecret with invalid body", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("DecodeRotateSecretFromIoRead").Return(&webhookEntity.RotateSecret{},
+			enumsParser.ErrorBodyInvalid)
+		handler := &Handler{
+			controller: &webhook.Mock{},
+			useCase:    useCaseMock,
+		}
+		handler.RotateSecret(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return status not found when call RotateSecret and webhook not exists", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		controllerMock := &webhook.Mock{}
+		controllerMock.On("RotateSecret").Return(&webhookEntity.Secret{}, enums.ErrorWebhookNotFound)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("DecodeRotateSecretFromIoRead").Return(&webhookEntity.RotateSecret{}, nil)
+		handler := &Handler{
+			controller: controllerMock,
+			useCase:    useCaseMock,
+		}
+		handler.RotateSecret(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should return status internal server error when call RotateSecret with unexpected error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		controllerMock := &webhook.Mock{}
+		controllerMock.On("RotateSecret").Return(&webhookEntity.Secret{}, errors.New("unexpected error"))
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("DecodeRotateSecretFromIoRead").Return(&webhookEntity.RotateSecret{}, nil)
+		handler := &Handler{
+			controller: controllerMock,
+			useCase:    useCaseMock,
+		}
+		handler.RotateSecret(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestHandler_TestWebhook(t *testing.T) {
+	t.Run("Should return status ok when call TestWebhook", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		dispatcherMock := &dispatcher.Mock{}
+		dispatcherMock.On("TestWebhook").Return(&delivery.TestResult{StatusCode: http.StatusOK}, nil)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		handler := &Handler{
+			dispatcher: dispatcherMock,
+			useCase:    useCaseMock,
+		}
+		handler.TestWebhook(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+	t.Run("Should return status bad request when call TestWebhook with wrong webhookID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.Nil, enums.ErrorWrongWebhookID)
+		handler := &Handler{
+			dispatcher: &dispatcher.Mock{},
+			useCase:    useCaseMock,
+		}
+		handler.TestWebhook(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return status not found when call TestWebhook and webhook not exists", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		dispatcherMock := &dispatcher.Mock{}
+		dispatcherMock.On("TestWebhook").Return(&delivery.TestResult{}, enums.ErrorWebhookNotFound)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		handler := &Handler{
+			dispatcher: dispatcherMock,
+			useCase:    useCaseMock,
+		}
+		handler.TestWebhook(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should return status internal server error when call TestWebhook with unexpected error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		dispatcherMock := &dispatcher.Mock{}
+		dispatcherMock.On("TestWebhook").Return(&delivery.TestResult{}, errors.New("unexpected error"))
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWorkspaceIDFromURL").Return(uuid.New(), nil)
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		handler := &Handler{
+			dispatcher: dispatcherMock,
+			useCase:    useCaseMock,
+		}
+		handler.TestWebhook(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
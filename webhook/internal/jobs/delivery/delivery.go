@@ -0,0 +1,54 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delivery
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/controllers/dispatcher"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+type IJob interface{}
+
+type Job struct {
+	controller dispatcher.IDispatcherController
+}
+
+func NewDeliveryJob(controller dispatcher.IDispatcherController) IJob {
+	j := &Job{
+		controller: controller,
+	}
+	return j.start()
+}
+
+func (j *Job) start() IJob {
+	go j.retryPendingDeliveries(time.NewTicker(enums.DeliveryRetryInterval))
+	return j
+}
+
+func (j *Job) retryPendingDeliveries(ticker *time.Ticker) {
+	for range ticker.C {
+		j.run()
+	}
+}
+
+func (j *Job) run() {
+	if err := j.controller.RetryPendingDeliveries(); err != nil {
+		logger.LogError(enums.MessageFailedToRetryDeliveries, err)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delivery
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/controllers/dispatcher"
+)
+
+func TestNewDeliveryJob(t *testing.T) {
+	t.Run("Should start job without panics", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			assert.NotNil(t, NewDeliveryJob(&dispatcher.Mock{}))
+		})
+	})
+}
+
+func TestRun(t *testing.T) {
+	t.Run("Should retry pending deliveries without panics", func(t *testing.T) {
+		controllerMock := &dispatcher.Mock{}
+		controllerMock.On("RetryPendingDeliveries").Return(nil)
+		job := &Job{controller: controllerMock}
+		assert.NotPanics(t, job.run)
+		controllerMock.AssertCalled(t, "RetryPendingDeliveries")
+	})
+	t.Run("Should log error when retry pending deliveries fails", func(t *testing.T) {
+		controllerMock := &dispatcher.Mock{}
+		controllerMock.On("RetryPendingDeliveries").Return(errors.New("unexpected error"))
+		job := &Job{controller: controllerMock}
+		assert.NotPanics(t, job.run)
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"database/sql"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/google/uuid"
+)
+
+type IAnalysisRepository interface {
+	GetPreviousAnalysis(entity *analysis.Analysis) (*analysis.Analysis, error)
+}
+
+type Repository struct {
+	dbRead database.IDatabaseRead
+}
+
+func NewAnalysisRepository(connection *database.Connection) IAnalysisRepository {
+	return &Repository{
+		dbRead: connection.Read,
+	}
+}
+
+// GetPreviousAnalysis returns the last analysis of the same repository created before the analysis informed with
+// its vulnerabilities, when there is no previous analysis nil will be returned. The stored creation date is used,
+// since the date of a packet published again may not be the original one
+func (r *Repository) GetPreviousAnalysis(entity *analysis.Analysis) (*analysis.Analysis, error) {
+	var analysisIDs []uuid.UUID
+
+	if err := r.dbRead.Raw(r.queryGetPreviousAnalysisID(), &analysisIDs, sql.Named("analysisID", entity.ID),
+		sql.Named("repositoryID", entity.RepositoryID),
+	).GetErrorExceptNotFound(); err != nil || len(analysisIDs) == 0 {
+		return nil, err
+	}
+
+	return r.getAnalysisWithVulnerabilities(analysisIDs[0])
+}
+
+func (r *Repository) queryGetPreviousAnalysisID() string {
+	return `
+		SELECT analysis_id FROM analysis
+		WHERE repository_id = @repositoryID
+		AND analysis_id <> @analysisID
+		AND created_at < (SELECT created_at FROM analysis WHERE analysis_id = @analysisID)
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+}
+
+func (r *Repository) getAnalysisWithVulnerabilities(analysisID uuid.UUID) (*analysis.Analysis, error) {
+	entity := &analysis.Analysis{}
+
+	preloads := map[string][]interface{}{
+		"AnalysisVulnerabilities":               {},
+		"AnalysisVulnerabilities.Vulnerability": {},
+	}
+
+	return entity, r.dbRead.FindPreload(entity, map[string]interface{}{"analysis_id": analysisID}, preloads,
+		entity.GetTable()).GetError()
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAnalysisRepository(t *testing.T) {
+	t.Run("Should create a new analysis repository instance", func(t *testing.T) {
+		assert.NotNil(t, NewAnalysisRepository(&database.Connection{}))
+	})
+}
+
+func TestRepository_GetPreviousAnalysis(t *testing.T) {
+	entity := &analysis.Analysis{ID: uuid.New(), RepositoryID: uuid.New()}
+
+	t.Run("Should return the previous analysis with its vulnerabilities", func(t *testing.T) {
+		previousID := uuid.New()
+
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(1, nil, []uuid.UUID{previousID}))
+		dbRead.On("FindPreload").Return(response.NewResponse(1, nil, &analysis.Analysis{ID: previousID}))
+
+		previous, err := NewAnalysisRepository(&database.Connection{Read: dbRead}).GetPreviousAnalysis(entity)
+		assert.NoError(t, err)
+		assert.Equal(t, previousID, previous.ID)
+	})
+
+	t.Run("Should return nil when there is no previous analysis", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(0, nil, nil))
+
+		previous, err := NewAnalysisRepository(&database.Connection{Read: dbRead}).GetPreviousAnalysis(entity)
+		assert.NoError(t, err)
+		assert.Nil(t, previous)
+		dbRead.AssertNotCalled(t, "FindPreload")
+	})
+
+	t.Run("Should return error when failed to get previous analysis id", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := NewAnalysisRepository(&database.Connection{Read: dbRead}).GetPreviousAnalysis(entity)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should return error when failed to get previous analysis vulnerabilities", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(1, nil, []uuid.UUID{uuid.New()}))
+		dbRead.On("FindPreload").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := NewAnalysisRepository(&database.Connection{Read: dbRead}).GetPreviousAnalysis(entity)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,191 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delivery
+
+import (
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/pagination"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/delivery"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+type IDeliveryRepository interface {
+	Save(entity *delivery.Delivery) error
+	ExistsByEventKey(webhookID uuid.UUID, eventKey string) (bool, error)
+	Update(entity *delivery.Delivery) error
+	SaveAttempt(entity *delivery.Attempt) error
+	ListOne(condition map[string]interface{}) (*delivery.Delivery, error)
+	ListByWebhook(webhookID uuid.UUID, page, size int) (*[]delivery.Delivery, error)
+	ClaimPending(limit int, leaseTime time.Duration) (*[]delivery.Delivery, error)
+}
+
+type Repository struct {
+	dbRead  database.IDatabaseRead
+	dbWrite database.IDatabaseWrite
+}
+
+func NewDeliveryRepository(connection *database.Connection) IDeliveryRepository {
+	return &Repository{
+		dbRead:  connection.Read,
+		dbWrite: connection.Write,
+	}
+}
+
+func (r *Repository) Save(entity *delivery.Delivery) error {
+	return r.dbWrite.Create(entity, entity.GetTable()).GetError()
+}
+
+func (r *Repository) ExistsByEventKey(webhookID uuid.UUID, eventKey string) (bool, error) {
+	entity := &delivery.Delivery{}
+	if err := r.dbRead.Find(entity, map[string]interface{}{"webhook_id": webhookID, "event_key": eventKey},
+		entity.GetTable()).GetErrorExceptNotFound(); err != nil {
+		return false, err
+	}
+
+	return entity.DeliveryID != uuid.Nil, nil
+}
+
+func (r *Repository) Update(entity *delivery.Delivery) error {
+	condition := map[string]interface{}{"delivery_id": entity.DeliveryID}
+	return r.dbWrite.Update(entity.ToUpdateMap(), condition, entity.GetTable()).GetError()
+}
+
+func (r *Repository) SaveAttempt(entity *delivery.Attempt) error {
+	return r.dbWrite.Create(entity, entity.GetTable()).GetError()
+}
+
+func (r *Repository) ListOne(condition map[string]interface{}) (*delivery.Delivery, error) {
+	entity := &delivery.Delivery{}
+	if err := r.dbRead.Find(entity, condition, entity.GetTable()).GetError(); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (r *Repository) ListByWebhook(webhookID uuid.UUID, page, size int) (*[]delivery.Delivery, error) {
+	entities := &[]delivery.Delivery{}
+	if err := r.dbRead.Raw(r.queryListByWebhook(), entities, sql.Named("webhookID", webhookID),
+		sql.Named("size", size), sql.Named("skip", pagination.GetSkip(int64(page), int64(size))),
+	).GetErrorExceptNotFound(); err != nil {
+		return &[]delivery.Delivery{}, err
+	}
+
+	return entities, r.setHistory(*entities)
+}
+
+func (r *Repository) queryListByWebhook() string {
+	return `
+		SELECT * FROM webhook_deliveries
+		WHERE webhook_id = @webhookID
+		ORDER BY created_at DESC
+		LIMIT @size OFFSET @skip
+	`
+}
+
+func (r *Repository) setHistory(entities []delivery.Delivery) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	attempts, err := r.listAttempts(entities)
+	if err != nil {
+		return err
+	}
+
+	for index := range entities {
+		entities[index].History = attempts[entities[index].DeliveryID]
+	}
+
+	return nil
+}
+
+func (r *Repository) listAttempts(entities []delivery.Delivery) (map[uuid.UUID][]delivery.Attempt, error) {
+	deliveryIDs := make([]uuid.UUID, 0, len(entities))
+	for index := range entities {
+		deliveryIDs = append(deliveryIDs, entities[index].DeliveryID)
+	}
+
+	attempts := &[]delivery.Attempt{}
+	if err := r.dbRead.Find(attempts, map[string]interface{}{"delivery_id": deliveryIDs},
+		(&delivery.Attempt{}).GetTable()).GetErrorExceptNotFound(); err != nil {
+		return nil, err
+	}
+
+	return r.groupAttemptsByDelivery(*attempts), nil
+}
+
+func (r *Repository) groupAttemptsByDelivery(attempts []delivery.Attempt) map[uuid.UUID][]delivery.Attempt {
+	sort.Slice(attempts, func(i, j int) bool {
+		return attempts[i].Number < attempts[j].Number
+	})
+
+	grouped := map[uuid.UUID][]delivery.Attempt{}
+	for index := range attempts {
+		grouped[attempts[index].DeliveryID] = append(grouped[attempts[index].DeliveryID], attempts[index])
+	}
+
+	return grouped
+}
+
+// ClaimPending selects the deliveries which retry time has come and pushes its next retry time forward by the
+// lease time, so other webhook instances running the retry job will not send the same delivery concurrently.
+// The lease is only taken when the next retry time is still the one read, otherwise another instance claimed it
+func (r *Repository) ClaimPending(limit int, leaseTime time.Duration) (*[]delivery.Delivery, error) {
+	entities := &[]delivery.Delivery{}
+	now := time.Now()
+
+	if err := r.dbRead.Raw(r.queryListPending(), entities, sql.Named("now", now), sql.Named("limit", limit),
+		sql.Named("status", enums.DeliveryPending.ToString())).GetErrorExceptNotFound(); err != nil {
+		return &[]delivery.Delivery{}, err
+	}
+
+	return r.claim(*entities, now.Add(leaseTime))
+}
+
+func (r *Repository) queryListPending() string {
+	return `
+		SELECT * FROM webhook_deliveries
+		WHERE status = @status AND next_retry_at <= @now
+		ORDER BY next_retry_at
+		LIMIT @limit
+	`
+}
+
+func (r *Repository) claim(entities []delivery.Delivery, leaseUntil time.Time) (*[]delivery.Delivery, error) {
+	claimed := []delivery.Delivery{}
+
+	for index := range entities {
+		result := r.dbWrite.Update(map[string]interface{}{"next_retry_at": leaseUntil}, map[string]interface{}{
+			"delivery_id": entities[index].DeliveryID, "status": enums.DeliveryPending,
+			"next_retry_at": entities[index].NextRetryAt}, entities[index].GetTable())
+		if err := result.GetError(); err != nil {
+			return &claimed, err
+		}
+
+		if result.GetRowsAffected() > 0 {
+			entities[index].NextRetryAt = &leaseUntil
+			claimed = append(claimed, entities[index])
+		}
+	}
+
+	return &claimed, nil
+}
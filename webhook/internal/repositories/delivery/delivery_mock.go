@@ -0,0 +1,64 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delivery
+
+import (
+	"time"
+
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/delivery"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Save(_ *delivery.Delivery) error {
+	args := m.MethodCalled("Save")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) Update(_ *delivery.Delivery) error {
+	args := m.MethodCalled("Update")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ExistsByEventKey(_ uuid.UUID, _ string) (bool, error) {
+	args := m.MethodCalled("ExistsByEventKey")
+	return args.Get(0).(bool), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) SaveAttempt(_ *delivery.Attempt) error {
+	args := m.MethodCalled("SaveAttempt")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListOne(_ map[string]interface{}) (*delivery.Delivery, error) {
+	args := m.MethodCalled("ListOne")
+	return args.Get(0).(*delivery.Delivery), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListByWebhook(_ uuid.UUID, _, _ int) (*[]delivery.Delivery, error) {
+	args := m.MethodCalled("ListByWebhook")
+	return args.Get(0).(*[]delivery.Delivery), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ClaimPending(_ int, _ time.Duration) (*[]delivery.Delivery, error) {
+	args := m.MethodCalled("ClaimPending")
+	return args.Get(0).(*[]delivery.Delivery), utilsMock.ReturnNilOrError(args, 1)
+}
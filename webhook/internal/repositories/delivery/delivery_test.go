@@ -0,0 +1,203 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delivery
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/delivery"
+)
+
+func TestNewDeliveryRepository(t *testing.T) {
+	assert.NotNil(t, NewDeliveryRepository(&database.Connection{}))
+}
+
+func TestRepository_Save(t *testing.T) {
+	t.Run("Should save delivery without error", func(t *testing.T) {
+		dbWrite := &database.Mock{}
+		dbWrite.On("Create").Return(response.NewResponse(1, nil, nil))
+		connection := &database.Connection{Read: &database.Mock{}, Write: dbWrite}
+		assert.NoError(t, NewDeliveryRepository(connection).Save(&delivery.Delivery{}))
+	})
+	t.Run("Should return error when save delivery", func(t *testing.T) {
+		dbWrite := &database.Mock{}
+		dbWrite.On("Create").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		connection := &database.Connection{Read: &database.Mock{}, Write: dbWrite}
+		assert.Error(t, NewDeliveryRepository(connection).Save(&delivery.Delivery{}))
+	})
+}
+
+func TestRepository_Update(t *testing.T) {
+	t.Run("Should update delivery without error", func(t *testing.T) {
+		dbWrite := &database.Mock{}
+		dbWrite.On("Update").Return(response.NewResponse(1, nil, nil))
+		connection := &database.Connection{Read: &database.Mock{}, Write: dbWrite}
+		assert.NoError(t, NewDeliveryRepository(connection).Update(&delivery.Delivery{}))
+	})
+	t.Run("Should return error when update delivery", func(t *testing.T) {
+		dbWrite := &database.Mock{}
+		dbWrite.On("Update").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		connection := &database.Connection{Read: &database.Mock{}, Write: dbWrite}
+		assert.Error(t, NewDeliveryRepository(connection).Update(&delivery.Delivery{}))
+	})
+}
+
+func TestRepository_SaveAttempt(t *testing.T) {
+	t.Run("Should save attempt without error", func(t *testing.T) {
+		dbWrite := &database.Mock{}
+		dbWrite.On("Create").Return(response.NewResponse(1, nil, nil))
+		connection := &database.Connection{Read: &database.Mock{}, Write: dbWrite}
+		assert.NoError(t, NewDeliveryRepository(connection).SaveAttempt(&delivery.Attempt{}))
+	})
+}
+
+func TestRepository_ListOne(t *testing.T) {
+	t.Run("Should return delivery without error", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Find").Return(response.NewResponse(1, nil, &delivery.Delivery{}))
+		connection := &database.Connection{Read: dbRead, Write: &database.Mock{}}
+		res, err := NewDeliveryRepository(connection).ListOne(map[string]interface{}{})
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("Should return error not found when delivery not exists", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Find").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+		connection := &database.Connection{Read: dbRead, Write: &database.Mock{}}
+		res, err := NewDeliveryRepository(connection).ListOne(map[string]interface{}{})
+		assert.Equal(t, enums.ErrorNotFoundRecords, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestRepository_ListByWebhook(t *testing.T) {
+	t.Run("Should return deliveries without error", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(1, nil, nil))
+		dbRead.On("Find").Return(response.NewResponse(1, nil, nil))
+		connection := &database.Connection{Read: dbRead, Write: &database.Mock{}}
+		res, err := NewDeliveryRepository(connection).ListByWebhook(uuid.New(), 1, 10)
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("Should return error when list deliveries", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		connection := &database.Connection{Read: dbRead, Write: &database.Mock{}}
+		res, err := NewDeliveryRepository(connection).ListByWebhook(uuid.New(), 1, 10)
+		assert.Error(t, err)
+		assert.Empty(t, res)
+	})
+}
+
+func TestRepository_setHistory(t *testing.T) {
+	t.Run("Should set history ordered by attempt number", func(t *testing.T) {
+		deliveryID := uuid.New()
+		attempts := []delivery.Attempt{{DeliveryID: deliveryID, Number: 2}, {DeliveryID: deliveryID, Number: 1}}
+		grouped := (&Repository{}).groupAttemptsByDelivery(attempts)
+		assert.Len(t, grouped[deliveryID], 2)
+		assert.Equal(t, 1, grouped[deliveryID][0].Number)
+	})
+	t.Run("Should return error when list attempts", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Find").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		repository := &Repository{dbRead: dbRead}
+		assert.Error(t, repository.setHistory([]delivery.Delivery{{DeliveryID: uuid.New()}}))
+	})
+}
+
+func TestRepository_ExistsByEventKey(t *testing.T) {
+	t.Run("Should return true when delivery of event already exists", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Find").Return(response.NewResponse(1, nil, &delivery.Delivery{DeliveryID: uuid.New()}))
+		connection := &database.Connection{Read: dbRead, Write: &database.Mock{}}
+		exists, err := NewDeliveryRepository(connection).ExistsByEventKey(uuid.New(), "test")
+		assert.NoError(t, err)
+		assert.True(t, exists)
+	})
+	t.Run("Should return false when delivery of event not exists", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Find").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+		connection := &database.Connection{Read: dbRead, Write: &database.Mock{}}
+		exists, err := NewDeliveryRepository(connection).ExistsByEventKey(uuid.New(), "test")
+		assert.NoError(t, err)
+		assert.False(t, exists)
+	})
+	t.Run("Should return error when find delivery of event fails", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Find").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		connection := &database.Connection{Read: dbRead, Write: &database.Mock{}}
+		_, err := NewDeliveryRepository(connection).ExistsByEventKey(uuid.New(), "test")
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_ClaimPending(t *testing.T) {
+	pending := &[]delivery.Delivery{{DeliveryID: uuid.New()}, {DeliveryID: uuid.New()}}
+
+	t.Run("Should claim pending deliveries through the write connection", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(2, nil, pending))
+		dbWrite := &database.Mock{}
+		dbWrite.On("Update").Return(response.NewResponse(1, nil, nil))
+		connection := &database.Connection{Read: dbRead, Write: dbWrite}
+		res, err := NewDeliveryRepository(connection).ClaimPending(10, time.Minute)
+		assert.NoError(t, err)
+		assert.Len(t, *res, 2)
+		assert.NotNil(t, (*res)[0].NextRetryAt)
+		dbWrite.AssertNumberOfCalls(t, "Update", 2)
+	})
+	t.Run("Should skip deliveries claimed by another instance", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(2, nil, pending))
+		dbWrite := &database.Mock{}
+		dbWrite.On("Update").Return(response.NewResponse(0, nil, nil))
+		connection := &database.Connection{Read: dbRead, Write: dbWrite}
+		res, err := NewDeliveryRepository(connection).ClaimPending(10, time.Minute)
+		assert.NoError(t, err)
+		assert.Empty(t, *res)
+	})
+	t.Run("Should return error when claim delivery fails", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(2, nil, pending))
+		dbWrite := &database.Mock{}
+		dbWrite.On("Update").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		connection := &database.Connection{Read: dbRead, Write: dbWrite}
+		_, err := NewDeliveryRepository(connection).ClaimPending(10, time.Minute)
+		assert.Error(t, err)
+	})
+	t.Run("Should return error when list pending deliveries fails", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		connection := &database.Connection{Read: dbRead, Write: &database.Mock{}}
+		_, err := NewDeliveryRepository(connection).ClaimPending(10, time.Minute)
+		assert.Error(t, err)
+	})
+	t.Run("Should not return error when there are no pending deliveries", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+		connection := &database.Connection{Read: dbRead, Write: &database.Mock{}}
+		_, err := NewDeliveryRepository(connection).ClaimPending(10, time.Minute)
+		assert.NoError(t, err)
+	})
+}
@@ -15,18 +15,23 @@
 package webhook
 
 import (
+	"database/sql"
+
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
 	"github.com/google/uuid"
 
 	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
 )
 
 type IWebhookRepository interface {
 	Save(entity *webhook.Webhook) error
-	Update(entity *webhook.Webhook, webhookID uuid.UUID) error
+	Update(entity *webhook.Webhook, workspaceID, webhookID uuid.UUID) error
+	UpdateSecret(entity *webhook.Webhook) error
 	ListAll(workspaceID uuid.UUID) (entities *[]webhook.WithRepository, err error)
 	ListOne(condition map[string]interface{}) (entity *webhook.Webhook, err error)
-	Remove(webhookID uuid.UUID) error
+	ListSubscribed(workspaceID, repositoryID uuid.UUID, eventType enums.EventType) (*[]webhook.Webhook, error)
+	Remove(workspaceID, webhookID uuid.UUID) error
 }
 
 type Repository struct {
@@ -45,9 +50,14 @@ func (r *Repository) Save(entity *webhook.Webhook) error {
 	return r.dbWrite.Create(entity, entity.GetTable()).GetErrorExceptNotFound()
 }
 
-func (r *Repository) Update(entity *webhook.Webhook, webhookID uuid.UUID) error {
-	condition := map[string]interface{}{"webhook_id": webhookID}
-	return r.dbWrite.Update(entity, condition, entity.GetTable()).GetError()
+func (r *Repository) Update(entity *webhook.Webhook, workspaceID, webhookID uuid.UUID) error {
+	condition := map[string]interface{}{"workspace_id": workspaceID, "webhook_id": webhookID}
+	return r.dbWrite.Update(entity.ToUpdateMap(), condition, entity.GetTable()).GetError()
+}
+
+func (r *Repository) UpdateSecret(entity *webhook.Webhook) error {
+	condition := map[string]interface{}{"webhook_id": entity.WebhookID}
+	return r.dbWrite.Update(entity.ToSecretUpdateMap(), condition, entity.GetTable()).GetError()
 }
 
 func (r *Repository) ListAll(workspaceID uuid.UUID) (entities *[]webhook.WithRepository, err error) {
@@ -76,7 +86,24 @@ func (r *Repository) ListOne(condition map[string]interface{}) (entity *webhook.
 	return res.GetData().(*webhook.Webhook), nil
 }
 
-func (r *Repository) Remove(webhookID uuid.UUID) error {
-	condition := map[string]interface{}{"webhook_id": webhookID}
+func (r *Repository) ListSubscribed(workspaceID, repositoryID uuid.UUID,
+	eventType enums.EventType) (*[]webhook.Webhook, error) {
+	entities := &[]webhook.Webhook{}
+
+	return entities, r.dbRead.Raw(r.queryListSubscribed(), entities, sql.Named("workspaceID", workspaceID),
+		sql.Named("repositoryID", repositoryID), sql.Named("eventType", eventType.ToString())).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListSubscribed() string {
+	return `
+		SELECT * FROM webhooks
+		WHERE workspace_id = @workspaceID
+		AND (repository_id = @repositoryID OR repository_id IS NULL)
+		AND events @> jsonb_build_array(CAST(@eventType AS TEXT))
+	`
+}
+
+func (r *Repository) Remove(workspaceID, webhookID uuid.UUID) error {
+	condition := map[string]interface{}{"workspace_id": workspaceID, "webhook_id": webhookID}
 	return r.dbWrite.Delete(condition, (&webhook.Webhook{}).GetTable()).GetError()
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
 )
 
 type Mock struct {
@@ -31,11 +32,16 @@ func (m *Mock) Save(_ *webhook.Webhook) error {
 	return utilsMock.ReturnNilOrError(args, 0)
 }
 
-func (m *Mock) Update(_ *webhook.Webhook, _ uuid.UUID) error {
+func (m *Mock) Update(_ *webhook.Webhook, _, _ uuid.UUID) error {
 	args := m.MethodCalled("Update")
 	return utilsMock.ReturnNilOrError(args, 0)
 }
 
+func (m *Mock) UpdateSecret(_ *webhook.Webhook) error {
+	args := m.MethodCalled("UpdateSecret")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
 func (m *Mock) ListAll(_ uuid.UUID) (entities *[]webhook.WithRepository, err error) {
 	args := m.MethodCalled("ListAll")
 	return args.Get(0).(*[]webhook.WithRepository), utilsMock.ReturnNilOrError(args, 1)
@@ -46,7 +52,12 @@ func (m *Mock) ListOne(_ map[string]interface{}) (entity *webhook.Webhook, err e
 	return args.Get(0).(*webhook.Webhook), utilsMock.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) Remove(_ uuid.UUID) error {
+func (m *Mock) ListSubscribed(_, _ uuid.UUID, _ enums.EventType) (*[]webhook.Webhook, error) {
+	args := m.MethodCalled("ListSubscribed")
+	return args.Get(0).(*[]webhook.Webhook), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Remove(_, _ uuid.UUID) error {
 	args := m.MethodCalled("Remove")
 	return utilsMock.ReturnNilOrError(args, 0)
 }
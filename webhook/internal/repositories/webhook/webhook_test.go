@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
 )
 
 func TestRepository_ListAll(t *testing.T) {
@@ -110,6 +111,29 @@ func TestRepository_ListOne(t *testing.T) {
 	})
 }
 
+func TestRepository_ListSubscribed(t *testing.T) {
+	t.Run("Should return subscribed webhooks without errors", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(0, nil, &[]webhook.Webhook{{WebhookID: uuid.New()}}))
+		connection := &database.Connection{
+			Read:  dbRead,
+			Write: &database.Mock{},
+		}
+		_, err := NewWebhookRepository(connection).ListSubscribed(uuid.New(), uuid.New(), enums.EventNewAnalysis)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error unknown on list subscribed webhooks", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		connection := &database.Connection{
+			Read:  dbRead,
+			Write: &database.Mock{},
+		}
+		_, err := NewWebhookRepository(connection).ListSubscribed(uuid.New(), uuid.New(), enums.EventNewAnalysis)
+		assert.Error(t, err)
+	})
+}
+
 func TestRepository_Save(t *testing.T) {
 	t.Run("Should save new webhook without error", func(t *testing.T) {
 		dbRead := &database.Mock{}
@@ -135,6 +159,29 @@ func TestRepository_Save(t *testing.T) {
 	})
 }
 
+func TestRepository_UpdateSecret(t *testing.T) {
+	t.Run("Should update webhook secret without error", func(t *testing.T) {
+		dbWrite := &database.Mock{}
+		dbWrite.On("Update").Return(response.NewResponse(1, nil, nil))
+		connection := &database.Connection{
+			Read:  &database.Mock{},
+			Write: dbWrite,
+		}
+		err := NewWebhookRepository(connection).UpdateSecret(&webhook.Webhook{WebhookID: uuid.New()})
+		assert.NoError(t, err)
+	})
+	t.Run("Should update webhook secret with error", func(t *testing.T) {
+		dbWrite := &database.Mock{}
+		dbWrite.On("Update").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		connection := &database.Connection{
+			Read:  &database.Mock{},
+			Write: dbWrite,
+		}
+		err := NewWebhookRepository(connection).UpdateSecret(&webhook.Webhook{WebhookID: uuid.New()})
+		assert.Error(t, err)
+	})
+}
+
 func TestRepository_Update(t *testing.T) {
 	t.Run("Should update webhook without error", func(t *testing.T) {
 		dbRead := &database.Mock{}
@@ -144,7 +191,19 @@ func TestRepository_Update(t *testing.T) {
 			Read:  dbRead,
 			Write: dbWrite,
 		}
-		err := NewWebhookRepository(connection).Update(&webhook.Webhook{}, uuid.New())
+		err := NewWebhookRepository(connection).Update(&webhook.Webhook{}, uuid.New(), uuid.New())
+		assert.NoError(t, err)
+	})
+	t.Run("Should update webhook clearing repository, template and filters without error", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbWrite := &database.Mock{}
+		dbWrite.On("Update").Return(response.NewResponse(1, nil, nil))
+		connection := &database.Connection{
+			Read:  dbRead,
+			Write: dbWrite,
+		}
+		entity := &webhook.Webhook{RepositoryID: nil, Template: "", Filters: webhook.Filters{}}
+		err := NewWebhookRepository(connection).Update(entity, uuid.New(), uuid.New())
 		assert.NoError(t, err)
 	})
 	t.Run("Should update webhook with error", func(t *testing.T) {
@@ -155,7 +214,7 @@ func TestRepository_Update(t *testing.T) {
 			Read:  dbRead,
 			Write: dbWrite,
 		}
-		err := NewWebhookRepository(connection).Update(&webhook.Webhook{}, uuid.New())
+		err := NewWebhookRepository(connection).Update(&webhook.Webhook{}, uuid.New(), uuid.New())
 		assert.Error(t, err)
 	})
 }
@@ -169,7 +228,7 @@ func TestRepository_Remove(t *testing.T) {
 			Read:  dbRead,
 			Write: dbWrite,
 		}
-		err := NewWebhookRepository(connection).Remove(uuid.New())
+		err := NewWebhookRepository(connection).Remove(uuid.New(), uuid.New())
 		assert.NoError(t, err)
 	})
 	t.Run("Should remove webhook with error", func(t *testing.T) {
@@ -180,7 +239,7 @@ func TestRepository_Remove(t *testing.T) {
 			Read:  dbRead,
 			Write: dbWrite,
 		}
-		err := NewWebhookRepository(connection).Remove(uuid.New())
+		err := NewWebhookRepository(connection).Remove(uuid.New(), uuid.New())
 		assert.Error(t, err)
 	})
 }
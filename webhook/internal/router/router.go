@@ -21,6 +21,7 @@ import (
 
 	webhookEvent "github.com/ZupIT/horusec-platform/webhook/internal/events/webhook"
 	"github.com/ZupIT/horusec-platform/webhook/internal/handlers/webhook"
+	deliveryJob "github.com/ZupIT/horusec-platform/webhook/internal/jobs/delivery"
 
 	"github.com/ZupIT/horusec-platform/webhook/internal/handlers/health"
 
@@ -42,10 +43,12 @@ type Router struct {
 	healthHandler  *health.Handler
 	webhookHandler *webhook.Handler
 	webhookEvents  webhookEvent.IEvent
+	deliveryJob    deliveryJob.IJob
 }
 
 func NewHTTPRouter(routerConn router.IRouter, authzMiddleware middlewares.IAuthzMiddleware,
-	healthHandler *health.Handler, webhookHandler *webhook.Handler, webhookEvents webhookEvent.IEvent) IRouter {
+	healthHandler *health.Handler, webhookHandler *webhook.Handler, webhookEvents webhookEvent.IEvent,
+	deliveryJob deliveryJob.IJob) IRouter {
 	routes := &Router{
 		IRouter:          routerConn,
 		IAuthzMiddleware: authzMiddleware,
@@ -53,6 +56,7 @@ func NewHTTPRouter(routerConn router.IRouter, authzMiddleware middlewares.IAuthz
 		healthHandler:    healthHandler,
 		webhookHandler:   webhookHandler,
 		webhookEvents:    webhookEvents,
+		deliveryJob:      deliveryJob,
 	}
 	return routes.setRoutes()
 }
@@ -83,5 +87,10 @@ func (r *Router) routerWebhook() {
 		router.With(r.IsWorkspaceAdmin).Post("/", r.webhookHandler.Save)
 		router.With(r.IsWorkspaceAdmin).Put("/{webhookID}", r.webhookHandler.Update)
 		router.With(r.IsWorkspaceAdmin).Delete("/{webhookID}", r.webhookHandler.Remove)
+		router.With(r.IsWorkspaceAdmin).Get("/{webhookID}/deliveries", r.webhookHandler.ListDeliveries)
+		router.With(r.IsWorkspaceAdmin).Post("/{webhookID}/deliveries/{deliveryID}/redeliver",
+			r.webhookHandler.Redeliver)
+		router.With(r.IsWorkspaceAdmin).Post("/{webhookID}/secret/rotate", r.webhookHandler.RotateSecret)
+		router.With(r.IsWorkspaceAdmin).Post("/{webhookID}/test", r.webhookHandler.TestWebhook)
 	})
 }
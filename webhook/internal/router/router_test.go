@@ -19,6 +19,7 @@ import (
 
 	webhook2 "github.com/ZupIT/horusec-platform/webhook/internal/events/webhook"
 	"github.com/ZupIT/horusec-platform/webhook/internal/handlers/webhook"
+	deliveryJob "github.com/ZupIT/horusec-platform/webhook/internal/jobs/delivery"
 
 	"github.com/ZupIT/horusec-platform/webhook/internal/handlers/health"
 
@@ -37,7 +38,8 @@ func TestNewHTTPRouter(t *testing.T) {
 		healthMock := &health.Handler{}
 		webhookHandlerMock := &webhook.Handler{}
 		webhookEventMock := &webhook2.Event{}
-		instance := NewHTTPRouter(routerConn, middlewareMock, healthMock, webhookHandlerMock, webhookEventMock)
+		instance := NewHTTPRouter(routerConn, middlewareMock, healthMock, webhookHandlerMock, webhookEventMock,
+			&deliveryJob.Job{})
 		assert.NotEmpty(t, instance)
 	})
 }
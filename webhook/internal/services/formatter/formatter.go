@@ -0,0 +1,90 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/diff"
+	eventEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/event"
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/summary"
+	webhookEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+type IFormatter interface {
+	Format(webhook *webhookEntity.Webhook, event *eventEntity.Event) ([]byte, error)
+	ContentType(webhook *webhookEntity.Webhook, payload []byte) string
+}
+
+type Formatter struct {
+	managerURL string
+}
+
+func NewFormatterService() IFormatter {
+	return &Formatter{
+		managerURL: env.GetHorusecManagerURL(),
+	}
+}
+
+// Format renders the body that will be sent to the webhook, the full format keeps the event payload untouched
+func (f *Formatter) Format(webhook *webhookEntity.Webhook, event *eventEntity.Event) ([]byte, error) {
+	if webhook.Format == "" || webhook.Format == enums.FormatFull {
+		return event.Payload, nil
+	}
+
+	eventSummary, err := summary.NewSummary(event, f.managerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.render(webhook, eventSummary, event)
+}
+
+func (f *Formatter) render(webhook *webhookEntity.Webhook, eventSummary *summary.Summary,
+	event *eventEntity.Event) ([]byte, error) {
+	switch webhook.Format {
+	case enums.FormatSlack:
+		return json.Marshal(NewSlackMessage(eventSummary))
+	case enums.FormatTeams:
+		return json.Marshal(NewTeamsMessage(eventSummary))
+	case enums.FormatTemplate:
+		return ExecuteTemplate(webhook.Template, NewTemplateData(eventSummary, event))
+	default:
+		return json.Marshal(eventSummary)
+	}
+}
+
+// ContentType returns the content type of the rendered payload, templates may render any text, so they are only sent
+// as json when the rendered payload is a valid json
+func (f *Formatter) ContentType(webhook *webhookEntity.Webhook, payload []byte) string {
+	if webhook.Format == enums.FormatTemplate && !json.Valid(payload) {
+		return enums.ContentTypeText
+	}
+
+	return enums.ContentTypeJSON
+}
+
+// describeDiffGroup describes the total of a diff group with the counts by severity, like "3 (2 HIGH, 1 LOW)"
+func describeDiffGroup(group *diff.Group) string {
+	if group.Total == 0 {
+		return "0"
+	}
+
+	return fmt.Sprintf("%d (%s)", group.Total, group.String())
+}
@@ -0,0 +1,37 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatter
+
+import (
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+	"github.com/stretchr/testify/mock"
+
+	eventEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/event"
+	webhookEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Format(_ *webhookEntity.Webhook, _ *eventEntity.Event) ([]byte, error) {
+	args := m.MethodCalled("Format")
+	return args.Get(0).([]byte), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ContentType(_ *webhookEntity.Webhook, _ []byte) string {
+	args := m.MethodCalled("ContentType")
+	return args.Get(0).(string)
+}
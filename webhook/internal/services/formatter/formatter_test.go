@@ -0,0 +1,196 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	vulnerabilityEntity "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/diff"
+	eventEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/event"
+	webhookEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+func newAnalysisEvent() *eventEntity.Event {
+	return eventEntity.NewEventFromAnalysis(&analysis.Analysis{
+		ID:             uuid.New(),
+		WorkspaceID:    uuid.New(),
+		RepositoryID:   uuid.New(),
+		RepositoryName: "my-repository",
+		AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+			{Vulnerability: vulnerabilityEntity.Vulnerability{Severity: severities.High, Type: vulnerability.Vulnerability}},
+		},
+	})
+}
+
+func newAnalysisEventWithDiff() *eventEntity.Event {
+	event := newAnalysisEvent()
+	event.Diff = diff.NewDiff(nil, &analysis.Analysis{
+		AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+			{Vulnerability: vulnerabilityEntity.Vulnerability{VulnHash: "1", Severity: severities.High,
+				Type: vulnerability.Vulnerability}},
+		},
+	})
+
+	return event
+}
+
+func TestNewFormatterService(t *testing.T) {
+	assert.NotNil(t, NewFormatterService())
+}
+
+func TestFormatter_Format(t *testing.T) {
+	formatter := &Formatter{managerURL: "http://localhost:8043"}
+
+	t.Run("Should keep event payload on full format", func(t *testing.T) {
+		event := newAnalysisEvent()
+		payload, err := formatter.Format(&webhookEntity.Webhook{Format: enums.FormatFull}, event)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(event.Payload), payload)
+
+		payload, err = formatter.Format(&webhookEntity.Webhook{}, event)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(event.Payload), payload)
+	})
+	t.Run("Should render summary format", func(t *testing.T) {
+		payload, err := formatter.Format(&webhookEntity.Webhook{Format: enums.FormatSummary}, newAnalysisEvent())
+		assert.NoError(t, err)
+		result := map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal(payload, &result))
+		assert.Equal(t, float64(1), result["totalVulnerabilities"])
+		assert.Equal(t, float64(1), result["severities"].(map[string]interface{})["HIGH"])
+		assert.Contains(t, result["link"], "http://localhost:8043/overview/workspace/")
+		assert.NotContains(t, result, "analysisVulnerabilities")
+	})
+	t.Run("Should render slack block kit format", func(t *testing.T) {
+		payload, err := formatter.Format(&webhookEntity.Webhook{Format: enums.FormatSlack}, newAnalysisEvent())
+		assert.NoError(t, err)
+		message := &SlackMessage{}
+		assert.NoError(t, json.Unmarshal(payload, message))
+		assert.Contains(t, message.Text, "my-repository")
+		assert.Len(t, message.Blocks, 3)
+		assert.Len(t, message.Blocks[1].Fields, len(severities.Values()))
+		assert.Equal(t, "actions", message.Blocks[2].Type)
+	})
+	t.Run("Should render slack block kit format with new and fixed findings", func(t *testing.T) {
+		payload, err := formatter.Format(&webhookEntity.Webhook{Format: enums.FormatSlack}, newAnalysisEventWithDiff())
+		assert.NoError(t, err)
+		message := &SlackMessage{}
+		assert.NoError(t, json.Unmarshal(payload, message))
+		assert.Len(t, message.Blocks, 4)
+		assert.Equal(t, "*New*\n1 (1 HIGH)", message.Blocks[2].Fields[0].Text)
+		assert.Equal(t, "*Fixed*\n0", message.Blocks[2].Fields[1].Text)
+	})
+	t.Run("Should render slack message without severities when event is not an analysis", func(t *testing.T) {
+		event := &eventEntity.Event{Type: enums.EventRepositoryCreated, WorkspaceID: uuid.New(), Payload: []byte("{}")}
+		payload, err := formatter.Format(&webhookEntity.Webhook{Format: enums.FormatSlack}, event)
+		assert.NoError(t, err)
+		message := &SlackMessage{}
+		assert.NoError(t, json.Unmarshal(payload, message))
+		assert.Len(t, message.Blocks, 2)
+	})
+	t.Run("Should render teams message card format", func(t *testing.T) {
+		payload, err := formatter.Format(&webhookEntity.Webhook{Format: enums.FormatTeams}, newAnalysisEvent())
+		assert.NoError(t, err)
+		message := &TeamsMessage{}
+		assert.NoError(t, json.Unmarshal(payload, message))
+		assert.Equal(t, "MessageCard", message.Type)
+		assert.Equal(t, teamsColorDanger, message.ThemeColor)
+		assert.Len(t, message.Sections[0].Facts, 3+len(severities.Values()))
+		assert.Equal(t, "OpenUri", message.PotentialAction[0].Type)
+	})
+	t.Run("Should render teams message card format with new and fixed findings", func(t *testing.T) {
+		payload, err := formatter.Format(&webhookEntity.Webhook{Format: enums.FormatTeams}, newAnalysisEventWithDiff())
+		assert.NoError(t, err)
+		message := &TeamsMessage{}
+		assert.NoError(t, json.Unmarshal(payload, message))
+		facts := message.Sections[0].Facts
+		assert.Len(t, facts, 5+len(severities.Values()))
+		assert.Equal(t, TeamsFact{Name: "New", Value: "1 (1 HIGH)"}, facts[len(facts)-2])
+		assert.Equal(t, TeamsFact{Name: "Fixed", Value: "0"}, facts[len(facts)-1])
+	})
+	t.Run("Should render teams message card to event without analysis", func(t *testing.T) {
+		event := &eventEntity.Event{Type: enums.EventRepositoryCreated, WorkspaceID: uuid.New(), Payload: []byte("{}")}
+		payload, err := formatter.Format(&webhookEntity.Webhook{Format: enums.FormatTeams}, event)
+		assert.NoError(t, err)
+		message := &TeamsMessage{}
+		assert.NoError(t, json.Unmarshal(payload, message))
+		assert.Equal(t, teamsColorDefault, message.ThemeColor)
+		assert.Len(t, message.Sections[0].Facts, 2)
+	})
+	t.Run("Should render user defined template", func(t *testing.T) {
+		webhook := &webhookEntity.Webhook{Format: enums.FormatTemplate,
+			Template: `{"repo": {{ json .RepositoryName }}, "high": {{ index .Severities "HIGH" }}, "id": "{{ .Analysis.ID }}"}`}
+		event := newAnalysisEvent()
+		payload, err := formatter.Format(webhook, event)
+		assert.NoError(t, err)
+		result := map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal(payload, &result))
+		assert.Equal(t, "my-repository", result["repo"])
+		assert.Equal(t, float64(1), result["high"])
+	})
+	t.Run("Should return error when template execution fails", func(t *testing.T) {
+		webhook := &webhookEntity.Webhook{Format: enums.FormatTemplate, Template: `{{ .Unknown }}`}
+		_, err := formatter.Format(webhook, newAnalysisEvent())
+		assert.Error(t, err)
+	})
+	t.Run("Should return error when analysis payload is invalid", func(t *testing.T) {
+		event := &eventEntity.Event{Type: enums.EventNewAnalysis, WorkspaceID: uuid.New(), Payload: []byte("invalid")}
+		_, err := formatter.Format(&webhookEntity.Webhook{Format: enums.FormatSummary}, event)
+		assert.Error(t, err)
+	})
+}
+
+func TestFormatter_ContentType(t *testing.T) {
+	formatter := &Formatter{}
+
+	t.Run("Should return json content type for json formats", func(t *testing.T) {
+		assert.Equal(t, enums.ContentTypeJSON, formatter.ContentType(&webhookEntity.Webhook{}, []byte("{}")))
+		assert.Equal(t, enums.ContentTypeJSON,
+			formatter.ContentType(&webhookEntity.Webhook{Format: enums.FormatSlack}, []byte("{}")))
+	})
+	t.Run("Should return json content type when template renders a json", func(t *testing.T) {
+		assert.Equal(t, enums.ContentTypeJSON,
+			formatter.ContentType(&webhookEntity.Webhook{Format: enums.FormatTemplate}, []byte(`{"text":"a"}`)))
+	})
+	t.Run("Should return text content type when template renders plain text", func(t *testing.T) {
+		assert.Equal(t, enums.ContentTypeText,
+			formatter.ContentType(&webhookEntity.Webhook{Format: enums.FormatTemplate}, []byte("new analysis")))
+	})
+}
+
+func TestValidateTemplate(t *testing.T) {
+	t.Run("Should not return error when template is valid", func(t *testing.T) {
+		assert.NoError(t, ValidateTemplate(`{"text": {{ json .Title }}}`))
+	})
+	t.Run("Should return error when template is invalid", func(t *testing.T) {
+		assert.Equal(t, enums.ErrorInvalidTemplate, ValidateTemplate(`{{ .Title `))
+	})
+}
+
+func TestExecuteTemplate(t *testing.T) {
+	t.Run("Should return error when template is invalid", func(t *testing.T) {
+		_, err := ExecuteTemplate(`{{ if }}`, &TemplateData{})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatter
+
+import (
+	"fmt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/summary"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+type SlackMessage struct {
+	Text   string       `json:"text"`
+	Blocks []SlackBlock `json:"blocks"`
+}
+
+type SlackBlock struct {
+	Type     string        `json:"type"`
+	Text     *SlackText    `json:"text,omitempty"`
+	Fields   []SlackText   `json:"fields,omitempty"`
+	Elements []SlackButton `json:"elements,omitempty"`
+}
+
+type SlackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type SlackButton struct {
+	Type string    `json:"type"`
+	Text SlackText `json:"text"`
+	URL  string    `json:"url"`
+}
+
+// NewSlackMessage creates a Block Kit message, the text field is used by slack as fallback on notifications
+func NewSlackMessage(eventSummary *summary.Summary) *SlackMessage {
+	message := &SlackMessage{
+		Text: eventSummary.GetTitle(),
+		Blocks: []SlackBlock{
+			{Type: "section", Text: &SlackText{Type: "mrkdwn", Text: fmt.Sprintf("*%s*", eventSummary.GetTitle())}},
+		},
+	}
+
+	if eventSummary.IsAnalysis() {
+		message.Blocks = append(message.Blocks, SlackBlock{Type: "section", Fields: newSlackSeverityFields(eventSummary)})
+	}
+
+	if eventSummary.IsAnalysis() && eventSummary.Diff != nil {
+		message.Blocks = append(message.Blocks, SlackBlock{Type: "section", Fields: newSlackDiffFields(eventSummary)})
+	}
+
+	message.Blocks = append(message.Blocks, SlackBlock{Type: "actions", Elements: []SlackButton{
+		{Type: "button", Text: SlackText{Type: "plain_text", Text: enums.ManagerLinkTitle}, URL: eventSummary.Link},
+	}})
+
+	return message
+}
+
+func newSlackSeverityFields(eventSummary *summary.Summary) (fields []SlackText) {
+	for _, severity := range severities.Values() {
+		fields = append(fields, SlackText{
+			Type: "mrkdwn",
+			Text: fmt.Sprintf("*%s*\n%d", severity, eventSummary.Severities[severity.ToString()]),
+		})
+	}
+
+	return fields
+}
+
+func newSlackDiffFields(eventSummary *summary.Summary) []SlackText {
+	return []SlackText{
+		{Type: "mrkdwn", Text: fmt.Sprintf("*New*\n%s", describeDiffGroup(eventSummary.Diff.New))},
+		{Type: "mrkdwn", Text: fmt.Sprintf("*Fixed*\n%s", describeDiffGroup(eventSummary.Diff.Fixed))},
+	}
+}
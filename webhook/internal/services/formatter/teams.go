@@ -0,0 +1,112 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatter
+
+import (
+	"strconv"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/summary"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+const (
+	teamsColorDanger  = "D32F2F"
+	teamsColorSuccess = "388E3C"
+	teamsColorDefault = "0078D7"
+)
+
+type TeamsMessage struct {
+	Type            string        `json:"@type"`
+	Context         string        `json:"@context"`
+	Summary         string        `json:"summary"`
+	Title           string        `json:"title"`
+	ThemeColor      string        `json:"themeColor"`
+	Sections        []TeamsFacts  `json:"sections"`
+	PotentialAction []TeamsAction `json:"potentialAction"`
+}
+
+type TeamsFacts struct {
+	Facts []TeamsFact `json:"facts"`
+}
+
+type TeamsFact struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type TeamsAction struct {
+	Type    string        `json:"@type"`
+	Name    string        `json:"name"`
+	Targets []TeamsTarget `json:"targets"`
+}
+
+type TeamsTarget struct {
+	OS  string `json:"os"`
+	URI string `json:"uri"`
+}
+
+func NewTeamsMessage(eventSummary *summary.Summary) *TeamsMessage {
+	return &TeamsMessage{
+		Type:       "MessageCard",
+		Context:    "https://schema.org/extensions",
+		Summary:    eventSummary.GetTitle(),
+		Title:      eventSummary.GetTitle(),
+		ThemeColor: getTeamsColor(eventSummary),
+		Sections:   []TeamsFacts{{Facts: newTeamsFacts(eventSummary)}},
+		PotentialAction: []TeamsAction{{
+			Type:    "OpenUri",
+			Name:    enums.ManagerLinkTitle,
+			Targets: []TeamsTarget{{OS: "default", URI: eventSummary.Link}},
+		}},
+	}
+}
+
+func getTeamsColor(eventSummary *summary.Summary) string {
+	if !eventSummary.IsAnalysis() {
+		return teamsColorDefault
+	}
+
+	if eventSummary.HasHighRisk() {
+		return teamsColorDanger
+	}
+
+	return teamsColorSuccess
+}
+
+func newTeamsFacts(eventSummary *summary.Summary) []TeamsFact {
+	facts := []TeamsFact{
+		{Name: "Event", Value: eventSummary.Event.ToString()},
+		{Name: "Workspace", Value: eventSummary.WorkspaceID.String()},
+	}
+
+	if !eventSummary.IsAnalysis() {
+		return facts
+	}
+
+	facts = append(facts, TeamsFact{Name: "Repository", Value: eventSummary.RepositoryName})
+	for _, severity := range severities.Values() {
+		facts = append(facts, TeamsFact{Name: severity.ToString(),
+			Value: strconv.Itoa(eventSummary.Severities[severity.ToString()])})
+	}
+
+	if eventSummary.Diff != nil {
+		facts = append(facts, TeamsFact{Name: "New", Value: describeDiffGroup(eventSummary.Diff.New)},
+			TeamsFact{Name: "Fixed", Value: describeDiffGroup(eventSummary.Diff.Fixed)})
+	}
+
+	return facts
+}
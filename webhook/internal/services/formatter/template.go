@@ -0,0 +1,76 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatter
+
+import (
+	"bytes"
+	"encoding/json"
+	"text/template"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/event"
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/summary"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+// TemplateData is the data available to user defined templates, besides the summary fields the full analysis
+// can be accessed by .Analysis when the event is a new analysis and the raw event payload by .Payload
+type TemplateData struct {
+	*summary.Summary
+	Title   string
+	Payload string
+}
+
+func NewTemplateData(eventSummary *summary.Summary, event *event.Event) *TemplateData {
+	return &TemplateData{
+		Summary: eventSummary,
+		Title:   eventSummary.GetTitle(),
+		Payload: string(event.Payload),
+	}
+}
+
+func newTemplate(text string) (*template.Template, error) {
+	return template.New("webhook").Option("missingkey=error").Funcs(template.FuncMap{
+		"json": toJSON,
+	}).Parse(text)
+}
+
+// toJSON allows templates to escape values to be used inside a json body, like {"text": {{ json .Title }}}
+func toJSON(value interface{}) (string, error) {
+	bytes, err := json.Marshal(value)
+	return string(bytes), err
+}
+
+func ValidateTemplate(value interface{}) error {
+	text, _ := value.(string)
+	if _, err := newTemplate(text); err != nil {
+		return enums.ErrorInvalidTemplate
+	}
+
+	return nil
+}
+
+func ExecuteTemplate(text string, data *TemplateData) ([]byte, error) {
+	parsed, err := newTemplate(text)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := parsed.Execute(buffer, data); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"strconv"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+type ISignature interface {
+	GenerateSecret() (string, error)
+	Encrypt(secret string) (string, error)
+	Decrypt(encrypted string) (string, error)
+	Sign(secret string, timestamp int64, body []byte) string
+}
+
+type Signature struct {
+	key []byte
+}
+
+func NewSignatureService() ISignature {
+	return &Signature{
+		key: getSecretKey(),
+	}
+}
+
+// getSecretKey panics when the key is not configured, since there is no safe value to encrypt the webhook secrets
+func getSecretKey() []byte {
+	secretKey := env.GetEnvOrDefault(enums.EnvWebhookSecretKey, "")
+	if len(secretKey) < enums.SecretKeyMinLength {
+		logger.LogPanic(enums.MessageInvalidSecretKey, enums.ErrorInvalidSecretKey)
+	}
+
+	key := sha256.Sum256([]byte(secretKey))
+	return key[:]
+}
+
+func (s *Signature) GenerateSecret() (string, error) {
+	secret := make([]byte, enums.SecretLength)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
+
+// Encrypt uses AES-GCM to store the secret, it cannot be hashed since the plain value is needed to sign the payloads
+func (s *Signature) Encrypt(secret string) (string, error) {
+	gcm, err := s.newGCM()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(secret), nil)), nil
+}
+
+func (s *Signature) Decrypt(encrypted string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", enums.ErrorInvalidSecret
+	}
+
+	gcm, err := s.newGCM()
+	if err != nil {
+		return "", err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return "", enums.ErrorInvalidSecret
+	}
+
+	secret, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", enums.ErrorInvalidSecret
+	}
+
+	return string(secret), nil
+}
+
+func (s *Signature) newGCM() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(s.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// Sign returns the HMAC-SHA256 of the timestamp and body joined by a dot, receivers must compute the same value
+// using the timestamp header and reject old timestamps to avoid replay attacks
+func (s *Signature) Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	_, _ = mac.Write(body)
+
+	return enums.SignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,44 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+	"github.com/stretchr/testify/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GenerateSecret() (string, error) {
+	args := m.MethodCalled("GenerateSecret")
+	return args.Get(0).(string), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Encrypt(_ string) (string, error) {
+	args := m.MethodCalled("Encrypt")
+	return args.Get(0).(string), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Decrypt(_ string) (string, error) {
+	args := m.MethodCalled("Decrypt")
+	return args.Get(0).(string), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Sign(_ string, _ int64, _ []byte) string {
+	args := m.MethodCalled("Sign")
+	return args.Get(0).(string)
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+const testSecretKey = "horusec-webhook-secret-key-for-tests"
+
+func TestMain(m *testing.M) {
+	_ = os.Setenv(enums.EnvWebhookSecretKey, testSecretKey)
+	os.Exit(m.Run())
+}
+
+func TestNewSignatureService(t *testing.T) {
+	t.Run("Should create service with key from environment", func(t *testing.T) {
+		expected := sha256.Sum256([]byte(testSecretKey))
+		assert.Equal(t, expected[:], NewSignatureService().(*Signature).key)
+	})
+	t.Run("Should panic when secret key is not configured", func(t *testing.T) {
+		t.Setenv(enums.EnvWebhookSecretKey, "")
+		assert.Panics(t, func() {
+			NewSignatureService()
+		})
+	})
+	t.Run("Should panic when secret key is too short", func(t *testing.T) {
+		t.Setenv(enums.EnvWebhookSecretKey, "horusec-webhook-secret")
+		assert.Panics(t, func() {
+			NewSignatureService()
+		})
+	})
+}
+
+func TestSignature_GenerateSecret(t *testing.T) {
+	t.Run("Should generate different random secrets", func(t *testing.T) {
+		service := NewSignatureService()
+		first, err := service.GenerateSecret()
+		assert.NoError(t, err)
+		second, _ := service.GenerateSecret()
+		assert.Len(t, first, enums.SecretLength*2)
+		assert.NotEqual(t, first, second)
+	})
+}
+
+func TestSignature_EncryptAndDecrypt(t *testing.T) {
+	t.Run("Should encrypt and decrypt secret", func(t *testing.T) {
+		service := NewSignatureService()
+		encrypted, err := service.Encrypt("my-secret")
+		assert.NoError(t, err)
+		assert.NotContains(t, encrypted, "my-secret")
+		secret, err := service.Decrypt(encrypted)
+		assert.NoError(t, err)
+		assert.Equal(t, "my-secret", secret)
+	})
+	t.Run("Should return error when decrypt with other key", func(t *testing.T) {
+		encrypted, _ := NewSignatureService().Encrypt("my-secret")
+		other := &Signature{key: make([]byte, 32)}
+		_, err := other.Decrypt(encrypted)
+		assert.Equal(t, enums.ErrorInvalidSecret, err)
+	})
+	t.Run("Should return error when decrypt invalid values", func(t *testing.T) {
+		service := NewSignatureService()
+		_, err := service.Decrypt("not base64 !")
+		assert.Equal(t, enums.ErrorInvalidSecret, err)
+		_, err = service.Decrypt("YQ==")
+		assert.Equal(t, enums.ErrorInvalidSecret, err)
+	})
+	t.Run("Should return error when key is invalid", func(t *testing.T) {
+		service := &Signature{key: []byte("invalid")}
+		_, err := service.Encrypt("my-secret")
+		assert.Error(t, err)
+		_, err = service.Decrypt("YWFhYWFhYWFhYWFhYWFhYQ==")
+		assert.Error(t, err)
+	})
+}
+
+func TestSignature_Sign(t *testing.T) {
+	t.Run("Should sign timestamp and body with hmac sha256", func(t *testing.T) {
+		mac := hmac.New(sha256.New, []byte("my-secret"))
+		_, _ = mac.Write([]byte(`1640908799.{"id":"1"}`))
+		expected := enums.SignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+		assert.Equal(t, expected, NewSignatureService().Sign("my-secret", 1640908799, []byte(`{"id":"1"}`)))
+	})
+	t.Run("Should return different signatures to different timestamps", func(t *testing.T) {
+		service := NewSignatureService()
+		assert.NotEqual(t, service.Sign("my-secret", 1, []byte("{}")), service.Sign("my-secret", 2, []byte("{}")))
+	})
+}
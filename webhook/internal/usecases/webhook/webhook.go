@@ -16,8 +16,10 @@ package webhook
 
 import (
 	netHTTP "net/http"
+	"strconv"
 
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+	parserEnums "github.com/ZupIT/horusec-devkit/pkg/utils/parser/enums"
 	"github.com/go-chi/chi"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
@@ -25,12 +27,16 @@ import (
 
 	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
 	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+	"github.com/ZupIT/horusec-platform/webhook/internal/services/formatter"
 )
 
 type IUseCaseWebhook interface {
 	DecodeWebhookFromIoRead(r *netHTTP.Request) (*webhook.Webhook, error)
 	ExtractWebhookIDFromURL(r *netHTTP.Request) (uuid.UUID, error)
 	ExtractWorkspaceIDFromURL(r *netHTTP.Request) (uuid.UUID, error)
+	ExtractDeliveryIDFromURL(r *netHTTP.Request) (uuid.UUID, error)
+	ExtractPaginationFromURL(r *netHTTP.Request) (page, size int, err error)
+	DecodeRotateSecretFromIoRead(r *netHTTP.Request) (*webhook.RotateSecret, error)
 }
 
 type UseCaseWebhook struct{}
@@ -43,7 +49,20 @@ func (uc *UseCaseWebhook) DecodeWebhookFromIoRead(r *netHTTP.Request) (entity *w
 	if err := parser.ParseBodyToEntity(r.Body, &entity); err != nil {
 		return nil, err
 	}
-	return entity, uc.validateWebhook(entity)
+	if entity == nil {
+		return nil, parserEnums.ErrorBodyEmpty
+	}
+	return entity.SetDefaultValues(), uc.validateWebhook(entity)
+}
+
+func (uc *UseCaseWebhook) DecodeRotateSecretFromIoRead(r *netHTTP.Request) (*webhook.RotateSecret, error) {
+	entity := &webhook.RotateSecret{}
+	if err := parser.ParseBodyToEntity(r.Body, entity); err != nil && err != parserEnums.ErrorBodyEmpty {
+		return nil, err
+	}
+	return entity, validation.ValidateStruct(entity,
+		validation.Field(&entity.GracePeriodHours, validation.Min(0), validation.Max(enums.MaxGracePeriodHours)),
+	)
 }
 
 func (uc *UseCaseWebhook) ExtractWebhookIDFromURL(r *netHTTP.Request) (uuid.UUID, error) {
@@ -62,11 +81,50 @@ func (uc *UseCaseWebhook) ExtractWorkspaceIDFromURL(r *netHTTP.Request) (uuid.UU
 	return ID, nil
 }
 
+func (uc *UseCaseWebhook) ExtractDeliveryIDFromURL(r *netHTTP.Request) (uuid.UUID, error) {
+	ID, err := uuid.Parse(chi.URLParam(r, enums.DeliveryID))
+	if err != nil || ID == uuid.Nil {
+		return uuid.Nil, enums.ErrorWrongDeliveryID
+	}
+	return ID, nil
+}
+
+func (uc *UseCaseWebhook) ExtractPaginationFromURL(r *netHTTP.Request) (page, size int, err error) {
+	page, err = uc.getQueryIntOrDefault(r, enums.Page, 1)
+	if err != nil {
+		return 0, 0, err
+	}
+	size, err = uc.getQueryIntOrDefault(r, enums.Size, enums.DefaultPaginationSize)
+	if err != nil {
+		return 0, 0, err
+	}
+	return page, size, nil
+}
+
+func (uc *UseCaseWebhook) getQueryIntOrDefault(r *netHTTP.Request, key string, defaultValue int) (int, error) {
+	if r.URL.Query().Get(key) == "" {
+		return defaultValue, nil
+	}
+	value, err := strconv.Atoi(r.URL.Query().Get(key))
+	if err != nil || value < 1 {
+		return 0, enums.ErrorWrongPagination
+	}
+	return value, nil
+}
+
 func (uc *UseCaseWebhook) validateWebhook(entity *webhook.Webhook) error {
 	return validation.ValidateStruct(entity,
 		validation.Field(&entity.URL, validation.Required, is.URL),
 		validation.Field(&entity.Method, validation.Required, validation.In(netHTTP.MethodPost)),
-		validation.Field(&entity.RepositoryID, validation.Required, is.UUID),
+		validation.Field(&entity.Events, validation.Required, validation.Each(validation.In(enums.EventNewAnalysis,
+			enums.EventVulnerabilityStatusChanged, enums.EventRepositoryCreated, enums.EventTokenExpiring))),
+		validation.Field(&entity.MaxAttempts, validation.Min(1), validation.Max(enums.MaxAllowedAttempts)),
+		validation.Field(&entity.Format, validation.Required, validation.In(enums.FormatFull, enums.FormatSummary,
+			enums.FormatSlack, enums.FormatTeams, enums.FormatTemplate)),
+		validation.Field(&entity.Template, validation.When(entity.Format == enums.FormatTemplate, validation.Required),
+			validation.Length(0, enums.MaxTemplateLength), validation.By(formatter.ValidateTemplate)),
+		validation.Field(&entity.Filters),
+		validation.Field(&entity.RepositoryID, is.UUID),
 		validation.Field(&entity.WorkspaceID, validation.Required, is.UUID),
 	)
 }
@@ -40,3 +40,15 @@ func (m *Mock) ExtractWorkspaceIDFromURL(_ *netHTTP.Request) (uuid.UUID, error)
 	args := m.MethodCalled("ExtractWorkspaceIDFromURL")
 	return args.Get(0).(uuid.UUID), utilsMock.ReturnNilOrError(args, 1)
 }
+func (m *Mock) ExtractDeliveryIDFromURL(_ *netHTTP.Request) (uuid.UUID, error) {
+	args := m.MethodCalled("ExtractDeliveryIDFromURL")
+	return args.Get(0).(uuid.UUID), utilsMock.ReturnNilOrError(args, 1)
+}
+func (m *Mock) ExtractPaginationFromURL(_ *netHTTP.Request) (page, size int, err error) {
+	args := m.MethodCalled("ExtractPaginationFromURL")
+	return args.Get(0).(int), args.Get(1).(int), utilsMock.ReturnNilOrError(args, 2)
+}
+func (m *Mock) DecodeRotateSecretFromIoRead(_ *netHTTP.Request) (*webhook.RotateSecret, error) {
+	args := m.MethodCalled("DecodeRotateSecretFromIoRead")
+	return args.Get(0).(*webhook.RotateSecret), utilsMock.ReturnNilOrError(args, 1)
+}
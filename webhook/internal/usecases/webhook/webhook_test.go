@@ -21,23 +21,26 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
 	"github.com/go-chi/chi"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
 )
 
 func TestUseCaseWebhook_DecodeWebhookFromIoRead(t *testing.T) {
 	t.Run("Should decode webhook code without error", func(t *testing.T) {
+		repositoryID := uuid.New()
 		wh := &webhook.Webhook{
 			URL:    "http://google.com",
 			Method: "POST",
 			Headers: []webhook.Headers{
 				{Key: "x-authorization", Value: "1243567890"},
 			},
-			RepositoryID: uuid.New(),
+			RepositoryID: &repositoryID,
 			WorkspaceID:  uuid.New(),
 		}
 		body, err := parser.ParseEntityToIOReadCloser(wh)
@@ -49,13 +52,14 @@ func TestUseCaseWebhook_DecodeWebhookFromIoRead(t *testing.T) {
 		assert.NotEmpty(t, entity)
 	})
 	t.Run("Should decode webhook code with error invalid method type", func(t *testing.T) {
+		repositoryID := uuid.New()
 		wh := &webhook.Webhook{
 			URL:    "http://google.com",
 			Method: "GET",
 			Headers: []webhook.Headers{
 				{Key: "x-authorization", Value: "1243567890"},
 			},
-			RepositoryID: uuid.New(),
+			RepositoryID: &repositoryID,
 			WorkspaceID:  uuid.New(),
 		}
 		body, err := parser.ParseEntityToIOReadCloser(wh)
@@ -66,6 +70,115 @@ func TestUseCaseWebhook_DecodeWebhookFromIoRead(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "method: must be a valid value")
 	})
+	t.Run("Should decode workspace webhook with default events without error", func(t *testing.T) {
+		wh := &webhook.Webhook{
+			URL:         "http://google.com",
+			Method:      "POST",
+			WorkspaceID: uuid.New(),
+		}
+		body, err := parser.ParseEntityToIOReadCloser(wh)
+		assert.NoError(t, err)
+		r, _ := http.NewRequest(http.MethodPost, "/test", body)
+		entity, err := NewUseCaseWebhook().DecodeWebhookFromIoRead(r)
+		assert.NoError(t, err)
+		assert.Nil(t, entity.RepositoryID)
+		assert.Equal(t, webhook.EventsType{enums.EventNewAnalysis}, entity.Events)
+	})
+	t.Run("Should decode webhook code with error invalid event type", func(t *testing.T) {
+		wh := &webhook.Webhook{
+			URL:         "http://google.com",
+			Method:      "POST",
+			Events:      webhook.EventsType{"test"},
+			WorkspaceID: uuid.New(),
+		}
+		body, err := parser.ParseEntityToIOReadCloser(wh)
+		assert.NoError(t, err)
+		r, _ := http.NewRequest(http.MethodPost, "/test", body)
+		_, err = NewUseCaseWebhook().DecodeWebhookFromIoRead(r)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "events: (0: must be a valid value.)")
+	})
+	t.Run("Should decode webhook code with error when invalid format", func(t *testing.T) {
+		wh := &webhook.Webhook{
+			URL:         "http://google.com",
+			Method:      "POST",
+			Format:      "xml",
+			Template:    "",
+			WorkspaceID: uuid.New(),
+		}
+		body, err := parser.ParseEntityToIOReadCloser(wh)
+		assert.NoError(t, err)
+		r, _ := http.NewRequest(http.MethodPost, "/test", body)
+		_, err = NewUseCaseWebhook().DecodeWebhookFromIoRead(r)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "format: must be a valid value.")
+	})
+	t.Run("Should decode webhook code with error when template format without template", func(t *testing.T) {
+		wh := &webhook.Webhook{
+			URL:         "http://google.com",
+			Method:      "POST",
+			Format:      enums.FormatTemplate,
+			Template:    "",
+			WorkspaceID: uuid.New(),
+		}
+		body, err := parser.ParseEntityToIOReadCloser(wh)
+		assert.NoError(t, err)
+		r, _ := http.NewRequest(http.MethodPost, "/test", body)
+		_, err = NewUseCaseWebhook().DecodeWebhookFromIoRead(r)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "template: cannot be blank.")
+	})
+	t.Run("Should decode webhook code with error when template is invalid", func(t *testing.T) {
+		wh := &webhook.Webhook{
+			URL:         "http://google.com",
+			Method:      "POST",
+			Format:      enums.FormatTemplate,
+			Template:    "{{ .Title ",
+			WorkspaceID: uuid.New(),
+		}
+		body, err := parser.ParseEntityToIOReadCloser(wh)
+		assert.NoError(t, err)
+		r, _ := http.NewRequest(http.MethodPost, "/test", body)
+		_, err = NewUseCaseWebhook().DecodeWebhookFromIoRead(r)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "template: {HORUSEC} webhook template is not a valid go text/template")
+	})
+	t.Run("Should decode webhook code with error when filters are invalid", func(t *testing.T) {
+		wh := &webhook.Webhook{
+			URL:         "http://google.com",
+			Method:      "POST",
+			Filters:     webhook.Filters{MinSeverity: "test", VulnerabilityTypes: []vulnerability.Type{"test"}},
+			WorkspaceID: uuid.New(),
+		}
+		body, err := parser.ParseEntityToIOReadCloser(wh)
+		assert.NoError(t, err)
+		r, _ := http.NewRequest(http.MethodPost, "/test", body)
+		_, err = NewUseCaseWebhook().DecodeWebhookFromIoRead(r)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "minSeverity: must be a valid value")
+		assert.Contains(t, err.Error(), "vulnerabilityTypes: (0: must be a valid value.)")
+	})
+	t.Run("Should decode webhook with template format without error", func(t *testing.T) {
+		wh := &webhook.Webhook{
+			URL:         "http://google.com",
+			Method:      "POST",
+			Format:      enums.FormatTemplate,
+			Template:    `{"text": {{ json .Title }}}`,
+			WorkspaceID: uuid.New(),
+		}
+		body, err := parser.ParseEntityToIOReadCloser(wh)
+		assert.NoError(t, err)
+		r, _ := http.NewRequest(http.MethodPost, "/test", body)
+		entity, err := NewUseCaseWebhook().DecodeWebhookFromIoRead(r)
+		assert.NoError(t, err)
+		assert.Equal(t, enums.FormatTemplate, entity.Format)
+	})
+	t.Run("Should return error when body is null", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", ioutil.NopCloser(strings.NewReader("null")))
+		entity, err := NewUseCaseWebhook().DecodeWebhookFromIoRead(r)
+		assert.Error(t, err)
+		assert.Nil(t, entity)
+	})
 	t.Run("Should decode body empty and return nil value", func(t *testing.T) {
 		r, _ := http.NewRequest(http.MethodPost, "/test", ioutil.NopCloser(strings.NewReader(string("some wrong type"))))
 		uc := NewUseCaseWebhook()
@@ -120,3 +233,83 @@ func TestUseCaseWebhook_ExtractWorkspaceIDFromURL(t *testing.T) {
 		assert.Equal(t, entity, uuid.Nil)
 	})
 }
+
+func TestUseCaseWebhook_ExtractDeliveryIDFromURL(t *testing.T) {
+	t.Run("Should get deliveryID from url param without error", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("deliveryID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		uc := NewUseCaseWebhook()
+		entity, err := uc.ExtractDeliveryIDFromURL(r)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, entity)
+	})
+	t.Run("Should get deliveryID from url param with error", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("deliveryID", "wrong data type")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		uc := NewUseCaseWebhook()
+		entity, err := uc.ExtractDeliveryIDFromURL(r)
+		assert.Equal(t, enums.ErrorWrongDeliveryID, err)
+		assert.Equal(t, entity, uuid.Nil)
+	})
+}
+
+func TestUseCaseWebhook_ExtractPaginationFromURL(t *testing.T) {
+	t.Run("Should return default pagination when query is empty", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		page, size, err := NewUseCaseWebhook().ExtractPaginationFromURL(r)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, page)
+		assert.Equal(t, enums.DefaultPaginationSize, size)
+	})
+	t.Run("Should return pagination from query without error", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test?page=3&size=25", nil)
+		page, size, err := NewUseCaseWebhook().ExtractPaginationFromURL(r)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, page)
+		assert.Equal(t, 25, size)
+	})
+	t.Run("Should return error when page is invalid", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test?page=abc", nil)
+		_, _, err := NewUseCaseWebhook().ExtractPaginationFromURL(r)
+		assert.Equal(t, enums.ErrorWrongPagination, err)
+	})
+	t.Run("Should return error when size is lower than one", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/test?size=0", nil)
+		_, _, err := NewUseCaseWebhook().ExtractPaginationFromURL(r)
+		assert.Equal(t, enums.ErrorWrongPagination, err)
+	})
+}
+
+func TestUseCaseWebhook_DecodeRotateSecretFromIoRead(t *testing.T) {
+	t.Run("Should decode rotate secret without error", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", strings.NewReader(`{"gracePeriodHours": 48}`))
+		entity, err := NewUseCaseWebhook().DecodeRotateSecretFromIoRead(r)
+		assert.NoError(t, err)
+		assert.Equal(t, 48, *entity.GracePeriodHours)
+	})
+	t.Run("Should decode rotate secret with default values when body is empty", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", strings.NewReader(""))
+		entity, err := NewUseCaseWebhook().DecodeRotateSecretFromIoRead(r)
+		assert.NoError(t, err)
+		assert.Nil(t, entity.GracePeriodHours)
+	})
+	t.Run("Should return error when body is invalid", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", strings.NewReader("invalid"))
+		_, err := NewUseCaseWebhook().DecodeRotateSecretFromIoRead(r)
+		assert.Error(t, err)
+	})
+	t.Run("Should return error when grace period is greater than max allowed", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", strings.NewReader(`{"gracePeriodHours": 1000}`))
+		_, err := NewUseCaseWebhook().DecodeRotateSecretFromIoRead(r)
+		assert.Error(t, err)
+	})
+	t.Run("Should return error when grace period is negative", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", strings.NewReader(`{"gracePeriodHours": -1}`))
+		_, err := NewUseCaseWebhook().DecodeRotateSecretFromIoRead(r)
+		assert.Error(t, err)
+	})
+}